@@ -0,0 +1,108 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/bls"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// CandidateBLSKeyBaseIntrinsicGas represents the base intrinsic gas for CandidateBLSKey
+	CandidateBLSKeyBaseIntrinsicGas = uint64(10000)
+)
+
+// CandidateBLSKey defines the action of registering the BLS public key a candidate endorses blocks with
+type CandidateBLSKey struct {
+	AbstractAction
+
+	publicKey []byte
+	proof     []byte
+}
+
+// NewCandidateBLSKey returns a CandidateBLSKey instance
+func NewCandidateBLSKey(
+	nonce uint64,
+	publicKey []byte,
+	proof []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CandidateBLSKey, error) {
+	return &CandidateBLSKey{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		publicKey: publicKey,
+		proof:     proof,
+	}, nil
+}
+
+// NewCandidateBLSKeyFromPrivateKey returns a CandidateBLSKey instance registering the public key of sk
+func NewCandidateBLSKeyFromPrivateKey(
+	nonce uint64,
+	sk *bls.PrivateKey,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CandidateBLSKey, error) {
+	return NewCandidateBLSKey(nonce, sk.PublicKey().Bytes(), sk.ProvePossession().Bytes(), gasLimit, gasPrice)
+}
+
+// PublicKey returns the BLS public key
+func (cb *CandidateBLSKey) PublicKey() []byte { return cb.publicKey }
+
+// ProofOfPossession returns the signature of the public key by the private key
+func (cb *CandidateBLSKey) ProofOfPossession() []byte { return cb.proof }
+
+// Serialize returns a raw byte stream of the CandidateBLSKey struct
+func (cb *CandidateBLSKey) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(cb.Proto()))
+}
+
+// Proto converts to protobuf CandidateBLSKey Action
+func (cb *CandidateBLSKey) Proto() *iotextypes.CandidateBLSKey {
+	return &iotextypes.CandidateBLSKey{
+		PublicKey:         cb.publicKey,
+		ProofOfPossession: cb.proof,
+	}
+}
+
+// LoadProto converts a protobuf's Action to CandidateBLSKey
+func (cb *CandidateBLSKey) LoadProto(pbAct *iotextypes.CandidateBLSKey) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	cb.publicKey = pbAct.GetPublicKey()
+	cb.proof = pbAct.GetProofOfPossession()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a CandidateBLSKey
+func (cb *CandidateBLSKey) IntrinsicGas() (uint64, error) {
+	return CandidateBLSKeyBaseIntrinsicGas, nil
+}
+
+// Cost returns the total cost of a CandidateBLSKey
+func (cb *CandidateBLSKey) Cost() (*big.Int, error) {
+	intrinsicGas, err := cb.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the CandidateBLSKey")
+	}
+	fee := big.NewInt(0).Mul(cb.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return fee, nil
+}
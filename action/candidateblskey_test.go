@@ -0,0 +1,44 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/pkg/bls"
+)
+
+func TestCandidateBLSKey(t *testing.T) {
+	require := require.New(t)
+	sk, err := bls.DerivePrivateKey([]byte("delegate"))
+	require.NoError(err)
+	cb, err := NewCandidateBLSKeyFromPrivateKey(5, sk, 200000, big.NewInt(2000))
+	require.NoError(err)
+
+	require.Equal(uint64(5), cb.Nonce())
+	require.Equal(uint64(200000), cb.GasLimit())
+	require.Equal(big.NewInt(2000), cb.GasPrice())
+	require.Equal(sk.PublicKey().Bytes(), cb.PublicKey())
+	require.Equal(sk.ProvePossession().Bytes(), cb.ProofOfPossession())
+
+	gas, err := cb.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(uint64(10000), gas)
+	cost, err := cb.Cost()
+	require.NoError(err)
+	require.Equal("20000000", cost.Text(10))
+
+	cb2 := &CandidateBLSKey{}
+	require.NoError(cb2.LoadProto(cb.Proto()))
+	require.Equal(cb.PublicKey(), cb2.PublicKey())
+	require.Equal(cb.ProofOfPossession(), cb2.ProofOfPossession())
+	require.Equal(cb.Serialize(), cb2.Serialize())
+	require.Error(cb2.LoadProto(nil))
+}
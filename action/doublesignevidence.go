@@ -0,0 +1,200 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	blake2b "github.com/minio/blake2b-simd"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// ErrInvalidEvidence indicates that the double sign evidence does not prove a double sign
+var ErrInvalidEvidence = errors.New("invalid double sign evidence")
+
+// DoubleSignEvidence is the system action that carries the proof of a delegate endorsing two different blocks with
+// the same consensus vote topic in the same round. The endorsement timestamp of a vote is derived from the round
+// start time, so two votes of the same topic and timestamp belong to the same height and round.
+type DoubleSignEvidence struct {
+	AbstractAction
+
+	message1 *iotextypes.ConsensusMessage
+	message2 *iotextypes.ConsensusMessage
+}
+
+// consensusVote is the signed document of a consensus vote, its hash must match the one used by rolldpos
+type consensusVote struct {
+	*iotextypes.ConsensusVote
+}
+
+func (v *consensusVote) Hash() ([]byte, error) {
+	ser, err := proto.Marshal(v.ConsensusVote)
+	if err != nil {
+		return nil, err
+	}
+	h := blake2b.Sum256(ser)
+	return h[:], nil
+}
+
+// NewDoubleSignEvidence instantiates a double sign evidence action struct
+func NewDoubleSignEvidence(
+	nonce uint64,
+	message1 *iotextypes.ConsensusMessage,
+	message2 *iotextypes.ConsensusMessage,
+) *DoubleSignEvidence {
+	return &DoubleSignEvidence{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: 0,
+			gasPrice: big.NewInt(0),
+		},
+		message1: message1,
+		message2: message2,
+	}
+}
+
+// Messages returns the two conflicting consensus messages
+func (ds *DoubleSignEvidence) Messages() (*iotextypes.ConsensusMessage, *iotextypes.ConsensusMessage) {
+	return ds.message1, ds.message2
+}
+
+// Height returns the consensus height of the double sign
+func (ds *DoubleSignEvidence) Height() uint64 { return ds.message1.GetHeight() }
+
+// Verify checks that the two messages are valid votes signed by the same endorser, with the same topic and
+// timestamp but for different blocks
+func (ds *DoubleSignEvidence) Verify() error {
+	if ds.message1 == nil || ds.message2 == nil {
+		return errors.Wrap(ErrInvalidEvidence, "missing consensus message")
+	}
+	if ds.message1.GetHeight() != ds.message2.GetHeight() {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different heights")
+	}
+	vote1, vote2 := ds.message1.GetVote(), ds.message2.GetVote()
+	if vote1 == nil || vote2 == nil {
+		return errors.Wrap(ErrInvalidEvidence, "consensus message is not a vote")
+	}
+	if vote1.GetTopic() != vote2.GetTopic() {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different topics")
+	}
+	if len(vote1.GetBlockHash()) == 0 || len(vote2.GetBlockHash()) == 0 {
+		return errors.Wrap(ErrInvalidEvidence, "vote for empty block")
+	}
+	if bytes.Equal(vote1.GetBlockHash(), vote2.GetBlockHash()) {
+		return errors.Wrap(ErrInvalidEvidence, "votes for the same block")
+	}
+	en1, en2 := &endorsement.Endorsement{}, &endorsement.Endorsement{}
+	if err := en1.LoadProto(ds.message1.GetEndorsement()); err != nil {
+		return errors.Wrap(ErrInvalidEvidence, err.Error())
+	}
+	if err := en2.LoadProto(ds.message2.GetEndorsement()); err != nil {
+		return errors.Wrap(ErrInvalidEvidence, err.Error())
+	}
+	if !bytes.Equal(en1.Endorser().Bytes(), en2.Endorser().Bytes()) {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different endorsers")
+	}
+	if !en1.Timestamp().Equal(en2.Timestamp()) {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different rounds")
+	}
+	if !endorsement.VerifyEndorsement(&consensusVote{vote1}, en1) ||
+		!endorsement.VerifyEndorsement(&consensusVote{vote2}, en2) {
+		return errors.Wrap(ErrInvalidEvidence, "invalid endorsement signature")
+	}
+	return nil
+}
+
+// Offender returns the address of the delegate who double signed
+func (ds *DoubleSignEvidence) Offender() (address.Address, error) {
+	en := &endorsement.Endorsement{}
+	if err := en.LoadProto(ds.message1.GetEndorsement()); err != nil {
+		return nil, err
+	}
+	return address.FromBytes(en.Endorser().Hash())
+}
+
+// Key returns the identity of the double sign, which does not depend on the order of the two messages
+func (ds *DoubleSignEvidence) Key() (hash.Hash256, error) {
+	en := &endorsement.Endorsement{}
+	if err := en.LoadProto(ds.message1.GetEndorsement()); err != nil {
+		return hash.ZeroHash256, err
+	}
+	ts := en.Timestamp()
+	key := append(en.Endorser().Bytes(), byteutil.Uint64ToBytes(uint64(ts.Unix()))...)
+	key = append(key, byteutil.Uint32ToBytes(uint32(ts.Nanosecond()))...)
+	key = append(key, byteutil.Uint32ToBytes(uint32(ds.message1.GetVote().GetTopic()))...)
+	return hash.Hash256b(key), nil
+}
+
+// Proto converts the double sign evidence into a proto message
+func (ds *DoubleSignEvidence) Proto() *iotextypes.DoubleSignEvidence {
+	return &iotextypes.DoubleSignEvidence{
+		Message1: serializeConsensusMessage(ds.message1),
+		Message2: serializeConsensusMessage(ds.message2),
+	}
+}
+
+// LoadProto converts a proto message into double sign evidence
+func (ds *DoubleSignEvidence) LoadProto(pbAct *iotextypes.DoubleSignEvidence) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if ds == nil {
+		return errors.New("nil action to load proto")
+	}
+	*ds = DoubleSignEvidence{}
+	var err error
+	if ds.message1, err = deserializeConsensusMessage(pbAct.GetMessage1()); err != nil {
+		return errors.Wrap(err, "failed to load the first consensus message")
+	}
+	if ds.message2, err = deserializeConsensusMessage(pbAct.GetMessage2()); err != nil {
+		return errors.Wrap(err, "failed to load the second consensus message")
+	}
+	return nil
+}
+
+func serializeConsensusMessage(msg *iotextypes.ConsensusMessage) []byte {
+	if msg == nil {
+		return nil
+	}
+	return byteutil.Must(proto.Marshal(msg))
+}
+
+func deserializeConsensusMessage(buf []byte) (*iotextypes.ConsensusMessage, error) {
+	if len(buf) == 0 {
+		return nil, nil
+	}
+	msg := &iotextypes.ConsensusMessage{}
+	if err := proto.Unmarshal(buf, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Serialize returns the byte representation of the double sign evidence
+func (ds *DoubleSignEvidence) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ds.Proto()))
+}
+
+// IntrinsicGas returns the intrinsic gas of a double sign evidence
+func (ds *DoubleSignEvidence) IntrinsicGas() (uint64, error) {
+	return 0, nil
+}
+
+// Cost returns the total cost of a double sign evidence
+func (ds *DoubleSignEvidence) Cost() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func signedVote(
+	t *testing.T,
+	sk crypto.PrivateKey,
+	height uint64,
+	blkHash []byte,
+	topic iotextypes.ConsensusVote_Topic,
+	ts time.Time,
+) *iotextypes.ConsensusMessage {
+	vote := &iotextypes.ConsensusVote{BlockHash: blkHash, Topic: topic}
+	en, err := endorsement.Endorse(sk, &consensusVote{vote}, ts)
+	require.NoError(t, err)
+	enProto, err := en.Proto()
+	require.NoError(t, err)
+	return &iotextypes.ConsensusMessage{
+		Height:      height,
+		Endorsement: enProto,
+		Msg:         &iotextypes.ConsensusMessage_Vote{Vote: vote},
+	}
+}
+
+func TestDoubleSignEvidence(t *testing.T) {
+	require := require.New(t)
+	sk := identityset.PrivateKey(27)
+	now := time.Now()
+	msg1 := signedVote(t, sk, 10, []byte{1}, iotextypes.ConsensusVote_COMMIT, now)
+	msg2 := signedVote(t, sk, 10, []byte{2}, iotextypes.ConsensusVote_COMMIT, now)
+
+	ev := NewDoubleSignEvidence(1, msg1, msg2)
+	require.NoError(ev.Verify())
+	require.Equal(uint64(1), ev.Nonce())
+	require.Equal(uint64(10), ev.Height())
+	gas, err := ev.IntrinsicGas()
+	require.NoError(err)
+	require.Zero(gas)
+	cost, err := ev.Cost()
+	require.NoError(err)
+	require.Zero(cost.Sign())
+	offender, err := ev.Offender()
+	require.NoError(err)
+	require.Equal(identityset.Address(27).String(), offender.String())
+
+	// the key does not depend on the order of the messages
+	key, err := ev.Key()
+	require.NoError(err)
+	key2, err := NewDoubleSignEvidence(1, msg2, msg1).Key()
+	require.NoError(err)
+	require.Equal(key, key2)
+
+	ev2 := &DoubleSignEvidence{}
+	require.NoError(ev2.LoadProto(ev.Proto()))
+	require.NoError(ev2.Verify())
+	require.Equal(ev.Serialize(), ev2.Serialize())
+
+	// round trip through the action envelope
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetAction(ev).Build()
+	elp2 := Envelope{}
+	require.NoError(elp2.LoadProto(elp.Proto()))
+	ev3, ok := elp2.Action().(*DoubleSignEvidence)
+	require.True(ok)
+	require.NoError(ev3.Verify())
+	require.Equal(ev.Serialize(), ev3.Serialize())
+	require.Equal(elp.Hash(), elp2.Hash())
+	require.Error(ev3.LoadProto(&iotextypes.DoubleSignEvidence{Message1: []byte{0xff}}))
+
+	tests := []struct {
+		name string
+		msg  *iotextypes.ConsensusMessage
+	}{
+		{"same block", signedVote(t, sk, 10, []byte{1}, iotextypes.ConsensusVote_COMMIT, now)},
+		{"empty block", signedVote(t, sk, 10, []byte{}, iotextypes.ConsensusVote_COMMIT, now)},
+		{"different height", signedVote(t, sk, 11, []byte{2}, iotextypes.ConsensusVote_COMMIT, now)},
+		{"different topic", signedVote(t, sk, 10, []byte{2}, iotextypes.ConsensusVote_PROPOSAL, now)},
+		{"different round", signedVote(t, sk, 10, []byte{2}, iotextypes.ConsensusVote_COMMIT, now.Add(time.Second))},
+		{"different endorser", signedVote(t, identityset.PrivateKey(28), 10, []byte{2}, iotextypes.ConsensusVote_COMMIT, now)},
+		{"missing message", nil},
+	}
+	for _, test := range tests {
+		err := NewDoubleSignEvidence(1, msg1, test.msg).Verify()
+		require.Equal(ErrInvalidEvidence, errors.Cause(err), test.name)
+	}
+
+	// tampered signature
+	msg2.GetVote().BlockHash = []byte{3}
+	require.Equal(ErrInvalidEvidence, errors.Cause(NewDoubleSignEvidence(1, msg1, msg2).Verify()))
+}
@@ -88,6 +88,8 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		actCore.Action = &iotextypes.ActionCore_DepositToRewardingFund{DepositToRewardingFund: act.Proto()}
 	case *PutPollResult:
 		actCore.Action = &iotextypes.ActionCore_PutPollResult{PutPollResult: act.Proto()}
+	case *DoubleSignEvidence:
+		actCore.Action = &iotextypes.ActionCore_DoubleSignEvidence{DoubleSignEvidence: act.Proto()}
 	case *CreateStake:
 		actCore.Action = &iotextypes.ActionCore_StakeCreate{StakeCreate: act.Proto()}
 	case *Unstake:
@@ -106,6 +108,18 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		actCore.Action = &iotextypes.ActionCore_CandidateRegister{CandidateRegister: act.Proto()}
 	case *CandidateUpdate:
 		actCore.Action = &iotextypes.ActionCore_CandidateUpdate{CandidateUpdate: act.Proto()}
+	case *CandidateBLSKey:
+		actCore.Action = &iotextypes.ActionCore_CandidateBLSKey{CandidateBLSKey: act.Proto()}
+	case *SplitStake:
+		actCore.Action = &iotextypes.ActionCore_StakeSplit{StakeSplit: act.Proto()}
+	case *MergeStake:
+		actCore.Action = &iotextypes.ActionCore_StakeMerge{StakeMerge: act.Proto()}
+	case *CompoundStake:
+		actCore.Action = &iotextypes.ActionCore_StakeCompound{StakeCompound: act.Proto()}
+	case *ProposeParameter:
+		actCore.Action = &iotextypes.ActionCore_ProposeParameter{ProposeParameter: act.Proto()}
+	case *VoteProposal:
+		actCore.Action = &iotextypes.ActionCore_VoteProposal{VoteProposal: act.Proto()}
 	default:
 		log.S().Panicf("Cannot convert type of action %T.\r\n", act)
 	}
@@ -164,6 +178,12 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 			return err
 		}
 		elp.payload = act
+	case pbAct.GetDoubleSignEvidence() != nil:
+		act := &DoubleSignEvidence{}
+		if err := act.LoadProto(pbAct.GetDoubleSignEvidence()); err != nil {
+			return err
+		}
+		elp.payload = act
 
 	case pbAct.GetStakeCreate() != nil:
 		act := &CreateStake{}
@@ -219,6 +239,42 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 			return err
 		}
 		elp.payload = act
+	case pbAct.GetCandidateBLSKey() != nil:
+		act := &CandidateBLSKey{}
+		if err := act.LoadProto(pbAct.GetCandidateBLSKey()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeSplit() != nil:
+		act := &SplitStake{}
+		if err := act.LoadProto(pbAct.GetStakeSplit()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeMerge() != nil:
+		act := &MergeStake{}
+		if err := act.LoadProto(pbAct.GetStakeMerge()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeCompound() != nil:
+		act := &CompoundStake{}
+		if err := act.LoadProto(pbAct.GetStakeCompound()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetProposeParameter() != nil:
+		act := &ProposeParameter{}
+		if err := act.LoadProto(pbAct.GetProposeParameter()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetVoteProposal() != nil:
+		act := &VoteProposal{}
+		if err := act.LoadProto(pbAct.GetVoteProposal()); err != nil {
+			return err
+		}
+		elp.payload = act
 	default:
 		return errors.Errorf("no applicable action to handle in action proto %+v", pbAct)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/pkg/bls"
 	"github.com/iotexproject/iotex-core/pkg/unit"
 	"github.com/iotexproject/iotex-core/test/identityset"
 )
@@ -81,6 +82,34 @@ func TestEnvelope_LoadProto(t *testing.T) {
 	proto := evlp.Proto()
 	req.NoError(evlp.LoadProto(proto))
 }
+func TestEnvelope_ProtoRoundTrip(t *testing.T) {
+	req := require.New(t)
+	split, err := NewSplitStake(1, 2, "100", []byte("split"), 10000, big.NewInt(10))
+	req.NoError(err)
+	merge, err := NewMergeStake(2, 3, []uint64{4, 5}, []byte("merge"), 10000, big.NewInt(10))
+	req.NoError(err)
+	compound, err := NewCompoundStake(3, 4, true, []byte("compound"), 10000, big.NewInt(10))
+	req.NoError(err)
+	propose, err := NewProposeParameter(4, "BlockReward", "10", []byte("propose"), 10000, big.NewInt(10))
+	req.NoError(err)
+	vote, err := NewVoteProposal(5, 1, true, []byte("vote"), 10000, big.NewInt(10))
+	req.NoError(err)
+	sk, err := bls.DerivePrivateKey([]byte("delegate"))
+	req.NoError(err)
+	blsKey, err := NewCandidateBLSKeyFromPrivateKey(6, sk, 10000, big.NewInt(10))
+	req.NoError(err)
+	for _, act := range []actionPayload{split, merge, compound, propose, vote, blsKey} {
+		eb := EnvelopeBuilder{}
+		evlp := eb.SetAction(act).SetNonce(1).SetGasLimit(10000).SetGasPrice(big.NewInt(10)).Build()
+		pb := evlp.Proto()
+		req.NotNil(pb.GetAction())
+
+		loaded := &Envelope{}
+		req.NoError(loaded.LoadProto(pb))
+		req.Equal(pb, loaded.Proto())
+		req.Equal(evlp.Serialize(), loaded.Serialize())
+	}
+}
 func TestEnvelope_Serialize(t *testing.T) {
 	req := require.New(t)
 	evlp, _ := createEnvelope()
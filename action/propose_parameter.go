@@ -0,0 +1,114 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// ProposeParameterPayloadGas represents the ProposeParameter payload gas per uint
+	ProposeParameterPayloadGas = uint64(100)
+	// ProposeParameterBaseIntrinsicGas represents the base intrinsic gas for ProposeParameter
+	ProposeParameterBaseIntrinsicGas = uint64(10000)
+)
+
+// ErrInvalidProposal indicates an invalid parameter change proposal
+var ErrInvalidProposal = errors.New("invalid proposal")
+
+// ProposeParameter defines the action of proposing to change a protocol parameter
+type ProposeParameter struct {
+	AbstractAction
+
+	parameter string
+	value     string
+	payload   []byte
+}
+
+// NewProposeParameter returns a ProposeParameter instance
+func NewProposeParameter(
+	nonce uint64,
+	parameter string,
+	value string,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*ProposeParameter, error) {
+	if parameter == "" || value == "" {
+		return nil, errors.Wrapf(ErrInvalidProposal, "parameter %s, value %s", parameter, value)
+	}
+	return &ProposeParameter{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		parameter: parameter,
+		value:     value,
+		payload:   payload,
+	}, nil
+}
+
+// Parameter returns the name of the parameter to change
+func (pp *ProposeParameter) Parameter() string { return pp.parameter }
+
+// Value returns the proposed value of the parameter in decimal string format
+func (pp *ProposeParameter) Value() string { return pp.value }
+
+// Payload returns the payload bytes
+func (pp *ProposeParameter) Payload() []byte { return pp.payload }
+
+// Serialize returns a raw byte stream of the ProposeParameter struct
+func (pp *ProposeParameter) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(pp.Proto()))
+}
+
+// Proto converts to protobuf ProposeParameter Action
+func (pp *ProposeParameter) Proto() *iotextypes.ProposeParameter {
+	return &iotextypes.ProposeParameter{
+		Parameter: pp.parameter,
+		Value:     pp.value,
+		Payload:   pp.payload,
+	}
+}
+
+// LoadProto converts a protobuf's Action to ProposeParameter
+func (pp *ProposeParameter) LoadProto(pbAct *iotextypes.ProposeParameter) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	pp.parameter = pbAct.GetParameter()
+	pp.value = pbAct.GetValue()
+	pp.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a ProposeParameter
+func (pp *ProposeParameter) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(pp.Payload()))
+	return calculateIntrinsicGas(ProposeParameterBaseIntrinsicGas, ProposeParameterPayloadGas, payloadSize)
+}
+
+// Cost returns the total cost of a ProposeParameter
+func (pp *ProposeParameter) Cost() (*big.Int, error) {
+	intrinsicGas, err := pp.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the ProposeParameter")
+	}
+	proposeFee := big.NewInt(0).Mul(pp.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return proposeFee, nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposeParameter(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewProposeParameter(nonce, "", "10", payload, gaslimit, gasprice)
+	require.Equal(ErrInvalidProposal, errors.Cause(err))
+	_, err = NewProposeParameter(nonce, "BlockReward", "", payload, gaslimit, gasprice)
+	require.Equal(ErrInvalidProposal, errors.Cause(err))
+
+	pp, err := NewProposeParameter(nonce, "BlockReward", "10", payload, gaslimit, gasprice)
+	require.NoError(err)
+	require.Equal(gaslimit, pp.GasLimit())
+	require.Equal(gasprice, pp.GasPrice())
+	require.Equal(nonce, pp.Nonce())
+	require.Equal(payload, pp.Payload())
+	require.Equal("BlockReward", pp.Parameter())
+	require.Equal("10", pp.Value())
+
+	gas, err := pp.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(uint64(10700), gas)
+	cost, err := pp.Cost()
+	require.NoError(err)
+	require.Equal("107000", cost.Text(10))
+
+	pp2 := &ProposeParameter{}
+	require.NoError(pp2.LoadProto(pp.Proto()))
+	require.Equal(pp.Parameter(), pp2.Parameter())
+	require.Equal(pp.Value(), pp2.Value())
+	require.Equal(pp.Serialize(), pp2.Serialize())
+	require.Error(pp2.LoadProto(nil))
+}
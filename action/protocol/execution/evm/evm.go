@@ -24,6 +24,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -94,6 +95,16 @@ func NewParams(
 	if blkCtx.BlockHeight > 0 && hu.IsPre(config.Aleutian, blkCtx.BlockHeight) && gasLimit > preAleutianActionGasLimit {
 		gasLimit = preAleutianActionGasLimit
 	}
+	if blkCtx.BlockHeight > 0 && !hu.IsPre(config.Aleutian, blkCtx.BlockHeight) {
+		// the action gas limit cap could be set by governance, which only lowers the gas limit of the execution
+		gasLimitCap, err := governance.Uint64Parameter(ctx, stateDB.sm, governance.ActionGasLimit, gasLimit)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get action gas limit")
+		}
+		if gasLimit > gasLimitCap {
+			gasLimit = gasLimitCap
+		}
+	}
 
 	context := vm.Context{
 		CanTransfer: CanTransfer,
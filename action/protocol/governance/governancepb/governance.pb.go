@@ -0,0 +1,390 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: governance.proto
+
+package governancepb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProposalStatus int32
+
+const (
+	ProposalStatus_PENDING  ProposalStatus = 0
+	ProposalStatus_PASSED   ProposalStatus = 1
+	ProposalStatus_REJECTED ProposalStatus = 2
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "PASSED",
+	2: "REJECTED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PENDING":  0,
+	"PASSED":   1,
+	"REJECTED": 2,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{0}
+}
+
+type Proposal struct {
+	Id                   uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer             string         `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Parameter            string         `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Value                string         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	StartEpoch           uint64         `protobuf:"varint,5,opt,name=startEpoch,proto3" json:"startEpoch,omitempty"`
+	EndEpoch             uint64         `protobuf:"varint,6,opt,name=endEpoch,proto3" json:"endEpoch,omitempty"`
+	TotalWeight          string         `protobuf:"bytes,7,opt,name=totalWeight,proto3" json:"totalWeight,omitempty"`
+	ApproveWeight        string         `protobuf:"bytes,8,opt,name=approveWeight,proto3" json:"approveWeight,omitempty"`
+	RejectWeight         string         `protobuf:"bytes,9,opt,name=rejectWeight,proto3" json:"rejectWeight,omitempty"`
+	Voters               []string       `protobuf:"bytes,10,rep,name=voters,proto3" json:"voters,omitempty"`
+	Status               ProposalStatus `protobuf:"varint,11,opt,name=status,proto3,enum=governancepb.ProposalStatus" json:"status,omitempty"`
+	ActivationEpoch      uint64         `protobuf:"varint,12,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{0}
+}
+
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Proposal.Unmarshal(m, b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return xxx_messageInfo_Proposal.Size(m)
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Proposal) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
+}
+
+func (m *Proposal) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Proposal) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *Proposal) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *Proposal) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return ""
+}
+
+func (m *Proposal) GetApproveWeight() string {
+	if m != nil {
+		return m.ApproveWeight
+	}
+	return ""
+}
+
+func (m *Proposal) GetRejectWeight() string {
+	if m != nil {
+		return m.RejectWeight
+	}
+	return ""
+}
+
+func (m *Proposal) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *Proposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PENDING
+}
+
+func (m *Proposal) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+type ProposalList struct {
+	Proposals            []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProposalList) Reset()         { *m = ProposalList{} }
+func (m *ProposalList) String() string { return proto.CompactTextString(m) }
+func (*ProposalList) ProtoMessage()    {}
+func (*ProposalList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{1}
+}
+
+func (m *ProposalList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalList.Unmarshal(m, b)
+}
+func (m *ProposalList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalList.Marshal(b, m, deterministic)
+}
+func (m *ProposalList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalList.Merge(m, src)
+}
+func (m *ProposalList) XXX_Size() int {
+	return xxx_messageInfo_ProposalList.Size(m)
+}
+func (m *ProposalList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalList proto.InternalMessageInfo
+
+func (m *ProposalList) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type ProposalMeta struct {
+	NextID               uint64   `protobuf:"varint,1,opt,name=nextID,proto3" json:"nextID,omitempty"`
+	PendingIDs           []uint64 `protobuf:"varint,2,rep,packed,name=pendingIDs,proto3" json:"pendingIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposalMeta) Reset()         { *m = ProposalMeta{} }
+func (m *ProposalMeta) String() string { return proto.CompactTextString(m) }
+func (*ProposalMeta) ProtoMessage()    {}
+func (*ProposalMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{2}
+}
+
+func (m *ProposalMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalMeta.Unmarshal(m, b)
+}
+func (m *ProposalMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalMeta.Marshal(b, m, deterministic)
+}
+func (m *ProposalMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMeta.Merge(m, src)
+}
+func (m *ProposalMeta) XXX_Size() int {
+	return xxx_messageInfo_ProposalMeta.Size(m)
+}
+func (m *ProposalMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalMeta proto.InternalMessageInfo
+
+func (m *ProposalMeta) GetNextID() uint64 {
+	if m != nil {
+		return m.NextID
+	}
+	return 0
+}
+
+func (m *ProposalMeta) GetPendingIDs() []uint64 {
+	if m != nil {
+		return m.PendingIDs
+	}
+	return nil
+}
+
+type ParameterChange struct {
+	ActivationEpoch      uint64   `protobuf:"varint,1,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParameterChange) Reset()         { *m = ParameterChange{} }
+func (m *ParameterChange) String() string { return proto.CompactTextString(m) }
+func (*ParameterChange) ProtoMessage()    {}
+func (*ParameterChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{3}
+}
+
+func (m *ParameterChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParameterChange.Unmarshal(m, b)
+}
+func (m *ParameterChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParameterChange.Marshal(b, m, deterministic)
+}
+func (m *ParameterChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterChange.Merge(m, src)
+}
+func (m *ParameterChange) XXX_Size() int {
+	return xxx_messageInfo_ParameterChange.Size(m)
+}
+func (m *ParameterChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterChange proto.InternalMessageInfo
+
+func (m *ParameterChange) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *ParameterChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ParameterSchedule struct {
+	Changes              []*ParameterChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ParameterSchedule) Reset()         { *m = ParameterSchedule{} }
+func (m *ParameterSchedule) String() string { return proto.CompactTextString(m) }
+func (*ParameterSchedule) ProtoMessage()    {}
+func (*ParameterSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{4}
+}
+
+func (m *ParameterSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParameterSchedule.Unmarshal(m, b)
+}
+func (m *ParameterSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParameterSchedule.Marshal(b, m, deterministic)
+}
+func (m *ParameterSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSchedule.Merge(m, src)
+}
+func (m *ParameterSchedule) XXX_Size() int {
+	return xxx_messageInfo_ParameterSchedule.Size(m)
+}
+func (m *ParameterSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSchedule proto.InternalMessageInfo
+
+func (m *ParameterSchedule) GetChanges() []*ParameterChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("governancepb.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*Proposal)(nil), "governancepb.Proposal")
+	proto.RegisterType((*ProposalList)(nil), "governancepb.ProposalList")
+	proto.RegisterType((*ProposalMeta)(nil), "governancepb.ProposalMeta")
+	proto.RegisterType((*ParameterChange)(nil), "governancepb.ParameterChange")
+	proto.RegisterType((*ParameterSchedule)(nil), "governancepb.ParameterSchedule")
+}
+
+func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
+
+var fileDescriptor_e18a03da5266c714 = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0xad, 0xa4, 0x44, 0xb6, 0xc6, 0xaa, 0xe3, 0x0e, 0x25, 0x2c, 0x25, 0x2d, 0x42, 0xf4, 0x20,
+	0x7a, 0xf0, 0x21, 0x0d, 0xe4, 0x5c, 0x22, 0xb5, 0xb8, 0xa4, 0xc1, 0x95, 0x0b, 0x3d, 0x6f, 0xa4,
+	0xc1, 0x56, 0x71, 0xb5, 0xcb, 0xee, 0x5a, 0xf4, 0x37, 0xf4, 0x57, 0x07, 0xaf, 0x25, 0xcb, 0x36,
+	0x3e, 0xbe, 0x0f, 0xde, 0xe8, 0xcd, 0xac, 0x60, 0xb2, 0x14, 0x0d, 0xa9, 0x9a, 0xd7, 0x05, 0x4d,
+	0xa5, 0x12, 0x46, 0x60, 0xd8, 0x33, 0xf2, 0x39, 0xfe, 0xef, 0xc1, 0x70, 0xae, 0x84, 0x14, 0x9a,
+	0xaf, 0x71, 0x0c, 0x6e, 0x55, 0x32, 0x27, 0x72, 0x92, 0x8b, 0xdc, 0xad, 0x4a, 0x7c, 0x07, 0x43,
+	0x69, 0x35, 0x52, 0xcc, 0x8d, 0x9c, 0x24, 0xc8, 0xf7, 0x18, 0x6f, 0x20, 0x90, 0x5c, 0xf1, 0xbf,
+	0x64, 0x48, 0x31, 0xcf, 0x8a, 0x3d, 0x81, 0x6f, 0xe1, 0xb2, 0xe1, 0xeb, 0x0d, 0xb1, 0x0b, 0xab,
+	0xec, 0x00, 0x7e, 0x00, 0xd0, 0x86, 0x2b, 0x93, 0x49, 0x51, 0xac, 0xd8, 0xa5, 0x9d, 0x73, 0xc0,
+	0x6c, 0xe7, 0x51, 0x5d, 0xee, 0x54, 0xdf, 0xaa, 0x7b, 0x8c, 0x11, 0x8c, 0x8c, 0x30, 0x7c, 0xfd,
+	0x9b, 0xaa, 0xe5, 0xca, 0xb0, 0x81, 0xcd, 0x3d, 0xa4, 0xf0, 0x23, 0xbc, 0xe6, 0x52, 0x2a, 0xd1,
+	0x50, 0xeb, 0x19, 0x5a, 0xcf, 0x31, 0x89, 0x31, 0x84, 0x8a, 0xfe, 0x50, 0x61, 0x5a, 0x53, 0x60,
+	0x4d, 0x47, 0x1c, 0x5e, 0x83, 0xdf, 0x08, 0x43, 0x4a, 0x33, 0x88, 0xbc, 0x24, 0xc8, 0x5b, 0x84,
+	0x77, 0xe0, 0x6b, 0xc3, 0xcd, 0x46, 0xb3, 0x51, 0xe4, 0x24, 0xe3, 0xdb, 0x9b, 0xe9, 0xe1, 0x2e,
+	0xa7, 0xdd, 0x1e, 0x17, 0xd6, 0x93, 0xb7, 0x5e, 0x4c, 0xe0, 0x8a, 0x17, 0xa6, 0x6a, 0xb8, 0xa9,
+	0x44, 0xbd, 0x2b, 0x17, 0xda, 0x72, 0xa7, 0x74, 0x9c, 0x42, 0xd8, 0x65, 0x3c, 0x56, 0xda, 0xe0,
+	0x1d, 0x04, 0xb2, 0xc5, 0x9a, 0x39, 0x91, 0x97, 0x8c, 0x6e, 0xaf, 0xcf, 0x8f, 0xcc, 0x7b, 0x63,
+	0xfc, 0xb5, 0x4f, 0xf9, 0x41, 0x86, 0x6f, 0xdb, 0xd4, 0xf4, 0xcf, 0xcc, 0xd2, 0xf6, 0xb2, 0x2d,
+	0xda, 0x5e, 0x43, 0x52, 0x5d, 0x56, 0xf5, 0x72, 0x96, 0x6a, 0xe6, 0x46, 0xde, 0xf6, 0x1a, 0x3d,
+	0x13, 0xff, 0x84, 0xab, 0x79, 0x77, 0xd0, 0x87, 0x15, 0xaf, 0x97, 0x74, 0xae, 0x8a, 0x73, 0xb6,
+	0x4a, 0xff, 0x00, 0xdc, 0x83, 0x07, 0x10, 0x3f, 0xc2, 0x9b, 0x7d, 0xe4, 0xa2, 0x58, 0x51, 0xb9,
+	0x59, 0x13, 0xde, 0xc3, 0xa0, 0xb0, 0xf1, 0x5d, 0xc7, 0xf7, 0x27, 0x1d, 0x8f, 0x3f, 0x22, 0xef,
+	0xdc, 0x9f, 0xee, 0x61, 0x7c, 0xbc, 0x72, 0x1c, 0xc1, 0x60, 0x9e, 0x3d, 0xa5, 0xb3, 0xa7, 0x6f,
+	0x93, 0x57, 0x08, 0xe0, 0xcf, 0xbf, 0x2c, 0x16, 0x59, 0x3a, 0x71, 0x30, 0x84, 0x61, 0x9e, 0x7d,
+	0xcf, 0x1e, 0x7e, 0x65, 0xe9, 0xc4, 0x7d, 0xf6, 0xed, 0x9f, 0xf0, 0xf9, 0x65, 0x00, 0x8e, 0x14,
+	0x3c, 0xed, 0x1d, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/governance/governancepb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	// HandleProposeParameter is the handler name of proposeParameter
+	HandleProposeParameter = "proposeParameter"
+	// HandleVoteProposal is the handler name of voteProposal
+	HandleVoteProposal = "voteProposal"
+)
+
+// Errors
+var (
+	ErrNotActivated     = errors.New("governance is not activated")
+	ErrNotDelegate      = errors.New("caller is not an active delegate")
+	ErrProposalNotFound = errors.New("proposal not found")
+	ErrVotingClosed     = errors.New("voting of the proposal is closed")
+	ErrAlreadyVoted     = errors.New("delegate has already voted on the proposal")
+)
+
+func (p *Protocol) handleProposeParameter(ctx context.Context, act *action.ProposeParameter, sm protocol.StateManager) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	epoch := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx)).GetEpochNum(blkCtx.BlockHeight)
+
+	if !activated(ctx) {
+		log.L().Debug("Error when proposing parameter change", zap.Error(ErrNotActivated))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+	delegates, err := p.delegates(ctx, sm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get delegates")
+	}
+	if delegateWeight(delegates, actionCtx.Caller.String()) == nil {
+		log.L().Debug("Error when proposing parameter change", zap.Error(ErrNotDelegate))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+	totalWeight := big.NewInt(0)
+	for _, c := range delegates {
+		totalWeight.Add(totalWeight, c.Votes)
+	}
+
+	meta, err := p.meta(sm)
+	if err != nil {
+		return nil, err
+	}
+	prop := newProposal(
+		meta.nextID,
+		actionCtx.Caller.String(),
+		act.Parameter(),
+		act.Value(),
+		epoch,
+		epoch+p.config.VotingPeriod-1,
+		totalWeight,
+	)
+	if err := putState(sm, proposalKey(prop.id), prop); err != nil {
+		return nil, errors.Wrapf(err, "failed to put proposal %d", prop.id)
+	}
+	meta.nextID++
+	meta.pendingIDs = append(meta.pendingIDs, prop.id)
+	if err := putState(sm, metaKey, meta); err != nil {
+		return nil, errors.Wrap(err, "failed to put proposal meta")
+	}
+
+	log := p.createLog(ctx, HandleProposeParameter, byteutil.Uint64ToBytesBigEndian(prop.id))
+	return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), log)
+}
+
+func (p *Protocol) handleVoteProposal(ctx context.Context, act *action.VoteProposal, sm protocol.StateManager) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	epoch := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx)).GetEpochNum(blkCtx.BlockHeight)
+	voter := actionCtx.Caller.String()
+
+	if !activated(ctx) {
+		log.L().Debug("Error when voting on proposal", zap.Error(ErrNotActivated))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+	prop, err := p.proposal(sm, act.ProposalID())
+	if err != nil {
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return nil, err
+		}
+		log.L().Debug("Error when voting on proposal", zap.Error(ErrProposalNotFound))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+	if prop.status != governancepb.ProposalStatus_PENDING || epoch > prop.endEpoch {
+		log.L().Debug("Error when voting on proposal", zap.Error(ErrVotingClosed))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+	if prop.hasVoted(voter) {
+		log.L().Debug("Error when voting on proposal", zap.Error(ErrAlreadyVoted))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+	delegates, err := p.delegates(ctx, sm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get delegates")
+	}
+	weight := delegateWeight(delegates, voter)
+	if weight == nil {
+		log.L().Debug("Error when voting on proposal", zap.Error(ErrNotDelegate))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Failure))
+	}
+
+	prop.vote(voter, weight, act.Approve())
+	if err := putState(sm, proposalKey(prop.id), prop); err != nil {
+		return nil, errors.Wrapf(err, "failed to put proposal %d", prop.id)
+	}
+
+	log := p.createLog(ctx, HandleVoteProposal, byteutil.Uint64ToBytesBigEndian(prop.id))
+	return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), log)
+}
+
+// settleAction deposits gas fee and updates caller's nonce
+func (p *Protocol) settleAction(
+	ctx context.Context,
+	sm protocol.StateManager,
+	status uint64,
+	logs ...*action.Log,
+) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+
+	if blkCtx.GasLimit < actionCtx.IntrinsicGas {
+		return nil, errors.Wrap(action.ErrHitGasLimit, "block gas limit exceeded")
+	}
+	gasFee := big.NewInt(0).Mul(actionCtx.GasPrice, big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
+	if err := p.depositGas(ctx, sm, gasFee); err != nil {
+		return nil, errors.Wrap(err, "failed to deposit gas")
+	}
+	acc, err := accountutil.LoadOrCreateAccount(sm, actionCtx.Caller.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load caller account")
+	}
+	// TODO: this check shouldn't be necessary
+	if actionCtx.Nonce > acc.Nonce {
+		acc.Nonce = actionCtx.Nonce
+	}
+	if err := accountutil.StoreAccount(sm, actionCtx.Caller.String(), acc); err != nil {
+		return nil, errors.Wrap(err, "failed to update nonce")
+	}
+	return &action.Receipt{
+		Status:          status,
+		BlockHeight:     blkCtx.BlockHeight,
+		ActionHash:      actionCtx.ActionHash,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: p.addr.String(),
+		Logs:            logs,
+	}, nil
+}
+
+func (p *Protocol) createLog(ctx context.Context, handlerName string, data []byte) *action.Log {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+
+	return &action.Log{
+		Address: p.addr.String(),
+		Topics: []hash.Hash256{
+			hash.Hash256b([]byte(handlerName)),
+			hash.Hash256b(actionCtx.Caller.Bytes()),
+		},
+		Data:        data,
+		BlockHeight: blkCtx.BlockHeight,
+		ActionHash:  actionCtx.ActionHash,
+	}
+}
+
+// delegateWeight returns the votes of the delegate, or nil if the address is not an active delegate
+func delegateWeight(delegates state.CandidateList, addr string) *big.Int {
+	for _, c := range delegates {
+		if c.Address == addr {
+			return c.Votes
+		}
+	}
+	return nil
+}
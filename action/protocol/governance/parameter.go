@@ -0,0 +1,144 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// Governed parameters
+const (
+	// ActionGasLimit is the per action gas limit cap of executions after Aleutian. Executions are not capped without
+	// it, so the governed value can only lower the gas limit of an execution, never raise it
+	ActionGasLimit = "ActionGasLimit"
+	// BlockReward is the block reward amount
+	BlockReward = "BlockReward"
+	// EpochReward is the epoch reward amount
+	EpochReward = "EpochReward"
+	// FoundationBonus is the foundation bonus amount
+	FoundationBonus = "FoundationBonus"
+	// NumDelegatesForEpochReward is the number of top candidates that share an epoch reward
+	NumDelegatesForEpochReward = "NumDelegatesForEpochReward"
+	// MinStakeAmount is the minimum amount of a vote bucket
+	MinStakeAmount = "MinStakeAmount"
+	// CandidateRegistrationFee is the fee of registering a candidate
+	CandidateRegistrationFee = "CandidateRegistrationFee"
+	// CandidateMinSelfStake is the minimum self-stake amount of registering a candidate
+	CandidateMinSelfStake = "CandidateMinSelfStake"
+)
+
+type parameterType int
+
+const (
+	uint64Parameter parameterType = iota
+	amountParameter
+)
+
+var parameterTypes = map[string]parameterType{
+	ActionGasLimit:             uint64Parameter,
+	BlockReward:                amountParameter,
+	EpochReward:                amountParameter,
+	FoundationBonus:            amountParameter,
+	NumDelegatesForEpochReward: uint64Parameter,
+	MinStakeAmount:             amountParameter,
+	CandidateRegistrationFee:   amountParameter,
+	CandidateMinSelfStake:      amountParameter,
+}
+
+// validateParameter checks that the parameter is governed and the value is valid for it
+func validateParameter(name, value string) error {
+	t, ok := parameterTypes[name]
+	if !ok {
+		return errors.Wrapf(action.ErrInvalidProposal, "parameter %s is not governed", name)
+	}
+	switch t {
+	case uint64Parameter:
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || v == 0 {
+			return errors.Wrapf(action.ErrInvalidProposal, "invalid value %s of parameter %s", value, name)
+		}
+	case amountParameter:
+		v, ok := new(big.Int).SetString(value, 10)
+		if !ok || v.Sign() < 0 {
+			return errors.Wrapf(action.ErrInvalidProposal, "invalid value %s of parameter %s", value, name)
+		}
+	}
+	return nil
+}
+
+// Uint64Parameter returns the value of the parameter active at the block in context, or at the block next to the
+// state if there is no block in context. If governance is not enabled or the parameter has never been changed, def is
+// returned.
+func Uint64Parameter(ctx context.Context, sr protocol.StateReader, name string, def uint64) (uint64, error) {
+	value, ok, err := governedValue(ctx, sr, name)
+	if err != nil || !ok {
+		return def, err
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return def, errors.Wrapf(err, "invalid value %s of parameter %s", value, name)
+	}
+	return v, nil
+}
+
+// AmountParameter returns the value of the parameter active at the block in context, or at the block next to the
+// state if there is no block in context. If governance is not enabled or the parameter has never been changed, def is
+// returned.
+func AmountParameter(ctx context.Context, sr protocol.StateReader, name string, def *big.Int) (*big.Int, error) {
+	value, ok, err := governedValue(ctx, sr, name)
+	if err != nil || !ok {
+		return def, err
+	}
+	v, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return def, errors.Errorf("invalid value %s of parameter %s", value, name)
+	}
+	return v, nil
+}
+
+func governedValue(ctx context.Context, sr protocol.StateReader, name string) (string, bool, error) {
+	registry, ok := protocol.GetRegistry(ctx)
+	if !ok || sr == nil {
+		return "", false, nil
+	}
+	if FindProtocol(registry) == nil {
+		return "", false, nil
+	}
+	rp := rolldpos.FindProtocol(registry)
+	if rp == nil {
+		return "", false, nil
+	}
+	var height uint64
+	if blkCtx, ok := protocol.GetBlockCtx(ctx); ok {
+		height = blkCtx.BlockHeight
+	} else {
+		// without a block in context, the value applies to the block next to the state
+		tip, err := sr.Height()
+		if err != nil {
+			return "", false, err
+		}
+		height = tip + 1
+	}
+	var schedule parameterSchedule
+	if err := getState(sr, parameterKey(name), &schedule); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return "", false, nil
+		}
+		return "", false, errors.Wrapf(err, "failed to get schedule of parameter %s", name)
+	}
+	value, ok := schedule.valueAt(rp.GetEpochNum(height))
+	return value, ok, nil
+}
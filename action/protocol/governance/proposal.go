@@ -0,0 +1,210 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/governance/governancepb"
+)
+
+type (
+	// proposal is a parameter change proposal and its voting result
+	proposal struct {
+		id              uint64
+		proposer        string
+		parameter       string
+		value           string
+		startEpoch      uint64
+		endEpoch        uint64
+		totalWeight     *big.Int
+		approveWeight   *big.Int
+		rejectWeight    *big.Int
+		voters          []string
+		status          governancepb.ProposalStatus
+		activationEpoch uint64
+	}
+
+	// proposalMeta stores the id of the next proposal and the proposals in voting
+	proposalMeta struct {
+		nextID     uint64
+		pendingIDs []uint64
+	}
+
+	// parameterChange is a value of a parameter and the epoch it becomes active
+	parameterChange struct {
+		activationEpoch uint64
+		value           string
+	}
+
+	// parameterSchedule is the list of changes of a parameter in the order of activation
+	parameterSchedule []parameterChange
+)
+
+func newProposal(id uint64, proposer, parameter, value string, startEpoch, endEpoch uint64, totalWeight *big.Int) *proposal {
+	return &proposal{
+		id:            id,
+		proposer:      proposer,
+		parameter:     parameter,
+		value:         value,
+		startEpoch:    startEpoch,
+		endEpoch:      endEpoch,
+		totalWeight:   totalWeight,
+		approveWeight: big.NewInt(0),
+		rejectWeight:  big.NewInt(0),
+		status:        governancepb.ProposalStatus_PENDING,
+	}
+}
+
+// hasVoted returns true if the delegate has voted on the proposal
+func (p *proposal) hasVoted(voter string) bool {
+	for _, v := range p.voters {
+		if v == voter {
+			return true
+		}
+	}
+	return false
+}
+
+// vote adds the weight of the voter to the proposal
+func (p *proposal) vote(voter string, weight *big.Int, approve bool) {
+	p.voters = append(p.voters, voter)
+	if approve {
+		p.approveWeight.Add(p.approveWeight, weight)
+	} else {
+		p.rejectWeight.Add(p.rejectWeight, weight)
+	}
+}
+
+// passed returns true if the approving weight reaches threshold percent of the total weight
+func (p *proposal) passed(threshold uint32) bool {
+	approve := new(big.Int).Mul(p.approveWeight, big.NewInt(100))
+	required := new(big.Int).Mul(p.totalWeight, big.NewInt(int64(threshold)))
+	return approve.Sign() > 0 && approve.Cmp(required) >= 0
+}
+
+// Proto converts the proposal to protobuf
+func (p *proposal) Proto() *governancepb.Proposal {
+	return &governancepb.Proposal{
+		Id:              p.id,
+		Proposer:        p.proposer,
+		Parameter:       p.parameter,
+		Value:           p.value,
+		StartEpoch:      p.startEpoch,
+		EndEpoch:        p.endEpoch,
+		TotalWeight:     p.totalWeight.String(),
+		ApproveWeight:   p.approveWeight.String(),
+		RejectWeight:    p.rejectWeight.String(),
+		Voters:          p.voters,
+		Status:          p.status,
+		ActivationEpoch: p.activationEpoch,
+	}
+}
+
+// LoadProto loads the proposal from protobuf
+func (p *proposal) LoadProto(pb *governancepb.Proposal) error {
+	if pb == nil {
+		return errors.New("empty proposal proto to load")
+	}
+	weights := make([]*big.Int, 3)
+	for i, w := range []string{pb.TotalWeight, pb.ApproveWeight, pb.RejectWeight} {
+		weight, ok := new(big.Int).SetString(w, 10)
+		if !ok {
+			return errors.Errorf("failed to parse weight %s", w)
+		}
+		weights[i] = weight
+	}
+	*p = proposal{
+		id:              pb.Id,
+		proposer:        pb.Proposer,
+		parameter:       pb.Parameter,
+		value:           pb.Value,
+		startEpoch:      pb.StartEpoch,
+		endEpoch:        pb.EndEpoch,
+		totalWeight:     weights[0],
+		approveWeight:   weights[1],
+		rejectWeight:    weights[2],
+		voters:          pb.Voters,
+		status:          pb.Status,
+		activationEpoch: pb.ActivationEpoch,
+	}
+	return nil
+}
+
+// Serialize serializes the proposal into bytes
+func (p *proposal) Serialize() ([]byte, error) {
+	return proto.Marshal(p.Proto())
+}
+
+// Deserialize deserializes bytes into the proposal
+func (p *proposal) Deserialize(data []byte) error {
+	pb := &governancepb.Proposal{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal proposal")
+	}
+	return p.LoadProto(pb)
+}
+
+// Serialize serializes the proposal meta into bytes
+func (m *proposalMeta) Serialize() ([]byte, error) {
+	return proto.Marshal(&governancepb.ProposalMeta{
+		NextID:     m.nextID,
+		PendingIDs: m.pendingIDs,
+	})
+}
+
+// Deserialize deserializes bytes into the proposal meta
+func (m *proposalMeta) Deserialize(data []byte) error {
+	pb := &governancepb.ProposalMeta{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal proposal meta")
+	}
+	m.nextID = pb.NextID
+	m.pendingIDs = pb.PendingIDs
+	return nil
+}
+
+// valueAt returns the value active at the epoch, and false if the parameter has not been changed by then
+func (s parameterSchedule) valueAt(epoch uint64) (string, bool) {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i].activationEpoch <= epoch {
+			return s[i].value, true
+		}
+	}
+	return "", false
+}
+
+// Serialize serializes the parameter schedule into bytes
+func (s parameterSchedule) Serialize() ([]byte, error) {
+	pb := &governancepb.ParameterSchedule{}
+	for _, c := range s {
+		pb.Changes = append(pb.Changes, &governancepb.ParameterChange{
+			ActivationEpoch: c.activationEpoch,
+			Value:           c.value,
+		})
+	}
+	return proto.Marshal(pb)
+}
+
+// Deserialize deserializes bytes into the parameter schedule
+func (s *parameterSchedule) Deserialize(data []byte) error {
+	pb := &governancepb.ParameterSchedule{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal parameter schedule")
+	}
+	*s = nil
+	for _, c := range pb.Changes {
+		*s = append(*s, parameterChange{
+			activationEpoch: c.ActivationEpoch,
+			value:           c.Value,
+		})
+	}
+	return nil
+}
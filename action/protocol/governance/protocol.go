@@ -0,0 +1,293 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance/governancepb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	// protocolID is the protocol ID
+	protocolID = "governance"
+
+	// GovernanceNameSpace is the bucket name for governance state
+	GovernanceNameSpace = "Governance"
+)
+
+const (
+	// keys in the namespace GovernanceNameSpace are prefixed with 1-byte tag
+	_meta = byte(iota)
+	_proposal
+	_parameter
+)
+
+var metaKey = []byte{_meta}
+
+type (
+	// DepositGas deposits gas to some pool
+	DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error
+
+	// Delegates returns the active delegates of the current epoch, whose votes are the weights in governance
+	Delegates func(context.Context, protocol.StateReader) (state.CandidateList, error)
+
+	// Protocol defines the protocol of proposing and voting on protocol parameter changes. Active delegates propose
+	// new values of governed parameters and vote on the proposals with their votes. A proposal passed at the end of
+	// its voting period becomes active after the activation delay. The protocol is activated at the genesis
+	// GovernanceHeight.
+	Protocol struct {
+		addr       address.Address
+		depositGas DepositGas
+		delegates  Delegates
+		config     genesis.Governance
+	}
+)
+
+// NewProtocol instantiates the protocol of governance
+func NewProtocol(depositGas DepositGas, delegates Delegates, cfg genesis.Governance) (*Protocol, error) {
+	if cfg.VotingPeriod == 0 {
+		return nil, errors.New("voting period cannot be zero")
+	}
+	if cfg.ActivationDelay == 0 {
+		return nil, errors.New("activation delay cannot be zero")
+	}
+	if cfg.PassThreshold == 0 || cfg.PassThreshold > 100 {
+		return nil, errors.Errorf("invalid pass threshold %d", cfg.PassThreshold)
+	}
+	h := hash.Hash160b([]byte(protocolID))
+	addr, err := address.FromBytes(h[:])
+	if err != nil {
+		return nil, err
+	}
+	return &Protocol{
+		addr:       addr,
+		depositGas: depositGas,
+		delegates:  delegates,
+		config:     cfg,
+	}, nil
+}
+
+// FindProtocol finds the registered protocol from registry
+func FindProtocol(registry *protocol.Registry) *Protocol {
+	if registry == nil {
+		return nil
+	}
+	p, ok := registry.Find(protocolID)
+	if !ok {
+		return nil
+	}
+	gp, ok := p.(*Protocol)
+	if !ok {
+		log.S().Panic("fail to cast governance protocol")
+	}
+	return gp
+}
+
+// CreatePreStates tallies the proposals whose voting period ended at the start of an epoch
+func (p *Protocol) CreatePreStates(ctx context.Context, sm protocol.StateManager) error {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if !activated(ctx) {
+		return nil
+	}
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	epoch := rp.GetEpochNum(blkCtx.BlockHeight)
+	if blkCtx.BlockHeight != rp.GetEpochHeight(epoch) {
+		return nil
+	}
+	meta, err := p.meta(sm)
+	if err != nil {
+		return err
+	}
+	var pending []uint64
+	for _, id := range meta.pendingIDs {
+		prop, err := p.proposal(sm, id)
+		if err != nil {
+			return err
+		}
+		if prop.endEpoch >= epoch {
+			pending = append(pending, id)
+			continue
+		}
+		if err := p.tally(sm, prop, epoch); err != nil {
+			return err
+		}
+	}
+	if len(pending) == len(meta.pendingIDs) {
+		return nil
+	}
+	meta.pendingIDs = pending
+	return putState(sm, metaKey, meta)
+}
+
+// Handle handles a governance action
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	switch act := act.(type) {
+	case *action.ProposeParameter:
+		return p.handleProposeParameter(ctx, act, sm)
+	case *action.VoteProposal:
+		return p.handleVoteProposal(ctx, act, sm)
+	}
+	return nil, nil
+}
+
+// Validate validates a governance action
+func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
+	switch act := act.(type) {
+	case *action.ProposeParameter:
+		return validateParameter(act.Parameter(), act.Value())
+	case *action.VoteProposal:
+		if act.ProposalID() == 0 {
+			return errors.Wrap(action.ErrInvalidProposal, "proposal id cannot be zero")
+		}
+	}
+	return nil
+}
+
+// ReadState read the state on blockchain via protocol
+func (p *Protocol) ReadState(ctx context.Context, sr protocol.StateReader, method []byte, args ...[]byte) ([]byte, error) {
+	switch string(method) {
+	case "Proposal":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		prop, err := p.proposal(sr, byteutil.BytesToUint64(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(prop.Proto())
+	case "PendingProposals":
+		meta, err := p.meta(sr)
+		if err != nil {
+			return nil, err
+		}
+		list := &governancepb.ProposalList{}
+		for _, id := range meta.pendingIDs {
+			prop, err := p.proposal(sr, id)
+			if err != nil {
+				return nil, err
+			}
+			list.Proposals = append(list.Proposals, prop.Proto())
+		}
+		return proto.Marshal(list)
+	case "Parameter":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		name := string(args[0])
+		if _, ok := parameterTypes[name]; !ok {
+			return nil, errors.Errorf("parameter %s is not governed", name)
+		}
+		value, ok, err := governedValue(ctx, sr, name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.Wrapf(state.ErrStateNotExist, "parameter %s has not been changed", name)
+		}
+		return []byte(value), nil
+	default:
+		return nil, errors.New("corresponding method isn't found")
+	}
+}
+
+// Register registers the protocol with a unique ID
+func (p *Protocol) Register(r *protocol.Registry) error {
+	return r.Register(protocolID, p)
+}
+
+// ForceRegister registers the protocol with a unique ID and force replacing the previous protocol if it exists
+func (p *Protocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(protocolID, p)
+}
+
+// tally closes the voting of the proposal, and schedules the parameter change if it passed
+func (p *Protocol) tally(sm protocol.StateManager, prop *proposal, epoch uint64) error {
+	if !prop.passed(p.config.PassThreshold) {
+		prop.status = governancepb.ProposalStatus_REJECTED
+		return putState(sm, proposalKey(prop.id), prop)
+	}
+	prop.status = governancepb.ProposalStatus_PASSED
+	prop.activationEpoch = epoch + p.config.ActivationDelay
+	if err := putState(sm, proposalKey(prop.id), prop); err != nil {
+		return err
+	}
+	var schedule parameterSchedule
+	if err := getState(sm, parameterKey(prop.parameter), &schedule); err != nil &&
+		errors.Cause(err) != state.ErrStateNotExist {
+		return errors.Wrapf(err, "failed to get schedule of parameter %s", prop.parameter)
+	}
+	schedule = append(schedule, parameterChange{
+		activationEpoch: prop.activationEpoch,
+		value:           prop.value,
+	})
+	log.L().Info("Parameter change is scheduled.",
+		zap.Uint64("proposal", prop.id),
+		zap.String("parameter", prop.parameter),
+		zap.String("value", prop.value),
+		zap.Uint64("activationEpoch", prop.activationEpoch))
+	return putState(sm, parameterKey(prop.parameter), schedule)
+}
+
+func (p *Protocol) meta(sr protocol.StateReader) (*proposalMeta, error) {
+	meta := &proposalMeta{}
+	if err := getState(sr, metaKey, meta); err != nil {
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return nil, errors.Wrap(err, "failed to get proposal meta")
+		}
+		meta.nextID = 1
+	}
+	return meta, nil
+}
+
+func (p *Protocol) proposal(sr protocol.StateReader, id uint64) (*proposal, error) {
+	prop := &proposal{}
+	if err := getState(sr, proposalKey(id), prop); err != nil {
+		return nil, errors.Wrapf(err, "failed to get proposal %d", id)
+	}
+	return prop, nil
+}
+
+// activated returns true if the block is at or after the genesis GovernanceHeight
+func activated(ctx context.Context) bool {
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	return blkCtx.BlockHeight >= bcCtx.Genesis.GovernanceHeight
+}
+
+func proposalKey(id uint64) []byte {
+	return append([]byte{_proposal}, byteutil.Uint64ToBytesBigEndian(id)...)
+}
+
+func parameterKey(name string) []byte {
+	return append([]byte{_parameter}, []byte(name)...)
+}
+
+func getState(sr protocol.StateReader, key []byte, s interface{}) error {
+	_, err := sr.State(s, protocol.NamespaceOption(GovernanceNameSpace), protocol.KeyOption(key))
+	return err
+}
+
+func putState(sm protocol.StateManager, key []byte, s interface{}) error {
+	_, err := sm.PutState(s, protocol.NamespaceOption(GovernanceNameSpace), protocol.KeyOption(key))
+	return err
+}
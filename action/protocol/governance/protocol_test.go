@@ -0,0 +1,281 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance/governancepb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+)
+
+func newTestStateManager(ctrl *gomock.Controller) protocol.StateManager {
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := batch.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(s interface{}, opts ...protocol.StateOption) (uint64, error) {
+			cfg, err := protocol.CreateStateConfig(opts...)
+			if err != nil {
+				return 0, err
+			}
+			val, err := cb.Get(cfg.Namespace, cfg.Key)
+			if err != nil {
+				return 0, state.ErrStateNotExist
+			}
+			return 0, state.Deserialize(s, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(s interface{}, opts ...protocol.StateOption) (uint64, error) {
+			cfg, err := protocol.CreateStateConfig(opts...)
+			if err != nil {
+				return 0, err
+			}
+			ss, err := state.Serialize(s)
+			if err != nil {
+				return 0, err
+			}
+			cb.Put(cfg.Namespace, cfg.Key, ss, "failed to put state")
+			return 0, nil
+		}).AnyTimes()
+	return sm
+}
+
+func TestNewProtocol(t *testing.T) {
+	require := require.New(t)
+
+	for _, cfg := range []genesis.Governance{
+		{VotingPeriod: 0, ActivationDelay: 1, PassThreshold: 50},
+		{VotingPeriod: 1, ActivationDelay: 0, PassThreshold: 50},
+		{VotingPeriod: 1, ActivationDelay: 1, PassThreshold: 0},
+		{VotingPeriod: 1, ActivationDelay: 1, PassThreshold: 101},
+	} {
+		_, err := NewProtocol(nil, nil, cfg)
+		require.Error(err)
+	}
+	p, err := NewProtocol(nil, nil, genesis.Default.Governance)
+	require.NoError(err)
+	registry := protocol.NewRegistry()
+	require.Nil(FindProtocol(registry))
+	require.NoError(p.Register(registry))
+	require.Equal(p, FindProtocol(registry))
+}
+
+func TestValidate(t *testing.T) {
+	require := require.New(t)
+
+	p, err := NewProtocol(nil, nil, genesis.Default.Governance)
+	require.NoError(err)
+	ctx := context.Background()
+	tests := []struct {
+		parameter string
+		value     string
+		valid     bool
+	}{
+		{BlockReward, "0", true},
+		{BlockReward, "100", true},
+		{BlockReward, "-1", false},
+		{BlockReward, "abc", false},
+		{ActionGasLimit, "2000000", true},
+		{ActionGasLimit, "0", false},
+		{ActionGasLimit, "-1", false},
+		{"VoteThreshold", "100", false},
+	}
+	for _, test := range tests {
+		act, err := action.NewProposeParameter(1, test.parameter, test.value, nil, 0, big.NewInt(0))
+		require.NoError(err)
+		if test.valid {
+			require.NoError(p.Validate(ctx, act))
+		} else {
+			require.Error(p.Validate(ctx, act))
+		}
+	}
+	vote, err := action.NewVoteProposal(1, 0, true, nil, 0, big.NewInt(0))
+	require.NoError(err)
+	require.Error(p.Validate(ctx, vote))
+	vote, err = action.NewVoteProposal(1, 1, true, nil, 0, big.NewInt(0))
+	require.NoError(err)
+	require.NoError(p.Validate(ctx, vote))
+}
+
+func TestProposeAndVote(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newTestStateManager(ctrl)
+	delegates := state.CandidateList{
+		{Address: identityset.Address(1).String(), Votes: big.NewInt(50)},
+		{Address: identityset.Address(2).String(), Votes: big.NewInt(30)},
+		{Address: identityset.Address(3).String(), Votes: big.NewInt(20)},
+	}
+	p, err := NewProtocol(
+		func(context.Context, protocol.StateManager, *big.Int) error { return nil },
+		func(context.Context, protocol.StateReader) (state.CandidateList, error) { return delegates, nil },
+		genesis.Governance{VotingPeriod: 1, ActivationDelay: 1, PassThreshold: 67},
+	)
+	require.NoError(err)
+	registry := protocol.NewRegistry()
+	require.NoError(rolldpos.NewProtocol(36, 24, 1).Register(registry))
+	require.NoError(p.Register(registry))
+	g := genesis.Default
+	g.GovernanceHeight = 5
+	ctx := protocol.WithBlockchainCtx(
+		protocol.WithRegistry(context.Background(), registry),
+		protocol.BlockchainCtx{Genesis: g},
+	)
+
+	blockCtx := func(height uint64) context.Context {
+		return protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: height, GasLimit: 1000000})
+	}
+	handle := func(height uint64, caller int, act action.Action) *action.Receipt {
+		actCtx := protocol.WithActionCtx(blockCtx(height), protocol.ActionCtx{
+			Caller:       identityset.Address(caller),
+			GasPrice:     big.NewInt(0),
+			IntrinsicGas: 10000,
+		})
+		r, err := p.Handle(actCtx, act, sm)
+		require.NoError(err)
+		return r
+	}
+	propose := func(caller int, parameter, value string) *action.Receipt {
+		act, err := action.NewProposeParameter(0, parameter, value, nil, 0, big.NewInt(0))
+		require.NoError(err)
+		return handle(10, caller, act)
+	}
+	vote := func(caller int, id uint64, approve bool) *action.Receipt {
+		act, err := action.NewVoteProposal(0, id, approve, nil, 0, big.NewInt(0))
+		require.NoError(err)
+		return handle(10, caller, act)
+	}
+
+	// nothing could be proposed or voted before the activation
+	act, err := action.NewProposeParameter(0, BlockReward, "5", nil, 0, big.NewInt(0))
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), handle(4, 1, act).Status)
+	vp, err := action.NewVoteProposal(0, 1, true, nil, 0, big.NewInt(0))
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), handle(4, 1, vp).Status)
+
+	// only active delegates could propose
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), propose(9, BlockReward, "5").Status)
+	r := propose(1, BlockReward, "5")
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	require.Equal(byteutil.Uint64ToBytesBigEndian(1), r.Logs[0].Data)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), propose(1, EpochReward, "100").Status)
+
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), vote(1, 1, true).Status)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), vote(2, 1, true).Status)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), vote(3, 1, false).Status)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), vote(1, 2, false).Status)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), vote(3, 2, true).Status)
+	// each delegate votes once
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), vote(2, 1, false).Status)
+	// only active delegates could vote
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), vote(9, 1, true).Status)
+	// proposal not found
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), vote(1, 3, true).Status)
+
+	data, err := p.ReadState(ctx, sm, []byte("PendingProposals"))
+	require.NoError(err)
+	list := &governancepb.ProposalList{}
+	require.NoError(proto.Unmarshal(data, list))
+	require.Equal(2, len(list.Proposals))
+	require.Equal("80", list.Proposals[0].ApproveWeight)
+	require.Equal("20", list.Proposals[0].RejectWeight)
+	require.Equal("100", list.Proposals[0].TotalWeight)
+	require.Equal(uint64(1), list.Proposals[0].EndEpoch)
+
+	// tally at the start of epoch 2
+	require.NoError(p.CreatePreStates(blockCtx(26), sm))
+	data, err = p.ReadState(ctx, sm, []byte("PendingProposals"))
+	require.NoError(err)
+	require.NoError(proto.Unmarshal(data, list))
+	require.Equal(2, len(list.Proposals))
+	require.NoError(p.CreatePreStates(blockCtx(25), sm))
+	data, err = p.ReadState(ctx, sm, []byte("PendingProposals"))
+	require.NoError(err)
+	require.NoError(proto.Unmarshal(data, list))
+	require.Empty(list.Proposals)
+
+	prop := &governancepb.Proposal{}
+	data, err = p.ReadState(ctx, sm, []byte("Proposal"), byteutil.Uint64ToBytes(1))
+	require.NoError(err)
+	require.NoError(proto.Unmarshal(data, prop))
+	require.Equal(governancepb.ProposalStatus_PASSED, prop.Status)
+	require.Equal(uint64(3), prop.ActivationEpoch)
+	data, err = p.ReadState(ctx, sm, []byte("Proposal"), byteutil.Uint64ToBytes(2))
+	require.NoError(err)
+	require.NoError(proto.Unmarshal(data, prop))
+	require.Equal(governancepb.ProposalStatus_REJECTED, prop.Status)
+
+	// voting is closed
+	vp, err = action.NewVoteProposal(0, 2, true, nil, 0, big.NewInt(0))
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), handle(30, 2, vp).Status)
+
+	// the change becomes active at epoch 3
+	def := big.NewInt(16)
+	v, err := AmountParameter(blockCtx(48), sm, BlockReward, def)
+	require.NoError(err)
+	require.Equal(def, v)
+	v, err = AmountParameter(blockCtx(49), sm, BlockReward, def)
+	require.NoError(err)
+	require.Equal(big.NewInt(5), v)
+	v, err = AmountParameter(blockCtx(49), sm, EpochReward, def)
+	require.NoError(err)
+	require.Equal(def, v)
+	v, err = AmountParameter(context.Background(), sm, BlockReward, def)
+	require.NoError(err)
+	require.Equal(def, v)
+
+	_, err = p.ReadState(blockCtx(48), sm, []byte("Parameter"), []byte(BlockReward))
+	require.Error(err)
+	data, err = p.ReadState(blockCtx(49), sm, []byte("Parameter"), []byte(BlockReward))
+	require.NoError(err)
+	require.Equal("5", string(data))
+	_, err = p.ReadState(blockCtx(49), sm, []byte("Parameter"), []byte("VoteThreshold"))
+	require.Error(err)
+}
+
+func TestParameterSchedule(t *testing.T) {
+	require := require.New(t)
+
+	s := parameterSchedule{
+		{activationEpoch: 3, value: "1"},
+		{activationEpoch: 5, value: "2"},
+		{activationEpoch: 5, value: "3"},
+	}
+	_, ok := s.valueAt(2)
+	require.False(ok)
+	for epoch, expected := range map[uint64]string{3: "1", 4: "1", 5: "3", 10: "3"} {
+		v, ok := s.valueAt(epoch)
+		require.True(ok)
+		require.Equal(expected, v)
+	}
+
+	data, err := s.Serialize()
+	require.NoError(err)
+	var s2 parameterSchedule
+	require.NoError(s2.Deserialize(data))
+	require.Equal(s, s2)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/poll/pollpb"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+type (
+	// doubleSigners is the list of delegates caught double signing, which will be put on probation at next epoch
+	doubleSigners []string
+
+	// evidenceProvider is implemented by poll protocols which carry double sign evidences into blocks
+	evidenceProvider interface {
+		pendingEvidences() []*action.DoubleSignEvidence
+	}
+
+	// selfStakeSlasher burns part of the self-stake of a delegate
+	selfStakeSlasher interface {
+		SlashSelfStake(context.Context, protocol.StateManager, address.Address, uint32) (*big.Int, error)
+	}
+)
+
+// Serialize serializes the double signers into bytes
+func (ds doubleSigners) Serialize() ([]byte, error) {
+	return proto.Marshal(&pollpb.DoubleSigners{Addresses: ds})
+}
+
+// Deserialize deserializes bytes into double signers
+func (ds *doubleSigners) Deserialize(buf []byte) error {
+	pb := &pollpb.DoubleSigners{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal double signers")
+	}
+	*ds = pb.Addresses
+	return nil
+}
+
+func (ds doubleSigners) contains(addr string) bool {
+	for _, d := range ds {
+		if d == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func getDoubleSigners(sr protocol.StateReader) (doubleSigners, error) {
+	var ds doubleSigners
+	key := candidatesutil.ConstructKey(candidatesutil.DoubleSignerKey)
+	_, err := sr.State(&ds, protocol.KeyOption(key[:]), protocol.NamespaceOption(protocol.SystemNamespace))
+	switch errors.Cause(err) {
+	case nil:
+		return ds, nil
+	case state.ErrStateNotExist:
+		return doubleSigners{}, nil
+	default:
+		return nil, errors.Wrap(err, "failed to read double signers")
+	}
+}
+
+func setDoubleSigners(sm protocol.StateManager, ds doubleSigners) error {
+	key := candidatesutil.ConstructKey(candidatesutil.DoubleSignerKey)
+	_, err := sm.PutState(ds, protocol.KeyOption(key[:]), protocol.NamespaceOption(protocol.SystemNamespace))
+	return err
+}
+
+func evidenceStateKey(ev *action.DoubleSignEvidence) (hash.Hash256, error) {
+	key, err := ev.Key()
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	return hash.Hash256b(append([]byte(candidatesutil.DoubleSignEvidenceKeyPrefix), key[:]...)), nil
+}
+
+// recordDoubleSigner records the offender of the evidence to be put on probation, and returns false if the evidence
+// has been handled before
+func (sh *Slasher) recordDoubleSigner(sm protocol.StateManager, ev *action.DoubleSignEvidence) (bool, error) {
+	offender, err := ev.Offender()
+	if err != nil {
+		return false, err
+	}
+	evKey, err := evidenceStateKey(ev)
+	if err != nil {
+		return false, err
+	}
+	var handled doubleSigners
+	_, err = sm.State(&handled, protocol.KeyOption(evKey[:]), protocol.NamespaceOption(protocol.SystemNamespace))
+	switch errors.Cause(err) {
+	case nil:
+		return false, nil
+	case state.ErrStateNotExist:
+	default:
+		return false, errors.Wrap(err, "failed to read handled evidence")
+	}
+	if _, err := sm.PutState(
+		doubleSigners{offender.String()},
+		protocol.KeyOption(evKey[:]),
+		protocol.NamespaceOption(protocol.SystemNamespace),
+	); err != nil {
+		return false, errors.Wrap(err, "failed to put handled evidence")
+	}
+	ds, err := getDoubleSigners(sm)
+	if err != nil {
+		return false, err
+	}
+	if ds.contains(offender.String()) {
+		return true, nil
+	}
+	return true, setDoubleSigners(sm, append(ds, offender.String()))
+}
+
+// mergeDoubleSigners merges the recorded double signers into the unproductive delegates of the last epoch, so that
+// they are put on probation the same way, and clears the record
+func (sh *Slasher) mergeDoubleSigners(sm protocol.StateManager, unqualified []string) ([]string, error) {
+	ds, err := getDoubleSigners(sm)
+	if err != nil {
+		return nil, err
+	}
+	if len(ds) == 0 {
+		return unqualified, nil
+	}
+	for _, addr := range ds {
+		if !doubleSigners(unqualified).contains(addr) {
+			unqualified = append(unqualified, addr)
+		}
+	}
+	return unqualified, setDoubleSigners(sm, doubleSigners{})
+}
+
+func (sh *Slasher) pendingEvidences() []*action.DoubleSignEvidence {
+	if sh == nil || sh.evidencePool == nil {
+		return nil
+	}
+	return sh.evidencePool.PendingEvidences()
+}
+
+func createDoubleSignEvidenceActions(ctx context.Context, p Protocol) []action.Envelope {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	if blkCtx.BlockHeight < bcCtx.Genesis.DoubleSignEvidenceHeight {
+		return nil
+	}
+	provider, ok := p.(evidenceProvider)
+	if !ok {
+		return nil
+	}
+	evidences := provider.pendingEvidences()
+	if len(evidences) == 0 {
+		return nil
+	}
+	nonce := uint64(0)
+	envs := make([]action.Envelope, 0, len(evidences))
+	for _, ev := range evidences {
+		builder := action.EnvelopeBuilder{}
+		envs = append(envs, builder.SetNonce(nonce).SetAction(ev).Build())
+	}
+	return envs
+}
+
+func validateDoubleSignEvidence(ctx context.Context, ev *action.DoubleSignEvidence) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+
+	if blkCtx.BlockHeight < bcCtx.Genesis.DoubleSignEvidenceHeight {
+		return errors.Wrapf(action.ErrInvalidEvidence, "evidence is not accepted before height %d", bcCtx.Genesis.DoubleSignEvidenceHeight)
+	}
+	if blkCtx.Producer.String() != actionCtx.Caller.String() {
+		return errors.New("Only producer could create this protocol")
+	}
+	if ev.Height() >= blkCtx.BlockHeight {
+		return errors.Wrapf(action.ErrInvalidEvidence, "evidence height %d is not lower than block height %d", ev.Height(), blkCtx.BlockHeight)
+	}
+	return ev.Verify()
+}
+
+func handleDoubleSignEvidence(
+	ctx context.Context,
+	sm protocol.StateManager,
+	sh *Slasher,
+	ev *action.DoubleSignEvidence,
+	protocolAddr string,
+	sss selfStakeSlasher,
+) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+
+	offender, err := ev.Offender()
+	if err != nil {
+		return nil, err
+	}
+	zap.L().Debug("Handle DoubleSignEvidence Action",
+		zap.Uint64("height", ev.Height()),
+		zap.String("offender", offender.String()),
+	)
+	status := uint64(iotextypes.ReceiptStatus_Success)
+	recorded, err := sh.recordDoubleSigner(sm, ev)
+	switch {
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to record double signer")
+	case !recorded:
+		// the same double sign has been punished already
+		status = uint64(iotextypes.ReceiptStatus_Failure)
+	case sss != nil && bcCtx.Genesis.DoubleSignSlashRate > 0:
+		amount, err := sss.SlashSelfStake(ctx, sm, offender, bcCtx.Genesis.DoubleSignSlashRate)
+		switch errors.Cause(err) {
+		case nil:
+			if amount.Sign() == 0 {
+				// the self-staking bucket has been unstaked, probation is the only penalty
+				log.L().Debug("Double signer has no self-stake to slash", zap.String("offender", offender.String()))
+				break
+			}
+			log.L().Info("Slashed self-stake of double signer",
+				zap.String("offender", offender.String()),
+				zap.String("amount", amount.String()),
+			)
+		case staking.ErrInvalidOperator:
+			// the offender is not a native staking candidate, probation is the only penalty
+			log.L().Debug("Double signer has no self-staking bucket", zap.String("offender", offender.String()))
+		default:
+			return nil, errors.Wrap(err, "failed to slash self-stake of double signer")
+		}
+	}
+	return &action.Receipt{
+		Status:          status,
+		ActionHash:      actionCtx.ActionHash,
+		BlockHeight:     blkCtx.BlockHeight,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: protocolAddr,
+	}, nil
+}
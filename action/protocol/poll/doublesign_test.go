@@ -0,0 +1,136 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+)
+
+type testSelfStakeSlasher struct {
+	slashed map[string]uint32
+	err     error
+}
+
+func (s *testSelfStakeSlasher) SlashSelfStake(
+	_ context.Context,
+	_ protocol.StateManager,
+	operator address.Address,
+	rate uint32,
+) (*big.Int, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.slashed[operator.String()] = rate
+	return big.NewInt(int64(rate)), nil
+}
+
+func TestHandleDoubleSignEvidence(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := batch.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+			cfg, err := protocol.CreateStateConfig(opts...)
+			if err != nil {
+				return 0, err
+			}
+			val, err := cb.Get(cfg.Namespace, cfg.Key)
+			if err != nil {
+				return 0, state.ErrStateNotExist
+			}
+			return 0, state.Deserialize(account, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+			cfg, err := protocol.CreateStateConfig(opts...)
+			if err != nil {
+				return 0, err
+			}
+			ss, err := state.Serialize(account)
+			if err != nil {
+				return 0, err
+			}
+			cb.Put(cfg.Namespace, cfg.Key, ss, "failed to put state")
+			return 0, nil
+		}).AnyTimes()
+
+	g := config.Default.Genesis
+	g.DoubleSignSlashRate = 10
+	g.DoubleSignEvidenceHeight = 31
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: g})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: 30,
+		Producer:    identityset.Address(3),
+	})
+	ctx = protocol.WithActionCtx(ctx, protocol.ActionCtx{Caller: identityset.Address(3)})
+
+	sh := &Slasher{}
+	now := time.Now()
+	ev1 := testEvidence(t, identityset.PrivateKey(1), 20, now)
+	ev2 := testEvidence(t, identityset.PrivateKey(2), 21, now)
+	// evidences are rejected before the hard fork
+	require.Equal(action.ErrInvalidEvidence, errors.Cause(validateDoubleSignEvidence(ctx, ev1)))
+	g.DoubleSignEvidenceHeight = 30
+	ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{Genesis: g})
+	require.NoError(validateDoubleSignEvidence(ctx, ev1))
+	require.Error(validateDoubleSignEvidence(ctx, testEvidence(t, identityset.PrivateKey(1), 30, now)))
+
+	sss := &testSelfStakeSlasher{slashed: map[string]uint32{}}
+	r, err := handleDoubleSignEvidence(ctx, sm, sh, ev1, "poll", sss)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	require.Equal(uint32(10), sss.slashed[identityset.Address(1).String()])
+
+	// the same double sign is only punished once
+	delete(sss.slashed, identityset.Address(1).String())
+	r, err = handleDoubleSignEvidence(ctx, sm, sh, ev1, "poll", sss)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), r.Status)
+	require.Empty(sss.slashed)
+
+	// offenders without self-staking bucket are put on probation only
+	sss.err = staking.ErrInvalidOperator
+	r, err = handleDoubleSignEvidence(ctx, sm, sh, ev2, "poll", sss)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+
+	ds, err := getDoubleSigners(sm)
+	require.NoError(err)
+	require.Equal(doubleSigners{identityset.Address(1).String(), identityset.Address(2).String()}, ds)
+
+	unqualified, err := sh.mergeDoubleSigners(sm, []string{identityset.Address(2).String(), identityset.Address(4).String()})
+	require.NoError(err)
+	require.ElementsMatch([]string{
+		identityset.Address(1).String(),
+		identityset.Address(2).String(),
+		identityset.Address(4).String(),
+	}, unqualified)
+	ds, err = getDoubleSigners(sm)
+	require.NoError(err)
+	require.Empty(ds)
+}
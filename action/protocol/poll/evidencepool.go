@@ -0,0 +1,95 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+)
+
+// EvidencePool keeps the double sign evidences detected by consensus until they are committed into a block
+type EvidencePool struct {
+	mutex     sync.RWMutex
+	evidences map[hash.Hash256]*action.DoubleSignEvidence
+}
+
+// NewEvidencePool creates an empty evidence pool
+func NewEvidencePool() *EvidencePool {
+	return &EvidencePool{
+		evidences: make(map[hash.Hash256]*action.DoubleSignEvidence),
+	}
+}
+
+// Add verifies an evidence and adds it into the pool, evidences of the same double sign are only kept once
+func (ep *EvidencePool) Add(ev *action.DoubleSignEvidence) error {
+	if err := ev.Verify(); err != nil {
+		return err
+	}
+	key, err := ev.Key()
+	if err != nil {
+		return err
+	}
+	ep.mutex.Lock()
+	defer ep.mutex.Unlock()
+	if _, ok := ep.evidences[key]; !ok {
+		ep.evidences[key] = ev
+	}
+	return nil
+}
+
+// Size returns the number of pending evidences
+func (ep *EvidencePool) Size() int {
+	ep.mutex.RLock()
+	defer ep.mutex.RUnlock()
+	return len(ep.evidences)
+}
+
+// PendingEvidences returns the pending evidences sorted by height and key
+func (ep *EvidencePool) PendingEvidences() []*action.DoubleSignEvidence {
+	ep.mutex.RLock()
+	defer ep.mutex.RUnlock()
+	keys := make([]hash.Hash256, 0, len(ep.evidences))
+	for k := range ep.evidences {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		hi, hj := ep.evidences[keys[i]].Height(), ep.evidences[keys[j]].Height()
+		if hi != hj {
+			return hi < hj
+		}
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	evidences := make([]*action.DoubleSignEvidence, 0, len(keys))
+	for _, k := range keys {
+		evidences = append(evidences, ep.evidences[k])
+	}
+	return evidences
+}
+
+// ReceiveBlock removes the evidences committed in the block from the pool
+func (ep *EvidencePool) ReceiveBlock(blk *block.Block) error {
+	ep.mutex.Lock()
+	defer ep.mutex.Unlock()
+	for _, selp := range blk.Actions {
+		ev, ok := selp.Action().(*action.DoubleSignEvidence)
+		if !ok {
+			continue
+		}
+		key, err := ev.Key()
+		if err != nil {
+			return err
+		}
+		delete(ep.evidences, key)
+	}
+	return nil
+}
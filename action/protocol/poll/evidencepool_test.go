@@ -0,0 +1,83 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+type testVote struct {
+	*iotextypes.ConsensusVote
+}
+
+func (v *testVote) Hash() ([]byte, error) {
+	ser, err := proto.Marshal(v.ConsensusVote)
+	if err != nil {
+		return nil, err
+	}
+	h := blake2b.Sum256(ser)
+	return h[:], nil
+}
+
+func testEvidence(t *testing.T, sk crypto.PrivateKey, height uint64, ts time.Time) *action.DoubleSignEvidence {
+	msgs := make([]*iotextypes.ConsensusMessage, 0, 2)
+	for _, blkHash := range [][]byte{{1}, {2}} {
+		vote := &iotextypes.ConsensusVote{BlockHash: blkHash, Topic: iotextypes.ConsensusVote_COMMIT}
+		en, err := endorsement.Endorse(sk, &testVote{vote}, ts)
+		require.NoError(t, err)
+		enProto, err := en.Proto()
+		require.NoError(t, err)
+		msgs = append(msgs, &iotextypes.ConsensusMessage{
+			Height:      height,
+			Endorsement: enProto,
+			Msg:         &iotextypes.ConsensusMessage_Vote{Vote: vote},
+		})
+	}
+	return action.NewDoubleSignEvidence(0, msgs[0], msgs[1])
+}
+
+func TestEvidencePool(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	ep := NewEvidencePool()
+	ev1 := testEvidence(t, identityset.PrivateKey(1), 20, now)
+	ev2 := testEvidence(t, identityset.PrivateKey(2), 10, now)
+	require.NoError(ep.Add(ev1))
+	require.NoError(ep.Add(ev2))
+	// the same double sign is only kept once
+	m1, m2 := ev1.Messages()
+	require.NoError(ep.Add(action.NewDoubleSignEvidence(0, m2, m1)))
+	require.Equal(2, ep.Size())
+	// invalid evidence is rejected
+	require.Error(ep.Add(action.NewDoubleSignEvidence(0, m1, m1)))
+	require.Equal(2, ep.Size())
+
+	pending := ep.PendingEvidences()
+	require.Equal(2, len(pending))
+	require.Equal(uint64(10), pending[0].Height())
+	require.Equal(uint64(20), pending[1].Height())
+
+	// blocks without evidences leave the pool untouched
+	blk, err := block.NewTestingBuilder().
+		SetHeight(21).
+		SignAndBuild(identityset.PrivateKey(3))
+	require.NoError(err)
+	require.NoError(ep.ReceiveBlock(&blk))
+	require.Equal(2, ep.Size())
+}
@@ -117,9 +117,16 @@ func (p *governanceChainCommitteeProtocol) CreatePreStates(ctx context.Context,
 }
 
 func (p *governanceChainCommitteeProtocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	if ev, ok := act.(*action.DoubleSignEvidence); ok {
+		return handleDoubleSignEvidence(ctx, sm, p.sh, ev, p.addr.String(), nil)
+	}
 	return handle(ctx, act, sm, p.indexer, p.addr.String())
 }
 
+func (p *governanceChainCommitteeProtocol) pendingEvidences() []*action.DoubleSignEvidence {
+	return p.sh.pendingEvidences()
+}
+
 func (p *governanceChainCommitteeProtocol) Validate(ctx context.Context, act action.Action) error {
 	return validate(ctx, p, act)
 }
@@ -64,9 +64,16 @@ func (ns *nativeStakingV2) CreatePostSystemActions(ctx context.Context) ([]actio
 }
 
 func (ns *nativeStakingV2) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	if ev, ok := act.(*action.DoubleSignEvidence); ok {
+		return handleDoubleSignEvidence(ctx, sm, ns.slasher, ev, ns.addr.String(), ns.stakingV2)
+	}
 	return handle(ctx, act, sm, ns.candIndexer, ns.addr.String())
 }
 
+func (ns *nativeStakingV2) pendingEvidences() []*action.DoubleSignEvidence {
+	return ns.slasher.pendingEvidences()
+}
+
 func (ns *nativeStakingV2) Validate(ctx context.Context, act action.Action) error {
 	return validate(ctx, ns, act)
 }
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: poll.proto
+
+package pollpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DoubleSigners struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoubleSigners) Reset()         { *m = DoubleSigners{} }
+func (m *DoubleSigners) String() string { return proto.CompactTextString(m) }
+func (*DoubleSigners) ProtoMessage()    {}
+func (*DoubleSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d64382c74eeea90, []int{0}
+}
+
+func (m *DoubleSigners) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSigners.Unmarshal(m, b)
+}
+func (m *DoubleSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSigners.Marshal(b, m, deterministic)
+}
+func (m *DoubleSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSigners.Merge(m, src)
+}
+func (m *DoubleSigners) XXX_Size() int {
+	return xxx_messageInfo_DoubleSigners.Size(m)
+}
+func (m *DoubleSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSigners proto.InternalMessageInfo
+
+func (m *DoubleSigners) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type ProbationInfo struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	RemainingEpochs      uint64   `protobuf:"varint,3,opt,name=remainingEpochs,proto3" json:"remainingEpochs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbationInfo) Reset()         { *m = ProbationInfo{} }
+func (m *ProbationInfo) String() string { return proto.CompactTextString(m) }
+func (*ProbationInfo) ProtoMessage()    {}
+func (*ProbationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d64382c74eeea90, []int{1}
+}
+
+func (m *ProbationInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbationInfo.Unmarshal(m, b)
+}
+func (m *ProbationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbationInfo.Marshal(b, m, deterministic)
+}
+func (m *ProbationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbationInfo.Merge(m, src)
+}
+func (m *ProbationInfo) XXX_Size() int {
+	return xxx_messageInfo_ProbationInfo.Size(m)
+}
+func (m *ProbationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbationInfo proto.InternalMessageInfo
+
+func (m *ProbationInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProbationInfo) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ProbationInfo) GetRemainingEpochs() uint64 {
+	if m != nil {
+		return m.RemainingEpochs
+	}
+	return 0
+}
+
+type ProbationInfoList struct {
+	Epoch                uint64           `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	IntensityRate        uint32           `protobuf:"varint,2,opt,name=intensityRate,proto3" json:"intensityRate,omitempty"`
+	ProbationInfos       []*ProbationInfo `protobuf:"bytes,3,rep,name=probationInfos,proto3" json:"probationInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ProbationInfoList) Reset()         { *m = ProbationInfoList{} }
+func (m *ProbationInfoList) String() string { return proto.CompactTextString(m) }
+func (*ProbationInfoList) ProtoMessage()    {}
+func (*ProbationInfoList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d64382c74eeea90, []int{2}
+}
+
+func (m *ProbationInfoList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbationInfoList.Unmarshal(m, b)
+}
+func (m *ProbationInfoList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbationInfoList.Marshal(b, m, deterministic)
+}
+func (m *ProbationInfoList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbationInfoList.Merge(m, src)
+}
+func (m *ProbationInfoList) XXX_Size() int {
+	return xxx_messageInfo_ProbationInfoList.Size(m)
+}
+func (m *ProbationInfoList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbationInfoList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbationInfoList proto.InternalMessageInfo
+
+func (m *ProbationInfoList) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProbationInfoList) GetIntensityRate() uint32 {
+	if m != nil {
+		return m.IntensityRate
+	}
+	return 0
+}
+
+func (m *ProbationInfoList) GetProbationInfos() []*ProbationInfo {
+	if m != nil {
+		return m.ProbationInfos
+	}
+	return nil
+}
+
+type DelegateProductivity struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Production           uint64   `protobuf:"varint,2,opt,name=production,proto3" json:"production,omitempty"`
+	ExpectedProduction   uint64   `protobuf:"varint,3,opt,name=expectedProduction,proto3" json:"expectedProduction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegateProductivity) Reset()         { *m = DelegateProductivity{} }
+func (m *DelegateProductivity) String() string { return proto.CompactTextString(m) }
+func (*DelegateProductivity) ProtoMessage()    {}
+func (*DelegateProductivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d64382c74eeea90, []int{3}
+}
+
+func (m *DelegateProductivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateProductivity.Unmarshal(m, b)
+}
+func (m *DelegateProductivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateProductivity.Marshal(b, m, deterministic)
+}
+func (m *DelegateProductivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateProductivity.Merge(m, src)
+}
+func (m *DelegateProductivity) XXX_Size() int {
+	return xxx_messageInfo_DelegateProductivity.Size(m)
+}
+func (m *DelegateProductivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateProductivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateProductivity proto.InternalMessageInfo
+
+func (m *DelegateProductivity) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DelegateProductivity) GetProduction() uint64 {
+	if m != nil {
+		return m.Production
+	}
+	return 0
+}
+
+func (m *DelegateProductivity) GetExpectedProduction() uint64 {
+	if m != nil {
+		return m.ExpectedProduction
+	}
+	return 0
+}
+
+type EpochProductivity struct {
+	Epoch                uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TotalBlocks          uint64                  `protobuf:"varint,2,opt,name=totalBlocks,proto3" json:"totalBlocks,omitempty"`
+	Productivities       []*DelegateProductivity `protobuf:"bytes,3,rep,name=productivities,proto3" json:"productivities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *EpochProductivity) Reset()         { *m = EpochProductivity{} }
+func (m *EpochProductivity) String() string { return proto.CompactTextString(m) }
+func (*EpochProductivity) ProtoMessage()    {}
+func (*EpochProductivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d64382c74eeea90, []int{4}
+}
+
+func (m *EpochProductivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochProductivity.Unmarshal(m, b)
+}
+func (m *EpochProductivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochProductivity.Marshal(b, m, deterministic)
+}
+func (m *EpochProductivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProductivity.Merge(m, src)
+}
+func (m *EpochProductivity) XXX_Size() int {
+	return xxx_messageInfo_EpochProductivity.Size(m)
+}
+func (m *EpochProductivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProductivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProductivity proto.InternalMessageInfo
+
+func (m *EpochProductivity) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochProductivity) GetTotalBlocks() uint64 {
+	if m != nil {
+		return m.TotalBlocks
+	}
+	return 0
+}
+
+func (m *EpochProductivity) GetProductivities() []*DelegateProductivity {
+	if m != nil {
+		return m.Productivities
+	}
+	return nil
+}
+
+type EpochProductivityList struct {
+	EpochProductivities  []*EpochProductivity `protobuf:"bytes,1,rep,name=epochProductivities,proto3" json:"epochProductivities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EpochProductivityList) Reset()         { *m = EpochProductivityList{} }
+func (m *EpochProductivityList) String() string { return proto.CompactTextString(m) }
+func (*EpochProductivityList) ProtoMessage()    {}
+func (*EpochProductivityList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d64382c74eeea90, []int{5}
+}
+
+func (m *EpochProductivityList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochProductivityList.Unmarshal(m, b)
+}
+func (m *EpochProductivityList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochProductivityList.Marshal(b, m, deterministic)
+}
+func (m *EpochProductivityList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProductivityList.Merge(m, src)
+}
+func (m *EpochProductivityList) XXX_Size() int {
+	return xxx_messageInfo_EpochProductivityList.Size(m)
+}
+func (m *EpochProductivityList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProductivityList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProductivityList proto.InternalMessageInfo
+
+func (m *EpochProductivityList) GetEpochProductivities() []*EpochProductivity {
+	if m != nil {
+		return m.EpochProductivities
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DoubleSigners)(nil), "pollpb.DoubleSigners")
+	proto.RegisterType((*ProbationInfo)(nil), "pollpb.ProbationInfo")
+	proto.RegisterType((*ProbationInfoList)(nil), "pollpb.ProbationInfoList")
+	proto.RegisterType((*DelegateProductivity)(nil), "pollpb.DelegateProductivity")
+	proto.RegisterType((*EpochProductivity)(nil), "pollpb.EpochProductivity")
+	proto.RegisterType((*EpochProductivityList)(nil), "pollpb.EpochProductivityList")
+}
+
+func init() { proto.RegisterFile("poll.proto", fileDescriptor_5d64382c74eeea90) }
+
+var fileDescriptor_5d64382c74eeea90 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0x4b, 0xfb, 0x40,
+	0x10, 0xc6, 0xd9, 0x7f, 0xfb, 0xaf, 0x74, 0x4a, 0x94, 0xae, 0x2d, 0x44, 0x28, 0x12, 0x82, 0x87,
+	0x5c, 0xcc, 0xa1, 0x9e, 0xbd, 0x48, 0x3d, 0x88, 0x1e, 0xca, 0xfa, 0x09, 0xf2, 0x32, 0xd6, 0xc5,
+	0xb8, 0xbb, 0x64, 0xa7, 0x62, 0x6f, 0x7e, 0x02, 0xc1, 0x6f, 0x2c, 0x79, 0xa3, 0x4d, 0x1a, 0xbc,
+	0x65, 0x9e, 0x67, 0x66, 0x9e, 0xcd, 0x6f, 0x17, 0xc0, 0xe8, 0x2c, 0x0b, 0x4d, 0xae, 0x49, 0xf3,
+	0x51, 0xf1, 0x6d, 0x62, 0xff, 0x1a, 0x9c, 0x95, 0xde, 0xc6, 0x19, 0x3e, 0xcb, 0x8d, 0xc2, 0xdc,
+	0xf2, 0x05, 0x8c, 0xa3, 0x34, 0xcd, 0xd1, 0x5a, 0xb4, 0x2e, 0xf3, 0x06, 0xc1, 0x58, 0xec, 0x05,
+	0x5f, 0x82, 0xb3, 0xce, 0x75, 0x1c, 0x91, 0xd4, 0xea, 0x41, 0xbd, 0x68, 0xee, 0xc2, 0x49, 0xed,
+	0xba, 0xcc, 0x63, 0xc1, 0x58, 0x34, 0x25, 0x9f, 0xc1, 0xff, 0x44, 0x6f, 0x15, 0xb9, 0xff, 0x3c,
+	0x16, 0x38, 0xa2, 0x2a, 0x78, 0x00, 0x67, 0x39, 0xbe, 0x47, 0x52, 0x49, 0xb5, 0xb9, 0x37, 0x3a,
+	0x79, 0xb5, 0xee, 0xc0, 0x63, 0xc1, 0x50, 0x74, 0x65, 0xff, 0x9b, 0xc1, 0xb4, 0x95, 0xf5, 0x24,
+	0x2d, 0x15, 0x5b, 0xb1, 0xf0, 0xcb, 0xb4, 0xa1, 0xa8, 0x0a, 0x7e, 0x05, 0x8e, 0x54, 0x84, 0xca,
+	0x4a, 0xda, 0x89, 0x88, 0xb0, 0xce, 0x6c, 0x8b, 0xfc, 0x16, 0x4e, 0xcd, 0xe1, 0xc2, 0x22, 0x7a,
+	0x10, 0x4c, 0x96, 0xf3, 0xb0, 0x82, 0x11, 0xb6, 0xe2, 0x44, 0xa7, 0xd9, 0xff, 0x62, 0x30, 0x5b,
+	0x61, 0x86, 0x9b, 0x88, 0x70, 0x9d, 0xeb, 0x74, 0x9b, 0x90, 0xfc, 0x90, 0xb4, 0xfb, 0x83, 0xc1,
+	0x25, 0x80, 0xa9, 0x3b, 0xb5, 0x2a, 0x0f, 0x35, 0x14, 0x07, 0x0a, 0x0f, 0x81, 0xe3, 0xa7, 0xc1,
+	0x84, 0x30, 0x5d, 0xef, 0xfb, 0x2a, 0x20, 0x3d, 0x8e, 0xff, 0xc3, 0x60, 0x5a, 0xe2, 0x69, 0xe5,
+	0xf7, 0x33, 0xf1, 0x60, 0x42, 0x9a, 0xa2, 0xec, 0x2e, 0xd3, 0xc9, 0x9b, 0xad, 0xc3, 0x0f, 0x25,
+	0xbe, 0x2a, 0x79, 0x34, 0x7b, 0x24, 0x36, 0x3c, 0x16, 0x0d, 0x8f, 0xbe, 0xbf, 0x15, 0x9d, 0x19,
+	0x3f, 0x85, 0xf9, 0xd1, 0x91, 0xca, 0xab, 0x7a, 0x84, 0x73, 0xec, 0x18, 0xb2, 0x7e, 0x53, 0x93,
+	0xe5, 0x45, 0x93, 0x71, 0x34, 0x2b, 0xfa, 0xa6, 0xe2, 0x51, 0xf9, 0x6c, 0x6f, 0x7e, 0x07, 0x00,
+	0x2a, 0x14, 0xa8, 0xc9, 0xc4, 0x02, 0x00, 0x00,
+}
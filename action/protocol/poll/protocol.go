@@ -128,6 +128,7 @@ func NewProtocol(
 	getBlockTimeFunc GetBlockTime,
 	productivity Productivity,
 	getBlockHash evm.GetBlockHash,
+	evidencePool *EvidencePool,
 ) (Protocol, error) {
 	genesisConfig := cfg.Genesis
 	if cfg.Consensus.Scheme != config.RollDPoSScheme {
@@ -161,6 +162,7 @@ func NewProtocol(
 		if err != nil {
 			return nil, err
 		}
+		slasher.evidencePool = evidencePool
 		scoreThreshold, ok = new(big.Int).SetString(cfg.Genesis.ScoreThreshold, 10)
 		if !ok {
 			return nil, errors.Errorf("failed to parse score threshold %s", cfg.Genesis.ScoreThreshold)
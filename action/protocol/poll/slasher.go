@@ -40,6 +40,7 @@ type Slasher struct {
 	probationEpochPeriod  uint64
 	maxProbationPeriod    uint64
 	probationIntensity    uint32
+	evidencePool          *EvidencePool
 }
 
 // NewSlasher returns a new Slasher
@@ -370,6 +371,9 @@ func (sh *Slasher) CalculateProbationList(
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to calculate current epoch upd %d", epochNum-1)
 		}
+		if uq, err = sh.mergeDoubleSigners(sm, uq); err != nil {
+			return nil, errors.Wrap(err, "failed to merge double signers")
+		}
 		for _, addr := range uq {
 			if _, ok := unqualifiedDelegates[addr]; !ok {
 				unqualifiedDelegates[addr] = 1
@@ -409,6 +413,9 @@ func (sh *Slasher) CalculateProbationList(
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to calculate current epoch upd %d", epochNum-1)
 	}
+	if addList, err = sh.mergeDoubleSigners(sm, addList); err != nil {
+		return nil, errors.Wrap(err, "failed to merge double signers")
+	}
 	if err := upd.AddRecentUPD(addList); err != nil {
 		return nil, errors.Wrap(err, "failed to add recent upd")
 	}
@@ -89,6 +89,14 @@ func (sc *stakingCommand) Handle(ctx context.Context, act action.Action, sm prot
 	return sc.stakingV1.Handle(ctx, act, sm)
 }
 
+func (sc *stakingCommand) pendingEvidences() []*action.DoubleSignEvidence {
+	// v1 and v2 share the same slasher, so either of them returns the same evidences
+	if provider, ok := sc.stakingV2.(evidenceProvider); ok {
+		return provider.pendingEvidences()
+	}
+	return nil
+}
+
 func (sc *stakingCommand) Validate(ctx context.Context, act action.Action) error {
 	// no height here,  v1 v2 has the same validate method, so directly use common one
 	return validate(ctx, sc, act)
@@ -189,6 +189,13 @@ func (sc *stakingCommittee) Handle(ctx context.Context, act action.Action, sm pr
 	return receipt, err
 }
 
+func (sc *stakingCommittee) pendingEvidences() []*action.DoubleSignEvidence {
+	if provider, ok := sc.governanceStaking.(evidenceProvider); ok {
+		return provider.pendingEvidences()
+	}
+	return nil
+}
+
 func (sc *stakingCommittee) Validate(ctx context.Context, act action.Action) error {
 	return validate(ctx, sc, act)
 }
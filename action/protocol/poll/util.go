@@ -68,6 +68,9 @@ func handle(ctx context.Context, act action.Action, sm protocol.StateManager, in
 }
 
 func validate(ctx context.Context, p Protocol, act action.Action) error {
+	if ev, ok := act.(*action.DoubleSignEvidence); ok {
+		return validateDoubleSignEvidence(ctx, ev)
+	}
 	ppr, ok := act.(*action.PutPollResult)
 	if !ok {
 		return nil
@@ -110,9 +113,10 @@ func createPostSystemActions(ctx context.Context, p Protocol) ([]action.Envelope
 	lastBlkHeight := rp.GetEpochLastBlockHeight(epochNum)
 	epochHeight := rp.GetEpochHeight(epochNum)
 	nextEpochHeight := rp.GetEpochHeight(epochNum + 1)
+	evidences := createDoubleSignEvidenceActions(ctx, p)
 	// make sure that putpollresult action is created around half of each epoch
 	if blkCtx.BlockHeight < epochHeight+(nextEpochHeight-epochHeight)/2 {
-		return nil, nil
+		return evidences, nil
 	}
 	log.L().Debug(
 		"createPutPollResultAction",
@@ -142,7 +146,7 @@ func createPostSystemActions(ctx context.Context, p Protocol) ([]action.Envelope
 	pollAction := action.NewPutPollResult(nonce, nextEpochHeight, l)
 	builder := action.EnvelopeBuilder{}
 
-	return []action.Envelope{builder.SetNonce(nonce).SetAction(pollAction).Build()}, nil
+	return append([]action.Envelope{builder.SetNonce(nonce).SetAction(pollAction).Build()}, evidences...), nil
 }
 
 // setCandidates sets the candidates for the given state manager
@@ -15,7 +15,9 @@ import (
 
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 )
 
 // admin stores the admin data of the rewarding protocol
@@ -27,6 +29,8 @@ type admin struct {
 	numDelegatesForFoundationBonus uint64
 	foundationBonusLastEpoch       uint64
 	productivityThreshold          uint64
+	emissionSchedule               []emissionStep
+	maxTotalReward                 *big.Int
 }
 
 // Serialize serializes admin state into bytes
@@ -40,6 +44,12 @@ func (a admin) Serialize() ([]byte, error) {
 		FoundationBonusLastEpoch:       a.foundationBonusLastEpoch,
 		ProductivityThreshold:          a.productivityThreshold,
 	}
+	for _, step := range a.emissionSchedule {
+		gen.EmissionSchedule = append(gen.EmissionSchedule, step.Proto())
+	}
+	if a.maxTotalReward != nil {
+		gen.MaxTotalReward = a.maxTotalReward.String()
+	}
 	return proto.Marshal(&gen)
 }
 
@@ -68,6 +78,22 @@ func (a *admin) Deserialize(data []byte) error {
 	a.numDelegatesForFoundationBonus = gen.NumDelegatesForFoundationBonus
 	a.foundationBonusLastEpoch = gen.FoundationBonusLastEpoch
 	a.productivityThreshold = gen.ProductivityThreshold
+	a.emissionSchedule = nil
+	for _, stepPb := range gen.EmissionSchedule {
+		step := emissionStep{}
+		if err := step.LoadProto(stepPb); err != nil {
+			return err
+		}
+		a.emissionSchedule = append(a.emissionSchedule, step)
+	}
+	a.maxTotalReward = nil
+	if gen.MaxTotalReward != "" {
+		maxTotalReward, ok := big.NewInt(0).SetString(gen.MaxTotalReward, 10)
+		if !ok {
+			return errors.New("failed to set max total reward")
+		}
+		a.maxTotalReward = maxTotalReward
+	}
 	return nil
 }
 
@@ -130,6 +156,16 @@ func (p *Protocol) CreateGenesisStates(
 	numDelegatesForFoundationBonus := bcCtx.Genesis.NumDelegatesForFoundationBonus
 	foundationBonusLastEpoch := bcCtx.Genesis.FoundationBonusLastEpoch
 	productivityThreshold := bcCtx.Genesis.ProductivityThreshold
+	emissionSchedule, err := newEmissionSchedule(bcCtx.Genesis.EmissionSchedule)
+	if err != nil {
+		return err
+	}
+	maxTotalReward := bcCtx.Genesis.MaxTotalReward()
+	if maxTotalReward != nil {
+		if err := p.assertAmount(maxTotalReward); err != nil {
+			return err
+		}
+	}
 
 	if err := p.putState(
 		sm,
@@ -142,6 +178,8 @@ func (p *Protocol) CreateGenesisStates(
 			numDelegatesForFoundationBonus: numDelegatesForFoundationBonus,
 			foundationBonusLastEpoch:       foundationBonusLastEpoch,
 			productivityThreshold:          productivityThreshold,
+			emissionSchedule:               emissionSchedule,
+			maxTotalReward:                 maxTotalReward,
 		},
 	); err != nil {
 		return err
@@ -167,11 +205,11 @@ func (p *Protocol) CreateGenesisStates(
 
 // BlockReward returns the block reward amount
 func (p *Protocol) BlockReward(
-	_ context.Context,
+	ctx context.Context,
 	sm protocol.StateReader,
 ) (*big.Int, error) {
-	a := admin{}
-	if err := p.state(sm, adminKey, &a); err != nil {
+	a, err := p.activeAdmin(ctx, sm)
+	if err != nil {
 		return nil, err
 	}
 	return a.blockReward, nil
@@ -179,11 +217,11 @@ func (p *Protocol) BlockReward(
 
 // EpochReward returns the epoch reward amount
 func (p *Protocol) EpochReward(
-	_ context.Context,
+	ctx context.Context,
 	sm protocol.StateReader,
 ) (*big.Int, error) {
-	a := admin{}
-	if err := p.state(sm, adminKey, &a); err != nil {
+	a, err := p.activeAdmin(ctx, sm)
+	if err != nil {
 		return nil, err
 	}
 	return a.epochReward, nil
@@ -191,20 +229,20 @@ func (p *Protocol) EpochReward(
 
 // NumDelegatesForEpochReward returns the number of candidates sharing an epoch reward
 func (p *Protocol) NumDelegatesForEpochReward(
-	_ context.Context,
+	ctx context.Context,
 	sm protocol.StateManager,
 ) (uint64, error) {
-	a := admin{}
-	if err := p.state(sm, adminKey, &a); err != nil {
+	a, err := p.activeAdmin(ctx, sm)
+	if err != nil {
 		return 0, err
 	}
 	return a.numDelegatesForEpochReward, nil
 }
 
 // FoundationBonus returns the foundation bonus amount
-func (p *Protocol) FoundationBonus(_ context.Context, sm protocol.StateReader) (*big.Int, error) {
-	a := admin{}
-	if err := p.state(sm, adminKey, &a); err != nil {
+func (p *Protocol) FoundationBonus(ctx context.Context, sm protocol.StateReader) (*big.Int, error) {
+	a, err := p.activeAdmin(ctx, sm)
+	if err != nil {
 		return nil, err
 	}
 	return a.foundationBonus, nil
@@ -259,6 +297,41 @@ func (p *Protocol) SetReward(
 	return p.putState(sm, adminKey, &a)
 }
 
+// activeAdmin returns the admin data in effect at the block in context, with the emission schedule and the parameters
+// changed by governance applied
+func (p *Protocol) activeAdmin(ctx context.Context, sr protocol.StateReader) (*admin, error) {
+	a := admin{}
+	if err := p.state(sr, adminKey, &a); err != nil {
+		return nil, err
+	}
+	registry, hasRegistry := protocol.GetRegistry(ctx)
+	blkCtx, hasBlkCtx := protocol.GetBlockCtx(ctx)
+	if hasRegistry && hasBlkCtx {
+		if rp := rolldpos.FindProtocol(registry); rp != nil {
+			a.applyEmissionSchedule(rp.GetEpochNum(blkCtx.BlockHeight))
+		}
+	}
+	var err error
+	if a.blockReward, err = governance.AmountParameter(ctx, sr, governance.BlockReward, a.blockReward); err != nil {
+		return nil, err
+	}
+	if a.epochReward, err = governance.AmountParameter(ctx, sr, governance.EpochReward, a.epochReward); err != nil {
+		return nil, err
+	}
+	if a.foundationBonus, err = governance.AmountParameter(ctx, sr, governance.FoundationBonus, a.foundationBonus); err != nil {
+		return nil, err
+	}
+	if a.numDelegatesForEpochReward, err = governance.Uint64Parameter(
+		ctx,
+		sr,
+		governance.NumDelegatesForEpochReward,
+		a.numDelegatesForEpochReward,
+	); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
 func (p *Protocol) assertAmount(amount *big.Int) error {
 	if amount.Cmp(big.NewInt(0)) >= 0 {
 		return nil
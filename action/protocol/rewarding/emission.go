@@ -0,0 +1,251 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rewarding
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// maxProjectedEpochs is the max number of epochs projected in one read
+const maxProjectedEpochs = 1000
+
+var grantedKey = []byte("grt")
+
+// emissionStep defines the block and epoch reward from the start epoch, which decrease by the decay rate every decay
+// interval
+type emissionStep struct {
+	startEpoch    uint64
+	blockReward   *big.Int
+	epochReward   *big.Int
+	decayRate     uint32
+	decayInterval uint64
+}
+
+func newEmissionSchedule(cfg []genesis.EmissionStep) ([]emissionStep, error) {
+	var schedule []emissionStep
+	for i, c := range cfg {
+		step := emissionStep{
+			startEpoch:    c.StartEpoch,
+			blockReward:   c.BlockReward(),
+			epochReward:   c.EpochReward(),
+			decayRate:     c.DecayRate,
+			decayInterval: c.DecayInterval,
+		}
+		if err := step.validate(); err != nil {
+			return nil, err
+		}
+		if i > 0 && step.startEpoch <= schedule[i-1].startEpoch {
+			return nil, errors.Errorf("emission step at epoch %d is not in order", step.startEpoch)
+		}
+		schedule = append(schedule, step)
+	}
+	return schedule, nil
+}
+
+func (s *emissionStep) validate() error {
+	if s.blockReward.Sign() < 0 || s.epochReward.Sign() < 0 {
+		return errors.Errorf("negative reward of emission step at epoch %d", s.startEpoch)
+	}
+	if s.decayRate >= 100 {
+		return errors.Errorf("invalid decay rate %d of emission step at epoch %d", s.decayRate, s.startEpoch)
+	}
+	return nil
+}
+
+// rewards returns the block and epoch reward at the epoch, which should not be earlier than the start epoch
+func (s *emissionStep) rewards(epoch uint64) (*big.Int, *big.Int) {
+	blockReward := new(big.Int).Set(s.blockReward)
+	epochReward := new(big.Int).Set(s.epochReward)
+	if s.decayRate == 0 || s.decayInterval == 0 {
+		return blockReward, epochReward
+	}
+	remaining := big.NewInt(int64(100 - s.decayRate))
+	hundred := big.NewInt(100)
+	for n := (epoch - s.startEpoch) / s.decayInterval; n > 0; n-- {
+		if blockReward.Sign() == 0 && epochReward.Sign() == 0 {
+			break
+		}
+		blockReward.Div(blockReward.Mul(blockReward, remaining), hundred)
+		epochReward.Div(epochReward.Mul(epochReward, remaining), hundred)
+	}
+	return blockReward, epochReward
+}
+
+// Proto converts the emission step to protobuf
+func (s *emissionStep) Proto() *rewardingpb.EmissionStep {
+	return &rewardingpb.EmissionStep{
+		StartEpoch:    s.startEpoch,
+		BlockReward:   s.blockReward.String(),
+		EpochReward:   s.epochReward.String(),
+		DecayRate:     s.decayRate,
+		DecayInterval: s.decayInterval,
+	}
+}
+
+// LoadProto loads the emission step from protobuf
+func (s *emissionStep) LoadProto(pb *rewardingpb.EmissionStep) error {
+	blockReward, ok := big.NewInt(0).SetString(pb.BlockReward, 10)
+	if !ok {
+		return errors.New("failed to set block reward of emission step")
+	}
+	epochReward, ok := big.NewInt(0).SetString(pb.EpochReward, 10)
+	if !ok {
+		return errors.New("failed to set epoch reward of emission step")
+	}
+	s.startEpoch = pb.StartEpoch
+	s.blockReward = blockReward
+	s.epochReward = epochReward
+	s.decayRate = pb.DecayRate
+	s.decayInterval = pb.DecayInterval
+	return nil
+}
+
+// applyEmissionSchedule sets the block and epoch reward to those of the emission step in effect at the epoch
+func (a *admin) applyEmissionSchedule(epoch uint64) {
+	for i := len(a.emissionSchedule) - 1; i >= 0; i-- {
+		if a.emissionSchedule[i].startEpoch <= epoch {
+			a.blockReward, a.epochReward = a.emissionSchedule[i].rewards(epoch)
+			return
+		}
+	}
+}
+
+// remainingReward returns the amount that can still be granted under the cap of total reward, or nil if there is no
+// cap
+func (p *Protocol) remainingReward(sr protocol.StateReader, a *admin) (*big.Int, error) {
+	if a.maxTotalReward == nil {
+		return nil, nil
+	}
+	granted, err := p.totalGranted(sr)
+	if err != nil {
+		return nil, err
+	}
+	remaining := new(big.Int).Sub(a.maxTotalReward, granted)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+	return remaining, nil
+}
+
+// addGranted adds the amount to the total granted reward, which is only tracked if the total reward is capped
+func (p *Protocol) addGranted(sm protocol.StateManager, a *admin, amount *big.Int) error {
+	if a.maxTotalReward == nil {
+		return nil
+	}
+	granted, err := p.totalGranted(sm)
+	if err != nil {
+		return err
+	}
+	// the total granted reward is stored in the same format as a reward account
+	return p.putState(sm, grantedKey, &rewardAccount{balance: granted.Add(granted, amount)})
+}
+
+func (p *Protocol) totalGranted(sr protocol.StateReader) (*big.Int, error) {
+	acc := rewardAccount{}
+	if err := p.state(sr, grantedKey, &acc); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+	return acc.balance, nil
+}
+
+// capAmount returns the amount limited by the remaining reward, where nil remaining means no limit
+func capAmount(amount, remaining *big.Int) *big.Int {
+	if remaining != nil && amount.Cmp(remaining) > 0 {
+		return new(big.Int).Set(remaining)
+	}
+	return amount
+}
+
+// ProjectIssuance projects the maximum reward issued in each epoch of [startEpoch, startEpoch+count) per the emission
+// schedule and the cap of total reward, assuming all the rewards are granted. At most maxProjectedEpochs epochs are
+// projected at a time.
+func (p *Protocol) ProjectIssuance(
+	ctx context.Context,
+	sr protocol.StateReader,
+	startEpoch uint64,
+	count uint64,
+) (*rewardingpb.IssuanceProjection, error) {
+	if startEpoch == 0 || count == 0 || count > maxProjectedEpochs || startEpoch+count < startEpoch {
+		return nil, errors.Errorf("invalid epoch range [%d, %d)", startEpoch, startEpoch+count)
+	}
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	a := admin{}
+	if err := p.state(sr, adminKey, &a); err != nil {
+		return nil, err
+	}
+	remaining, err := p.remainingReward(sr, &a)
+	if err != nil {
+		return nil, err
+	}
+	granted, err := p.totalGranted(sr)
+	if err != nil {
+		return nil, err
+	}
+	projection := &rewardingpb.IssuanceProjection{TotalGranted: granted.String()}
+	if a.maxTotalReward != nil {
+		projection.MaxTotalReward = a.maxTotalReward.String()
+	}
+	for epoch := startEpoch; epoch < startEpoch+count; epoch++ {
+		a.applyEmissionSchedule(epoch)
+		numBlocks := rp.GetEpochLastBlockHeight(epoch) - rp.GetEpochHeight(epoch) + 1
+		blockRewards := capAmount(new(big.Int).Mul(a.blockReward, new(big.Int).SetUint64(numBlocks)), remaining)
+		remaining = subRemaining(remaining, blockRewards)
+		epochReward := capAmount(a.epochReward, remaining)
+		remaining = subRemaining(remaining, epochReward)
+		foundationBonus := big.NewInt(0)
+		if epoch <= a.foundationBonusLastEpoch {
+			foundationBonus = capAmount(
+				new(big.Int).Mul(a.foundationBonus, new(big.Int).SetUint64(a.numDelegatesForFoundationBonus)),
+				remaining,
+			)
+			remaining = subRemaining(remaining, foundationBonus)
+		}
+		total := new(big.Int).Add(blockRewards, epochReward)
+		total.Add(total, foundationBonus)
+		projection.Epochs = append(projection.Epochs, &rewardingpb.EpochIssuance{
+			Epoch:           epoch,
+			NumBlocks:       numBlocks,
+			BlockReward:     capAmount(a.blockReward, blockRewards).String(),
+			EpochReward:     epochReward.String(),
+			FoundationBonus: foundationBonus.String(),
+			Total:           total.String(),
+		})
+	}
+	return projection, nil
+}
+
+func subRemaining(remaining, amount *big.Int) *big.Int {
+	if remaining == nil {
+		return nil
+	}
+	return new(big.Int).Sub(remaining, amount)
+}
+
+func (p *Protocol) readIssuanceProjection(ctx context.Context, sr protocol.StateReader, args ...[]byte) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, errors.Errorf("invalid number of arguments %d", len(args))
+	}
+	projection, err := p.ProjectIssuance(ctx, sr, byteutil.BytesToUint64(args[0]), byteutil.BytesToUint64(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(projection)
+}
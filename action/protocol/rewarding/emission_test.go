@@ -0,0 +1,124 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rewarding
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+func TestNewEmissionSchedule(t *testing.T) {
+	require := require.New(t)
+
+	schedule, err := newEmissionSchedule([]genesis.EmissionStep{
+		{StartEpoch: 1, BlockRewardStr: "16", EpochRewardStr: "100", DecayRate: 50, DecayInterval: 10},
+		{StartEpoch: 100, BlockRewardStr: "2", EpochRewardStr: "10"},
+	})
+	require.NoError(err)
+	require.Len(schedule, 2)
+
+	_, err = newEmissionSchedule([]genesis.EmissionStep{
+		{StartEpoch: 10, BlockRewardStr: "1", EpochRewardStr: "1"},
+		{StartEpoch: 10, BlockRewardStr: "1", EpochRewardStr: "1"},
+	})
+	require.Error(err)
+	_, err = newEmissionSchedule([]genesis.EmissionStep{
+		{StartEpoch: 1, BlockRewardStr: "-1", EpochRewardStr: "1"},
+	})
+	require.Error(err)
+	_, err = newEmissionSchedule([]genesis.EmissionStep{
+		{StartEpoch: 1, BlockRewardStr: "1", EpochRewardStr: "1", DecayRate: 100},
+	})
+	require.Error(err)
+
+	a := admin{
+		blockReward:      big.NewInt(10),
+		epochReward:      big.NewInt(100),
+		foundationBonus:  big.NewInt(5),
+		emissionSchedule: schedule,
+		maxTotalReward:   big.NewInt(1000),
+	}
+	tests := []struct {
+		epoch       uint64
+		blockReward int64
+		epochReward int64
+	}{
+		{1, 16, 100},
+		{10, 16, 100},
+		{11, 8, 50},
+		{31, 2, 12},
+		{99, 0, 0},
+		{100, 2, 10},
+		{1000, 2, 10},
+	}
+	for _, test := range tests {
+		a.applyEmissionSchedule(test.epoch)
+		require.Equal(big.NewInt(test.blockReward).String(), a.blockReward.String())
+		require.Equal(big.NewInt(test.epochReward).String(), a.epochReward.String())
+	}
+
+	// Emission schedule and max total reward survive serialization
+	data, err := a.Serialize()
+	require.NoError(err)
+	a2 := admin{}
+	require.NoError(a2.Deserialize(data))
+	require.Equal(a.emissionSchedule, a2.emissionSchedule)
+	require.Equal(a.maxTotalReward, a2.maxTotalReward)
+}
+
+func TestProtocol_RewardCap(t *testing.T) {
+	testProtocol(t, func(t *testing.T, ctx context.Context, sm protocol.StateManager, p *Protocol) {
+		require := require.New(t)
+
+		a := admin{}
+		require.NoError(p.state(sm, adminKey, &a))
+		a.maxTotalReward = big.NewInt(110)
+		require.NoError(p.putState(sm, adminKey, &a))
+		require.NoError(p.Deposit(ctx, sm, big.NewInt(1000)))
+
+		// The projection of the first two epochs is limited by the cap
+		data, err := p.ReadState(ctx, sm, []byte("ProjectedIssuance"), byteutil.Uint64ToBytes(1), byteutil.Uint64ToBytes(2))
+		require.NoError(err)
+		var projection rewardingpb.IssuanceProjection
+		require.NoError(proto.Unmarshal(data, &projection))
+		require.Equal("110", projection.MaxTotalReward)
+		require.Equal("0", projection.TotalGranted)
+		require.Len(projection.Epochs, 2)
+		require.Equal("110", projection.Epochs[0].Total)
+		require.Equal("0", projection.Epochs[1].Total)
+		_, err = p.ReadState(ctx, sm, []byte("ProjectedIssuance"), byteutil.Uint64ToBytes(1), byteutil.Uint64ToBytes(maxProjectedEpochs+1))
+		require.Error(err)
+
+		// Grant the epoch reward and the foundation bonus
+		_, err = p.GrantEpochReward(ctx, sm)
+		require.NoError(err)
+		availableBalance, err := p.AvailableBalance(ctx, sm)
+		require.NoError(err)
+		require.Equal(big.NewInt(895), availableBalance)
+
+		// Only the remaining 5 can be granted as block reward
+		blkCtx := protocol.MustGetBlockCtx(ctx)
+		blkCtx.BlockHeight++
+		rewardLog, err := p.GrantBlockReward(protocol.WithBlockCtx(ctx, blkCtx), sm)
+		require.NoError(err)
+		var rl rewardingpb.RewardLog
+		require.NoError(proto.Unmarshal(rewardLog.Data, &rl))
+		require.Equal("5", rl.Amount)
+		availableBalance, err = p.AvailableBalance(ctx, sm)
+		require.NoError(err)
+		require.Equal(big.NewInt(890), availableBalance)
+	}, false)
+}
@@ -216,6 +216,8 @@ func (p *Protocol) ReadState(
 			return nil, err
 		}
 		return []byte(balance.String()), nil
+	case "ProjectedIssuance":
+		return p.readIssuanceProjection(ctx, sr, args...)
 	default:
 		return nil, errors.New("corresponding method isn't found")
 	}
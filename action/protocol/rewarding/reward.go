@@ -20,6 +20,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -97,18 +98,26 @@ func (p *Protocol) GrantBlockReward(
 		return nil, nil
 	}
 	rewardAddr, err := address.FromString(rewardAddrStr)
-
-	a := admin{}
-	if err := p.state(sm, adminKey, &a); err != nil {
+	if err != nil {
 		return nil, err
 	}
-	if err := p.updateAvailableBalance(sm, a.blockReward); err != nil {
+
+	a, err := p.activeAdmin(ctx, sm)
+	if err != nil {
 		return nil, err
 	}
+	remaining, err := p.remainingReward(sm, a)
 	if err != nil {
 		return nil, err
 	}
-	if err := p.grantToAccount(sm, rewardAddr, a.blockReward); err != nil {
+	blockReward := capAmount(a.blockReward, remaining)
+	if err := p.updateAvailableBalance(sm, blockReward); err != nil {
+		return nil, err
+	}
+	if err := p.grantToAccount(sm, rewardAddr, blockReward); err != nil {
+		return nil, err
+	}
+	if err := p.addGranted(sm, a, blockReward); err != nil {
 		return nil, err
 	}
 	if err := p.updateRewardHistory(sm, blockRewardHistoryKeyPrefix, blkCtx.BlockHeight); err != nil {
@@ -117,7 +126,7 @@ func (p *Protocol) GrantBlockReward(
 	rewardLog := rewardingpb.RewardLog{
 		Type:   rewardingpb.RewardLog_BLOCK_REWARD,
 		Addr:   rewardAddrStr,
-		Amount: a.blockReward.String(),
+		Amount: blockReward.String(),
 	}
 	data, err := proto.Marshal(&rewardLog)
 	if err != nil {
@@ -149,8 +158,8 @@ func (p *Protocol) GrantEpochReward(
 	if err := p.assertLastBlockInEpoch(blkCtx.BlockHeight, epochNum, rp); err != nil {
 		return nil, err
 	}
-	a := admin{}
-	if err := p.state(sm, adminKey, &a); err != nil {
+	a, err := p.activeAdmin(ctx, sm)
+	if err != nil {
 		return nil, err
 	}
 
@@ -164,7 +173,6 @@ func (p *Protocol) GrantEpochReward(
 		exemptAddrs[addr.String()] = nil
 	}
 
-	var err error
 	uqd := make(map[string]bool)
 	epochStartHeight := rp.GetEpochHeight(epochNum)
 	if hu.IsPre(config.Easter, epochStartHeight) {
@@ -177,7 +185,12 @@ func (p *Protocol) GrantEpochReward(
 	if err != nil {
 		return nil, err
 	}
-	addrs, amounts, err := p.splitEpochReward(epochStartHeight, sm, candidates, a.epochReward, a.numDelegatesForEpochReward, exemptAddrs, uqd)
+	remaining, err := p.remainingReward(sm, a)
+	if err != nil {
+		return nil, err
+	}
+	epochReward := capAmount(a.epochReward, remaining)
+	addrs, amounts, err := p.splitEpochReward(epochStartHeight, sm, candidates, epochReward, a.numDelegatesForEpochReward, exemptAddrs, uqd)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +205,8 @@ func (p *Protocol) GrantEpochReward(
 		if amounts[i].Cmp(big.NewInt(0)) == 0 {
 			continue
 		}
-		if err := p.grantToAccount(sm, addrs[i], amounts[i]); err != nil {
+		depositLog, err := p.grantOrCompound(ctx, sm, addrs[i], amounts[i])
+		if err != nil {
 			return nil, err
 		}
 		rewardLog := rewardingpb.RewardLog{
@@ -211,6 +225,9 @@ func (p *Protocol) GrantEpochReward(
 			BlockHeight: blkCtx.BlockHeight,
 			ActionHash:  actionCtx.ActionHash,
 		})
+		if depositLog != nil {
+			rewardLogs = append(rewardLogs, depositLog)
+		}
 		actualTotalReward = big.NewInt(0).Add(actualTotalReward, amounts[i])
 	}
 
@@ -234,13 +251,18 @@ func (p *Protocol) GrantEpochReward(
 			if err != nil {
 				return nil, err
 			}
-			if err := p.grantToAccount(sm, rewardAddr, a.foundationBonus); err != nil {
+			foundationBonus := capAmount(a.foundationBonus, subRemaining(remaining, actualTotalReward))
+			if foundationBonus.Sign() == 0 {
+				continue
+			}
+			depositLog, err := p.grantOrCompound(ctx, sm, rewardAddr, foundationBonus)
+			if err != nil {
 				return nil, err
 			}
 			rewardLog := rewardingpb.RewardLog{
 				Type:   rewardingpb.RewardLog_FOUNDATION_BONUS,
 				Addr:   candidates[i].RewardAddress,
-				Amount: a.foundationBonus.String(),
+				Amount: foundationBonus.String(),
 			}
 			data, err := proto.Marshal(&rewardLog)
 			if err != nil {
@@ -253,7 +275,10 @@ func (p *Protocol) GrantEpochReward(
 				BlockHeight: blkCtx.BlockHeight,
 				ActionHash:  actionCtx.ActionHash,
 			})
-			actualTotalReward = big.NewInt(0).Add(actualTotalReward, a.foundationBonus)
+			if depositLog != nil {
+				rewardLogs = append(rewardLogs, depositLog)
+			}
+			actualTotalReward = big.NewInt(0).Add(actualTotalReward, foundationBonus)
 		}
 	}
 
@@ -261,6 +286,9 @@ func (p *Protocol) GrantEpochReward(
 	if err := p.updateAvailableBalance(sm, actualTotalReward); err != nil {
 		return nil, err
 	}
+	if err := p.addGranted(sm, a, actualTotalReward); err != nil {
+		return nil, err
+	}
 	if err := p.updateRewardHistory(sm, epochRewardHistoryKeyPrefix, epochNum); err != nil {
 		return nil, err
 	}
@@ -327,6 +355,27 @@ func (p *Protocol) updateAvailableBalance(sm protocol.StateManager, amount *big.
 	return p.putState(sm, fundKey, &f)
 }
 
+// grantOrCompound grants the reward to addr, or deposits it into the staking bucket nominated by addr if auto-compounding
+// is turned on, in which case the log of the deposit is returned
+func (p *Protocol) grantOrCompound(
+	ctx context.Context,
+	sm protocol.StateManager,
+	addr address.Address,
+	amount *big.Int,
+) (*action.Log, error) {
+	if sp := staking.FindProtocol(protocol.MustGetRegistry(ctx)); sp != nil {
+		depositLog, err := sp.CompoundReward(ctx, sm, addr, amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compound reward of %s", addr.String())
+		}
+		if depositLog != nil {
+			// the compounded reward leaves the fund as if it were granted and claimed
+			return depositLog, p.updateTotalBalance(sm, amount)
+		}
+	}
+	return nil, p.grantToAccount(sm, addr, amount)
+}
+
 func (p *Protocol) grantToAccount(sm protocol.StateManager, addr address.Address, amount *big.Int) error {
 	acc := rewardAccount{}
 	accKey := append(adminKey, addr.Bytes()...)
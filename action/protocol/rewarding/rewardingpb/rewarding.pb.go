@@ -45,20 +45,22 @@ func (x RewardLog_RewardType) String() string {
 }
 
 func (RewardLog_RewardType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a5a8d72c965c1359, []int{5, 0}
+	return fileDescriptor_a5a8d72c965c1359, []int{6, 0}
 }
 
 type Admin struct {
-	BlockReward                    string   `protobuf:"bytes,1,opt,name=blockReward,proto3" json:"blockReward,omitempty"`
-	EpochReward                    string   `protobuf:"bytes,2,opt,name=epochReward,proto3" json:"epochReward,omitempty"`
-	NumDelegatesForEpochReward     uint64   `protobuf:"varint,3,opt,name=numDelegatesForEpochReward,proto3" json:"numDelegatesForEpochReward,omitempty"`
-	FoundationBonus                string   `protobuf:"bytes,4,opt,name=foundationBonus,proto3" json:"foundationBonus,omitempty"`
-	NumDelegatesForFoundationBonus uint64   `protobuf:"varint,5,opt,name=numDelegatesForFoundationBonus,proto3" json:"numDelegatesForFoundationBonus,omitempty"`
-	FoundationBonusLastEpoch       uint64   `protobuf:"varint,6,opt,name=foundationBonusLastEpoch,proto3" json:"foundationBonusLastEpoch,omitempty"`
-	ProductivityThreshold          uint64   `protobuf:"varint,7,opt,name=productivityThreshold,proto3" json:"productivityThreshold,omitempty"`
-	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
-	XXX_unrecognized               []byte   `json:"-"`
-	XXX_sizecache                  int32    `json:"-"`
+	BlockReward                    string          `protobuf:"bytes,1,opt,name=blockReward,proto3" json:"blockReward,omitempty"`
+	EpochReward                    string          `protobuf:"bytes,2,opt,name=epochReward,proto3" json:"epochReward,omitempty"`
+	NumDelegatesForEpochReward     uint64          `protobuf:"varint,3,opt,name=numDelegatesForEpochReward,proto3" json:"numDelegatesForEpochReward,omitempty"`
+	FoundationBonus                string          `protobuf:"bytes,4,opt,name=foundationBonus,proto3" json:"foundationBonus,omitempty"`
+	NumDelegatesForFoundationBonus uint64          `protobuf:"varint,5,opt,name=numDelegatesForFoundationBonus,proto3" json:"numDelegatesForFoundationBonus,omitempty"`
+	FoundationBonusLastEpoch       uint64          `protobuf:"varint,6,opt,name=foundationBonusLastEpoch,proto3" json:"foundationBonusLastEpoch,omitempty"`
+	ProductivityThreshold          uint64          `protobuf:"varint,7,opt,name=productivityThreshold,proto3" json:"productivityThreshold,omitempty"`
+	EmissionSchedule               []*EmissionStep `protobuf:"bytes,8,rep,name=emissionSchedule,proto3" json:"emissionSchedule,omitempty"`
+	MaxTotalReward                 string          `protobuf:"bytes,9,opt,name=maxTotalReward,proto3" json:"maxTotalReward,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}        `json:"-"`
+	XXX_unrecognized               []byte          `json:"-"`
+	XXX_sizecache                  int32           `json:"-"`
 }
 
 func (m *Admin) Reset()         { *m = Admin{} }
@@ -135,6 +137,91 @@ func (m *Admin) GetProductivityThreshold() uint64 {
 	return 0
 }
 
+func (m *Admin) GetEmissionSchedule() []*EmissionStep {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return nil
+}
+
+func (m *Admin) GetMaxTotalReward() string {
+	if m != nil {
+		return m.MaxTotalReward
+	}
+	return ""
+}
+
+type EmissionStep struct {
+	StartEpoch           uint64   `protobuf:"varint,1,opt,name=startEpoch,proto3" json:"startEpoch,omitempty"`
+	BlockReward          string   `protobuf:"bytes,2,opt,name=blockReward,proto3" json:"blockReward,omitempty"`
+	EpochReward          string   `protobuf:"bytes,3,opt,name=epochReward,proto3" json:"epochReward,omitempty"`
+	DecayRate            uint32   `protobuf:"varint,4,opt,name=decayRate,proto3" json:"decayRate,omitempty"`
+	DecayInterval        uint64   `protobuf:"varint,5,opt,name=decayInterval,proto3" json:"decayInterval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmissionStep) Reset()         { *m = EmissionStep{} }
+func (m *EmissionStep) String() string { return proto.CompactTextString(m) }
+func (*EmissionStep) ProtoMessage()    {}
+func (*EmissionStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a8d72c965c1359, []int{1}
+}
+
+func (m *EmissionStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmissionStep.Unmarshal(m, b)
+}
+func (m *EmissionStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmissionStep.Marshal(b, m, deterministic)
+}
+func (m *EmissionStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionStep.Merge(m, src)
+}
+func (m *EmissionStep) XXX_Size() int {
+	return xxx_messageInfo_EmissionStep.Size(m)
+}
+func (m *EmissionStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionStep proto.InternalMessageInfo
+
+func (m *EmissionStep) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *EmissionStep) GetBlockReward() string {
+	if m != nil {
+		return m.BlockReward
+	}
+	return ""
+}
+
+func (m *EmissionStep) GetEpochReward() string {
+	if m != nil {
+		return m.EpochReward
+	}
+	return ""
+}
+
+func (m *EmissionStep) GetDecayRate() uint32 {
+	if m != nil {
+		return m.DecayRate
+	}
+	return 0
+}
+
+func (m *EmissionStep) GetDecayInterval() uint64 {
+	if m != nil {
+		return m.DecayInterval
+	}
+	return 0
+}
+
 type Fund struct {
 	TotalBalance         string   `protobuf:"bytes,1,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
 	UnclaimedBalance     string   `protobuf:"bytes,2,opt,name=unclaimedBalance,proto3" json:"unclaimedBalance,omitempty"`
@@ -147,7 +234,7 @@ func (m *Fund) Reset()         { *m = Fund{} }
 func (m *Fund) String() string { return proto.CompactTextString(m) }
 func (*Fund) ProtoMessage()    {}
 func (*Fund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5a8d72c965c1359, []int{1}
+	return fileDescriptor_a5a8d72c965c1359, []int{2}
 }
 
 func (m *Fund) XXX_Unmarshal(b []byte) error {
@@ -192,7 +279,7 @@ func (m *RewardHistory) Reset()         { *m = RewardHistory{} }
 func (m *RewardHistory) String() string { return proto.CompactTextString(m) }
 func (*RewardHistory) ProtoMessage()    {}
 func (*RewardHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5a8d72c965c1359, []int{2}
+	return fileDescriptor_a5a8d72c965c1359, []int{3}
 }
 
 func (m *RewardHistory) XXX_Unmarshal(b []byte) error {
@@ -224,7 +311,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5a8d72c965c1359, []int{3}
+	return fileDescriptor_a5a8d72c965c1359, []int{4}
 }
 
 func (m *Account) XXX_Unmarshal(b []byte) error {
@@ -263,7 +350,7 @@ func (m *Exempt) Reset()         { *m = Exempt{} }
 func (m *Exempt) String() string { return proto.CompactTextString(m) }
 func (*Exempt) ProtoMessage()    {}
 func (*Exempt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5a8d72c965c1359, []int{4}
+	return fileDescriptor_a5a8d72c965c1359, []int{5}
 }
 
 func (m *Exempt) XXX_Unmarshal(b []byte) error {
@@ -304,7 +391,7 @@ func (m *RewardLog) Reset()         { *m = RewardLog{} }
 func (m *RewardLog) String() string { return proto.CompactTextString(m) }
 func (*RewardLog) ProtoMessage()    {}
 func (*RewardLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5a8d72c965c1359, []int{5}
+	return fileDescriptor_a5a8d72c965c1359, []int{6}
 }
 
 func (m *RewardLog) XXX_Unmarshal(b []byte) error {
@@ -346,45 +433,194 @@ func (m *RewardLog) GetAmount() string {
 	return ""
 }
 
+type EpochIssuance struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NumBlocks            uint64   `protobuf:"varint,2,opt,name=numBlocks,proto3" json:"numBlocks,omitempty"`
+	BlockReward          string   `protobuf:"bytes,3,opt,name=blockReward,proto3" json:"blockReward,omitempty"`
+	EpochReward          string   `protobuf:"bytes,4,opt,name=epochReward,proto3" json:"epochReward,omitempty"`
+	FoundationBonus      string   `protobuf:"bytes,5,opt,name=foundationBonus,proto3" json:"foundationBonus,omitempty"`
+	Total                string   `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochIssuance) Reset()         { *m = EpochIssuance{} }
+func (m *EpochIssuance) String() string { return proto.CompactTextString(m) }
+func (*EpochIssuance) ProtoMessage()    {}
+func (*EpochIssuance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a8d72c965c1359, []int{7}
+}
+
+func (m *EpochIssuance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochIssuance.Unmarshal(m, b)
+}
+func (m *EpochIssuance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochIssuance.Marshal(b, m, deterministic)
+}
+func (m *EpochIssuance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochIssuance.Merge(m, src)
+}
+func (m *EpochIssuance) XXX_Size() int {
+	return xxx_messageInfo_EpochIssuance.Size(m)
+}
+func (m *EpochIssuance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochIssuance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochIssuance proto.InternalMessageInfo
+
+func (m *EpochIssuance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochIssuance) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *EpochIssuance) GetBlockReward() string {
+	if m != nil {
+		return m.BlockReward
+	}
+	return ""
+}
+
+func (m *EpochIssuance) GetEpochReward() string {
+	if m != nil {
+		return m.EpochReward
+	}
+	return ""
+}
+
+func (m *EpochIssuance) GetFoundationBonus() string {
+	if m != nil {
+		return m.FoundationBonus
+	}
+	return ""
+}
+
+func (m *EpochIssuance) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+type IssuanceProjection struct {
+	Epochs               []*EpochIssuance `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	MaxTotalReward       string           `protobuf:"bytes,2,opt,name=maxTotalReward,proto3" json:"maxTotalReward,omitempty"`
+	TotalGranted         string           `protobuf:"bytes,3,opt,name=totalGranted,proto3" json:"totalGranted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *IssuanceProjection) Reset()         { *m = IssuanceProjection{} }
+func (m *IssuanceProjection) String() string { return proto.CompactTextString(m) }
+func (*IssuanceProjection) ProtoMessage()    {}
+func (*IssuanceProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a8d72c965c1359, []int{8}
+}
+
+func (m *IssuanceProjection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuanceProjection.Unmarshal(m, b)
+}
+func (m *IssuanceProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuanceProjection.Marshal(b, m, deterministic)
+}
+func (m *IssuanceProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceProjection.Merge(m, src)
+}
+func (m *IssuanceProjection) XXX_Size() int {
+	return xxx_messageInfo_IssuanceProjection.Size(m)
+}
+func (m *IssuanceProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuanceProjection proto.InternalMessageInfo
+
+func (m *IssuanceProjection) GetEpochs() []*EpochIssuance {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *IssuanceProjection) GetMaxTotalReward() string {
+	if m != nil {
+		return m.MaxTotalReward
+	}
+	return ""
+}
+
+func (m *IssuanceProjection) GetTotalGranted() string {
+	if m != nil {
+		return m.TotalGranted
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("rewardingpb.RewardLog_RewardType", RewardLog_RewardType_name, RewardLog_RewardType_value)
 	proto.RegisterType((*Admin)(nil), "rewardingpb.Admin")
+	proto.RegisterType((*EmissionStep)(nil), "rewardingpb.EmissionStep")
 	proto.RegisterType((*Fund)(nil), "rewardingpb.Fund")
 	proto.RegisterType((*RewardHistory)(nil), "rewardingpb.RewardHistory")
 	proto.RegisterType((*Account)(nil), "rewardingpb.Account")
 	proto.RegisterType((*Exempt)(nil), "rewardingpb.Exempt")
 	proto.RegisterType((*RewardLog)(nil), "rewardingpb.RewardLog")
+	proto.RegisterType((*EpochIssuance)(nil), "rewardingpb.EpochIssuance")
+	proto.RegisterType((*IssuanceProjection)(nil), "rewardingpb.IssuanceProjection")
 }
 
 func init() { proto.RegisterFile("rewarding.proto", fileDescriptor_a5a8d72c965c1359) }
 
 var fileDescriptor_a5a8d72c965c1359 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x71, 0xea, 0x24, 0xca, 0x34, 0x10, 0x6b, 0x54, 0x90, 0xc5, 0xa1, 0x0a, 0xcb, 0x25,
-	0xe2, 0x90, 0x03, 0x1f, 0x17, 0x0e, 0x48, 0x49, 0x13, 0xab, 0x88, 0x28, 0x46, 0x4b, 0x0a, 0xc7,
-	0x6a, 0xe3, 0x5d, 0x12, 0x0b, 0x7b, 0xd7, 0x5a, 0xaf, 0x81, 0xbc, 0x18, 0xaf, 0xc5, 0x2b, 0x20,
-	0xaf, 0x9d, 0xe2, 0x9a, 0x8f, 0xde, 0x66, 0xfe, 0xf3, 0x9b, 0xff, 0x8c, 0x46, 0x03, 0x23, 0x2d,
-	0xbe, 0x31, 0xcd, 0x63, 0xb9, 0x9b, 0x66, 0x5a, 0x19, 0x85, 0xa7, 0x37, 0x42, 0xb6, 0x25, 0x3f,
-	0x3b, 0xd0, 0x9d, 0xf1, 0x34, 0x96, 0x38, 0x86, 0xd3, 0x6d, 0xa2, 0xa2, 0x2f, 0xd4, 0x56, 0x7d,
-	0x67, 0xec, 0x4c, 0x06, 0xb4, 0x29, 0x95, 0x84, 0xc8, 0x54, 0xb4, 0xaf, 0x89, 0x4e, 0x45, 0x34,
-	0x24, 0x7c, 0x03, 0x8f, 0x65, 0x91, 0x2e, 0x44, 0x22, 0x76, 0xcc, 0x88, 0x3c, 0x50, 0x7a, 0xd9,
-	0x68, 0x38, 0x19, 0x3b, 0x13, 0x97, 0xfe, 0x87, 0xc0, 0x09, 0x8c, 0x3e, 0xab, 0x42, 0x72, 0x66,
-	0x62, 0x25, 0xe7, 0x4a, 0x16, 0xb9, 0xef, 0xda, 0x29, 0x6d, 0x19, 0x03, 0x38, 0x6f, 0xf9, 0x04,
-	0xad, 0xc6, 0xae, 0x9d, 0x76, 0x07, 0x85, 0xaf, 0xc1, 0x6f, 0x59, 0xaf, 0x58, 0x6e, 0xec, 0x4e,
-	0x7e, 0xcf, 0x3a, 0xfc, 0xb3, 0x8e, 0x2f, 0xe1, 0x61, 0xa6, 0x15, 0x2f, 0x22, 0x13, 0x7f, 0x8d,
-	0xcd, 0x61, 0xb3, 0xd7, 0x22, 0xdf, 0xab, 0x84, 0xfb, 0x7d, 0xdb, 0xf8, 0xf7, 0x22, 0xf9, 0x08,
-	0x6e, 0x50, 0x48, 0x8e, 0x04, 0x86, 0x46, 0x19, 0x96, 0xcc, 0x59, 0xc2, 0x64, 0x24, 0xea, 0x83,
-	0xdf, 0xd2, 0xf0, 0x19, 0x78, 0x85, 0x8c, 0x12, 0x16, 0xa7, 0x82, 0x1f, 0xb9, 0xea, 0xec, 0x7f,
-	0xe8, 0x64, 0x04, 0xf7, 0xab, 0x2b, 0x5e, 0xc6, 0xb9, 0x51, 0xfa, 0x40, 0x9e, 0x42, 0x7f, 0x16,
-	0x45, 0xaa, 0x90, 0x06, 0x7d, 0xe8, 0x6f, 0x6f, 0x8d, 0x39, 0xa6, 0xe4, 0x1c, 0x7a, 0xcb, 0xef,
-	0x22, 0xcd, 0x0c, 0x9e, 0x41, 0x97, 0x71, 0xae, 0x73, 0xdf, 0x19, 0x9f, 0x4c, 0x86, 0xb4, 0x4a,
-	0xc8, 0x0f, 0x07, 0x06, 0x95, 0xed, 0x4a, 0xed, 0xf0, 0x15, 0xb8, 0xe6, 0x90, 0x55, 0x26, 0x0f,
-	0x9e, 0x3f, 0x99, 0x36, 0x3e, 0x69, 0x7a, 0x43, 0xd5, 0xd1, 0xe6, 0x90, 0x09, 0x6a, 0x71, 0x44,
-	0x70, 0x4b, 0xb7, 0x7a, 0x75, 0x1b, 0xe3, 0x23, 0xe8, 0xb1, 0xb4, 0x5c, 0xce, 0xbe, 0xc5, 0x80,
-	0xd6, 0x19, 0x09, 0x00, 0x7e, 0xf7, 0xa3, 0x07, 0xc3, 0xf9, 0x2a, 0xbc, 0x78, 0x77, 0x4d, 0x97,
-	0x9f, 0x66, 0x74, 0xe1, 0xdd, 0x2b, 0x95, 0xe5, 0xfb, 0xf0, 0xe2, 0xf2, 0xa8, 0x38, 0x78, 0x06,
-	0x5e, 0x10, 0x5e, 0xad, 0x17, 0xb3, 0xcd, 0xdb, 0x70, 0x7d, 0x3d, 0x0f, 0xd7, 0x57, 0x1f, 0xbc,
-	0xce, 0xb6, 0x67, 0x9f, 0xfd, 0xc5, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x21, 0x0f, 0x1b,
-	0xff, 0x02, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0x9c, 0x38, 0xe9, 0x97, 0x9b, 0xa4, 0x8d, 0x46, 0x01, 0x99, 0x0a, 0x55, 0xc1, 0x20,
+	0x14, 0xb1, 0xe8, 0x22, 0xc0, 0x86, 0x05, 0x52, 0xd2, 0xc6, 0xb4, 0xa2, 0x6a, 0xaa, 0x69, 0x0a,
+	0xcb, 0x6a, 0x62, 0x0f, 0x8d, 0xc1, 0x9e, 0xb1, 0x66, 0xc6, 0xa5, 0x79, 0x0f, 0x9e, 0x85, 0x57,
+	0xe0, 0x2d, 0x78, 0x16, 0xe4, 0xb1, 0xdd, 0xda, 0x4e, 0x20, 0x3b, 0xdf, 0x33, 0xe7, 0xfe, 0x9f,
+	0x6b, 0xd8, 0x13, 0xf4, 0x3b, 0x11, 0x9e, 0xcf, 0x6e, 0x0e, 0x23, 0xc1, 0x15, 0x47, 0xed, 0x7b,
+	0x20, 0x5a, 0xd8, 0xbf, 0xeb, 0xd0, 0x18, 0x7b, 0xa1, 0xcf, 0xd0, 0x00, 0xda, 0x8b, 0x80, 0xbb,
+	0xdf, 0xb0, 0x7e, 0xb5, 0x8c, 0x81, 0x31, 0x6c, 0xe1, 0x22, 0x94, 0x30, 0x68, 0xc4, 0xdd, 0x65,
+	0xc6, 0xa8, 0xa5, 0x8c, 0x02, 0x84, 0xde, 0xc3, 0x3e, 0x8b, 0xc3, 0x63, 0x1a, 0xd0, 0x1b, 0xa2,
+	0xa8, 0x74, 0xb8, 0x98, 0x16, 0x1c, 0xea, 0x03, 0x63, 0x68, 0xe2, 0x7f, 0x30, 0xd0, 0x10, 0xf6,
+	0xbe, 0xf0, 0x98, 0x79, 0x44, 0xf9, 0x9c, 0x4d, 0x38, 0x8b, 0xa5, 0x65, 0xea, 0x2c, 0x55, 0x18,
+	0x39, 0x70, 0x50, 0x89, 0xe3, 0x54, 0x1c, 0x1b, 0x3a, 0xdb, 0x16, 0x16, 0x7a, 0x07, 0x56, 0x25,
+	0xf4, 0x19, 0x91, 0x4a, 0xd7, 0x64, 0x35, 0x75, 0x84, 0xbf, 0xbe, 0xa3, 0x37, 0xf0, 0x28, 0x12,
+	0xdc, 0x8b, 0x5d, 0xe5, 0xdf, 0xfa, 0x6a, 0x35, 0x5f, 0x0a, 0x2a, 0x97, 0x3c, 0xf0, 0xac, 0x1d,
+	0xed, 0xb8, 0xf9, 0x11, 0x4d, 0xa1, 0x47, 0x43, 0x5f, 0x4a, 0x9f, 0xb3, 0x4b, 0x77, 0x49, 0xbd,
+	0x38, 0xa0, 0xd6, 0xff, 0x83, 0xfa, 0xb0, 0x3d, 0x7a, 0x72, 0x58, 0xd8, 0xcc, 0xe1, 0x34, 0x27,
+	0x29, 0x1a, 0xe1, 0x35, 0x17, 0xf4, 0x12, 0x76, 0x43, 0x72, 0x37, 0xe7, 0x8a, 0x04, 0xd9, 0x78,
+	0x5b, 0x7a, 0x52, 0x15, 0xd4, 0xfe, 0x69, 0x40, 0xa7, 0x18, 0x0a, 0x1d, 0x00, 0x48, 0x45, 0x44,
+	0xd6, 0xa3, 0xa1, 0x4b, 0x2d, 0x20, 0x55, 0x1d, 0xd4, 0xb6, 0xea, 0xa0, 0xbe, 0xae, 0x83, 0xa7,
+	0xd0, 0xf2, 0xa8, 0x4b, 0x56, 0x98, 0x28, 0xaa, 0x37, 0xd8, 0xc5, 0x0f, 0x00, 0x7a, 0x01, 0x5d,
+	0x6d, 0x9c, 0x32, 0x45, 0xc5, 0x2d, 0x09, 0xb2, 0x55, 0x95, 0x41, 0xfb, 0x13, 0x98, 0x4e, 0xcc,
+	0x3c, 0x64, 0x43, 0x47, 0x25, 0xfd, 0x4c, 0x48, 0x40, 0x98, 0x4b, 0x33, 0x61, 0x96, 0x30, 0xf4,
+	0x0a, 0x7a, 0x31, 0x73, 0x03, 0xe2, 0x87, 0xd4, 0xcb, 0x79, 0x69, 0xe1, 0x6b, 0xb8, 0xbd, 0x07,
+	0xdd, 0xb4, 0xca, 0x13, 0x5f, 0x2a, 0x2e, 0x56, 0xf6, 0x73, 0xd8, 0x19, 0xbb, 0x2e, 0x8f, 0x99,
+	0x42, 0x16, 0xec, 0x2c, 0x4a, 0x69, 0x72, 0xd3, 0x3e, 0x80, 0xe6, 0xf4, 0x8e, 0x86, 0x91, 0x42,
+	0x7d, 0x68, 0x10, 0xcf, 0x13, 0xd2, 0x32, 0x06, 0xf5, 0x61, 0x07, 0xa7, 0x46, 0x32, 0xe6, 0x56,
+	0x1a, 0xf6, 0x8c, 0xdf, 0xa0, 0xb7, 0x60, 0xaa, 0x55, 0x94, 0x06, 0xd9, 0x1d, 0x3d, 0x2b, 0xed,
+	0xf5, 0x9e, 0x95, 0x7d, 0xcd, 0x57, 0x11, 0xc5, 0x9a, 0x8e, 0x10, 0x98, 0x49, 0xb4, 0xac, 0x74,
+	0xfd, 0x8d, 0x1e, 0x43, 0x93, 0x84, 0x49, 0x71, 0xd9, 0x9c, 0x33, 0xcb, 0x76, 0x00, 0x1e, 0xfc,
+	0x51, 0x0f, 0x3a, 0x93, 0xb3, 0xd9, 0xd1, 0xc7, 0x6b, 0x3c, 0xfd, 0x3c, 0xc6, 0xc7, 0xbd, 0xff,
+	0x12, 0x64, 0x7a, 0x31, 0x3b, 0x3a, 0xc9, 0x11, 0x03, 0xf5, 0xa1, 0xe7, 0xcc, 0xae, 0xce, 0x8f,
+	0xc7, 0xf3, 0xd3, 0xd9, 0xf9, 0xf5, 0x64, 0x76, 0x7e, 0x75, 0xd9, 0xab, 0xd9, 0xbf, 0x0c, 0xe8,
+	0xea, 0xc5, 0x9f, 0x4a, 0x19, 0xeb, 0x61, 0xf6, 0xa1, 0x41, 0x0b, 0xda, 0x48, 0x8d, 0x64, 0xa5,
+	0x2c, 0x0e, 0x27, 0x89, 0x0c, 0xa4, 0x2e, 0xd0, 0xc4, 0x0f, 0x40, 0x55, 0x34, 0xf5, 0xad, 0xa2,
+	0x31, 0xd7, 0x45, 0xb3, 0xe1, 0xf8, 0x1b, 0x9b, 0x8f, 0xbf, 0x0f, 0x0d, 0xbd, 0x7e, 0x7d, 0xa1,
+	0x2d, 0x9c, 0x1a, 0xf6, 0x0f, 0x03, 0x50, 0xde, 0xc4, 0x85, 0xe0, 0x5f, 0xa9, 0x9b, 0x78, 0xa0,
+	0x11, 0x34, 0x75, 0x96, 0x74, 0x61, 0xed, 0xd1, 0x7e, 0xf9, 0xca, 0x8a, 0xad, 0xe3, 0x8c, 0xb9,
+	0xe1, 0xb8, 0x6a, 0x9b, 0x8e, 0xeb, 0x5e, 0x9b, 0x1f, 0x04, 0x61, 0x8a, 0xe6, 0x7d, 0x97, 0xb0,
+	0x45, 0x53, 0xff, 0x75, 0x5f, 0xff, 0x19, 0x00, 0x27, 0xcb, 0x23, 0x59, 0x88, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// candidateBLSKey stores the BLS public key a candidate endorses blocks with
+type candidateBLSKey struct {
+	publicKey []byte
+}
+
+// Deserialize deserializes bytes into candidate BLS key
+func (ck *candidateBLSKey) Deserialize(data []byte) error {
+	ck.publicKey = append([]byte{}, data...)
+	return nil
+}
+
+// Serialize serializes candidate BLS key into bytes
+func (ck *candidateBLSKey) Serialize() ([]byte, error) {
+	return ck.publicKey, nil
+}
+
+// CandidateBLSKey returns the BLS public key registered by the owner of a candidate
+func CandidateBLSKey(sr protocol.StateReader, owner address.Address) ([]byte, error) {
+	var ck candidateBLSKey
+	if _, err := sr.State(
+		&ck,
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(addrKeyWithPrefix(owner, _candBLSKey))); err != nil {
+		return nil, err
+	}
+	return ck.publicKey, nil
+}
+
+func putCandidateBLSKey(sm protocol.StateManager, owner address.Address, publicKey []byte) error {
+	_, err := sm.PutState(
+		&candidateBLSKey{publicKey: publicKey},
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(addrKeyWithPrefix(owner, _candBLSKey)))
+	return err
+}
+
+// BLSKeyByOperator returns the BLS public key of the candidate operated by operator, which is the address the
+// candidate endorses blocks with as a delegate. Both the candidate and its key are read from sr, so that the key at a
+// past height is looked up with a state reader at that height.
+func BLSKeyByOperator(sr protocol.StateReader, operator address.Address) ([]byte, error) {
+	cands, err := getAllCandidates(sr)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cands {
+		if address.Equal(c.Operator, operator) {
+			return CandidateBLSKey(sr, c.Owner)
+		}
+	}
+	return nil, errors.Wrapf(state.ErrStateNotExist, "no candidate operated by %s", operator.String())
+}
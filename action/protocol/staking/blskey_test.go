@@ -0,0 +1,93 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/pkg/bls"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestProtocol_HandleCandidateBLSKey(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm, p, candidate, _ := initAll(t, ctrl)
+	require.NoError(setupAccount(sm, identityset.Address(20), 1000))
+	require.NoError(setupAccount(sm, candidate.Owner, 1000))
+	sk, err := bls.DerivePrivateKey(identityset.PrivateKey(1).Bytes())
+	require.NoError(err)
+	other, err := bls.DerivePrivateKey(identityset.PrivateKey(2).Bytes())
+	require.NoError(err)
+
+	// the proof of possession must be signed by the registered key
+	act, err := action.NewCandidateBLSKey(1, sk.PublicKey().Bytes(), other.ProvePossession().Bytes(), 10000, big.NewInt(unit.Qev))
+	require.NoError(err)
+	require.Equal(ErrInvalidBLSKey, errors.Cause(p.Validate(context.Background(), act)))
+	act, err = action.NewCandidateBLSKey(1, []byte{1, 2, 3}, sk.ProvePossession().Bytes(), 10000, big.NewInt(unit.Qev))
+	require.NoError(err)
+	require.Equal(ErrInvalidBLSKey, errors.Cause(p.Validate(context.Background(), act)))
+
+	nonce := uint64(1)
+	handle := func(caller address.Address) *action.Receipt {
+		act, err := action.NewCandidateBLSKeyFromPrivateKey(nonce, sk, 10000, big.NewInt(unit.Qev))
+		require.NoError(err)
+		require.NoError(p.Validate(context.Background(), act))
+		intrinsic, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			GasPrice:     big.NewInt(unit.Qev),
+			IntrinsicGas: intrinsic,
+			Nonce:        nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    1,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       10000000,
+		})
+		r, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		nonce++
+		return r
+	}
+
+	// only the owner of a candidate can register a key
+	r := handle(identityset.Address(20))
+	require.Equal(uint64(iotextypes.ReceiptStatus_ErrCandidateNotExist), r.Status)
+	_, err = CandidateBLSKey(sm, identityset.Address(20))
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+
+	r = handle(candidate.Owner)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	key, err := CandidateBLSKey(sm, candidate.Owner)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().Bytes(), key)
+
+	// the key is looked up by the operator address the candidate endorses blocks with
+	key, err = BLSKeyByOperator(sm, candidate.Operator)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().Bytes(), key)
+	_, err = BLSKeyByOperator(sm, identityset.Address(20))
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+}
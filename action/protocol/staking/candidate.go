@@ -253,13 +253,32 @@ func getCandidate(sr protocol.StateReader, name address.Address) (*Candidate, er
 }
 
 func putCandidate(sm protocol.StateManager, d *Candidate) error {
-	_, err := sm.PutState(d, protocol.NamespaceOption(CandidateNameSpace), protocol.KeyOption(d.Owner.Bytes()))
-	return err
+	var prevVotes *big.Int
+	prev, err := getCandidate(sm, d.Owner)
+	switch errors.Cause(err) {
+	case nil:
+		prevVotes = prev.Votes
+	case state.ErrStateNotExist:
+	default:
+		return err
+	}
+
+	if _, err := sm.PutState(d, protocol.NamespaceOption(CandidateNameSpace), protocol.KeyOption(d.Owner.Bytes())); err != nil {
+		return err
+	}
+	return updateTotalVotes(sm, prevVotes, d.Votes)
 }
 
 func delCandidate(sm protocol.StateManager, name address.Address) error {
-	_, err := sm.DelState(protocol.NamespaceOption(CandidateNameSpace), protocol.KeyOption(name.Bytes()))
-	return err
+	prev, err := getCandidate(sm, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sm.DelState(protocol.NamespaceOption(CandidateNameSpace), protocol.KeyOption(name.Bytes())); err != nil {
+		return err
+	}
+	return updateTotalVotes(sm, prev.Votes, nil)
 }
 
 func getAllCandidates(sr protocol.StateReader) (CandidateList, error) {
@@ -87,6 +87,17 @@ func (m CandidateCenter) GetByOwner(owner address.Address) *Candidate {
 	return nil
 }
 
+// GetByOperator returns the candidate by operator
+func (m CandidateCenter) GetByOperator(operator address.Address) *Candidate {
+	if operator == nil {
+		return nil
+	}
+	if d, ok := m.operatorMap[operator.String()]; ok {
+		return d.Clone()
+	}
+	return nil
+}
+
 // GetBySelfStakingIndex returns the candidate by self-staking index
 func (m CandidateCenter) GetBySelfStakingIndex(index uint64) *Candidate {
 	if d, ok := m.selfStkBucketMap[index]; ok {
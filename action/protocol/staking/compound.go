@@ -0,0 +1,106 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// compoundBucket stores the index of the bucket an owner's rewards are deposited into
+type compoundBucket struct {
+	index uint64
+}
+
+// Deserialize deserializes bytes into compound bucket
+func (cb *compoundBucket) Deserialize(data []byte) error {
+	cb.index = byteutil.BytesToUint64BigEndian(data)
+	return nil
+}
+
+// Serialize serializes compound bucket into bytes
+func (cb *compoundBucket) Serialize() ([]byte, error) {
+	return byteutil.Uint64ToBytesBigEndian(cb.index), nil
+}
+
+// CompoundBucket returns the index of the bucket the rewards of owner are deposited into, and false if owner has not
+// turned on auto-compounding
+func CompoundBucket(sr protocol.StateReader, owner address.Address) (uint64, bool, error) {
+	var cb compoundBucket
+	_, err := sr.State(
+		&cb,
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(addrKeyWithPrefix(owner, _compoundBucket)))
+	switch errors.Cause(err) {
+	case nil:
+		return cb.index, true, nil
+	case state.ErrStateNotExist:
+		return 0, false, nil
+	default:
+		return 0, false, err
+	}
+}
+
+func putCompoundBucket(sm protocol.StateManager, owner address.Address, index uint64) error {
+	_, err := sm.PutState(
+		&compoundBucket{index: index},
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(addrKeyWithPrefix(owner, _compoundBucket)))
+	return err
+}
+
+func delCompoundBucket(sm protocol.StateManager, owner address.Address) error {
+	_, err := sm.DelState(
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(addrKeyWithPrefix(owner, _compoundBucket)))
+	if errors.Cause(err) == state.ErrStateNotExist {
+		return nil
+	}
+	return err
+}
+
+// CompoundReward deposits amount into the bucket nominated by owner, and returns the same log as a DepositToStake
+// action by owner. A nil log is returned if owner has not turned on auto-compounding, or the nominated bucket is no
+// longer a staked auto-stake bucket of owner, in which case the reward should be granted as usual.
+func (p *Protocol) CompoundReward(
+	ctx context.Context,
+	sm protocol.StateManager,
+	owner address.Address,
+	amount *big.Int,
+) (*action.Log, error) {
+	index, ok, err := CompoundBucket(sm, owner)
+	if err != nil || !ok {
+		return nil, err
+	}
+	bucket, err := getBucket(sm, index)
+	switch errors.Cause(err) {
+	case nil:
+	case state.ErrStateNotExist:
+		return nil, nil
+	default:
+		return nil, errors.Wrapf(err, "failed to fetch compound bucket %d", index)
+	}
+	if !address.Equal(bucket.Owner, owner) || !bucket.AutoStake || bucket.isUnstaked() {
+		return nil, nil
+	}
+	candidate, err := p.depositToBucket(sm, index, bucket, amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.inMemCandidates.Upsert(candidate); err != nil {
+		return nil, err
+	}
+	return p.createLog(ctx, HandleDepositToStake, nil, owner, nil), nil
+}
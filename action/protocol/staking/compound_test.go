@@ -0,0 +1,122 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestProtocol_HandleCompoundStake(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm, p, candidate, _ := initAll(t, ctrl)
+	stakeAmount := unit.ConvertIotxToRau(300)
+	owner := candidate.Owner
+	// bucket 0 is auto-stake, bucket 1 is not
+	initCreateStake(t, sm, owner, 1000, big.NewInt(unit.Qev), 10000, 1, 1, time.Now(), 10000, p, candidate, stakeAmount.String(), true)
+	initCreateStake(t, sm, owner, 1000, big.NewInt(unit.Qev), 10000, 2, 1, time.Now(), 10000, p, candidate, stakeAmount.String(), false)
+	require.NoError(setupAccount(sm, identityset.Address(2), 1000))
+
+	nonce := uint64(3)
+	handle := func(caller address.Address, index uint64, enable bool) *action.Receipt {
+		act, err := action.NewCompoundStake(nonce, index, enable, nil, 10000, big.NewInt(unit.Qev))
+		require.NoError(err)
+		require.NoError(p.Validate(context.Background(), act))
+		intrinsic, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			GasPrice:     big.NewInt(unit.Qev),
+			IntrinsicGas: intrinsic,
+			Nonce:        nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    1,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       10000000,
+		})
+		r, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		nonce++
+		return r
+	}
+
+	tests := []struct {
+		caller address.Address
+		index  uint64
+		status iotextypes.ReceiptStatus
+	}{
+		{owner, 10, iotextypes.ReceiptStatus_ErrInvalidBucketIndex},
+		{identityset.Address(2), 0, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		{owner, 1, iotextypes.ReceiptStatus_ErrInvalidBucketType},
+		{owner, 0, iotextypes.ReceiptStatus_Success},
+	}
+	for _, test := range tests {
+		r := handle(test.caller, test.index, true)
+		require.Equal(uint64(test.status), r.Status)
+	}
+	index, ok, err := CompoundBucket(sm, owner)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(0), index)
+
+	// reward is deposited into the nominated bucket
+	ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{Caller: identityset.Address(0)})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 2})
+	votes := new(big.Int).Set(p.inMemCandidates.GetByOwner(candidate.Owner).Votes)
+	reward := unit.ConvertIotxToRau(100)
+	log, err := p.CompoundReward(ctx, sm, owner, reward)
+	require.NoError(err)
+	require.NotNil(log)
+	require.Equal(hash.Hash256b([]byte(HandleDepositToStake)), log.Topics[0])
+	require.Equal(hash.Hash256b(owner.Bytes()), log.Topics[1])
+	bucket, err := getBucket(sm, 0)
+	require.NoError(err)
+	require.Equal(new(big.Int).Add(stakeAmount, reward), bucket.StakedAmount)
+	votes.Sub(votes, p.calculateVoteWeight(&VoteBucket{
+		StakedAmount:   stakeAmount,
+		StakedDuration: bucket.StakedDuration,
+		AutoStake:      true,
+	}, false))
+	votes.Add(votes, p.calculateVoteWeight(bucket, false))
+	require.Equal(votes, p.inMemCandidates.GetByOwner(candidate.Owner).Votes)
+	c, err := getCandidate(sm, candidate.Owner)
+	require.NoError(err)
+	require.Equal(votes, c.Votes)
+
+	// addresses without compound bucket are skipped
+	log, err = p.CompoundReward(ctx, sm, identityset.Address(2), reward)
+	require.NoError(err)
+	require.Nil(log)
+
+	// turn off auto-compounding
+	r := handle(owner, 0, false)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	_, ok, err = CompoundBucket(sm, owner)
+	require.NoError(err)
+	require.False(ok)
+	log, err = p.CompoundReward(ctx, sm, owner, reward)
+	require.NoError(err)
+	require.Nil(log)
+}
@@ -47,10 +47,18 @@ const (
 	HandleDepositToStake = "depositToStake"
 	// HandleRestake is the handler name of restake
 	HandleRestake = "restake"
+	// HandleSplitStake is the handler name of splitStake
+	HandleSplitStake = "splitStake"
+	// HandleMergeStake is the handler name of mergeStake
+	HandleMergeStake = "mergeStake"
+	// HandleCompoundStake is the handler name of compoundStake
+	HandleCompoundStake = "compoundStake"
 	// HandleCandidateRegister is the handler name of candidateRegister
 	HandleCandidateRegister = "candidateRegister"
 	// HandleCandidateUpdate is the handler name of candidateUpdate
 	HandleCandidateUpdate = "candidateUpdate"
+	// HandleCandidateBLSKey is the handler name of candidateBLSKey
+	HandleCandidateBLSKey = "candidateBLSKey"
 )
 
 type fetchError struct {
@@ -367,15 +375,44 @@ func (p *Protocol) handleDepositToStake(ctx context.Context, act *action.Deposit
 		log.L().Debug("Error when depositing to stake", zap.Error(err))
 		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_ErrInvalidBucketType), gasFee)
 	}
+	candidate, err := p.depositToBucket(sm, act.BucketIndex(), bucket, act.Amount())
+	if err != nil {
+		return nil, err
+	}
+
+	// update depositor balance
+	if err := depositor.SubBalance(act.Amount()); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the balance of depositor %s", actionCtx.Caller.String())
+	}
+	// put updated depositor's account state to trie
+	if err := accountutil.StoreAccount(sm, actionCtx.Caller.String(), depositor); err != nil {
+		return nil, errors.Wrapf(err, "failed to store account %s", actionCtx.Caller.String())
+	}
+
+	log := p.createLog(ctx, HandleDepositToStake, nil, actionCtx.Caller, nil)
+	receipt, err := p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to settle action")
+	}
+	if err := p.inMemCandidates.Upsert(candidate); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// depositToBucket adds amount to the bucket and the votes of its candidate, and returns the updated candidate which
+// is yet to be upserted into the candidate center
+func (p *Protocol) depositToBucket(sm protocol.StateManager, index uint64, bucket *VoteBucket, amount *big.Int) (*Candidate, error) {
 	candidate := p.inMemCandidates.GetByOwner(bucket.Candidate)
 	if candidate == nil {
 		return nil, errors.Wrap(ErrInvalidOwner, "cannot find candidate in candidate center")
 	}
 
-	prevWeightedVotes := p.calculateVoteWeight(bucket, p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()))
+	selfStake := p.inMemCandidates.ContainsSelfStakingBucket(index)
+	prevWeightedVotes := p.calculateVoteWeight(bucket, selfStake)
 	// update bucket
-	bucket.StakedAmount.Add(bucket.StakedAmount, act.Amount())
-	if err := updateBucket(sm, act.BucketIndex(), bucket); err != nil {
+	bucket.StakedAmount.Add(bucket.StakedAmount, amount)
+	if err := updateBucket(sm, index, bucket); err != nil {
 		return nil, errors.Wrapf(err, "failed to update bucket for voter %s", bucket.Owner)
 	}
 
@@ -383,29 +420,67 @@ func (p *Protocol) handleDepositToStake(ctx context.Context, act *action.Deposit
 	if err := candidate.SubVote(prevWeightedVotes); err != nil {
 		return nil, errors.Wrapf(err, "failed to subtract vote for candidate %s", bucket.Candidate.String())
 	}
-	weightedVotes := p.calculateVoteWeight(bucket, p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()))
-	if err := candidate.AddVote(weightedVotes); err != nil {
+	if err := candidate.AddVote(p.calculateVoteWeight(bucket, selfStake)); err != nil {
 		return nil, errors.Wrapf(err, "failed to add vote for candidate %s", bucket.Candidate.String())
 	}
-	if p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()) {
-		if err := candidate.AddSelfStake(act.Amount()); err != nil {
+	if selfStake {
+		if err := candidate.AddSelfStake(amount); err != nil {
 			return nil, errors.Wrapf(err, "failed to add self stake for candidate %s", bucket.Candidate.String())
 		}
 	}
 	if err := putCandidate(sm, candidate); err != nil {
 		return nil, errors.Wrapf(err, "failed to put state of candidate %s", bucket.Candidate.String())
 	}
+	return candidate, nil
+}
 
-	// update depositor balance
-	if err := depositor.SubBalance(act.Amount()); err != nil {
-		return nil, errors.Wrapf(err, "failed to update the balance of depositor %s", actionCtx.Caller.String())
+func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, sm protocol.StateManager) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+
+	_, gasFee, fetchErr := fetchCaller(ctx, sm, big.NewInt(0))
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching caller", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
 	}
-	// put updated depositor's account state to trie
-	if err := accountutil.StoreAccount(sm, actionCtx.Caller.String(), depositor); err != nil {
-		return nil, errors.Wrapf(err, "failed to store account %s", actionCtx.Caller.String())
+
+	bucket, fetchErr := p.fetchBucket(ctx, sm, act.BucketIndex(), true, true)
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching bucket", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
 	}
 
-	log := p.createLog(ctx, HandleDepositToStake, nil, actionCtx.Caller, nil)
+	candidate := p.inMemCandidates.GetByOwner(bucket.Candidate)
+	if candidate == nil {
+		return nil, errors.Wrap(ErrInvalidOwner, "cannot find candidate in candidate center")
+	}
+
+	prevWeightedVotes := p.calculateVoteWeight(bucket, p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()))
+	// update bucket
+	bucket.StakedDuration = time.Duration(act.Duration()) * 24 * time.Hour
+	bucket.AutoStake = act.AutoStake()
+	if err := updateBucket(sm, act.BucketIndex(), bucket); err != nil {
+		return nil, errors.Wrapf(err, "failed to update bucket for voter %s", bucket.Owner)
+	}
+
+	// update candidate
+	if err := candidate.SubVote(prevWeightedVotes); err != nil {
+		return nil, errors.Wrapf(err, "failed to subtract vote for candidate %s", bucket.Candidate.String())
+	}
+	weightedVotes := p.calculateVoteWeight(bucket, p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()))
+	if err := candidate.AddVote(weightedVotes); err != nil {
+		return nil, errors.Wrapf(err, "failed to add vote for candidate %s", bucket.Candidate.String())
+	}
+	if err := putCandidate(sm, candidate); err != nil {
+		return nil, errors.Wrapf(err, "failed to put state of candidate %s", bucket.Candidate.String())
+	}
+
+	log := p.createLog(ctx, HandleRestake, nil, actionCtx.Caller, nil)
 	receipt, err := p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to settle action")
@@ -416,8 +491,9 @@ func (p *Protocol) handleDepositToStake(ctx context.Context, act *action.Deposit
 	return receipt, nil
 }
 
-func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, sm protocol.StateManager) (*action.Receipt, error) {
+func (p *Protocol) handleSplitStake(ctx context.Context, act *action.SplitStake, sm protocol.StateManager) (*action.Receipt, error) {
 	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
 
 	_, gasFee, fetchErr := fetchCaller(ctx, sm, big.NewInt(0))
 	if fetchErr != nil {
@@ -428,7 +504,7 @@ func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, sm pr
 		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
 	}
 
-	bucket, fetchErr := p.fetchBucket(ctx, sm, act.BucketIndex(), true, true)
+	bucket, fetchErr := p.fetchBucket(ctx, sm, act.BucketIndex(), true, false)
 	if fetchErr != nil {
 		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
 			return nil, fetchErr.err
@@ -436,33 +512,159 @@ func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, sm pr
 		log.L().Debug("Error when fetching bucket", zap.Error(fetchErr.err))
 		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
 	}
+	if bucket.isUnstaked() {
+		err := errors.New("unstaked bucket cannot be split")
+		log.L().Debug("Error when splitting bucket", zap.Error(err))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_ErrInvalidBucketType), gasFee)
+	}
+	minStakeAmount, err := p.minStakeAmount(ctx, sm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get minimum stake amount")
+	}
+	remaining := new(big.Int).Sub(bucket.StakedAmount, act.Amount())
+	if remaining.Cmp(minStakeAmount) == -1 {
+		err := fmt.Errorf("remaining amount %s is less than the minimum requirement", remaining)
+		log.L().Debug("Error when splitting bucket", zap.Error(err))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_ErrInvalidBucketType), gasFee)
+	}
 
 	candidate := p.inMemCandidates.GetByOwner(bucket.Candidate)
 	if candidate == nil {
 		return nil, errors.Wrap(ErrInvalidOwner, "cannot find candidate in candidate center")
 	}
 
-	prevWeightedVotes := p.calculateVoteWeight(bucket, p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()))
+	prevWeightedVotes := p.calculateVoteWeight(bucket, false)
 	// update bucket
-	bucket.StakedDuration = time.Duration(act.Duration()) * 24 * time.Hour
-	bucket.AutoStake = act.AutoStake()
+	bucket.StakedAmount = remaining
 	if err := updateBucket(sm, act.BucketIndex(), bucket); err != nil {
 		return nil, errors.Wrapf(err, "failed to update bucket for voter %s", bucket.Owner)
 	}
+	// the new bucket keeps the stake start time, so it does not lose the duration bonus
+	newBucket := &VoteBucket{
+		Candidate:        bucket.Candidate,
+		Owner:            bucket.Owner,
+		StakedAmount:     new(big.Int).Set(act.Amount()),
+		StakedDuration:   bucket.StakedDuration,
+		CreateTime:       blkCtx.BlockTimeStamp.UTC(),
+		StakeStartTime:   bucket.StakeStartTime,
+		UnstakeStartTime: bucket.UnstakeStartTime,
+		AutoStake:        bucket.AutoStake,
+	}
+	newBucketIdx, err := putBucketAndIndex(sm, newBucket)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to put bucket")
+	}
 
 	// update candidate
 	if err := candidate.SubVote(prevWeightedVotes); err != nil {
 		return nil, errors.Wrapf(err, "failed to subtract vote for candidate %s", bucket.Candidate.String())
 	}
-	weightedVotes := p.calculateVoteWeight(bucket, p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex()))
-	if err := candidate.AddVote(weightedVotes); err != nil {
+	if err := candidate.AddVote(p.calculateVoteWeight(bucket, false)); err != nil {
+		return nil, errors.Wrapf(err, "failed to add vote for candidate %s", bucket.Candidate.String())
+	}
+	if err := candidate.AddVote(p.calculateVoteWeight(newBucket, false)); err != nil {
 		return nil, errors.Wrapf(err, "failed to add vote for candidate %s", bucket.Candidate.String())
 	}
 	if err := putCandidate(sm, candidate); err != nil {
 		return nil, errors.Wrapf(err, "failed to put state of candidate %s", bucket.Candidate.String())
 	}
 
-	log := p.createLog(ctx, HandleRestake, nil, actionCtx.Caller, nil)
+	log := p.createLog(ctx, HandleSplitStake, nil, actionCtx.Caller, byteutil.Uint64ToBytes(newBucketIdx))
+	receipt, err := p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to settle action")
+	}
+	if err := p.inMemCandidates.Upsert(candidate); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (p *Protocol) handleMergeStake(ctx context.Context, act *action.MergeStake, sm protocol.StateManager) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+
+	_, gasFee, fetchErr := fetchCaller(ctx, sm, big.NewInt(0))
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching caller", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
+	}
+
+	bucket, fetchErr := p.fetchBucket(ctx, sm, act.BucketIndex(), true, true)
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching bucket", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
+	}
+
+	// check all buckets before touching any state, so a rejected merge leaves them intact
+	merged := make([]*VoteBucket, 0, len(act.MergeIndices()))
+	for _, index := range act.MergeIndices() {
+		b, fetchErr := p.fetchBucket(ctx, sm, index, true, false)
+		if fetchErr != nil {
+			if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+				return nil, fetchErr.err
+			}
+			log.L().Debug("Error when fetching bucket", zap.Error(fetchErr.err))
+			return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
+		}
+		if !isMergeable(bucket, b, blkCtx.BlockTimeStamp) {
+			err := fmt.Errorf("bucket %d cannot be merged into bucket %d", index, act.BucketIndex())
+			log.L().Debug("Error when merging buckets", zap.Error(err))
+			return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_ErrInvalidBucketType), gasFee)
+		}
+		merged = append(merged, b)
+	}
+
+	candidate := p.inMemCandidates.GetByOwner(bucket.Candidate)
+	if candidate == nil {
+		return nil, errors.Wrap(ErrInvalidOwner, "cannot find candidate in candidate center")
+	}
+
+	selfStaking := p.inMemCandidates.ContainsSelfStakingBucket(act.BucketIndex())
+	if err := candidate.SubVote(p.calculateVoteWeight(bucket, selfStaking)); err != nil {
+		return nil, errors.Wrapf(err, "failed to subtract vote for candidate %s", bucket.Candidate.String())
+	}
+	for _, b := range merged {
+		if err := candidate.SubVote(p.calculateVoteWeight(b, false)); err != nil {
+			return nil, errors.Wrapf(err, "failed to subtract vote for candidate %s", bucket.Candidate.String())
+		}
+		// delete merged bucket and bucket index
+		if err := delBucket(sm, b.Index); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete bucket for candidate %s", b.Candidate.String())
+		}
+		if err := delCandBucketIndex(sm, b.Candidate, b.Index); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete bucket index for candidate %s", b.Candidate.String())
+		}
+		if err := delVoterBucketIndex(sm, b.Owner, b.Index); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete bucket index for voter %s", b.Owner.String())
+		}
+		bucket.StakedAmount.Add(bucket.StakedAmount, b.StakedAmount)
+		if selfStaking {
+			if err := candidate.AddSelfStake(b.StakedAmount); err != nil {
+				return nil, errors.Wrapf(err, "failed to add self stake for candidate %s", bucket.Candidate.String())
+			}
+		}
+	}
+	// update bucket
+	if err := updateBucket(sm, act.BucketIndex(), bucket); err != nil {
+		return nil, errors.Wrapf(err, "failed to update bucket for voter %s", bucket.Owner)
+	}
+
+	// update candidate
+	if err := candidate.AddVote(p.calculateVoteWeight(bucket, selfStaking)); err != nil {
+		return nil, errors.Wrapf(err, "failed to add vote for candidate %s", bucket.Candidate.String())
+	}
+	if err := putCandidate(sm, candidate); err != nil {
+		return nil, errors.Wrapf(err, "failed to put state of candidate %s", bucket.Candidate.String())
+	}
+
+	log := p.createLog(ctx, HandleMergeStake, nil, actionCtx.Caller, nil)
 	receipt, err := p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to settle action")
@@ -473,11 +675,56 @@ func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, sm pr
 	return receipt, nil
 }
 
+func (p *Protocol) handleCompoundStake(ctx context.Context, act *action.CompoundStake, sm protocol.StateManager) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+
+	_, gasFee, fetchErr := fetchCaller(ctx, sm, big.NewInt(0))
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching caller", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
+	}
+
+	if !act.Enable() {
+		if err := delCompoundBucket(sm, actionCtx.Caller); err != nil {
+			return nil, errors.Wrapf(err, "failed to clear compound bucket of %s", actionCtx.Caller.String())
+		}
+		log := p.createLog(ctx, HandleCompoundStake, nil, actionCtx.Caller, nil)
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
+	}
+
+	bucket, fetchErr := p.fetchBucket(ctx, sm, act.BucketIndex(), true, true)
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching bucket", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
+	}
+	if !bucket.AutoStake || bucket.isUnstaked() {
+		err := errors.New("rewards can only be compounded into staked auto-stake bucket")
+		log.L().Debug("Error when setting compound bucket", zap.Error(err))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_ErrInvalidBucketType), gasFee)
+	}
+	if err := putCompoundBucket(sm, actionCtx.Caller, act.BucketIndex()); err != nil {
+		return nil, errors.Wrapf(err, "failed to put compound bucket of %s", actionCtx.Caller.String())
+	}
+
+	log := p.createLog(ctx, HandleCompoundStake, nil, actionCtx.Caller, byteutil.Uint64ToBytes(act.BucketIndex()))
+	return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
+}
+
 func (p *Protocol) handleCandidateRegister(ctx context.Context, act *action.CandidateRegister, sm protocol.StateManager) (*action.Receipt, error) {
 	actCtx := protocol.MustGetActionCtx(ctx)
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 
-	registrationFee := new(big.Int).Set(p.config.RegistrationConsts.Fee)
+	fee, err := p.registrationFee(ctx, sm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get registration fee")
+	}
+	registrationFee := new(big.Int).Set(fee)
 
 	caller, gasFee, fetchErr := fetchCaller(ctx, sm, new(big.Int).Add(act.Amount(), registrationFee))
 	if fetchErr != nil {
@@ -585,6 +832,33 @@ func (p *Protocol) handleCandidateUpdate(ctx context.Context, act *action.Candid
 	return receipt, nil
 }
 
+func (p *Protocol) handleCandidateBLSKey(ctx context.Context, act *action.CandidateBLSKey, sm protocol.StateManager) (*action.Receipt, error) {
+	actCtx := protocol.MustGetActionCtx(ctx)
+
+	_, gasFee, fetchErr := fetchCaller(ctx, sm, new(big.Int))
+	if fetchErr != nil {
+		if fetchErr.failureStatus == iotextypes.ReceiptStatus_Failure {
+			return nil, fetchErr.err
+		}
+		log.L().Debug("Error when fetching caller", zap.Error(fetchErr.err))
+		return p.settleAction(ctx, sm, uint64(fetchErr.failureStatus), gasFee)
+	}
+
+	// only owner can register the BLS key of candidate
+	c := p.inMemCandidates.GetByOwner(actCtx.Caller)
+	if c == nil {
+		log.L().Debug("Error when registering BLS key", zap.Error(ErrInvalidOwner))
+		return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_ErrCandidateNotExist), gasFee)
+	}
+
+	if err := putCandidateBLSKey(sm, c.Owner, act.PublicKey()); err != nil {
+		return nil, errors.Wrapf(err, "failed to put BLS key of %s", c.Owner.String())
+	}
+
+	log := p.createLog(ctx, HandleCandidateBLSKey, c.Owner, actCtx.Caller, act.PublicKey())
+	return p.settleAction(ctx, sm, uint64(iotextypes.ReceiptStatus_Success), gasFee, log)
+}
+
 // settleAccount deposits gas fee and updates caller's nonce
 func (p *Protocol) settleAction(
 	ctx context.Context,
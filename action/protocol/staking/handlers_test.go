@@ -26,6 +26,7 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/identityset"
 )
 
@@ -1778,6 +1779,268 @@ func TestProtocol_HandleDepositToStake(t *testing.T) {
 	}
 }
 
+func TestProtocol_HandleSplitStake(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakeAmount := unit.ConvertIotxToRau(300)
+	tests := []struct {
+		// action fields
+		caller address.Address
+		index  uint64
+		amount *big.Int
+		// clear flag for inMemCandidates
+		clear bool
+		// expected result
+		err    error
+		status iotextypes.ReceiptStatus
+	}{
+		// fetchBucket ReceiptStatus_ErrInvalidBucketIndex
+		{
+			identityset.Address(1),
+			10,
+			unit.ConvertIotxToRau(100),
+			false,
+			nil,
+			iotextypes.ReceiptStatus_ErrInvalidBucketIndex,
+		},
+		// fetchBucket ReceiptStatus_ErrUnauthorizedOperator
+		{
+			identityset.Address(2),
+			0,
+			unit.ConvertIotxToRau(100),
+			false,
+			nil,
+			iotextypes.ReceiptStatus_ErrUnauthorizedOperator,
+		},
+		// remaining amount less than the minimum requirement
+		{
+			identityset.Address(1),
+			0,
+			unit.ConvertIotxToRau(250),
+			false,
+			nil,
+			iotextypes.ReceiptStatus_ErrInvalidBucketType,
+		},
+		// for inMemCandidates.GetByOwner,ErrInvalidOwner
+		{
+			identityset.Address(1),
+			0,
+			unit.ConvertIotxToRau(100),
+			true,
+			ErrInvalidOwner,
+			iotextypes.ReceiptStatus_Success,
+		},
+		// ReceiptStatus_Success
+		{
+			identityset.Address(1),
+			0,
+			unit.ConvertIotxToRau(100),
+			false,
+			nil,
+			iotextypes.ReceiptStatus_Success,
+		},
+	}
+
+	for _, test := range tests {
+		sm, p, candidate, _ := initAll(t, ctrl)
+		initCreateStake(t, sm, candidate.Owner, 1000, big.NewInt(unit.Qev), 10000, 1, 1, time.Now(), 10000, p, candidate, stakeAmount.String(), true)
+		require.NoError(setupAccount(sm, identityset.Address(2), 1000))
+		candidate = p.inMemCandidates.GetByOwner(candidate.Owner)
+		votes := new(big.Int).Set(candidate.Votes)
+
+		act, err := action.NewSplitStake(2, test.index, test.amount.String(), nil, 10000, big.NewInt(unit.Qev))
+		require.NoError(err)
+		require.NoError(p.Validate(context.Background(), act))
+		if test.clear {
+			p.inMemCandidates.Delete(test.caller)
+		}
+		intrinsic, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       test.caller,
+			GasPrice:     big.NewInt(unit.Qev),
+			IntrinsicGas: intrinsic,
+			Nonce:        2,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    1,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       10000000,
+		})
+		r, err := p.Handle(ctx, act, sm)
+		require.Equal(test.err, errors.Cause(err))
+		if r != nil {
+			require.Equal(uint64(test.status), r.Status)
+		} else {
+			require.Equal(test.status, iotextypes.ReceiptStatus_Success)
+		}
+
+		if err == nil && test.status == iotextypes.ReceiptStatus_Success {
+			// test bucket index and bucket
+			bucketIndices, err := getCandBucketIndices(sm, candidate.Owner)
+			require.NoError(err)
+			require.Equal(BucketIndices{0, 1}, *bucketIndices)
+			bucketIndices, err = getVoterBucketIndices(sm, test.caller)
+			require.NoError(err)
+			require.Equal(BucketIndices{0, 1}, *bucketIndices)
+			bucket, err := getBucket(sm, 0)
+			require.NoError(err)
+			newBucket, err := getBucket(sm, 1)
+			require.NoError(err)
+			require.Equal(new(big.Int).Sub(stakeAmount, test.amount), bucket.StakedAmount)
+			require.Equal(test.amount, newBucket.StakedAmount)
+			require.Equal(bucket.Candidate, newBucket.Candidate)
+			require.Equal(bucket.Owner, newBucket.Owner)
+			require.Equal(bucket.StakedDuration, newBucket.StakedDuration)
+			require.Equal(bucket.StakeStartTime, newBucket.StakeStartTime)
+			require.Equal(bucket.AutoStake, newBucket.AutoStake)
+
+			// test candidate
+			votes.Sub(votes, p.calculateVoteWeight(&VoteBucket{
+				StakedAmount:   stakeAmount,
+				StakedDuration: bucket.StakedDuration,
+				AutoStake:      true,
+			}, false))
+			votes.Add(votes, p.calculateVoteWeight(bucket, false))
+			votes.Add(votes, p.calculateVoteWeight(newBucket, false))
+			candidate, err = getCandidate(sm, candidate.Owner)
+			require.NoError(err)
+			require.Equal(votes, candidate.Votes)
+			candidate = p.inMemCandidates.GetByOwner(candidate.Owner)
+			require.NotNil(candidate)
+			require.Equal(votes, candidate.Votes)
+		}
+	}
+}
+
+func TestProtocol_HandleMergeStake(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakeAmount := unit.ConvertIotxToRau(100)
+	tests := []struct {
+		// action fields
+		caller       address.Address
+		index        uint64
+		mergeIndices []uint64
+		// auto-stake flag of the bucket 3
+		autoStake bool
+		// expected result
+		status iotextypes.ReceiptStatus
+	}{
+		// fetchBucket ReceiptStatus_ErrInvalidBucketIndex
+		{
+			identityset.Address(1),
+			0,
+			[]uint64{10},
+			true,
+			iotextypes.ReceiptStatus_ErrInvalidBucketIndex,
+		},
+		// fetchBucket ReceiptStatus_ErrUnauthorizedOperator
+		{
+			identityset.Address(2),
+			0,
+			[]uint64{3},
+			true,
+			iotextypes.ReceiptStatus_ErrUnauthorizedOperator,
+		},
+		// self staking bucket cannot be merged
+		{
+			identityset.Address(1),
+			0,
+			[]uint64{1},
+			true,
+			iotextypes.ReceiptStatus_ErrInvalidBucketType,
+		},
+		// buckets with different auto-stake setting cannot be merged
+		{
+			identityset.Address(1),
+			0,
+			[]uint64{3},
+			false,
+			iotextypes.ReceiptStatus_ErrInvalidBucketType,
+		},
+		// ReceiptStatus_Success
+		{
+			identityset.Address(1),
+			0,
+			[]uint64{3},
+			true,
+			iotextypes.ReceiptStatus_Success,
+		},
+	}
+
+	for _, test := range tests {
+		sm, p, candidate, _ := initAll(t, ctrl)
+		for i := 0; i < 4; i++ {
+			initCreateStake(t, sm, candidate.Owner, 1000, big.NewInt(unit.Qev), 10000, 1, 1, time.Now(), 10000, p, candidate, stakeAmount.String(), i != 3 || test.autoStake)
+		}
+		require.NoError(setupAccount(sm, identityset.Address(2), 1000))
+		candidate = p.inMemCandidates.GetByOwner(candidate.Owner)
+		votes := new(big.Int).Set(candidate.Votes)
+
+		act, err := action.NewMergeStake(2, test.index, test.mergeIndices, nil, 10000, big.NewInt(unit.Qev))
+		require.NoError(err)
+		require.NoError(p.Validate(context.Background(), act))
+		intrinsic, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       test.caller,
+			GasPrice:     big.NewInt(unit.Qev),
+			IntrinsicGas: intrinsic,
+			Nonce:        2,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    1,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       10000000,
+		})
+		r, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(test.status), r.Status)
+
+		if test.status != iotextypes.ReceiptStatus_Success {
+			// no bucket is touched
+			for i := uint64(0); i < 4; i++ {
+				bucket, err := getBucket(sm, i)
+				require.NoError(err)
+				require.Equal(stakeAmount, bucket.StakedAmount)
+			}
+			continue
+		}
+
+		// test bucket index and bucket
+		bucketIndices, err := getCandBucketIndices(sm, candidate.Owner)
+		require.NoError(err)
+		require.Equal(BucketIndices{0, 1, 2}, *bucketIndices)
+		bucketIndices, err = getVoterBucketIndices(sm, test.caller)
+		require.NoError(err)
+		require.Equal(BucketIndices{0, 1, 2}, *bucketIndices)
+		_, err = getBucket(sm, 3)
+		require.Equal(state.ErrStateNotExist, errors.Cause(err))
+		bucket, err := getBucket(sm, 0)
+		require.NoError(err)
+		require.Equal(new(big.Int).Mul(stakeAmount, big.NewInt(2)), bucket.StakedAmount)
+
+		// test candidate
+		single := p.calculateVoteWeight(&VoteBucket{
+			StakedAmount:   stakeAmount,
+			StakedDuration: bucket.StakedDuration,
+			AutoStake:      true,
+		}, false)
+		votes.Sub(votes, single).Sub(votes, single).Add(votes, p.calculateVoteWeight(bucket, false))
+		candidate, err = getCandidate(sm, candidate.Owner)
+		require.NoError(err)
+		require.Equal(votes, candidate.Votes)
+		candidate = p.inMemCandidates.GetByOwner(candidate.Owner)
+		require.NotNil(candidate)
+		require.Equal(votes, candidate.Votes)
+	}
+}
+
 func initCreateStake(t *testing.T, sm protocol.StateManager, callerAddr address.Address, initBalance int64, gasPrice *big.Int, gasLimit uint64, nonce uint64, blkHeight uint64, blkTimestamp time.Time, blkGasLimit uint64, p *Protocol, candidate *Candidate, amount string, autoStake bool) (context.Context, *big.Int) {
 	require := require.New(t)
 	require.NoError(setupAccount(sm, callerAddr, initBalance))
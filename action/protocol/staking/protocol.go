@@ -20,7 +20,9 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/state"
 )
 
@@ -43,6 +45,10 @@ const (
 	_bucket
 	_voterIndex
 	_candIndex
+	_voterBucketCount
+	_candVoterCount
+	_compoundBucket
+	_candBLSKey
 )
 
 // Errors
@@ -113,6 +119,22 @@ func NewProtocol(depositGas DepositGas, sr protocol.StateReader, cfg genesis.Sta
 	}, nil
 }
 
+// FindProtocol finds the registered protocol from registry
+func FindProtocol(registry *protocol.Registry) *Protocol {
+	if registry == nil {
+		return nil
+	}
+	p, ok := registry.Find(protocolID)
+	if !ok {
+		return nil
+	}
+	sp, ok := p.(*Protocol)
+	if !ok {
+		log.S().Panic("fail to cast staking protocol")
+	}
+	return sp
+}
+
 // CreateGenesisStates is used to setup BootstrapCandidates from genesis config.
 func (p *Protocol) CreateGenesisStates(
 	ctx context.Context,
@@ -165,6 +187,22 @@ func (p *Protocol) CreateGenesisStates(
 	return nil
 }
 
+// CreatePreStates creates the statistics of staking from the existing buckets and candidates at the genesis
+// StakingStatsHeight
+func (p *Protocol) CreatePreStates(ctx context.Context, sm protocol.StateManager) error {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	if blkCtx.BlockHeight < bcCtx.Genesis.StakingStatsHeight {
+		return nil
+	}
+	// the protocol could be called more than once in a block, the statistics are only created once
+	_, err := loadStats(sm)
+	if errors.Cause(err) != state.ErrStateNotExist {
+		return err
+	}
+	return createStats(sm)
+}
+
 // Start starts the protocol
 func (p *Protocol) Start(ctx context.Context) error {
 	cands, err := getAllCandidates(p.sr)
@@ -198,10 +236,18 @@ func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.St
 		return p.handleDepositToStake(ctx, act, sm)
 	case *action.Restake:
 		return p.handleRestake(ctx, act, sm)
+	case *action.SplitStake:
+		return p.handleSplitStake(ctx, act, sm)
+	case *action.MergeStake:
+		return p.handleMergeStake(ctx, act, sm)
+	case *action.CompoundStake:
+		return p.handleCompoundStake(ctx, act, sm)
 	case *action.CandidateRegister:
 		return p.handleCandidateRegister(ctx, act, sm)
 	case *action.CandidateUpdate:
 		return p.handleCandidateUpdate(ctx, act, sm)
+	case *action.CandidateBLSKey:
+		return p.handleCandidateBLSKey(ctx, act, sm)
 	}
 	return nil, nil
 }
@@ -223,24 +269,37 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
 		return p.validateDepositToStake(ctx, act)
 	case *action.Restake:
 		return p.validateRestake(ctx, act)
+	case *action.SplitStake:
+		return p.validateSplitStake(ctx, act)
+	case *action.MergeStake:
+		return p.validateMergeStake(ctx, act)
+	case *action.CompoundStake:
+		return p.validateCompoundStake(ctx, act)
 	case *action.CandidateRegister:
 		return p.validateCandidateRegister(ctx, act)
 	case *action.CandidateUpdate:
 		return p.validateCandidateUpdate(ctx, act)
+	case *action.CandidateBLSKey:
+		return p.validateCandidateBLSKey(ctx, act)
 	}
 	return nil
 }
 
-// ActiveCandidates returns all active candidates in candidate center
-func (p *Protocol) ActiveCandidates(context.Context) (state.CandidateList, error) {
+// ActiveCandidates returns all active candidates in candidate center, whose self-stake reaches the minimum which may
+// be changed by governance
+func (p *Protocol) ActiveCandidates(ctx context.Context) (state.CandidateList, error) {
 	list, err := p.inMemCandidates.All()
 	if err != nil {
 		return nil, err
 	}
+	minSelfStake, err := p.minSelfStake(ctx, p.sr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get minimum self-stake")
+	}
 
 	cand := make(CandidateList, 0, len(list))
 	for i := range list {
-		if list[i].SelfStake.Cmp(p.config.RegistrationConsts.MinSelfStake) >= 0 {
+		if list[i].SelfStake.Cmp(minSelfStake) >= 0 {
 			cand = append(cand, list[i])
 		}
 	}
@@ -275,6 +334,16 @@ func (p *Protocol) ReadState(ctx context.Context, sr protocol.StateReader, metho
 		resp, err = readStateCandidates(ctx, sr, r.GetCandidates())
 	case iotexapi.ReadStakingDataMethod_CANDIDATE_BY_NAME:
 		resp, err = readStateCandidateByName(ctx, sr, r.GetCandidateByName())
+	case iotexapi.ReadStakingDataMethod_TOTAL_STAKED:
+		return readStateTotalStaked(ctx, sr)
+	case iotexapi.ReadStakingDataMethod_TOTAL_VOTES:
+		return readStateTotalVotes(ctx, sr)
+	case iotexapi.ReadStakingDataMethod_STAKE_DISTRIBUTION_BY_DURATION:
+		resp, err = readStateStakeDistributionByDuration(ctx, sr)
+	case iotexapi.ReadStakingDataMethod_STAKE_DISTRIBUTION_BY_AUTO_STAKE:
+		resp, err = readStateStakeDistributionByAutoStake(ctx, sr)
+	case iotexapi.ReadStakingDataMethod_VOTER_COUNT_BY_CANDIDATE:
+		return readStateVoterCountByCandidate(ctx, sr, r.GetVoterCountByCandidate())
 	default:
 		err = errors.New("corresponding method isn't found")
 	}
@@ -298,3 +367,18 @@ func (p *Protocol) ForceRegister(r *protocol.Registry) error {
 func (p *Protocol) calculateVoteWeight(v *VoteBucket, selfStake bool) *big.Int {
 	return calculateVoteWeight(p.config.VoteWeightCalConsts, v, selfStake)
 }
+
+// minStakeAmount returns the minimum amount of a vote bucket, which may be changed by governance
+func (p *Protocol) minStakeAmount(ctx context.Context, sr protocol.StateReader) (*big.Int, error) {
+	return governance.AmountParameter(ctx, sr, governance.MinStakeAmount, p.config.MinStakeAmount)
+}
+
+// registrationFee returns the fee of registering a candidate, which may be changed by governance
+func (p *Protocol) registrationFee(ctx context.Context, sr protocol.StateReader) (*big.Int, error) {
+	return governance.AmountParameter(ctx, sr, governance.CandidateRegistrationFee, p.config.RegistrationConsts.Fee)
+}
+
+// minSelfStake returns the minimum self-stake amount of a candidate, which may be changed by governance
+func (p *Protocol) minSelfStake(ctx context.Context, sr protocol.StateReader) (*big.Int, error) {
+	return governance.AmountParameter(ctx, sr, governance.CandidateMinSelfStake, p.config.RegistrationConsts.MinSelfStake)
+}
@@ -8,9 +8,11 @@ package staking
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
@@ -108,6 +110,57 @@ func readStateCandidateByName(ctx context.Context, sr protocol.StateReader,
 	return c.toIoTeXTypes(), nil
 }
 
+func readStateTotalStaked(ctx context.Context, sr protocol.StateReader) ([]byte, error) {
+	stats, err := getStats(sr)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(stats.Total.Amount.String()), nil
+}
+
+func readStateTotalVotes(ctx context.Context, sr protocol.StateReader) ([]byte, error) {
+	stats, err := getStats(sr)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(stats.TotalVotes.String()), nil
+}
+
+func readStateStakeDistributionByDuration(ctx context.Context, sr protocol.StateReader) (*stakingpb.DurationStatsList, error) {
+	stats, err := getStats(sr)
+	if err != nil {
+		return nil, err
+	}
+	return &stakingpb.DurationStatsList{Durations: stats.durationsProto()}, nil
+}
+
+func readStateStakeDistributionByAutoStake(ctx context.Context, sr protocol.StateReader) (*stakingpb.AutoStakeStats, error) {
+	stats, err := getStats(sr)
+	if err != nil {
+		return nil, err
+	}
+	return &stakingpb.AutoStakeStats{
+		AutoStake:    stats.AutoStake.toProto(),
+		NonAutoStake: stats.NonAutoStake.toProto(),
+	}, nil
+}
+
+func readStateVoterCountByCandidate(ctx context.Context, sr protocol.StateReader,
+	req *iotexapi.ReadStakingDataRequest_VoterCountByCandidate) ([]byte, error) {
+	c, err := getCandidateByName(sr, req.GetCandName())
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return []byte("0"), nil
+	}
+	count, err := getCandVoterCount(sr, c.Owner)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.FormatUint(count, 10)), nil
+}
+
 func toIoTeXTypesVoteBucketList(buckets []*VoteBucket) (*iotextypes.VoteBucketList, error) {
 	res := iotextypes.VoteBucketList{
 		Buckets: make([]*iotextypes.VoteBucket, 0, len(buckets)),
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// ErrInvalidSlashRate indicates that the slash rate is out of range [0, 100]
+var ErrInvalidSlashRate = errors.New("invalid slash rate")
+
+// SlashSelfStake burns rate percent of the self-staking bucket of the candidate operated by the given address, and
+// returns the amount burnt. Nothing is burnt if the bucket has been unstaked or withdrawn, as it no longer counts
+// towards the votes and self-stake of the candidate
+func (p *Protocol) SlashSelfStake(
+	ctx context.Context,
+	sm protocol.StateManager,
+	operator address.Address,
+	rate uint32,
+) (*big.Int, error) {
+	if rate > 100 {
+		return nil, errors.Wrapf(ErrInvalidSlashRate, "rate %d", rate)
+	}
+	candidate := p.inMemCandidates.GetByOperator(operator)
+	if candidate == nil {
+		return nil, errors.Wrapf(ErrInvalidOperator, "cannot find candidate operated by %s", operator)
+	}
+	bucket, err := getBucket(sm, candidate.SelfStakeBucketIdx)
+	switch errors.Cause(err) {
+	case nil:
+		if bucket.isUnstaked() {
+			return big.NewInt(0), nil
+		}
+	case state.ErrStateNotExist:
+		return big.NewInt(0), nil
+	default:
+		return nil, errors.Wrapf(err, "failed to fetch self-staking bucket of candidate %s", candidate.Name)
+	}
+	amount := new(big.Int).Mul(bucket.StakedAmount, big.NewInt(int64(rate)))
+	amount.Div(amount, big.NewInt(100))
+	if amount.Sign() == 0 {
+		return amount, nil
+	}
+
+	prevWeightedVotes := p.calculateVoteWeight(bucket, true)
+	// update bucket
+	bucket.StakedAmount.Sub(bucket.StakedAmount, amount)
+	if err := updateBucket(sm, candidate.SelfStakeBucketIdx, bucket); err != nil {
+		return nil, errors.Wrapf(err, "failed to update self-staking bucket of candidate %s", candidate.Name)
+	}
+
+	// update candidate
+	if err := candidate.SubVote(prevWeightedVotes); err != nil {
+		return nil, errors.Wrapf(err, "failed to subtract vote for candidate %s", candidate.Name)
+	}
+	if err := candidate.AddVote(p.calculateVoteWeight(bucket, true)); err != nil {
+		return nil, errors.Wrapf(err, "failed to add vote for candidate %s", candidate.Name)
+	}
+	if err := candidate.SubSelfStake(amount); err != nil {
+		return nil, errors.Wrapf(err, "failed to subtract self stake for candidate %s", candidate.Name)
+	}
+	if err := putCandidate(sm, candidate); err != nil {
+		return nil, errors.Wrapf(err, "failed to put state of candidate %s", candidate.Name)
+	}
+	if err := p.inMemCandidates.Upsert(candidate); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestProtocol_SlashSelfStake(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm, p, candidate, _ := initAll(t, ctrl)
+	ctx, _ := initCreateStake(t, sm, candidate.Owner, 101, big.NewInt(unit.Qev), 10000, 1, 1, time.Now(), 10000, p, candidate, "100000000000000000000", false)
+	bucket, err := getBucket(sm, 0)
+	require.NoError(err)
+	candidate, err = getCandidate(sm, candidate.Owner)
+	require.NoError(err)
+	// make the bucket the self-staking bucket of the candidate
+	candidate.SelfStakeBucketIdx = 0
+	candidate.SelfStake = new(big.Int).Set(bucket.StakedAmount)
+	candidate.Votes = p.calculateVoteWeight(bucket, true)
+	require.NoError(setupCandidate(p, sm, candidate))
+
+	_, err = p.SlashSelfStake(ctx, sm, candidate.Operator, 101)
+	require.Equal(ErrInvalidSlashRate, errors.Cause(err))
+	_, err = p.SlashSelfStake(ctx, sm, identityset.Address(20), 10)
+	require.Equal(ErrInvalidOperator, errors.Cause(err))
+
+	amount, err := p.SlashSelfStake(ctx, sm, candidate.Operator, 10)
+	require.NoError(err)
+	require.Equal("10000000000000000000", amount.String())
+	bucket, err = getBucket(sm, 0)
+	require.NoError(err)
+	require.Equal("90000000000000000000", bucket.StakedAmount.String())
+	slashed, err := getCandidate(sm, candidate.Owner)
+	require.NoError(err)
+	require.Equal("90000000000000000000", slashed.SelfStake.String())
+	require.Equal(p.calculateVoteWeight(bucket, true), slashed.Votes)
+	require.Equal(slashed, p.inMemCandidates.GetByOwner(candidate.Owner))
+
+	// an unstaked self-staking bucket is not slashed
+	bucket.UnstakeStartTime = time.Now().UTC()
+	require.NoError(updateBucket(sm, 0, bucket))
+	amount, err = p.SlashSelfStake(ctx, sm, candidate.Operator, 10)
+	require.NoError(err)
+	require.Zero(amount.Sign())
+	unstaked, err := getBucket(sm, 0)
+	require.NoError(err)
+	require.Equal("90000000000000000000", unstaked.StakedAmount.String())
+	require.Equal(slashed, p.inMemCandidates.GetByOwner(candidate.Owner))
+
+	// neither is a withdrawn one
+	require.NoError(delBucket(sm, 0))
+	amount, err = p.SlashSelfStake(ctx, sm, candidate.Operator, 10)
+	require.NoError(err)
+	require.Zero(amount.Sign())
+	require.Equal(slashed, p.inMemCandidates.GetByOwner(candidate.Owner))
+}
@@ -289,41 +289,307 @@ func (m *Candidates) GetCandidates() []*Candidate {
 	return nil
 }
 
+type AmountStats struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AmountStats) Reset()         { *m = AmountStats{} }
+func (m *AmountStats) String() string { return proto.CompactTextString(m) }
+func (*AmountStats) ProtoMessage()    {}
+func (*AmountStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{4}
+}
+
+func (m *AmountStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AmountStats.Unmarshal(m, b)
+}
+func (m *AmountStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AmountStats.Marshal(b, m, deterministic)
+}
+func (m *AmountStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmountStats.Merge(m, src)
+}
+func (m *AmountStats) XXX_Size() int {
+	return xxx_messageInfo_AmountStats.Size(m)
+}
+func (m *AmountStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmountStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmountStats proto.InternalMessageInfo
+
+func (m *AmountStats) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *AmountStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DurationStats struct {
+	Days                 uint32       `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Stats                *AmountStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DurationStats) Reset()         { *m = DurationStats{} }
+func (m *DurationStats) String() string { return proto.CompactTextString(m) }
+func (*DurationStats) ProtoMessage()    {}
+func (*DurationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{5}
+}
+
+func (m *DurationStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurationStats.Unmarshal(m, b)
+}
+func (m *DurationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurationStats.Marshal(b, m, deterministic)
+}
+func (m *DurationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationStats.Merge(m, src)
+}
+func (m *DurationStats) XXX_Size() int {
+	return xxx_messageInfo_DurationStats.Size(m)
+}
+func (m *DurationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationStats proto.InternalMessageInfo
+
+func (m *DurationStats) GetDays() uint32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *DurationStats) GetStats() *AmountStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type StakingStats struct {
+	TotalVotes           string           `protobuf:"bytes,1,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	Total                *AmountStats     `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	AutoStake            *AmountStats     `protobuf:"bytes,3,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	NonAutoStake         *AmountStats     `protobuf:"bytes,4,opt,name=nonAutoStake,proto3" json:"nonAutoStake,omitempty"`
+	Durations            []*DurationStats `protobuf:"bytes,5,rep,name=durations,proto3" json:"durations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StakingStats) Reset()         { *m = StakingStats{} }
+func (m *StakingStats) String() string { return proto.CompactTextString(m) }
+func (*StakingStats) ProtoMessage()    {}
+func (*StakingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{6}
+}
+
+func (m *StakingStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakingStats.Unmarshal(m, b)
+}
+func (m *StakingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakingStats.Marshal(b, m, deterministic)
+}
+func (m *StakingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingStats.Merge(m, src)
+}
+func (m *StakingStats) XXX_Size() int {
+	return xxx_messageInfo_StakingStats.Size(m)
+}
+func (m *StakingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingStats proto.InternalMessageInfo
+
+func (m *StakingStats) GetTotalVotes() string {
+	if m != nil {
+		return m.TotalVotes
+	}
+	return ""
+}
+
+func (m *StakingStats) GetTotal() *AmountStats {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *StakingStats) GetAutoStake() *AmountStats {
+	if m != nil {
+		return m.AutoStake
+	}
+	return nil
+}
+
+func (m *StakingStats) GetNonAutoStake() *AmountStats {
+	if m != nil {
+		return m.NonAutoStake
+	}
+	return nil
+}
+
+func (m *StakingStats) GetDurations() []*DurationStats {
+	if m != nil {
+		return m.Durations
+	}
+	return nil
+}
+
+type DurationStatsList struct {
+	Durations            []*DurationStats `protobuf:"bytes,1,rep,name=durations,proto3" json:"durations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DurationStatsList) Reset()         { *m = DurationStatsList{} }
+func (m *DurationStatsList) String() string { return proto.CompactTextString(m) }
+func (*DurationStatsList) ProtoMessage()    {}
+func (*DurationStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{7}
+}
+
+func (m *DurationStatsList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurationStatsList.Unmarshal(m, b)
+}
+func (m *DurationStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurationStatsList.Marshal(b, m, deterministic)
+}
+func (m *DurationStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationStatsList.Merge(m, src)
+}
+func (m *DurationStatsList) XXX_Size() int {
+	return xxx_messageInfo_DurationStatsList.Size(m)
+}
+func (m *DurationStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationStatsList proto.InternalMessageInfo
+
+func (m *DurationStatsList) GetDurations() []*DurationStats {
+	if m != nil {
+		return m.Durations
+	}
+	return nil
+}
+
+type AutoStakeStats struct {
+	AutoStake            *AmountStats `protobuf:"bytes,1,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	NonAutoStake         *AmountStats `protobuf:"bytes,2,opt,name=nonAutoStake,proto3" json:"nonAutoStake,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AutoStakeStats) Reset()         { *m = AutoStakeStats{} }
+func (m *AutoStakeStats) String() string { return proto.CompactTextString(m) }
+func (*AutoStakeStats) ProtoMessage()    {}
+func (*AutoStakeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{8}
+}
+
+func (m *AutoStakeStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoStakeStats.Unmarshal(m, b)
+}
+func (m *AutoStakeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoStakeStats.Marshal(b, m, deterministic)
+}
+func (m *AutoStakeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoStakeStats.Merge(m, src)
+}
+func (m *AutoStakeStats) XXX_Size() int {
+	return xxx_messageInfo_AutoStakeStats.Size(m)
+}
+func (m *AutoStakeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoStakeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoStakeStats proto.InternalMessageInfo
+
+func (m *AutoStakeStats) GetAutoStake() *AmountStats {
+	if m != nil {
+		return m.AutoStake
+	}
+	return nil
+}
+
+func (m *AutoStakeStats) GetNonAutoStake() *AmountStats {
+	if m != nil {
+		return m.NonAutoStake
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Bucket)(nil), "stakingpb.Bucket")
 	proto.RegisterType((*BucketIndices)(nil), "stakingpb.BucketIndices")
 	proto.RegisterType((*Candidate)(nil), "stakingpb.Candidate")
 	proto.RegisterType((*Candidates)(nil), "stakingpb.Candidates")
+	proto.RegisterType((*AmountStats)(nil), "stakingpb.AmountStats")
+	proto.RegisterType((*DurationStats)(nil), "stakingpb.DurationStats")
+	proto.RegisterType((*StakingStats)(nil), "stakingpb.StakingStats")
+	proto.RegisterType((*DurationStatsList)(nil), "stakingpb.DurationStatsList")
+	proto.RegisterType((*AutoStakeStats)(nil), "stakingpb.AutoStakeStats")
 }
 
 func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
 
 var fileDescriptor_289e7c8aea278311 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6a, 0x1b, 0x31,
-	0x10, 0x86, 0x51, 0xbd, 0x71, 0xa2, 0x49, 0xdd, 0x86, 0x21, 0x07, 0x11, 0x0a, 0x5d, 0x96, 0x52,
-	0xb6, 0x3d, 0x6c, 0x20, 0xed, 0xa9, 0xb7, 0xb8, 0xa5, 0xd0, 0xab, 0x92, 0x17, 0x90, 0xad, 0x89,
-	0x11, 0xc9, 0x4a, 0x46, 0xd2, 0x36, 0x79, 0x8e, 0xbe, 0x6c, 0xaf, 0x65, 0x25, 0xef, 0xc6, 0x76,
-	0x0a, 0xbe, 0x69, 0x7e, 0xfd, 0x33, 0xe2, 0x9f, 0x6f, 0x17, 0x66, 0x21, 0xaa, 0x7b, 0x63, 0x57,
-	0xcd, 0xda, 0xbb, 0xe8, 0x90, 0x6f, 0xca, 0xf5, 0xe2, 0xe2, 0xfd, 0xca, 0xb9, 0xd5, 0x03, 0x5d,
-	0xa6, 0x8b, 0x45, 0x77, 0x77, 0x19, 0x4d, 0x4b, 0x21, 0xaa, 0x76, 0x9d, 0xbd, 0xd5, 0x9f, 0x09,
-	0x4c, 0xe7, 0xdd, 0xf2, 0x9e, 0x22, 0x9e, 0xc3, 0x91, 0xb1, 0x9a, 0x9e, 0x04, 0x2b, 0x59, 0x5d,
-	0xc8, 0x5c, 0xe0, 0x67, 0x38, 0x5b, 0x2a, 0xab, 0x8d, 0x56, 0x91, 0xae, 0xb5, 0xf6, 0x14, 0x82,
-	0x78, 0x55, 0xb2, 0x9a, 0xcb, 0x17, 0x3a, 0x56, 0xf0, 0xba, 0x7f, 0x9a, 0xf4, 0x75, 0xeb, 0x3a,
-	0x1b, 0xc5, 0x24, 0xf9, 0x76, 0x34, 0xfc, 0x08, 0x6f, 0x72, 0xfd, 0xa3, 0xf3, 0x2a, 0x1a, 0x67,
-	0x45, 0x51, 0xb2, 0x7a, 0x26, 0xf7, 0x54, 0xfc, 0x06, 0xb0, 0xf4, 0xa4, 0x22, 0xdd, 0x9a, 0x96,
-	0xc4, 0x51, 0xc9, 0xea, 0xd3, 0xab, 0x8b, 0x26, 0xc7, 0x69, 0x86, 0x38, 0xcd, 0xed, 0x10, 0x47,
-	0x6e, 0xb9, 0x71, 0xbe, 0x79, 0xe3, 0x26, 0x2a, 0x1f, 0x53, 0xff, 0xf4, 0x60, 0xff, 0x5e, 0x07,
-	0xfe, 0x84, 0xb3, 0xce, 0xee, 0x4d, 0x39, 0x3e, 0x38, 0xe5, 0x45, 0x0f, 0xbe, 0x03, 0xae, 0xba,
-	0xe8, 0x6e, 0x7a, 0x55, 0x9c, 0x94, 0xac, 0x3e, 0x91, 0xcf, 0x42, 0xbf, 0x73, 0xf7, 0x68, 0xc9,
-	0x0b, 0x9e, 0x56, 0x95, 0x8b, 0xea, 0x13, 0xcc, 0x32, 0x93, 0x5f, 0x56, 0x9b, 0x25, 0x05, 0x14,
-	0x70, 0x6c, 0xf2, 0x51, 0xb0, 0x72, 0x52, 0x17, 0x72, 0x28, 0xab, 0xbf, 0x0c, 0xf8, 0xf7, 0x81,
-	0x43, 0x0f, 0x20, 0x4d, 0x18, 0x40, 0xb1, 0x0c, 0x60, 0x5b, 0xc3, 0x1a, 0xde, 0xba, 0x35, 0x79,
-	0x15, 0x9d, 0xdf, 0xe5, 0xb9, 0x2f, 0xe3, 0x07, 0x98, 0x79, 0x7a, 0x54, 0x5e, 0x0f, 0xbe, 0xcc,
-	0x73, 0x57, 0x44, 0x84, 0xc2, 0xaa, 0x96, 0x12, 0x46, 0x2e, 0xd3, 0xb9, 0x8f, 0xf5, 0xdb, 0x45,
-	0x0a, 0x89, 0x1b, 0x97, 0xb9, 0xc0, 0x06, 0x30, 0xd0, 0xc3, 0x5d, 0x4a, 0xbe, 0xc9, 0xa7, 0x9f,
-	0x12, 0x9a, 0x42, 0xfe, 0xe7, 0xa6, 0x5f, 0xdd, 0xa8, 0xa6, 0xdd, 0x73, 0xf9, 0x2c, 0x54, 0x73,
-	0x80, 0x31, 0x78, 0xc0, 0xaf, 0x00, 0xe3, 0xe7, 0x98, 0x97, 0x74, 0x7a, 0x75, 0xde, 0x8c, 0x3f,
-	0x42, 0x33, 0x5a, 0xe5, 0x96, 0x6f, 0x31, 0x4d, 0x08, 0xbf, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x39, 0x2e, 0xe7, 0x41, 0x03, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x96, 0xdb, 0xac, 0x5b, 0x5e, 0x97, 0x31, 0x9e, 0xa6, 0x29, 0x9a, 0x10, 0x44, 0x11, 0x42,
+	0x01, 0xa1, 0x4c, 0x1a, 0x13, 0x87, 0x71, 0x6a, 0x41, 0x48, 0x08, 0x2e, 0xb8, 0x13, 0x77, 0xb7,
+	0xf1, 0xaa, 0x68, 0xad, 0x5d, 0xc5, 0x0e, 0x1b, 0x57, 0x0e, 0xfc, 0x00, 0xfe, 0x2c, 0x57, 0x64,
+	0x3b, 0x49, 0x93, 0x0e, 0xad, 0x13, 0x37, 0xbf, 0xcf, 0xdf, 0xf7, 0xec, 0xf7, 0x7d, 0x4e, 0x20,
+	0x50, 0x9a, 0x5d, 0xe7, 0x62, 0x9e, 0xae, 0x0a, 0xa9, 0x25, 0xfa, 0x55, 0xb9, 0x9a, 0x9e, 0x3c,
+	0x9b, 0x4b, 0x39, 0x5f, 0xf0, 0x53, 0xbb, 0x31, 0x2d, 0xaf, 0x4e, 0x75, 0xbe, 0xe4, 0x4a, 0xb3,
+	0xe5, 0xca, 0x71, 0xe3, 0xdf, 0x7d, 0x18, 0x8c, 0xcb, 0xd9, 0x35, 0xd7, 0x78, 0x04, 0x3b, 0xb9,
+	0xc8, 0xf8, 0x6d, 0x48, 0x22, 0x92, 0x78, 0xd4, 0x15, 0xf8, 0x0a, 0x0e, 0x67, 0x4c, 0x64, 0x79,
+	0xc6, 0x34, 0x1f, 0x65, 0x59, 0xc1, 0x95, 0x0a, 0x7b, 0x11, 0x49, 0x7c, 0x7a, 0x07, 0xc7, 0x18,
+	0xf6, 0xcd, 0xd1, 0x3c, 0x1b, 0x2d, 0x65, 0x29, 0x74, 0xd8, 0xb7, 0xbc, 0x0e, 0x86, 0x2f, 0xe0,
+	0xc0, 0xd5, 0x1f, 0xca, 0x82, 0xe9, 0x5c, 0x8a, 0xd0, 0x8b, 0x48, 0x12, 0xd0, 0x0d, 0x14, 0x2f,
+	0x00, 0x66, 0x05, 0x67, 0x9a, 0x5f, 0xe6, 0x4b, 0x1e, 0xee, 0x44, 0x24, 0x19, 0x9e, 0x9d, 0xa4,
+	0x6e, 0x9c, 0xb4, 0x1e, 0x27, 0xbd, 0xac, 0xc7, 0xa1, 0x2d, 0x36, 0x8e, 0xab, 0x33, 0x26, 0x9a,
+	0x15, 0xda, 0xea, 0x07, 0x5b, 0xf5, 0x1b, 0x0a, 0xfc, 0x08, 0x87, 0xa5, 0xd8, 0xe8, 0xb2, 0xbb,
+	0xb5, 0xcb, 0x1d, 0x0d, 0x3e, 0x01, 0x9f, 0x95, 0x5a, 0x4e, 0x0c, 0x1a, 0xee, 0x45, 0x24, 0xd9,
+	0xa3, 0x6b, 0xc0, 0x78, 0x2e, 0x6f, 0x04, 0x2f, 0x42, 0xdf, 0x5a, 0xe5, 0x8a, 0xf8, 0x25, 0x04,
+	0x2e, 0x93, 0x4f, 0x22, 0xcb, 0x67, 0x5c, 0x61, 0x08, 0xbb, 0xb9, 0x5b, 0x86, 0x24, 0xea, 0x27,
+	0x1e, 0xad, 0xcb, 0xf8, 0x0f, 0x01, 0xff, 0x7d, 0x9d, 0x83, 0x09, 0xc0, 0x76, 0xa8, 0x83, 0x22,
+	0x2e, 0x80, 0x36, 0x86, 0x09, 0x3c, 0x92, 0x2b, 0x5e, 0x30, 0x2d, 0x8b, 0x6e, 0x9e, 0x9b, 0x30,
+	0x3e, 0x87, 0xa0, 0xe0, 0x37, 0xac, 0xc8, 0x6a, 0x9e, 0xcb, 0xb3, 0x0b, 0x22, 0x82, 0x27, 0xd8,
+	0x92, 0xdb, 0x18, 0x7d, 0x6a, 0xd7, 0x66, 0xac, 0xef, 0x52, 0x73, 0x65, 0x73, 0xf3, 0xa9, 0x2b,
+	0x30, 0x05, 0x54, 0x7c, 0x71, 0x65, 0x27, 0xaf, 0xe6, 0xcb, 0x6e, 0x6d, 0x34, 0x1e, 0xfd, 0xc7,
+	0x8e, 0xb1, 0xae, 0x41, 0xad, 0xf7, 0x3e, 0x5d, 0x03, 0xf1, 0x18, 0xa0, 0x19, 0x5c, 0xe1, 0x39,
+	0x40, 0xf3, 0x1c, 0x9d, 0x49, 0xc3, 0xb3, 0xa3, 0xb4, 0xf9, 0x10, 0xd2, 0x86, 0x4a, 0x5b, 0xbc,
+	0xf8, 0x1d, 0x0c, 0xdd, 0xb3, 0x9c, 0x68, 0xa6, 0x15, 0x1e, 0xc3, 0x80, 0xd9, 0xb2, 0x32, 0xae,
+	0xaa, 0xcc, 0x38, 0x33, 0x0b, 0xf7, 0xdc, 0x97, 0x61, 0x8b, 0xf8, 0x2b, 0x04, 0xf5, 0x6b, 0x75,
+	0x72, 0x04, 0x2f, 0x63, 0x3f, 0x9c, 0xeb, 0x01, 0xb5, 0x6b, 0x7c, 0x0d, 0x3b, 0xca, 0x6c, 0x5a,
+	0xe9, 0xf0, 0xec, 0xb8, 0x75, 0xa5, 0xd6, 0xc9, 0xd4, 0x91, 0xe2, 0x5f, 0x3d, 0xd8, 0x9f, 0x38,
+	0x82, 0x6b, 0xf9, 0x14, 0x40, 0x4b, 0xcd, 0x16, 0xdf, 0xa4, 0x1b, 0xcb, 0xdc, 0xaa, 0x85, 0x98,
+	0xf6, 0xb6, 0xda, 0xd6, 0xde, 0x92, 0xf0, 0xbc, 0xfd, 0x16, 0xfb, 0xf7, 0x2a, 0xd6, 0x44, 0xbc,
+	0x80, 0x7d, 0x21, 0xc5, 0xa8, 0x11, 0x7a, 0xf7, 0x0a, 0x3b, 0x5c, 0x7c, 0x0b, 0x7e, 0x56, 0x79,
+	0x64, 0x1e, 0x83, 0x49, 0x25, 0x6c, 0x09, 0x3b, 0xfe, 0xd1, 0x35, 0x35, 0xfe, 0x0c, 0x8f, 0x3b,
+	0x7b, 0x5f, 0x72, 0xa5, 0xbb, 0xcd, 0xc8, 0xc3, 0x9b, 0xfd, 0x24, 0x70, 0xd0, 0x5c, 0xc9, 0xf9,
+	0xda, 0x71, 0x82, 0xfc, 0xaf, 0x13, 0xbd, 0x87, 0x3b, 0x31, 0x1d, 0xd8, 0xbf, 0xc5, 0x9b, 0xbf,
+	0x03, 0x00, 0x1c, 0x4c, 0x5e, 0xba, 0xac, 0x05, 0x00, 0x00,
 }
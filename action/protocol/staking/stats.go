@@ -0,0 +1,423 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-address/address"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// statsKey is the key of the aggregate statistics in the namespace StakingNameSpace
+var statsKey = append([]byte{_const}, []byte("stats")...)
+
+type (
+	// AmountStats is the total amount and number of a group of buckets
+	AmountStats struct {
+		Amount *big.Int
+		Count  uint64
+	}
+
+	// Stats is the aggregate statistics of staking, it only counts buckets that have not been unstaked
+	Stats struct {
+		TotalVotes   *big.Int
+		Total        AmountStats
+		AutoStake    AmountStats
+		NonAutoStake AmountStats
+		// Durations is keyed by the staked duration in days
+		Durations map[uint32]*AmountStats
+	}
+
+	// voterCount stores the number of buckets of a voter, or the number of voters of a candidate
+	voterCount struct {
+		count uint64
+	}
+)
+
+func newAmountStats() AmountStats {
+	return AmountStats{Amount: big.NewInt(0)}
+}
+
+func (as *AmountStats) add(amount *big.Int) {
+	as.Amount.Add(as.Amount, amount)
+	as.Count++
+}
+
+func (as *AmountStats) sub(amount *big.Int) error {
+	if as.Count == 0 || as.Amount.Cmp(amount) < 0 {
+		return errors.New("amount stats cannot be negative")
+	}
+	as.Amount.Sub(as.Amount, amount)
+	as.Count--
+	return nil
+}
+
+func (as *AmountStats) toProto() *stakingpb.AmountStats {
+	return &stakingpb.AmountStats{
+		Amount: as.Amount.String(),
+		Count:  as.Count,
+	}
+}
+
+func (as *AmountStats) fromProto(pb *stakingpb.AmountStats) error {
+	as.Amount = big.NewInt(0)
+	if len(pb.GetAmount()) > 0 {
+		if _, ok := as.Amount.SetString(pb.GetAmount(), 10); !ok {
+			return ErrInvalidAmount
+		}
+	}
+	as.Count = pb.GetCount()
+	return nil
+}
+
+// NewStats creates empty staking statistics
+func NewStats() *Stats {
+	return &Stats{
+		TotalVotes:   big.NewInt(0),
+		Total:        newAmountStats(),
+		AutoStake:    newAmountStats(),
+		NonAutoStake: newAmountStats(),
+		Durations:    make(map[uint32]*AmountStats),
+	}
+}
+
+func (s *Stats) addBucket(vb *VoteBucket) {
+	s.Total.add(vb.StakedAmount)
+	if vb.AutoStake {
+		s.AutoStake.add(vb.StakedAmount)
+	} else {
+		s.NonAutoStake.add(vb.StakedAmount)
+	}
+	days := uint32(vb.StakedDuration / 24 / time.Hour)
+	ds, ok := s.Durations[days]
+	if !ok {
+		as := newAmountStats()
+		ds = &as
+		s.Durations[days] = ds
+	}
+	ds.add(vb.StakedAmount)
+}
+
+func (s *Stats) subBucket(vb *VoteBucket) error {
+	if err := s.Total.sub(vb.StakedAmount); err != nil {
+		return err
+	}
+	if vb.AutoStake {
+		if err := s.AutoStake.sub(vb.StakedAmount); err != nil {
+			return err
+		}
+	} else {
+		if err := s.NonAutoStake.sub(vb.StakedAmount); err != nil {
+			return err
+		}
+	}
+	days := uint32(vb.StakedDuration / 24 / time.Hour)
+	ds, ok := s.Durations[days]
+	if !ok {
+		return errors.Errorf("no bucket staked for %d days", days)
+	}
+	if err := ds.sub(vb.StakedAmount); err != nil {
+		return err
+	}
+	if ds.Count == 0 {
+		delete(s.Durations, days)
+	}
+	return nil
+}
+
+func (s *Stats) durationsProto() []*stakingpb.DurationStats {
+	days := make([]uint32, 0, len(s.Durations))
+	for d := range s.Durations {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	durations := make([]*stakingpb.DurationStats, 0, len(days))
+	for _, d := range days {
+		durations = append(durations, &stakingpb.DurationStats{
+			Days:  d,
+			Stats: s.Durations[d].toProto(),
+		})
+	}
+	return durations
+}
+
+// Serialize serializes staking statistics into bytes
+func (s *Stats) Serialize() ([]byte, error) {
+	return proto.Marshal(&stakingpb.StakingStats{
+		TotalVotes:   s.TotalVotes.String(),
+		Total:        s.Total.toProto(),
+		AutoStake:    s.AutoStake.toProto(),
+		NonAutoStake: s.NonAutoStake.toProto(),
+		Durations:    s.durationsProto(),
+	})
+}
+
+// Deserialize deserializes bytes into staking statistics
+func (s *Stats) Deserialize(buf []byte) error {
+	pb := &stakingpb.StakingStats{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal staking stats")
+	}
+
+	totalVotes, ok := big.NewInt(0).SetString(pb.GetTotalVotes(), 10)
+	if !ok {
+		return ErrInvalidAmount
+	}
+	s.TotalVotes = totalVotes
+	if err := s.Total.fromProto(pb.GetTotal()); err != nil {
+		return err
+	}
+	if err := s.AutoStake.fromProto(pb.GetAutoStake()); err != nil {
+		return err
+	}
+	if err := s.NonAutoStake.fromProto(pb.GetNonAutoStake()); err != nil {
+		return err
+	}
+	s.Durations = make(map[uint32]*AmountStats, len(pb.GetDurations()))
+	for _, d := range pb.GetDurations() {
+		as := &AmountStats{}
+		if err := as.fromProto(d.GetStats()); err != nil {
+			return err
+		}
+		s.Durations[d.GetDays()] = as
+	}
+	return nil
+}
+
+// Deserialize deserializes bytes into voter count
+func (vc *voterCount) Deserialize(data []byte) error {
+	vc.count = byteutil.BytesToUint64BigEndian(data)
+	return nil
+}
+
+// Serialize serializes voter count into bytes
+func (vc *voterCount) Serialize() ([]byte, error) {
+	return byteutil.Uint64ToBytesBigEndian(vc.count), nil
+}
+
+// getStats returns the statistics, which are empty before the statistics are created
+func getStats(sr protocol.StateReader) (*Stats, error) {
+	s, err := loadStats(sr)
+	if errors.Cause(err) == state.ErrStateNotExist {
+		return NewStats(), nil
+	}
+	return s, err
+}
+
+func loadStats(sr protocol.StateReader) (*Stats, error) {
+	s := NewStats()
+	if _, err := sr.State(
+		s,
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(statsKey)); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func putStats(sm protocol.StateManager, s *Stats) error {
+	_, err := sm.PutState(
+		s,
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(statsKey))
+	return err
+}
+
+// createStats creates the statistics and voter counts from the existing buckets and candidates, the statistics are
+// updated along with the buckets and candidates once they are created
+func createStats(sm protocol.StateManager) error {
+	buckets, err := getAllBuckets(sm)
+	if err != nil {
+		return errors.Wrap(err, "failed to get buckets")
+	}
+	s := NewStats()
+	for _, vb := range buckets {
+		if vb.isUnstaked() {
+			continue
+		}
+		s.addBucket(vb)
+		if err := addVoterBucket(sm, vb.Candidate, vb.Owner); err != nil {
+			return err
+		}
+	}
+	cands, err := getAllCandidates(sm)
+	if err != nil {
+		return errors.Wrap(err, "failed to get candidates")
+	}
+	for _, c := range cands {
+		s.TotalVotes.Add(s.TotalVotes, c.Votes)
+	}
+	return putStats(sm, s)
+}
+
+// updateStats applies the change of a bucket from prev to curr to the statistics, either of them can be nil when
+// the bucket is created or deleted. It does nothing before the statistics are created.
+func updateStats(sm protocol.StateManager, prev, curr *VoteBucket) error {
+	if prev != nil && prev.isUnstaked() {
+		prev = nil
+	}
+	if curr != nil && curr.isUnstaked() {
+		curr = nil
+	}
+	if prev == nil && curr == nil {
+		return nil
+	}
+
+	s, err := loadStats(sm)
+	if errors.Cause(err) == state.ErrStateNotExist {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if prev != nil {
+		if err := s.subBucket(prev); err != nil {
+			return errors.Wrapf(err, "failed to subtract bucket %d from stats", prev.Index)
+		}
+	}
+	if curr != nil {
+		s.addBucket(curr)
+	}
+	if err := putStats(sm, s); err != nil {
+		return err
+	}
+
+	if prev != nil && curr != nil && address.Equal(prev.Candidate, curr.Candidate) && address.Equal(prev.Owner, curr.Owner) {
+		return nil
+	}
+	if prev != nil {
+		if err := subVoterBucket(sm, prev.Candidate, prev.Owner); err != nil {
+			return err
+		}
+	}
+	if curr != nil {
+		return addVoterBucket(sm, curr.Candidate, curr.Owner)
+	}
+	return nil
+}
+
+// updateTotalVotes adds the change of a candidate's votes to the total votes. It does nothing before the statistics
+// are created.
+func updateTotalVotes(sm protocol.StateManager, prev, curr *big.Int) error {
+	delta := new(big.Int)
+	if curr != nil {
+		delta.Set(curr)
+	}
+	if prev != nil {
+		delta.Sub(delta, prev)
+	}
+	if delta.Sign() == 0 {
+		return nil
+	}
+
+	s, err := loadStats(sm)
+	if errors.Cause(err) == state.ErrStateNotExist {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.TotalVotes.Add(s.TotalVotes, delta)
+	if s.TotalVotes.Sign() < 0 {
+		return errors.New("total votes cannot be negative")
+	}
+	return putStats(sm, s)
+}
+
+func getVoterCount(sr protocol.StateReader, key []byte) (uint64, error) {
+	var vc voterCount
+	_, err := sr.State(
+		&vc,
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(key))
+	if errors.Cause(err) == state.ErrStateNotExist {
+		return 0, nil
+	}
+	return vc.count, err
+}
+
+func putVoterCount(sm protocol.StateManager, key []byte, count uint64) error {
+	var err error
+	if count == 0 {
+		_, err = sm.DelState(
+			protocol.NamespaceOption(StakingNameSpace),
+			protocol.KeyOption(key))
+	} else {
+		_, err = sm.PutState(
+			&voterCount{count: count},
+			protocol.NamespaceOption(StakingNameSpace),
+			protocol.KeyOption(key))
+	}
+	return err
+}
+
+// getCandVoterCount returns the number of voters having at least one bucket for the candidate
+func getCandVoterCount(sr protocol.StateReader, cand address.Address) (uint64, error) {
+	return getVoterCount(sr, addrKeyWithPrefix(cand, _candVoterCount))
+}
+
+func addVoterBucket(sm protocol.StateManager, cand, voter address.Address) error {
+	key := voterBucketCountKey(cand, voter)
+	count, err := getVoterCount(sm, key)
+	if err != nil {
+		return err
+	}
+	if err := putVoterCount(sm, key, count+1); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	// first bucket of the voter for this candidate
+	candKey := addrKeyWithPrefix(cand, _candVoterCount)
+	voters, err := getVoterCount(sm, candKey)
+	if err != nil {
+		return err
+	}
+	return putVoterCount(sm, candKey, voters+1)
+}
+
+func subVoterBucket(sm protocol.StateManager, cand, voter address.Address) error {
+	key := voterBucketCountKey(cand, voter)
+	count, err := getVoterCount(sm, key)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.Errorf("voter %s has no bucket for candidate %s", voter.String(), cand.String())
+	}
+	if err := putVoterCount(sm, key, count-1); err != nil {
+		return err
+	}
+	if count > 1 {
+		return nil
+	}
+	// last bucket of the voter for this candidate
+	candKey := addrKeyWithPrefix(cand, _candVoterCount)
+	voters, err := getVoterCount(sm, candKey)
+	if err != nil {
+		return err
+	}
+	if voters == 0 {
+		return errors.Errorf("candidate %s has no voter", cand.String())
+	}
+	return putVoterCount(sm, candKey, voters-1)
+}
+
+func voterBucketCountKey(cand, voter address.Address) []byte {
+	return append(addrKeyWithPrefix(cand, _voterBucketCount), voter.Bytes()...)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+)
+
+func TestStatsSerialize(t *testing.T) {
+	require := require.New(t)
+
+	s := NewStats()
+	s.addBucket(NewVoteBucket(identityset.Address(1), identityset.Address(2), big.NewInt(100), 7, time.Now(), true))
+	s.addBucket(NewVoteBucket(identityset.Address(1), identityset.Address(3), big.NewInt(200), 0, time.Now(), false))
+	s.addBucket(NewVoteBucket(identityset.Address(1), identityset.Address(3), big.NewInt(300), 7, time.Now(), false))
+	s.TotalVotes = big.NewInt(1000)
+	data, err := s.Serialize()
+	require.NoError(err)
+
+	s1 := NewStats()
+	require.NoError(s1.Deserialize(data))
+	require.Equal(s, s1)
+	require.Equal(&AmountStats{big.NewInt(600), 3}, &s1.Total)
+	require.Equal(&AmountStats{big.NewInt(100), 1}, &s1.AutoStake)
+	require.Equal(&AmountStats{big.NewInt(500), 2}, &s1.NonAutoStake)
+	require.Equal(&AmountStats{big.NewInt(400), 2}, s1.Durations[7])
+	require.Equal(&AmountStats{big.NewInt(200), 1}, s1.Durations[0])
+
+	require.NoError(s1.subBucket(NewVoteBucket(identityset.Address(1), identityset.Address(3), big.NewInt(200), 0, time.Now(), false)))
+	_, ok := s1.Durations[0]
+	require.False(ok)
+	require.Error(s1.subBucket(NewVoteBucket(identityset.Address(1), identityset.Address(3), big.NewInt(200), 0, time.Now(), false)))
+}
+
+func TestStatsUpdate(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newMockStateManager(ctrl)
+	_, err := sm.PutState(
+		&totalBucketCount{count: 0},
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(TotalBucketKey),
+	)
+	require.NoError(err)
+	p, err := NewProtocol(depositGas, sm, genesis.Default.Staking)
+	require.NoError(err)
+
+	c1, c2 := identityset.Address(1), identityset.Address(2)
+	v1, v2 := identityset.Address(3), identityset.Address(4)
+	readState := func(method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest) []byte {
+		m, err := proto.Marshal(&iotexapi.ReadStakingDataMethod{Method: method})
+		require.NoError(err)
+		arg, err := proto.Marshal(req)
+		require.NoError(err)
+		data, err := p.ReadState(context.Background(), sm, m, arg)
+		require.NoError(err)
+		return data
+	}
+	readString := func(method iotexapi.ReadStakingDataMethod_Name) string {
+		return string(readState(method, &iotexapi.ReadStakingDataRequest{}))
+	}
+	voterCount := func(name string) string {
+		return string(readState(iotexapi.ReadStakingDataMethod_VOTER_COUNT_BY_CANDIDATE, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_VoterCountByCandidate_{
+				VoterCountByCandidate: &iotexapi.ReadStakingDataRequest_VoterCountByCandidate{CandName: name},
+			},
+		}))
+	}
+
+	// empty state
+	require.Equal("0", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+	require.Equal("0", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+	require.Equal("0", voterCount("test1"))
+
+	now := time.Now()
+	buckets := []*VoteBucket{
+		NewVoteBucket(c1, v1, big.NewInt(100), 7, now, true),
+		NewVoteBucket(c1, v1, big.NewInt(200), 7, now, false),
+		NewVoteBucket(c1, v2, big.NewInt(300), 0, now, false),
+		NewVoteBucket(c2, v2, big.NewInt(400), 7, now, true),
+	}
+	for _, b := range buckets {
+		_, err := putBucketAndIndex(sm, b)
+		require.NoError(err)
+	}
+	for _, c := range []*Candidate{
+		{Owner: c1, Operator: identityset.Address(11), Reward: c1, Name: "test1", Votes: big.NewInt(0), SelfStake: big.NewInt(0)},
+		{Owner: c2, Operator: identityset.Address(13), Reward: c2, Name: "test2", Votes: big.NewInt(0), SelfStake: big.NewInt(0)},
+		{Owner: identityset.Address(5), Operator: identityset.Address(12), Reward: identityset.Address(5), Name: "test3", Votes: big.NewInt(20), SelfStake: big.NewInt(0)},
+	} {
+		require.NoError(putCandidate(sm, c))
+	}
+	// the stats are not kept before the genesis height
+	require.Equal("0", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+	require.Equal("0", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+	require.Equal("0", voterCount("test1"))
+
+	g := genesis.Default
+	g.StakingStatsHeight = 5
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: g})
+	require.NoError(p.CreatePreStates(protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 4}), sm))
+	require.Equal("0", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+	// the stats are created from the existing buckets and candidates only once
+	for _, height := range []uint64{5, 5, 6} {
+		require.NoError(p.CreatePreStates(protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: height}), sm))
+	}
+	require.Equal("1000", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+	require.Equal("20", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+	require.Equal("2", voterCount("test1"))
+	require.Equal("1", voterCount("test2"))
+
+	// unstake one of the two buckets of v1, v1 is still a voter of c1
+	b, err := getBucket(sm, 0)
+	require.NoError(err)
+	b.UnstakeStartTime = now.UTC()
+	require.NoError(updateBucket(sm, 0, b))
+	require.Equal("900", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+	require.Equal("2", voterCount("test1"))
+	// withdraw the unstaked bucket does not change the stats
+	require.NoError(delBucket(sm, 0))
+	require.Equal("900", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+
+	// move v2's bucket from c1 to c2
+	b, err = getBucket(sm, 2)
+	require.NoError(err)
+	b.Candidate = c2
+	require.NoError(updateBucket(sm, 2, b))
+	require.Equal("900", readString(iotexapi.ReadStakingDataMethod_TOTAL_STAKED))
+	require.Equal("1", voterCount("test1"))
+	require.Equal("1", voterCount("test2"))
+
+	durations := &stakingpb.DurationStatsList{}
+	require.NoError(proto.Unmarshal(readState(iotexapi.ReadStakingDataMethod_STAKE_DISTRIBUTION_BY_DURATION, &iotexapi.ReadStakingDataRequest{}), durations))
+	require.Equal(2, len(durations.Durations))
+	require.Equal(uint32(0), durations.Durations[0].Days)
+	require.Equal("300", durations.Durations[0].Stats.Amount)
+	require.Equal(uint64(1), durations.Durations[0].Stats.Count)
+	require.Equal(uint32(7), durations.Durations[1].Days)
+	require.Equal("600", durations.Durations[1].Stats.Amount)
+	require.Equal(uint64(2), durations.Durations[1].Stats.Count)
+
+	autoStake := &stakingpb.AutoStakeStats{}
+	require.NoError(proto.Unmarshal(readState(iotexapi.ReadStakingDataMethod_STAKE_DISTRIBUTION_BY_AUTO_STAKE, &iotexapi.ReadStakingDataRequest{}), autoStake))
+	require.Equal("400", autoStake.AutoStake.Amount)
+	require.Equal(uint64(1), autoStake.AutoStake.Count)
+	require.Equal("500", autoStake.NonAutoStake.Amount)
+	require.Equal(uint64(2), autoStake.NonAutoStake.Count)
+
+	// total votes follow the candidates
+	cand := &Candidate{
+		Owner:     c1,
+		Operator:  identityset.Address(11),
+		Reward:    c1,
+		Name:      "test1",
+		Votes:     big.NewInt(10),
+		SelfStake: big.NewInt(0),
+	}
+	require.NoError(putCandidate(sm, cand))
+	require.Equal("30", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+	cand2 := cand.Clone()
+	cand2.Owner = c2
+	cand2.Name = "test2"
+	cand2.Votes = big.NewInt(5)
+	require.NoError(putCandidate(sm, cand2))
+	require.Equal("35", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+	require.NoError(cand.SubVote(big.NewInt(3)))
+	require.NoError(putCandidate(sm, cand))
+	require.Equal("32", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+	require.NoError(delCandidate(sm, c2))
+	require.Equal("27", readString(iotexapi.ReadStakingDataMethod_TOTAL_VOTES))
+
+	// unknown candidate
+	require.Equal("0", voterCount("test4"))
+}
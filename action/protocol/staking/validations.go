@@ -16,6 +16,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/pkg/bls"
 )
 
 // Errors
@@ -27,6 +28,8 @@ var (
 	ErrInvalidOperator     = errors.New("invalid operator address")
 	ErrInvalidSelfStkIndex = errors.New("invalid self-staking bucket index")
 	ErrMissingField        = errors.New("missing data field")
+	ErrInvalidMergeIndices = errors.New("invalid bucket indices to merge")
+	ErrInvalidBLSKey       = errors.New("invalid BLS public key")
 )
 
 func (p *Protocol) validateCreateStake(ctx context.Context, act *action.CreateStake) error {
@@ -36,7 +39,11 @@ func (p *Protocol) validateCreateStake(ctx context.Context, act *action.CreateSt
 	if !IsValidCandidateName(act.Candidate()) {
 		return ErrInvalidCanName
 	}
-	if act.Amount().Cmp(p.config.MinStakeAmount) == -1 {
+	minStakeAmount, err := p.minStakeAmount(ctx, p.sr)
+	if err != nil {
+		return errors.Wrap(err, "failed to get minimum stake amount")
+	}
+	if act.Amount().Cmp(minStakeAmount) == -1 {
 		return errors.Wrap(ErrInvalidAmount, "stake amount is less than the minimum requirement")
 	}
 	if act.GasPrice().Sign() < 0 {
@@ -114,6 +121,74 @@ func (p *Protocol) validateRestake(ctx context.Context, act *action.Restake) err
 	return nil
 }
 
+func (p *Protocol) validateSplitStake(ctx context.Context, act *action.SplitStake) error {
+	if act == nil {
+		return ErrNilAction
+	}
+	minStakeAmount, err := p.minStakeAmount(ctx, p.sr)
+	if err != nil {
+		return errors.Wrap(err, "failed to get minimum stake amount")
+	}
+	if act.Amount().Cmp(minStakeAmount) == -1 {
+		return errors.Wrap(ErrInvalidAmount, "split amount is less than the minimum requirement")
+	}
+	if act.GasPrice().Sign() < 0 {
+		return errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	return nil
+}
+
+func (p *Protocol) validateMergeStake(ctx context.Context, act *action.MergeStake) error {
+	if act == nil {
+		return ErrNilAction
+	}
+	if len(act.MergeIndices()) == 0 {
+		return errors.Wrap(ErrInvalidMergeIndices, "no bucket to merge")
+	}
+	seen := map[uint64]bool{act.BucketIndex(): true}
+	for _, index := range act.MergeIndices() {
+		if seen[index] {
+			return errors.Wrapf(ErrInvalidMergeIndices, "duplicate bucket index %d", index)
+		}
+		seen[index] = true
+	}
+	if act.GasPrice().Sign() < 0 {
+		return errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	return nil
+}
+
+func (p *Protocol) validateCompoundStake(ctx context.Context, act *action.CompoundStake) error {
+	if act == nil {
+		return ErrNilAction
+	}
+	if act.GasPrice().Sign() < 0 {
+		return errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	return nil
+}
+
+func (p *Protocol) validateCandidateBLSKey(ctx context.Context, act *action.CandidateBLSKey) error {
+	if act == nil {
+		return ErrNilAction
+	}
+	pk, err := bls.PublicKeyFromBytes(act.PublicKey())
+	if err != nil {
+		return errors.Wrap(ErrInvalidBLSKey, err.Error())
+	}
+	proof, err := bls.SignatureFromBytes(act.ProofOfPossession())
+	if err != nil {
+		return errors.Wrap(ErrInvalidBLSKey, err.Error())
+	}
+	if !pk.VerifyPossession(proof) {
+		return errors.Wrap(ErrInvalidBLSKey, "invalid proof of possession")
+	}
+	if act.GasPrice().Sign() < 0 {
+		return errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	return nil
+}
+
 func (p *Protocol) validateCandidateRegister(ctx context.Context, act *action.CandidateRegister) error {
 	if act == nil {
 		return ErrNilAction
@@ -128,7 +203,11 @@ func (p *Protocol) validateCandidateRegister(ctx context.Context, act *action.Ca
 		return ErrInvalidCanName
 	}
 
-	if act.Amount().Cmp(p.config.RegistrationConsts.MinSelfStake) < 0 {
+	minSelfStake, err := p.minSelfStake(ctx, p.sr)
+	if err != nil {
+		return errors.Wrap(err, "failed to get minimum self-stake amount")
+	}
+	if act.Amount().Cmp(minSelfStake) < 0 {
 		return errors.Wrap(ErrInvalidAmount, "self staking amount is not valid")
 	}
 
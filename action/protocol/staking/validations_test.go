@@ -441,6 +441,96 @@ func TestProtocol_ValidateRestake(t *testing.T) {
 	require.Equal(ErrNilAction, errors.Cause(p.validateRestake(context.Background(), nil)))
 }
 
+func TestProtocol_ValidateSplitStake(t *testing.T) {
+	require := require.New(t)
+	p, _ := initTestProtocol(t)
+	tests := []struct {
+		index    uint64
+		amount   string
+		gasPrice *big.Int
+		// expected results
+		errorCause error
+	}{
+		{
+			1,
+			"100000000000000000000",
+			big.NewInt(unit.Qev),
+			nil,
+		},
+		{
+			1,
+			"10",
+			big.NewInt(unit.Qev),
+			ErrInvalidAmount,
+		},
+		{
+			1,
+			"100000000000000000000",
+			big.NewInt(-unit.Qev),
+			action.ErrGasPrice,
+		},
+	}
+
+	for _, test := range tests {
+		act, err := action.NewSplitStake(1, test.index, test.amount, nil, 10000, test.gasPrice)
+		require.NoError(err)
+		require.Equal(test.errorCause, errors.Cause(p.validateSplitStake(context.Background(), act)))
+	}
+	// test nil action
+	require.Equal(ErrNilAction, errors.Cause(p.validateSplitStake(context.Background(), nil)))
+}
+
+func TestProtocol_ValidateMergeStake(t *testing.T) {
+	require := require.New(t)
+	p, _ := initTestProtocol(t)
+	tests := []struct {
+		index        uint64
+		mergeIndices []uint64
+		gasPrice     *big.Int
+		// expected results
+		errorCause error
+	}{
+		{
+			1,
+			[]uint64{2, 3},
+			big.NewInt(unit.Qev),
+			nil,
+		},
+		{
+			1,
+			nil,
+			big.NewInt(unit.Qev),
+			ErrInvalidMergeIndices,
+		},
+		{
+			1,
+			[]uint64{2, 1},
+			big.NewInt(unit.Qev),
+			ErrInvalidMergeIndices,
+		},
+		{
+			1,
+			[]uint64{2, 2},
+			big.NewInt(unit.Qev),
+			ErrInvalidMergeIndices,
+		},
+		{
+			1,
+			[]uint64{2, 3},
+			big.NewInt(-unit.Qev),
+			action.ErrGasPrice,
+		},
+	}
+
+	for _, test := range tests {
+		act, err := action.NewMergeStake(1, test.index, test.mergeIndices, nil, 10000, test.gasPrice)
+		require.NoError(err)
+		require.Equal(test.errorCause, errors.Cause(p.validateMergeStake(context.Background(), act)))
+	}
+	// test nil action
+	require.Equal(ErrNilAction, errors.Cause(p.validateMergeStake(context.Background(), nil)))
+}
+
 func TestProtocol_ValidateCandidateRegister(t *testing.T) {
 	require := require.New(t)
 	p, cans := initTestProtocol(t)
@@ -216,15 +216,18 @@ func getBucket(sr protocol.StateReader, index uint64) (*VoteBucket, error) {
 }
 
 func updateBucket(sm protocol.StateManager, index uint64, bucket *VoteBucket) error {
-	if _, err := getBucket(sm, index); err != nil {
+	prev, err := getBucket(sm, index)
+	if err != nil {
 		return err
 	}
 
-	_, err := sm.PutState(
+	if _, err := sm.PutState(
 		bucket,
 		protocol.NamespaceOption(StakingNameSpace),
-		protocol.KeyOption(bucketKey(index)))
-	return err
+		protocol.KeyOption(bucketKey(index))); err != nil {
+		return err
+	}
+	return updateStats(sm, prev, bucket)
 }
 
 func putBucket(sm protocol.StateManager, bucket *VoteBucket) (uint64, error) {
@@ -246,18 +249,27 @@ func putBucket(sm protocol.StateManager, bucket *VoteBucket) (uint64, error) {
 		return 0, err
 	}
 	tc.count++
-	_, err := sm.PutState(
+	if _, err := sm.PutState(
 		&tc,
 		protocol.NamespaceOption(StakingNameSpace),
-		protocol.KeyOption(TotalBucketKey))
-	return index, err
+		protocol.KeyOption(TotalBucketKey)); err != nil {
+		return 0, err
+	}
+	return index, updateStats(sm, nil, bucket)
 }
 
 func delBucket(sm protocol.StateManager, index uint64) error {
-	_, err := sm.DelState(
+	prev, err := getBucket(sm, index)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sm.DelState(
 		protocol.NamespaceOption(StakingNameSpace),
-		protocol.KeyOption(bucketKey(index)))
-	return err
+		protocol.KeyOption(bucketKey(index))); err != nil {
+		return err
+	}
+	return updateStats(sm, prev, nil)
 }
 
 func getAllBuckets(sr protocol.StateReader) ([]*VoteBucket, error) {
@@ -298,6 +310,39 @@ func getBucketsWithIndices(sr protocol.StateReader, indices BucketIndices) ([]*V
 	return buckets, nil
 }
 
+// isUnstaked returns true if the bucket has been unstaked
+func (vb *VoteBucket) isUnstaked() bool {
+	return vb.UnstakeStartTime.Unix() != 0
+}
+
+// remainingDuration returns the staked duration left at the given time, an auto-stake bucket never
+// counts down so it always has its full staked duration remaining
+func (vb *VoteBucket) remainingDuration(now time.Time) time.Duration {
+	if vb.AutoStake {
+		return vb.StakedDuration
+	}
+	end := vb.StakeStartTime.Add(vb.StakedDuration)
+	if !now.Before(end) {
+		return 0
+	}
+	return end.Sub(now)
+}
+
+// remainingDays returns the remaining duration rounded up to whole days
+func (vb *VoteBucket) remainingDays(now time.Time) uint64 {
+	day := 24 * time.Hour
+	return uint64((vb.remainingDuration(now) + day - 1) / day)
+}
+
+// isMergeable returns true if the two buckets share owner, candidate, auto-stake setting and remaining days
+func isMergeable(b1, b2 *VoteBucket, now time.Time) bool {
+	return address.Equal(b1.Owner, b2.Owner) &&
+		address.Equal(b1.Candidate, b2.Candidate) &&
+		b1.AutoStake == b2.AutoStake &&
+		!b1.isUnstaked() && !b2.isUnstaked() &&
+		b1.remainingDays(now) == b2.remainingDays(now)
+}
+
 func bucketKey(index uint64) []byte {
 	key := []byte{_bucket}
 	return append(key, byteutil.Uint64ToBytesBigEndian(index)...)
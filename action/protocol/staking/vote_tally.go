@@ -0,0 +1,57 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+)
+
+// TallyCandidateVotes recomputes the votes of every candidate from all the buckets in state, weighting each
+// bucket with the given constants instead of the ones the protocol was created with. Unstaked buckets do not
+// count, and the candidates are returned sorted by votes in descending order.
+func TallyCandidateVotes(sr protocol.StateReader, c genesis.VoteWeightCalConsts) (CandidateList, error) {
+	cands, err := getAllCandidates(sr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get candidates")
+	}
+	buckets, err := getAllBuckets(sr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get buckets")
+	}
+
+	candMap := make(map[string]*Candidate, len(cands))
+	for _, cand := range cands {
+		clone := cand.Clone()
+		clone.Votes = big.NewInt(0)
+		candMap[clone.Owner.String()] = clone
+	}
+	for _, bucket := range buckets {
+		if bucket.isUnstaked() {
+			continue
+		}
+		cand, ok := candMap[bucket.Candidate.String()]
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidOwner, "cannot find candidate %s of bucket %d", bucket.Candidate.String(), bucket.Index)
+		}
+		if err := cand.AddVote(calculateVoteWeight(c, bucket, cand.SelfStakeBucketIdx == bucket.Index)); err != nil {
+			return nil, errors.Wrapf(err, "failed to add vote for candidate %s", cand.Owner.String())
+		}
+	}
+
+	list := make(CandidateList, 0, len(candMap))
+	for _, cand := range candMap {
+		list = append(list, cand)
+	}
+	sort.Sort(list)
+	return list, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestTallyCandidateVotes(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newMockStateManager(ctrl)
+	_, err := sm.PutState(
+		&totalBucketCount{count: 0},
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(TotalBucketKey),
+	)
+	require.NoError(err)
+
+	now := time.Now()
+	c1, c2 := identityset.Address(1), identityset.Address(2)
+	buckets := []*VoteBucket{
+		// self-stake buckets
+		NewVoteBucket(c1, c1, unit.ConvertIotxToRau(1000), 0, now, false),
+		NewVoteBucket(c2, c2, unit.ConvertIotxToRau(1000), 0, now, false),
+		// votes with a long duration for c1, a short duration for c2
+		NewVoteBucket(c1, identityset.Address(3), unit.ConvertIotxToRau(1000), 365, now, true),
+		NewVoteBucket(c2, identityset.Address(4), unit.ConvertIotxToRau(1100), 0, now, false),
+		// unstaked bucket does not count
+		NewVoteBucket(c2, identityset.Address(5), unit.ConvertIotxToRau(5000), 0, now, false),
+	}
+	buckets[4].UnstakeStartTime = now.UTC()
+	for _, b := range buckets {
+		_, err := putBucketAndIndex(sm, b)
+		require.NoError(err)
+	}
+	for i, cand := range []*Candidate{
+		{Owner: c1, Operator: identityset.Address(11), Reward: c1, Name: "test1", SelfStakeBucketIdx: 0},
+		{Owner: c2, Operator: identityset.Address(12), Reward: c2, Name: "test2", SelfStakeBucketIdx: 1},
+	} {
+		cand.Votes = big.NewInt(int64(i))
+		cand.SelfStake = unit.ConvertIotxToRau(1000)
+		require.NoError(putCandidate(sm, cand))
+	}
+
+	consts := genesis.Default.Staking.VoteWeightCalConsts
+	list, err := TallyCandidateVotes(sm, consts)
+	require.NoError(err)
+	require.Equal(2, len(list))
+	require.Equal("test1", list[0].Name)
+	require.Equal("test2", list[1].Name)
+	expected := calculateVoteWeight(consts, buckets[0], true)
+	expected.Add(expected, calculateVoteWeight(consts, buckets[2], false))
+	require.Equal(expected, list[0].Votes)
+	expected = calculateVoteWeight(consts, buckets[1], true)
+	expected.Add(expected, calculateVoteWeight(consts, buckets[3], false))
+	require.Equal(expected, list[1].Votes)
+
+	// a much larger log base shrinks the duration bonus and flips the ranking
+	consts.DurationLg = 1e9
+	list, err = TallyCandidateVotes(sm, consts)
+	require.NoError(err)
+	require.Equal("test2", list[0].Name)
+	require.Equal("test1", list[1].Name)
+
+	// the stored candidates are not touched
+	cand, err := getCandidate(sm, c1)
+	require.NoError(err)
+	require.Equal(big.NewInt(0), cand.Votes)
+}
@@ -41,6 +41,12 @@ const NxtProbationKey = "NextProbationKey."
 // UnproductiveDelegateKey is the key of unproductive Delegate struct
 const UnproductiveDelegateKey = "UnproductiveDelegateKey."
 
+// DoubleSignerKey is the key of double signers to be put on probation
+const DoubleSignerKey = "DoubleSignerKey."
+
+// DoubleSignEvidenceKeyPrefix is the key prefix of handled double sign evidences
+const DoubleSignEvidenceKeyPrefix = "DoubleSignEvidence."
+
 // CandidatesByHeight returns array of Candidates in candidate pool of a given height (deprecated version)
 func CandidatesByHeight(sr protocol.StateReader, height uint64) ([]*state.Candidate, error) {
 	var candidates state.CandidateList
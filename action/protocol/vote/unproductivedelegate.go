@@ -125,3 +125,16 @@ func (upd *UnproductiveDelegate) Equal(upd2 *UnproductiveDelegate) bool {
 func (upd *UnproductiveDelegate) DelegateList() [][]string {
 	return upd.delegatelist
 }
+
+// RemainingProbation returns the number of epochs the delegate stays on probation, counting from the epoch whose
+// probation list is calculated from the current upd-lists, assuming the delegate is productive from then on
+func (upd *UnproductiveDelegate) RemainingProbation(delegate string) uint64 {
+	for i := uint64(0); i < upd.probationPeriod && i < uint64(len(upd.delegatelist)); i++ {
+		for _, d := range upd.delegatelist[i] {
+			if d == delegate {
+				return upd.probationPeriod - i
+			}
+		}
+	}
+	return 0
+}
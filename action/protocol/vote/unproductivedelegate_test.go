@@ -38,6 +38,14 @@ func TestUnproductiveDelegate(t *testing.T) {
 		r.Equal(str3[i], data)
 	}
 
+	// str4 is the most recent and str3 is the oldest
+	r.Equal(uint64(2), upd.RemainingProbation("a"))
+	r.Equal(uint64(2), upd.RemainingProbation("f"))
+	r.Equal(uint64(0), upd.RemainingProbation("d"))
+	r.NoError(upd.AddRecentUPD([]string{"b"}))
+	r.Equal(uint64(1), upd.RemainingProbation("a"))
+	r.Equal(uint64(2), upd.RemainingProbation("b"))
+
 	sbytes, err := upd.Serialize()
 	r.NoError(err)
 
@@ -0,0 +1,109 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// CompoundStakePayloadGas represents the CompoundStake payload gas per uint
+	CompoundStakePayloadGas = uint64(100)
+	// CompoundStakeBaseIntrinsicGas represents the base intrinsic gas for CompoundStake
+	CompoundStakeBaseIntrinsicGas = uint64(10000)
+)
+
+// CompoundStake defines the action of nominating a bucket into which the epoch rewards of the caller are deposited
+// when granted, or of clearing the nomination
+type CompoundStake struct {
+	AbstractAction
+
+	bucketIndex uint64
+	enable      bool
+	payload     []byte
+}
+
+// NewCompoundStake returns a CompoundStake instance
+func NewCompoundStake(
+	nonce uint64,
+	index uint64,
+	enable bool,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CompoundStake, error) {
+	return &CompoundStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: index,
+		enable:      enable,
+		payload:     payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket rewards are deposited into
+func (cs *CompoundStake) BucketIndex() uint64 { return cs.bucketIndex }
+
+// Enable returns true if the action turns on auto-compounding, false if it turns it off
+func (cs *CompoundStake) Enable() bool { return cs.enable }
+
+// Payload returns the payload bytes
+func (cs *CompoundStake) Payload() []byte { return cs.payload }
+
+// Serialize returns a raw byte stream of the CompoundStake struct
+func (cs *CompoundStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(cs.Proto()))
+}
+
+// Proto converts to protobuf CompoundStake Action
+func (cs *CompoundStake) Proto() *iotextypes.StakeCompound {
+	return &iotextypes.StakeCompound{
+		BucketIndex: cs.bucketIndex,
+		Enable:      cs.enable,
+		Payload:     cs.payload,
+	}
+}
+
+// LoadProto converts a protobuf's Action to CompoundStake
+func (cs *CompoundStake) LoadProto(pbAct *iotextypes.StakeCompound) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	cs.bucketIndex = pbAct.GetBucketIndex()
+	cs.enable = pbAct.GetEnable()
+	cs.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a CompoundStake
+func (cs *CompoundStake) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(cs.Payload()))
+	return calculateIntrinsicGas(CompoundStakeBaseIntrinsicGas, CompoundStakePayloadGas, payloadSize)
+}
+
+// Cost returns the total cost of a CompoundStake
+func (cs *CompoundStake) Cost() (*big.Int, error) {
+	intrinsicGas, err := cs.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the CompoundStake")
+	}
+	compoundFee := big.NewInt(0).Mul(cs.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return compoundFee, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// MergeStakePayloadGas represents the MergeStake payload gas per uint
+	MergeStakePayloadGas = uint64(100)
+	// MergeStakeBaseIntrinsicGas represents the base intrinsic gas for MergeStake
+	MergeStakeBaseIntrinsicGas = uint64(10000)
+	// MergeStakePerBucketGas represents the MergeStake gas per merged bucket
+	MergeStakePerBucketGas = uint64(1000)
+)
+
+// MergeStake defines the action of merging buckets into a target bucket
+type MergeStake struct {
+	AbstractAction
+
+	bucketIndex  uint64
+	mergeIndices []uint64
+	payload      []byte
+}
+
+// NewMergeStake returns a MergeStake instance
+func NewMergeStake(
+	nonce uint64,
+	index uint64,
+	mergeIndices []uint64,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*MergeStake, error) {
+	return &MergeStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex:  index,
+		mergeIndices: mergeIndices,
+		payload:      payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket that absorbs the others
+func (ms *MergeStake) BucketIndex() uint64 { return ms.bucketIndex }
+
+// MergeIndices returns the indices of the buckets merged into the target bucket
+func (ms *MergeStake) MergeIndices() []uint64 { return ms.mergeIndices }
+
+// Payload returns the payload bytes
+func (ms *MergeStake) Payload() []byte { return ms.payload }
+
+// Serialize returns a raw byte stream of the MergeStake struct
+func (ms *MergeStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ms.Proto()))
+}
+
+// Proto converts to protobuf MergeStake Action
+func (ms *MergeStake) Proto() *iotextypes.StakeMerge {
+	return &iotextypes.StakeMerge{
+		BucketIndex:  ms.bucketIndex,
+		MergeIndices: ms.mergeIndices,
+		Payload:      ms.payload,
+	}
+}
+
+// LoadProto converts a protobuf's Action to MergeStake
+func (ms *MergeStake) LoadProto(pbAct *iotextypes.StakeMerge) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	ms.bucketIndex = pbAct.GetBucketIndex()
+	ms.mergeIndices = pbAct.GetMergeIndices()
+	ms.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a MergeStake
+func (ms *MergeStake) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(ms.Payload()))
+	gas, err := calculateIntrinsicGas(MergeStakeBaseIntrinsicGas, MergeStakePayloadGas, payloadSize)
+	if err != nil {
+		return 0, err
+	}
+	return calculateIntrinsicGas(gas, MergeStakePerBucketGas, uint64(len(ms.mergeIndices)))
+}
+
+// Cost returns the total cost of a MergeStake
+func (ms *MergeStake) Cost() (*big.Int, error) {
+	intrinsicGas, err := ms.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the MergeStake")
+	}
+	mergeFee := big.NewInt(0).Mul(ms.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return mergeFee, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// SplitStakePayloadGas represents the SplitStake payload gas per uint
+	SplitStakePayloadGas = uint64(100)
+	// SplitStakeBaseIntrinsicGas represents the base intrinsic gas for SplitStake
+	SplitStakeBaseIntrinsicGas = uint64(10000)
+)
+
+// SplitStake defines the action of splitting part of a bucket into a new bucket
+type SplitStake struct {
+	AbstractAction
+
+	bucketIndex uint64
+	amount      *big.Int
+	payload     []byte
+}
+
+// NewSplitStake returns a SplitStake instance
+func NewSplitStake(
+	nonce uint64,
+	index uint64,
+	amount string,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*SplitStake, error) {
+	split, ok := new(big.Int).SetString(amount, 10)
+	if !ok || split.Sign() != 1 {
+		return nil, errors.Wrapf(ErrInvalidAmount, "amount %s", amount)
+	}
+	return &SplitStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: index,
+		amount:      split,
+		payload:     payload,
+	}, nil
+}
+
+// Amount returns the amount moved into the new bucket
+func (ss *SplitStake) Amount() *big.Int { return ss.amount }
+
+// Payload returns the payload bytes
+func (ss *SplitStake) Payload() []byte { return ss.payload }
+
+// BucketIndex returns the index of the bucket to split
+func (ss *SplitStake) BucketIndex() uint64 { return ss.bucketIndex }
+
+// Serialize returns a raw byte stream of the SplitStake struct
+func (ss *SplitStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ss.Proto()))
+}
+
+// Proto converts to protobuf SplitStake Action
+func (ss *SplitStake) Proto() *iotextypes.StakeSplit {
+	act := &iotextypes.StakeSplit{
+		BucketIndex: ss.bucketIndex,
+		Payload:     ss.payload,
+	}
+
+	if ss.amount != nil {
+		act.Amount = ss.amount.String()
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's Action to SplitStake
+func (ss *SplitStake) LoadProto(pbAct *iotextypes.StakeSplit) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	ss.bucketIndex = pbAct.GetBucketIndex()
+	ss.payload = pbAct.GetPayload()
+	ss.amount = big.NewInt(0)
+	if len(pbAct.GetAmount()) > 0 {
+		ss.amount.SetString(pbAct.GetAmount(), 10)
+	}
+
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a SplitStake
+func (ss *SplitStake) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(ss.Payload()))
+	return calculateIntrinsicGas(SplitStakeBaseIntrinsicGas, SplitStakePayloadGas, payloadSize)
+}
+
+// Cost returns the total cost of a SplitStake
+func (ss *SplitStake) Cost() (*big.Int, error) {
+	intrinsicGas, err := ss.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the SplitStake")
+	}
+	splitFee := big.NewInt(0).Mul(ss.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return splitFee, nil
+}
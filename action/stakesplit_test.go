@@ -0,0 +1,47 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStake(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewSplitStake(nonce, index, "0", payload, gaslimit, gasprice)
+	require.Equal(ErrInvalidAmount, errors.Cause(err))
+	_, err = NewSplitStake(nonce, index, "-10", payload, gaslimit, gasprice)
+	require.Equal(ErrInvalidAmount, errors.Cause(err))
+
+	stake, err := NewSplitStake(nonce, index, "10", payload, gaslimit, gasprice)
+	require.NoError(err)
+	require.Equal(gaslimit, stake.GasLimit())
+	require.Equal(gasprice, stake.GasPrice())
+	require.Equal(nonce, stake.Nonce())
+	require.Equal(payload, stake.Payload())
+	require.Equal(index, stake.BucketIndex())
+	require.Equal("10", stake.Amount().String())
+
+	gas, err := stake.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(uint64(10700), gas)
+	cost, err := stake.Cost()
+	require.NoError(err)
+	require.Equal("107000", cost.Text(10))
+
+	stake2 := &SplitStake{}
+	require.NoError(stake2.LoadProto(stake.Proto()))
+	require.Equal(payload, stake2.Payload())
+	require.Equal(index, stake2.BucketIndex())
+	require.Equal(stake.Amount(), stake2.Amount())
+	require.Equal(stake.Serialize(), stake2.Serialize())
+	require.Error(stake2.LoadProto(nil))
+}
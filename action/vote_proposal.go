@@ -0,0 +1,108 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// VoteProposalPayloadGas represents the VoteProposal payload gas per uint
+	VoteProposalPayloadGas = uint64(100)
+	// VoteProposalBaseIntrinsicGas represents the base intrinsic gas for VoteProposal
+	VoteProposalBaseIntrinsicGas = uint64(10000)
+)
+
+// VoteProposal defines the action of a delegate voting on a parameter change proposal
+type VoteProposal struct {
+	AbstractAction
+
+	proposalID uint64
+	approve    bool
+	payload    []byte
+}
+
+// NewVoteProposal returns a VoteProposal instance
+func NewVoteProposal(
+	nonce uint64,
+	proposalID uint64,
+	approve bool,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*VoteProposal, error) {
+	return &VoteProposal{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		proposalID: proposalID,
+		approve:    approve,
+		payload:    payload,
+	}, nil
+}
+
+// ProposalID returns the id of the proposal to vote on
+func (vp *VoteProposal) ProposalID() uint64 { return vp.proposalID }
+
+// Approve returns true if the vote approves the proposal
+func (vp *VoteProposal) Approve() bool { return vp.approve }
+
+// Payload returns the payload bytes
+func (vp *VoteProposal) Payload() []byte { return vp.payload }
+
+// Serialize returns a raw byte stream of the VoteProposal struct
+func (vp *VoteProposal) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(vp.Proto()))
+}
+
+// Proto converts to protobuf VoteProposal Action
+func (vp *VoteProposal) Proto() *iotextypes.VoteProposal {
+	return &iotextypes.VoteProposal{
+		ProposalID: vp.proposalID,
+		Approve:    vp.approve,
+		Payload:    vp.payload,
+	}
+}
+
+// LoadProto converts a protobuf's Action to VoteProposal
+func (vp *VoteProposal) LoadProto(pbAct *iotextypes.VoteProposal) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	vp.proposalID = pbAct.GetProposalID()
+	vp.approve = pbAct.GetApprove()
+	vp.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a VoteProposal
+func (vp *VoteProposal) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(vp.Payload()))
+	return calculateIntrinsicGas(VoteProposalBaseIntrinsicGas, VoteProposalPayloadGas, payloadSize)
+}
+
+// Cost returns the total cost of a VoteProposal
+func (vp *VoteProposal) Cost() (*big.Int, error) {
+	intrinsicGas, err := vp.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the VoteProposal")
+	}
+	voteFee := big.NewInt(0).Mul(vp.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return voteFee, nil
+}
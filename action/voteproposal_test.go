@@ -0,0 +1,40 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteProposal(t *testing.T) {
+	require := require.New(t)
+
+	vp, err := NewVoteProposal(nonce, index, true, payload, gaslimit, gasprice)
+	require.NoError(err)
+	require.Equal(gaslimit, vp.GasLimit())
+	require.Equal(gasprice, vp.GasPrice())
+	require.Equal(nonce, vp.Nonce())
+	require.Equal(payload, vp.Payload())
+	require.Equal(index, vp.ProposalID())
+	require.True(vp.Approve())
+
+	gas, err := vp.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(uint64(10700), gas)
+	cost, err := vp.Cost()
+	require.NoError(err)
+	require.Equal("107000", cost.Text(10))
+
+	vp2 := &VoteProposal{}
+	require.NoError(vp2.LoadProto(vp.Proto()))
+	require.Equal(vp.ProposalID(), vp2.ProposalID())
+	require.Equal(vp.Approve(), vp2.Approve())
+	require.Equal(vp.Serialize(), vp2.Serialize())
+	require.Error(vp2.LoadProto(nil))
+}
@@ -0,0 +1,438 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actsync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/groupcache/lru"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/actsync/actsyncpb"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+const (
+	// maxHashesPerMessage is the maximal number of action hashes in an inventory or a request
+	maxHashesPerMessage = 1000
+	// maxAlternates is the maximal number of the other peers kept for an action requested
+	maxAlternates = 8
+)
+
+var actSyncMtc = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "iotex_actsync_action_counter",
+		Help: "Action gossip stats",
+	},
+	[]string{"event"},
+)
+
+func init() {
+	prometheus.MustRegister(actSyncMtc)
+	p2p.RegisterMessage(iotexrpc.MessageType_ACTION_INVENTORY, &actsyncpb.ActionInventory{})
+	p2p.RegisterMessage(iotexrpc.MessageType_ACTION_REQUEST, &actsyncpb.ActionRequest{})
+	p2p.RegisterMessage(iotexrpc.MessageType_ACTIONS, &actsyncpb.Actions{})
+}
+
+type (
+	// UnicastOutbound sends a unicast message to the given address
+	UnicastOutbound func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error
+	// Neighbors returns the neighbors' addresses
+	Neighbors func(ctx context.Context) ([]peerstore.PeerInfo, error)
+	// ActionHandler adds an action received into the actpool
+	ActionHandler func(ctx context.Context, selp action.SealedEnvelope) error
+
+	// request is an action requested, with the other peers having announced it while the request was in flight,
+	// which the action is requested from in turn once the request times out
+	request struct {
+		peerID     string
+		deadline   time.Time
+		alternates []peerstore.PeerInfo
+	}
+)
+
+// Config represents the config to setup actsync
+type Config struct {
+	unicastHandler   UnicastOutbound
+	neighborsHandler Neighbors
+}
+
+// Option is the option to override the actsync config
+type Option func(cfg *Config) error
+
+// WithUnicastOutBound is the option to set the unicast callback
+func WithUnicastOutBound(unicastHandler UnicastOutbound) Option {
+	return func(cfg *Config) error {
+		cfg.unicastHandler = unicastHandler
+		return nil
+	}
+}
+
+// WithNeighbors is the option to set the neighbors callback
+func WithNeighbors(neighborsHandler Neighbors) Option {
+	return func(cfg *Config) error {
+		cfg.neighborsHandler = neighborsHandler
+		return nil
+	}
+}
+
+// ActionSync gossips the actions by inventory. The hashes of the new actions are announced to the neighbors in
+// batches, and the neighbors request the actions they have not seen yet, so that each action is transferred to a
+// node only once.
+type ActionSync interface {
+	lifecycle.StartStopper
+
+	// Announce queues the hash of the action to announce to the neighbors
+	Announce(h hash.Hash256)
+	ProcessInventory(ctx context.Context, peer peerstore.PeerInfo, inv *actsyncpb.ActionInventory) error
+	ProcessActionRequest(ctx context.Context, peer peerstore.PeerInfo, req *actsyncpb.ActionRequest) error
+	ProcessActions(ctx context.Context, peer peerstore.PeerInfo, actions *actsyncpb.Actions) error
+}
+
+// actionSyncer implements ActionSync interface
+type actionSyncer struct {
+	mu               sync.Mutex
+	ap               actpool.ActPool
+	handler          ActionHandler
+	unicastHandler   UnicastOutbound
+	neighborsHandler Neighbors
+	clock            clock.Clock
+	timeout          time.Duration
+	cacheSize        int
+	// seen is the hashes known by each peer, which are not announced to the peer
+	seen map[string]*lru.Cache
+	// known is the hashes the node has handled, which are not requested again
+	known *lru.Cache
+	// requested is the hashes requested
+	requested map[hash.Hash256]*request
+	queue     []hash.Hash256
+	task      *routine.RecurringTask
+}
+
+// NewActionSyncer returns a new action syncer instance
+func NewActionSyncer(
+	cfg config.Config,
+	ap actpool.ActPool,
+	handler ActionHandler,
+	opts ...Option,
+) (ActionSync, error) {
+	asCfg := Config{}
+	for _, opt := range opts {
+		if err := opt(&asCfg); err != nil {
+			return nil, err
+		}
+	}
+	as := &actionSyncer{
+		ap:               ap,
+		handler:          handler,
+		unicastHandler:   asCfg.unicastHandler,
+		neighborsHandler: asCfg.neighborsHandler,
+		clock:            clock.New(),
+		timeout:          cfg.ActSync.RequestTimeout,
+		cacheSize:        cfg.ActSync.SeenCacheSize,
+		seen:             make(map[string]*lru.Cache),
+		known:            lru.New(cfg.ActSync.SeenCacheSize),
+		requested:        make(map[hash.Hash256]*request),
+	}
+	if cfg.ActSync.AnnounceInterval != 0 {
+		as.task = routine.NewRecurringTask(as.flush, cfg.ActSync.AnnounceInterval)
+	}
+	return as, nil
+}
+
+// Start starts the action syncer
+func (as *actionSyncer) Start(ctx context.Context) error {
+	if as.task != nil {
+		return as.task.Start(ctx)
+	}
+	return nil
+}
+
+// Stop stops the action syncer
+func (as *actionSyncer) Stop(ctx context.Context) error {
+	if as.task != nil {
+		return as.task.Stop(ctx)
+	}
+	return nil
+}
+
+// Announce queues the hash of the action to announce to the neighbors
+func (as *actionSyncer) Announce(h hash.Hash256) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	as.known.Add(h, struct{}{})
+	as.queue = append(as.queue, h)
+}
+
+// ProcessInventory processes the hashes announced by a peer, and requests the actions not seen yet from the peer. If
+// an action is being requested from another peer, the peer is kept to request the action from in case the request
+// times out.
+func (as *actionSyncer) ProcessInventory(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	inv *actsyncpb.ActionInventory,
+) error {
+	hashes, err := as.loadHashes(ctx, inv.Hashes)
+	if err != nil {
+		return err
+	}
+	as.mu.Lock()
+	now := as.clock.Now()
+	seen := as.seenBy(peer.ID.Pretty())
+	req := &actsyncpb.ActionRequest{}
+	for _, h := range hashes {
+		seen.Add(h, struct{}{})
+		if _, ok := as.known.Get(h); ok {
+			actSyncMtc.WithLabelValues("known").Inc()
+			continue
+		}
+		r, ok := as.requested[h]
+		if ok && now.Before(r.deadline) {
+			r.addAlternate(peer)
+			actSyncMtc.WithLabelValues("inFlight").Inc()
+			continue
+		}
+		if _, err := as.ap.GetActionByHash(h); err == nil {
+			as.known.Add(h, struct{}{})
+			actSyncMtc.WithLabelValues("known").Inc()
+			continue
+		}
+		if !ok {
+			r = &request{}
+			as.requested[h] = r
+		}
+		r.peerID = peer.ID.Pretty()
+		r.deadline = now.Add(as.timeout)
+		r.removeAlternate(peer)
+		req.Hashes = append(req.Hashes, h[:])
+	}
+	as.mu.Unlock()
+	if len(req.Hashes) == 0 {
+		return nil
+	}
+	actSyncMtc.WithLabelValues("requested").Add(float64(len(req.Hashes)))
+	return as.unicastHandler(context.Background(), peer, req)
+}
+
+// ProcessActionRequest processes a request for the actions announced
+func (as *actionSyncer) ProcessActionRequest(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	req *actsyncpb.ActionRequest,
+) error {
+	hashes, err := as.loadHashes(ctx, req.Hashes)
+	if err != nil {
+		return err
+	}
+	actions := &actsyncpb.Actions{}
+	for _, h := range hashes {
+		// the action may have been committed or evicted since it was announced
+		if selp, err := as.ap.GetActionByHash(h); err == nil {
+			actions.Actions = append(actions.Actions, selp.Proto())
+		}
+	}
+	as.mu.Lock()
+	seen := as.seenBy(peer.ID.Pretty())
+	for _, h := range hashes {
+		seen.Add(h, struct{}{})
+	}
+	as.mu.Unlock()
+	if len(actions.Actions) == 0 {
+		return nil
+	}
+	return as.unicastHandler(context.Background(), peer, actions)
+}
+
+// ProcessActions processes the actions requested, and announces the actions accepted to the other neighbors. The
+// actions not requested are dropped, and only the actions accepted become known, so that the others may be requested
+// again once announced.
+func (as *actionSyncer) ProcessActions(ctx context.Context, peer peerstore.PeerInfo, actions *actsyncpb.Actions) error {
+	for _, pb := range actions.Actions {
+		var selp action.SealedEnvelope
+		if err := selp.LoadProto(pb); err != nil {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			return err
+		}
+		h := selp.Hash()
+		as.mu.Lock()
+		_, requested := as.requested[h]
+		delete(as.requested, h)
+		as.seenBy(peer.ID.Pretty()).Add(h, struct{}{})
+		as.mu.Unlock()
+		if !requested {
+			actSyncMtc.WithLabelValues("unsolicited").Inc()
+			continue
+		}
+		actSyncMtc.WithLabelValues("received").Inc()
+		if err := as.handler(ctx, selp); err != nil {
+			log.L().Debug("Failed to add action.", zap.String("peerID", peer.ID.Pretty()), zap.Error(err))
+			continue
+		}
+		as.Announce(h)
+	}
+	return nil
+}
+
+// flush announces the hashes queued to the neighbors not knowing them yet, and requests the actions having timed out
+// from the next peers having announced them
+func (as *actionSyncer) flush() {
+	ctx := context.Background()
+	peers, err := as.neighborsHandler(ctx)
+	if err != nil {
+		log.L().Warn("Error when get neighbor peers.", zap.Error(err))
+		return
+	}
+	as.mu.Lock()
+	retries := as.retryRequests(as.clock.Now())
+	neighbors := make(map[string]bool, len(peers))
+	for _, p := range peers {
+		neighbors[p.ID.Pretty()] = true
+	}
+	for id := range as.seen {
+		if !neighbors[id] {
+			delete(as.seen, id)
+		}
+	}
+	queue := as.queue
+	as.queue = nil
+	invs := make([][]*actsyncpb.ActionInventory, len(peers))
+	for i, p := range peers {
+		seen := as.seenBy(p.ID.Pretty())
+		inv := &actsyncpb.ActionInventory{}
+		for _, h := range queue {
+			if _, ok := seen.Get(h); ok {
+				actSyncMtc.WithLabelValues("suppressed").Inc()
+				continue
+			}
+			seen.Add(h, struct{}{})
+			inv.Hashes = append(inv.Hashes, h[:])
+			if len(inv.Hashes) == maxHashesPerMessage {
+				invs[i] = append(invs[i], inv)
+				inv = &actsyncpb.ActionInventory{}
+			}
+		}
+		if len(inv.Hashes) > 0 {
+			invs[i] = append(invs[i], inv)
+		}
+	}
+	as.mu.Unlock()
+	for _, retry := range retries {
+		actSyncMtc.WithLabelValues("retried").Add(float64(len(retry.req.Hashes)))
+		if err := as.unicastHandler(ctx, retry.peer, retry.req); err != nil {
+			log.L().Debug("Failed to request actions.", zap.Error(err))
+		}
+	}
+	for i, p := range peers {
+		for _, inv := range invs[i] {
+			actSyncMtc.WithLabelValues("announced").Add(float64(len(inv.Hashes)))
+			if err := as.unicastHandler(ctx, p, inv); err != nil {
+				log.L().Debug("Failed to announce actions.", zap.Error(err))
+			}
+		}
+	}
+}
+
+type retryRequest struct {
+	peer peerstore.PeerInfo
+	req  *actsyncpb.ActionRequest
+}
+
+// retryRequests moves the requests having timed out to the next peers having announced the actions, and drops the
+// requests without such a peer. It returns the requests to send.
+func (as *actionSyncer) retryRequests(now time.Time) []*retryRequest {
+	var (
+		retries []*retryRequest
+		byPeer  = make(map[string]*retryRequest)
+	)
+	for h, r := range as.requested {
+		if now.Before(r.deadline) {
+			continue
+		}
+		if len(r.alternates) == 0 {
+			delete(as.requested, h)
+			actSyncMtc.WithLabelValues("timeout").Inc()
+			continue
+		}
+		peer := r.alternates[0]
+		r.alternates = r.alternates[1:]
+		id := peer.ID.Pretty()
+		r.peerID = id
+		r.deadline = now.Add(as.timeout)
+		retry, ok := byPeer[id]
+		if !ok || len(retry.req.Hashes) == maxHashesPerMessage {
+			retry = &retryRequest{peer: peer, req: &actsyncpb.ActionRequest{}}
+			byPeer[id] = retry
+			retries = append(retries, retry)
+		}
+		hb := h
+		retry.req.Hashes = append(retry.req.Hashes, hb[:])
+	}
+	return retries
+}
+
+func (r *request) addAlternate(peer peerstore.PeerInfo) {
+	if len(r.alternates) == maxAlternates || r.peerID == peer.ID.Pretty() {
+		return
+	}
+	for _, p := range r.alternates {
+		if p.ID == peer.ID {
+			return
+		}
+	}
+	r.alternates = append(r.alternates, peer)
+}
+
+func (r *request) removeAlternate(peer peerstore.PeerInfo) {
+	for i, p := range r.alternates {
+		if p.ID == peer.ID {
+			r.alternates = append(r.alternates[:i], r.alternates[i+1:]...)
+			return
+		}
+	}
+}
+
+// seenBy returns the hashes known by the peer
+func (as *actionSyncer) seenBy(peerID string) *lru.Cache {
+	seen, ok := as.seen[peerID]
+	if !ok {
+		seen = lru.New(as.cacheSize)
+		as.seen[peerID] = seen
+	}
+	return seen
+}
+
+// loadHashes loads the action hashes of an inventory or a request
+func (as *actionSyncer) loadHashes(ctx context.Context, raw [][]byte) ([]hash.Hash256, error) {
+	if len(raw) > maxHashesPerMessage {
+		p2p.ReportPeer(ctx, p2p.MalformedMessage)
+		return nil, errors.Errorf("too many action hashes: %d", len(raw))
+	}
+	hashes := make([]hash.Hash256, len(raw))
+	for i, h := range raw {
+		if len(h) != len(hash.ZeroHash256) {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			return nil, errors.Errorf("invalid action hash %x", h)
+		}
+		hashes[i] = hash.BytesToHash256(h)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actsync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actsync/actsyncpb"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type testNode struct {
+	info   peerstore.PeerInfo
+	as     *actionSyncer
+	pool   map[hash.Hash256]action.SealedEnvelope
+	peers  []peerstore.PeerInfo
+	sent   map[string]int
+	drop   bool
+	reject bool
+}
+
+func TestActionSync(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.Default
+	cfg.ActSync.AnnounceInterval = 0
+	c := clock.NewMock()
+	nodes := make(map[string]*testNode)
+	newNode := func(info peerstore.PeerInfo) *testNode {
+		n := &testNode{
+			info: info,
+			pool: make(map[hash.Hash256]action.SealedEnvelope),
+			sent: make(map[string]int),
+		}
+		ap := mock_actpool.NewMockActPool(ctrl)
+		ap.EXPECT().GetActionByHash(gomock.Any()).DoAndReturn(func(h hash.Hash256) (action.SealedEnvelope, error) {
+			if selp, ok := n.pool[h]; ok {
+				return selp, nil
+			}
+			return action.SealedEnvelope{}, errors.New("action not found")
+		}).AnyTimes()
+		as, err := NewActionSyncer(cfg, ap,
+			func(_ context.Context, selp action.SealedEnvelope) error {
+				if n.reject {
+					return errors.New("action rejected")
+				}
+				n.pool[selp.Hash()] = selp
+				return nil
+			},
+			WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+				n.sent[proto.MessageName(msg)]++
+				if n.drop {
+					return nil
+				}
+				to := nodes[peer.ID.Pretty()].as
+				switch m := msg.(type) {
+				case *actsyncpb.ActionInventory:
+					return to.ProcessInventory(ctx, n.info, m)
+				case *actsyncpb.ActionRequest:
+					return to.ProcessActionRequest(ctx, n.info, m)
+				case *actsyncpb.Actions:
+					return to.ProcessActions(ctx, n.info, m)
+				}
+				return nil
+			}),
+			WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) { return n.peers, nil }),
+		)
+		require.NoError(err)
+		n.as = as.(*actionSyncer)
+		n.as.clock = c
+		nodes[info.ID.Pretty()] = n
+		return n
+	}
+	a := newNode(peerstore.PeerInfo{ID: "a"})
+	b := newNode(peerstore.PeerInfo{ID: "b"})
+	d := newNode(peerstore.PeerInfo{ID: "d"})
+	a.peers = []peerstore.PeerInfo{b.info}
+	b.peers = []peerstore.PeerInfo{a.info, d.info}
+	d.peers = []peerstore.PeerInfo{b.info}
+
+	tsfs := make([]action.SealedEnvelope, 4)
+	for i := range tsfs {
+		tsf, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0), uint64(i+1),
+			big.NewInt(1), nil, 10000, big.NewInt(0))
+		require.NoError(err)
+		tsfs[i] = tsf
+	}
+
+	// the action is announced hop by hop, and transferred to each node once
+	h0 := tsfs[0].Hash()
+	a.pool[h0] = tsfs[0]
+	a.as.Announce(h0)
+	a.as.flush()
+	require.Contains(b.pool, h0)
+	b.as.flush()
+	require.Contains(d.pool, h0)
+	require.Equal(map[string]int{"actsyncpb.ActionInventory": 1, "actsyncpb.Actions": 1}, a.sent)
+	// b does not announce the action back to a
+	require.Equal(map[string]int{
+		"actsyncpb.ActionRequest":   1,
+		"actsyncpb.ActionInventory": 1,
+		"actsyncpb.Actions":         1,
+	}, b.sent)
+	require.Equal(map[string]int{"actsyncpb.ActionRequest": 1}, d.sent)
+
+	// the action known is not requested again
+	require.NoError(b.as.ProcessInventory(context.Background(), a.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h0[:]},
+	}))
+	require.Equal(1, b.sent["actsyncpb.ActionRequest"])
+
+	// the actions not requested are dropped
+	require.NoError(d.as.ProcessActions(context.Background(), b.info, &actsyncpb.Actions{
+		Actions: []*iotextypes.Action{tsfs[1].Proto()},
+	}))
+	require.NotContains(d.pool, tsfs[1].Hash())
+
+	// the action not requested or rejected is requested again once announced
+	h1 := tsfs[1].Hash()
+	b.pool[h1] = tsfs[1]
+	d.reject = true
+	require.NoError(d.as.ProcessInventory(context.Background(), b.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h1[:]},
+	}))
+	require.Equal(2, d.sent["actsyncpb.ActionRequest"])
+	require.NotContains(d.pool, h1)
+	d.reject = false
+	require.NoError(d.as.ProcessInventory(context.Background(), b.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h1[:]},
+	}))
+	require.Equal(3, d.sent["actsyncpb.ActionRequest"])
+	require.Contains(d.pool, h1)
+	require.Empty(d.as.requested)
+
+	// the action is requested from another peer once the request times out
+	h2 := tsfs[2].Hash()
+	a.pool[h2] = tsfs[2]
+	d.pool[h2] = tsfs[2]
+	a.drop = true
+	require.NoError(b.as.ProcessInventory(context.Background(), a.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h2[:]},
+	}))
+	require.NoError(b.as.ProcessInventory(context.Background(), d.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h2[:]},
+	}))
+	require.Equal(2, b.sent["actsyncpb.ActionRequest"])
+	require.NotContains(b.pool, h2)
+	c.Add(cfg.ActSync.RequestTimeout)
+	require.NoError(b.as.ProcessInventory(context.Background(), d.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h2[:]},
+	}))
+	require.Equal(3, b.sent["actsyncpb.ActionRequest"])
+	require.Contains(b.pool, h2)
+
+	// the action is requested from the other peer having announced it once the request times out, though the peer
+	// does not announce it again
+	h3 := tsfs[3].Hash()
+	a.pool[h3] = tsfs[3]
+	d.pool[h3] = tsfs[3]
+	require.NoError(b.as.ProcessInventory(context.Background(), a.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h3[:]},
+	}))
+	require.NoError(b.as.ProcessInventory(context.Background(), d.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{h3[:]},
+	}))
+	require.Equal(4, b.sent["actsyncpb.ActionRequest"])
+	b.as.flush()
+	require.Equal(4, b.sent["actsyncpb.ActionRequest"])
+	require.NotContains(b.pool, h3)
+	c.Add(cfg.ActSync.RequestTimeout)
+	b.as.flush()
+	require.Equal(5, b.sent["actsyncpb.ActionRequest"])
+	require.Contains(b.pool, h3)
+	require.Empty(b.as.requested)
+
+	// the malformed hashes are rejected
+	require.Error(b.as.ProcessInventory(context.Background(), a.info, &actsyncpb.ActionInventory{
+		Hashes: [][]byte{{1, 2, 3}},
+	}))
+}
@@ -12,6 +12,7 @@ import (
 	"math"
 	"math/big"
 	"net"
+	"sort"
 	"strconv"
 	"time"
 
@@ -36,7 +37,10 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/action/protocol/poll/pollpb"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -48,6 +52,8 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/rewardhistory"
+	"github.com/iotexproject/iotex-core/rewardhistory/rewardhistorypb"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/systemlog"
@@ -64,13 +70,22 @@ var (
 	candidateNameLen = 12
 )
 
+const (
+	rewardingProtocolID     = "rewarding"
+	readRewardHistoryMethod = "RewardHistory"
+	pollProtocolID          = "poll"
+	readProbationMethod     = "ProbationInfo"
+	readProductivityMethod  = "Productivity"
+)
+
 // BroadcastOutbound sends a broadcast message to the whole network
 type BroadcastOutbound func(ctx context.Context, chainID uint32, msg proto.Message) error
 
 // Config represents the config to setup api
 type Config struct {
-	broadcastHandler  BroadcastOutbound
-	electionCommittee committee.Committee
+	broadcastHandler     BroadcastOutbound
+	electionCommittee    committee.Committee
+	rewardHistoryIndexer *rewardhistory.Indexer
 }
 
 // Option is the option to override the api config
@@ -92,6 +107,14 @@ func WithNativeElection(committee committee.Committee) Option {
 	}
 }
 
+// WithRewardHistoryIndexer is the option to return the per-epoch reward history through API
+func WithRewardHistoryIndexer(indexer *rewardhistory.Indexer) Option {
+	return func(cfg *Config) error {
+		cfg.rewardHistoryIndexer = indexer
+		return nil
+	}
+}
+
 // Server provides api for user to query blockchain data
 type Server struct {
 	bc                blockchain.Blockchain
@@ -108,6 +131,7 @@ type Server struct {
 	grpcServer        *grpc.Server
 	hasActionIndex    bool
 	electionCommittee committee.Committee
+	rewardHistory     *rewardhistory.Indexer
 }
 
 // NewServer creates a new server
@@ -151,6 +175,7 @@ func NewServer(
 		chainListener:     NewChainListener(),
 		gs:                gasstation.NewGasStation(chain, sf.SimulateExecution, dao, cfg.API),
 		electionCommittee: apiCfg.electionCommittee,
+		rewardHistory:     apiCfg.rewardHistoryIndexer,
 	}
 	if _, ok := cfg.Plugins[config.GatewayPlugin]; ok {
 		svr.hasActionIndex = true
@@ -325,6 +350,10 @@ func (api *Server) GetServerMeta(ctx context.Context,
 // SendAction is the API to send an action to blockchain.
 func (api *Server) SendAction(ctx context.Context, in *iotexapi.SendActionRequest) (*iotexapi.SendActionResponse, error) {
 	log.L().Debug("receive send action request")
+	// the node without the broadcast, e.g. a read-only follower, does not take part in p2p
+	if api.broadcastHandler == nil {
+		return nil, status.Error(codes.Unavailable, "node does not accept actions")
+	}
 	var selp action.SealedEnvelope
 	var err error
 	if err = selp.LoadProto(in.Action); err != nil {
@@ -442,6 +471,15 @@ func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRe
 
 // ReadState reads state on blockchain
 func (api *Server) ReadState(ctx context.Context, in *iotexapi.ReadStateRequest) (*iotexapi.ReadStateResponse, error) {
+	switch {
+	case string(in.ProtocolID) == rewardingProtocolID && string(in.MethodName) == readRewardHistoryMethod:
+		// reward history is served by the indexer rather than the rewarding protocol
+		return api.readRewardHistory(in.Arguments...)
+	case string(in.ProtocolID) == pollProtocolID && string(in.MethodName) == readProbationMethod:
+		return api.readProbationInfo(in.Arguments...)
+	case string(in.ProtocolID) == pollProtocolID && string(in.MethodName) == readProductivityMethod:
+		return api.readProductivity(in.Arguments...)
+	}
 	p, ok := api.registry.Find(string(in.ProtocolID))
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "protocol %s isn't registered", string(in.ProtocolID))
@@ -760,6 +798,169 @@ func (api *Server) GetEvmTransferByBlockHeight(blockHeight uint64) (*systemlogpb
 	return api.systemLogIndexer.GetEvmTransferByBlockHeight(blockHeight)
 }
 
+// GetRewardHistory returns the rewards granted to the address in epochs [startEpoch, startEpoch+count)
+func (api *Server) GetRewardHistory(addr string, startEpoch, count uint64) (*rewardhistorypb.RewardHistoryList, error) {
+	if api.rewardHistory == nil {
+		return nil, status.Error(codes.NotFound, "reward history index not supported")
+	}
+	if count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if count > api.cfg.API.RangeQueryLimit {
+		return nil, status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	rewardAddr, err := address.FromString(addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	histories, err := api.rewardHistory.RewardHistories(rewardAddr, startEpoch, count)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	res := &rewardhistorypb.RewardHistoryList{}
+	for _, rh := range histories {
+		res.RewardHistories = append(res.RewardHistories, rh.Proto())
+	}
+	return res, nil
+}
+
+// GetProbationInfo returns the delegates on probation in the epoch. For the current epoch, it also returns the number
+// of epochs after the current one each delegate remains on probation if it is productive from then on.
+func (api *Server) GetProbationInfo(epoch uint64) (*pollpb.ProbationInfoList, error) {
+	rp := rolldpos.FindProtocol(api.registry)
+	if rp == nil {
+		return nil, status.Error(codes.NotFound, "rolldpos protocol is not registered")
+	}
+	pp := poll.FindProtocol(api.registry)
+	if pp == nil {
+		return nil, status.Error(codes.NotFound, "poll protocol is not registered")
+	}
+	if epoch < 1 {
+		return nil, status.Error(codes.InvalidArgument, "epoch number cannot be less than one")
+	}
+	tipHeight := api.bc.TipHeight()
+	tipEpoch := rp.GetEpochNum(tipHeight)
+	if epoch > tipEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d is larger than current epoch %d", epoch, tipEpoch)
+	}
+	data, err := api.readState(
+		context.Background(),
+		pp,
+		strconv.FormatUint(rp.GetEpochHeight(epoch), 10),
+		[]byte("ProbationListByEpoch"),
+		byteutil.Uint64ToBytes(epoch),
+	)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	probationList := &vote.ProbationList{}
+	if err := probationList.Deserialize(data); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var upd *vote.UnproductiveDelegate
+	// the unproductive delegates in state are those of the epoch after the tip block
+	updEpoch := rp.GetEpochNum(tipHeight + 1)
+	if epoch == tipEpoch && len(probationList.ProbationInfo) != 0 {
+		if upd, err = candidatesutil.UnproductiveDelegateFromDB(api.sf); err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	}
+	res := &pollpb.ProbationInfoList{
+		Epoch:         epoch,
+		IntensityRate: probationList.IntensityRate,
+	}
+	for _, info := range probationList.Proto().ProbationList {
+		pi := &pollpb.ProbationInfo{
+			Address: info.Address,
+			Count:   info.Count,
+		}
+		if upd != nil {
+			pi.RemainingEpochs = remainingProbationEpochs(upd, info.Address, updEpoch, epoch)
+		}
+		res.ProbationInfos = append(res.ProbationInfos, pi)
+	}
+	return res, nil
+}
+
+// remainingProbationEpochs returns the number of epochs after the current epoch the delegate remains on probation.
+// The upd-lists put the delegate on probation from updEpoch on, which is the current epoch or the next one.
+func remainingProbationEpochs(upd *vote.UnproductiveDelegate, delegate string, updEpoch, epoch uint64) uint64 {
+	// end is the first epoch the delegate is off probation
+	end := updEpoch + upd.RemainingProbation(delegate)
+	if end <= epoch+1 {
+		return 0
+	}
+	return end - epoch - 1
+}
+
+// GetProductivity returns the number of blocks produced by each active block producer in epochs
+// [startEpoch, startEpoch+count)
+func (api *Server) GetProductivity(startEpoch, count uint64) (*pollpb.EpochProductivityList, error) {
+	rp := rolldpos.FindProtocol(api.registry)
+	if rp == nil {
+		return nil, status.Error(codes.NotFound, "rolldpos protocol is not registered")
+	}
+	pp := poll.FindProtocol(api.registry)
+	if pp == nil {
+		return nil, status.Error(codes.NotFound, "poll protocol is not registered")
+	}
+	if startEpoch < 1 {
+		return nil, status.Error(codes.InvalidArgument, "epoch number cannot be less than one")
+	}
+	if count == 0 || count > api.cfg.API.RangeQueryLimit {
+		return nil, status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	tipHeight := api.bc.TipHeight()
+	tipEpoch := rp.GetEpochNum(tipHeight)
+	if startEpoch > tipEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d is larger than current epoch %d", startEpoch, tipEpoch)
+	}
+	res := &pollpb.EpochProductivityList{}
+	for epoch := startEpoch; epoch < startEpoch+count && epoch <= tipEpoch; epoch++ {
+		data, err := api.readState(
+			context.Background(),
+			pp,
+			strconv.FormatUint(rp.GetEpochHeight(epoch), 10),
+			[]byte("ActiveBlockProducersByEpoch"),
+			byteutil.Uint64ToBytes(epoch),
+		)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		var abps state.CandidateList
+		if err := abps.Deserialize(data); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		numBlks, produce, err := api.getProductivityByEpoch(rp, epoch, tipHeight, abps)
+		if err != nil {
+			return nil, err
+		}
+		ep := &pollpb.EpochProductivity{
+			Epoch:       epoch,
+			TotalBlocks: numBlks,
+		}
+		var expected uint64
+		if len(produce) != 0 {
+			expected = numBlks / uint64(len(produce))
+		}
+		addrs := make([]string, 0, len(produce))
+		for addr := range produce {
+			addrs = append(addrs, addr)
+		}
+		sort.Strings(addrs)
+		for _, addr := range addrs {
+			ep.Productivities = append(ep.Productivities, &pollpb.DelegateProductivity{
+				Address:            addr,
+				Production:         produce[addr],
+				ExpectedProduction: expected,
+			})
+		}
+		res.EpochProductivities = append(res.EpochProductivities, ep)
+	}
+	return res, nil
+}
+
 // Start starts the API server
 func (api *Server) Start() error {
 	portStr := ":" + strconv.Itoa(api.cfg.API.Port)
@@ -828,6 +1029,75 @@ func (api *Server) readState(ctx context.Context, p protocol.Protocol, height st
 	return p.ReadState(ctx, api.sf, methodName, arguments...)
 }
 
+// readRewardHistory decodes the arguments of reading reward history, which are the reward address, the start epoch
+// and the number of epochs
+func (api *Server) readRewardHistory(arguments ...[]byte) (*iotexapi.ReadStateResponse, error) {
+	if len(arguments) != 3 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid number of arguments %d", len(arguments))
+	}
+	startEpoch, err := strconv.ParseUint(string(arguments[1]), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	count, err := strconv.ParseUint(string(arguments[2]), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := api.GetRewardHistory(string(arguments[0]), startEpoch, count)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.ReadStateResponse{Data: data}, nil
+}
+
+// readProbationInfo decodes the argument of reading probation info, which is the epoch number
+func (api *Server) readProbationInfo(arguments ...[]byte) (*iotexapi.ReadStateResponse, error) {
+	if len(arguments) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid number of arguments %d", len(arguments))
+	}
+	epoch, err := strconv.ParseUint(string(arguments[0]), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := api.GetProbationInfo(epoch)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.ReadStateResponse{Data: data}, nil
+}
+
+// readProductivity decodes the arguments of reading productivity, which are the start epoch and the number of epochs
+func (api *Server) readProductivity(arguments ...[]byte) (*iotexapi.ReadStateResponse, error) {
+	if len(arguments) != 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid number of arguments %d", len(arguments))
+	}
+	startEpoch, err := strconv.ParseUint(string(arguments[0]), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	count, err := strconv.ParseUint(string(arguments[1]), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := api.GetProductivity(startEpoch, count)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.ReadStateResponse{Data: data}, nil
+}
+
 func (api *Server) getActionsFromIndex(totalActions, start, count uint64) (*iotexapi.GetActionsResponse, error) {
 	var actionInfo []*iotexapi.ActionInfo
 	hashes, err := api.indexer.GetActionHashFromIndex(start, count)
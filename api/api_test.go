@@ -19,6 +19,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
@@ -31,8 +33,10 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/action/protocol/execution"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/action/protocol/poll/pollpb"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -44,6 +48,8 @@ import (
 	"github.com/iotexproject/iotex-core/gasstation"
 	"github.com/iotexproject/iotex-core/pkg/unit"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/rewardhistory"
+	"github.com/iotexproject/iotex-core/rewardhistory/rewardhistorypb"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
@@ -1051,6 +1057,11 @@ func TestServer_SendAction(t *testing.T) {
 		require.Equal(i+1, broadcastHandlerCount)
 		require.Equal(test.actionHash, res.ActionHash)
 	}
+
+	// the node without the broadcast does not accept actions
+	svr.broadcastHandler = nil
+	_, err := svr.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: sendActionTests[0].actionPb})
+	require.Equal(codes.Unavailable, status.Code(err))
 }
 
 func TestServer_GetReceiptByAction(t *testing.T) {
@@ -1225,6 +1236,168 @@ func TestServer_AvailableBalance(t *testing.T) {
 	assert.Equal(t, unit.ConvertIotxToRau(199999936), val)
 }
 
+func TestServer_ReadRewardHistory(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.Consensus.Scheme = config.RollDPoSScheme
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	request := &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("rewarding"),
+		MethodName: []byte("RewardHistory"),
+		Arguments: [][]byte{
+			[]byte(identityset.Address(0).String()),
+			[]byte("1"),
+			[]byte("10"),
+		},
+	}
+	// reward history indexer is not enabled
+	_, err = svr.ReadState(context.Background(), request)
+	require.Error(err)
+
+	ctx := context.Background()
+	indexer, err := rewardhistory.NewIndexer(db.NewMemKVStore(), rolldpos.NewProtocol(
+		cfg.Genesis.NumCandidateDelegates,
+		cfg.Genesis.NumDelegates,
+		cfg.Genesis.NumSubEpochs,
+	))
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+	for i := uint64(1); i <= svr.bc.TipHeight(); i++ {
+		blk, err := svr.dao.GetBlockByHeight(i)
+		require.NoError(err)
+		blk.Receipts, err = svr.dao.GetReceipts(i)
+		require.NoError(err)
+		require.NoError(indexer.PutBlock(ctx, blk))
+	}
+	svr.rewardHistory = indexer
+
+	out, err := svr.ReadState(context.Background(), request)
+	require.NoError(err)
+	histories := &rewardhistorypb.RewardHistoryList{}
+	require.NoError(proto.Unmarshal(out.Data, histories))
+	require.NotEmpty(histories.RewardHistories)
+	total := big.NewInt(0)
+	numBlocks := uint64(0)
+	for _, h := range histories.RewardHistories {
+		blockReward, ok := big.NewInt(0).SetString(h.BlockReward, 10)
+		require.True(ok)
+		total.Add(total, blockReward)
+		numBlocks += h.NumBlocks
+	}
+	require.Equal(unit.ConvertIotxToRau(64), total)
+	require.Equal(uint64(4), numBlocks)
+
+	// invalid arguments
+	request.Arguments = request.Arguments[:2]
+	_, err = svr.ReadState(context.Background(), request)
+	require.Error(err)
+	request.Arguments = append(request.Arguments, []byte("0"))
+	_, err = svr.ReadState(context.Background(), request)
+	require.Error(err)
+}
+
+func TestServer_ReadProductivity(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	require.NoError(poll.NewLifeLongDelegatesProtocol(cfg.Genesis.Delegates).ForceRegister(svr.registry))
+
+	request := &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("poll"),
+		MethodName: []byte("Productivity"),
+		Arguments:  [][]byte{[]byte("1"), []byte("3")},
+	}
+	out, err := svr.ReadState(context.Background(), request)
+	require.NoError(err)
+	res := &pollpb.EpochProductivityList{}
+	require.NoError(proto.Unmarshal(out.Data, res))
+	// only the current epoch is returned
+	require.Equal(1, len(res.EpochProductivities))
+	ep := res.EpochProductivities[0]
+	require.Equal(uint64(1), ep.Epoch)
+	require.Equal(uint64(4), ep.TotalBlocks)
+	require.Equal(24, len(ep.Productivities))
+	var produced uint64
+	for i, p := range ep.Productivities {
+		if i > 0 {
+			require.True(ep.Productivities[i-1].Address < p.Address)
+		}
+		require.Equal(uint64(0), p.ExpectedProduction)
+		produced += p.Production
+	}
+	require.Equal(ep.TotalBlocks, produced)
+
+	// invalid arguments
+	for _, args := range [][][]byte{
+		{[]byte("1")},
+		{[]byte("0"), []byte("1")},
+		{[]byte("1"), []byte("0")},
+		{[]byte("2"), []byte("1")},
+		{[]byte("a"), []byte("1")},
+	} {
+		request.Arguments = args
+		_, err = svr.ReadState(context.Background(), request)
+		require.Error(err)
+	}
+}
+
+func TestServer_ReadProbationInfo(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	require.NoError(poll.NewLifeLongDelegatesProtocol(cfg.Genesis.Delegates).ForceRegister(svr.registry))
+
+	request := &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("poll"),
+		MethodName: []byte("ProbationInfo"),
+		Arguments:  [][]byte{[]byte("1")},
+	}
+	// life long delegates protocol has no probation list
+	_, err = svr.ReadState(context.Background(), request)
+	require.Error(err)
+
+	// invalid arguments
+	for _, args := range [][][]byte{
+		{},
+		{[]byte("0")},
+		{[]byte("2")},
+		{[]byte("a")},
+	} {
+		request.Arguments = args
+		_, err = svr.ReadState(context.Background(), request)
+		require.Error(err)
+	}
+}
+
+func TestRemainingProbationEpochs(t *testing.T) {
+	require := require.New(t)
+	upd, err := vote.NewUnproductiveDelegate(3, 3)
+	require.NoError(err)
+	// a has served one epoch of its probation, and b is just put on probation
+	require.NoError(upd.AddRecentUPD([]string{"a"}))
+	require.NoError(upd.AddRecentUPD([]string{"b"}))
+
+	// the upd-lists apply from the current epoch
+	require.Equal(uint64(1), remainingProbationEpochs(upd, "a", 5, 5))
+	require.Equal(uint64(2), remainingProbationEpochs(upd, "b", 5, 5))
+	// the upd-lists apply from the next epoch, at the last block of the current epoch
+	require.Equal(uint64(2), remainingProbationEpochs(upd, "a", 6, 5))
+	require.Equal(uint64(3), remainingProbationEpochs(upd, "b", 6, 5))
+	require.Equal(uint64(0), remainingProbationEpochs(upd, "c", 5, 5))
+
+	// a is off probation after the current epoch
+	require.NoError(upd.AddRecentUPD(nil))
+	require.Equal(uint64(0), remainingProbationEpochs(upd, "a", 6, 6))
+	require.Equal(uint64(1), remainingProbationEpochs(upd, "b", 6, 6))
+}
+
 func TestServer_ReadCandidatesByEpoch(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
@@ -121,3 +121,14 @@ func (b *Block) Finalize(endorsements []*endorsement.Endorsement, ts time.Time)
 
 	return nil
 }
+
+// FinalizeWithAggregate creates a footer for the block with an aggregate endorsement
+func (b *Block) FinalizeWithAggregate(aggregate *endorsement.AggregateEndorsement, ts time.Time) error {
+	if len(b.endorsements) != 0 || b.aggregate != nil {
+		return errors.New("the block has been finalized")
+	}
+	b.aggregate = aggregate
+	b.commitTime = ts
+
+	return nil
+}
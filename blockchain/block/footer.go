@@ -19,6 +19,7 @@ import (
 // Footer defines a set of proof of this block
 type Footer struct {
 	endorsements []*endorsement.Endorsement
+	aggregate    *endorsement.AggregateEndorsement
 	commitTime   time.Time
 }
 
@@ -38,6 +39,11 @@ func (f *Footer) ConvertToBlockFooterPb() (*iotextypes.BlockFooter, error) {
 		}
 		pb.Endorsements = append(pb.Endorsements, ePb)
 	}
+	if f.aggregate != nil {
+		if pb.AggregateEndorsement, err = f.aggregate.Proto(); err != nil {
+			return nil, err
+		}
+	}
 	return &pb, nil
 }
 
@@ -51,6 +57,12 @@ func (f *Footer) ConvertFromBlockFooterPb(pb *iotextypes.BlockFooter) error {
 		return err
 	}
 	f.commitTime = commitTime
+	if aPb := pb.GetAggregateEndorsement(); aPb != nil {
+		f.aggregate = &endorsement.AggregateEndorsement{}
+		if err := f.aggregate.LoadProto(aPb); err != nil {
+			return err
+		}
+	}
 	pbEndorsements := pb.GetEndorsements()
 	if pbEndorsements == nil {
 		return nil
@@ -77,6 +89,12 @@ func (f *Footer) Endorsements() []*endorsement.Endorsement {
 	return f.endorsements
 }
 
+// AggregateEndorsement returns the aggregate commit endorsement of delegates, which replaces the endorsements once
+// the BLS endorsements are activated
+func (f *Footer) AggregateEndorsement() *endorsement.AggregateEndorsement {
+	return f.aggregate
+}
+
 // Serialize returns the serialized byte stream of the block footer
 func (f *Footer) Serialize() ([]byte, error) {
 	pb, err := f.ConvertToBlockFooterPb()
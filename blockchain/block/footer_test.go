@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/stretchr/testify/require"
@@ -20,7 +21,7 @@ import (
 
 func TestConvertToBlockFooterPb(t *testing.T) {
 	require := require.New(t)
-	footer := &Footer{nil, time.Now()}
+	footer := &Footer{commitTime: time.Now()}
 	blockFooter, err := footer.ConvertToBlockFooterPb()
 	require.NoError(err)
 	require.NotNil(blockFooter)
@@ -36,14 +37,14 @@ func TestConvertToBlockFooterPb(t *testing.T) {
 func TestConvertFromBlockFooterPb(t *testing.T) {
 	require := require.New(t)
 	ts := &timestamp.Timestamp{Seconds: 10, Nanos: 10}
-	footerPb := &iotextypes.BlockFooter{nil, ts, struct{}{}, nil, 0}
+	footerPb := &iotextypes.BlockFooter{nil, ts, nil, struct{}{}, nil, 0}
 	footer := &Footer{}
 	require.NoError(footer.ConvertFromBlockFooterPb(footerPb))
 }
 
 func TestSerDesFooter(t *testing.T) {
 	require := require.New(t)
-	footer := &Footer{nil, time.Now()}
+	footer := &Footer{commitTime: time.Now()}
 	ser, err := footer.Serialize()
 	require.NoError(err)
 	require.NoError(footer.Deserialize(ser))
@@ -57,10 +58,39 @@ func TestSerDesFooter(t *testing.T) {
 	require.Equal(1, len(footer.endorsements))
 }
 
+func TestSerDesAggregateEndorsement(t *testing.T) {
+	require := require.New(t)
+	ts := time.Unix(1000, 0).UTC()
+	footer := &Footer{
+		aggregate:  endorsement.NewAggregateEndorsement(ts, []int{0, 2, 9}, []byte{1, 2, 3}),
+		commitTime: ts,
+	}
+	ser, err := footer.Serialize()
+	require.NoError(err)
+
+	pb := &iotextypes.BlockFooter{}
+	require.NoError(proto.Unmarshal(ser, pb))
+	require.Equal(0, len(pb.Endorsements))
+	require.Equal([]byte{1, 2, 3}, pb.GetAggregateEndorsement().GetSignature())
+
+	footer2 := &Footer{}
+	require.NoError(footer2.Deserialize(ser))
+	require.Equal(ts, footer2.CommitTime())
+	require.Equal(0, len(footer2.Endorsements()))
+	aggregate := footer2.AggregateEndorsement()
+	require.NotNil(aggregate)
+	require.Equal(ts, aggregate.Timestamp())
+	require.Equal([]int{0, 2, 9}, aggregate.Signers())
+	require.Equal([]byte{1, 2, 3}, aggregate.Signature())
+	ser2, err := footer2.Serialize()
+	require.NoError(err)
+	require.Equal(ser, ser2)
+}
+
 func makeFooter() (f *Footer) {
 	endors := make([]*endorsement.Endorsement, 0)
 	endor := endorsement.NewEndorsement(time.Now(), identityset.PrivateKey(27).PublicKey(), nil)
 	endors = append(endors, endor)
-	f = &Footer{endors, time.Now()}
+	f = &Footer{endorsements: endors, commitTime: time.Now()}
 	return
 }
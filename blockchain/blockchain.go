@@ -27,6 +27,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/compress"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
@@ -164,11 +165,16 @@ func BoltDBDaoOption(indexers ...blockdao.BlockIndexer) Option {
 			return nil
 		}
 		cfg.DB.DbPath = cfg.Chain.ChainDBPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
+		codec, err := compress.LoadCodec(cfg.Chain.BlockCodec, cfg.Chain.BlockCodecDictFile)
+		if err != nil {
+			return err
+		}
 		bc.dao = blockdao.NewBlockDAO(
 			db.NewBoltDB(cfg.DB),
 			indexers,
 			cfg.Chain.CompressBlock,
 			cfg.DB,
+			blockdao.WithCodec(codec),
 		)
 		return nil
 	}
@@ -180,11 +186,16 @@ func InMemDaoOption(indexers ...blockdao.BlockIndexer) Option {
 		if bc.dao != nil {
 			return nil
 		}
+		codec, err := compress.LoadCodec(cfg.Chain.BlockCodec, cfg.Chain.BlockCodecDictFile)
+		if err != nil {
+			return err
+		}
 		bc.dao = blockdao.NewBlockDAO(
 			db.NewMemKVStore(),
 			indexers,
 			cfg.Chain.CompressBlock,
 			cfg.DB,
+			blockdao.WithCodec(codec),
 		)
 		return nil
 	}
@@ -0,0 +1,532 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/groupcache/lru"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	archiveSuffix = ".gz"
+	// extractedSuffix is the suffix of the archives extracted, so that they are never taken for the files in hot
+	// storage
+	extractedSuffix = ".cache"
+)
+
+var (
+	archiveMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_blockdao_archive",
+			Help: "IoTeX blockdao cold storage counter.",
+		},
+		[]string{"event"},
+	)
+	// ErrArchived indicates the DB file has been archived into the cold storage, and is read-only
+	ErrArchived = errors.New("DB file is archived")
+)
+
+func init() {
+	prometheus.MustRegister(archiveMtc)
+}
+
+type (
+	// ArchiveStore is the cold storage keeping the archives of the sealed DB files, e.g., an object store
+	ArchiveStore interface {
+		// Has returns true if the archive exists
+		Has(name string) (bool, error)
+		// Put stores the archive read from the reader
+		Put(name string, r io.Reader) error
+		// Get opens the archive, and returns db.ErrNotExist if it does not exist
+		Get(name string) (io.ReadCloser, error)
+	}
+
+	// dirArchiveStore is an archive store in a local directory
+	dirArchiveStore struct {
+		dir string
+	}
+
+	// archivedFile is a DB file in the cold storage. It is extracted and opened on the first read, and closed and
+	// removed once it is evicted, so that only a few archives take up the local disk at a time.
+	archivedFile struct {
+		mutex   sync.RWMutex
+		name    string
+		store   ArchiveStore
+		cfg     config.DB
+		kvStore db.KVStore
+		onOpen  func()
+	}
+
+	// hotFile is a DB file in hot storage. Once it is sealed, it waits for the reads in flight before it is closed,
+	// and serves the later ones from the archived file instead.
+	hotFile struct {
+		mutex    sync.RWMutex
+		kvStore  db.KVStoreWithBucketFillPercent
+		archived *archivedFile
+	}
+
+	// archivedFiles keeps the archived DB files opened in LRU order
+	archivedFiles struct {
+		mutex sync.Mutex
+		files *lru.Cache
+	}
+)
+
+// NewDirArchiveStore creates an archive store in a local directory, which stands in for an object store
+func NewDirArchiveStore(dir string) ArchiveStore {
+	return &dirArchiveStore{dir: dir}
+}
+
+func (s *dirArchiveStore) Has(name string) (bool, error) {
+	_, err := os.Stat(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *dirArchiveStore) Put(name string, r io.Reader) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+	// the archive is written to a temp file first, so that a partial archive is never visible
+	tmp, err := ioutil.TempFile(s.dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	// the archive has to be durable before the DB file is removed from hot storage
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		return err
+	}
+	return syncDir(s.dir)
+}
+
+func (s *dirArchiveStore) Get(name string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil, errors.Wrapf(db.ErrNotExist, "archive %s", name)
+	}
+	return f, err
+}
+
+// syncDir flushes the entries of the directory, e.g., the files renamed into it
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}
+
+func newArchivedFiles(size int) *archivedFiles {
+	return &archivedFiles{files: lru.New(size)}
+}
+
+// Touch marks the archived file as opened recently, and closes the archived files evicted
+func (af *archivedFiles) Touch(name string, file *archivedFile) {
+	var evicted []*archivedFile
+	af.mutex.Lock()
+	af.files.OnEvicted = func(_ lru.Key, value interface{}) {
+		evicted = append(evicted, value.(*archivedFile))
+	}
+	af.files.Add(name, file)
+	af.files.OnEvicted = nil
+	af.mutex.Unlock()
+	for _, f := range evicted {
+		if err := f.Stop(context.Background()); err != nil {
+			log.L().Error("Failed to close archived DB file.", zap.String("file", f.name), zap.Error(err))
+		}
+		archiveMtc.WithLabelValues("evicted").Inc()
+	}
+}
+
+func newArchivedFile(name string, store ArchiveStore, cfg config.DB, onOpen func()) *archivedFile {
+	_, dir := getFileNameAndDir(cfg.DbPath)
+	if cfg.ColdStorage.CacheDir != "" {
+		dir = cfg.ColdStorage.CacheDir
+	}
+	cfg.DbPath = filepath.Join(dir, name+extractedSuffix)
+	return &archivedFile{
+		name:   name,
+		store:  store,
+		cfg:    cfg,
+		onOpen: onOpen,
+	}
+}
+
+// Start does nothing, as the archive is opened on the first read
+func (f *archivedFile) Start(context.Context) error { return nil }
+
+// Stop closes the archive, and removes the file extracted
+func (f *archivedFile) Stop(ctx context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.kvStore == nil {
+		return nil
+	}
+	if err := f.kvStore.Stop(ctx); err != nil {
+		return err
+	}
+	f.kvStore = nil
+	return os.Remove(f.cfg.DbPath)
+}
+
+func (f *archivedFile) Put(string, []byte, []byte) error {
+	return errors.Wrapf(ErrArchived, "failed to write %s", f.name)
+}
+
+func (f *archivedFile) Delete(string, []byte) error {
+	return errors.Wrapf(ErrArchived, "failed to write %s", f.name)
+}
+
+func (f *archivedFile) WriteBatch(batch.KVStoreBatch) error {
+	return errors.Wrapf(ErrArchived, "failed to write %s", f.name)
+}
+
+func (f *archivedFile) Get(namespace string, key []byte) (value []byte, err error) {
+	err = f.read(func(kvStore db.KVStore) error {
+		value, err = kvStore.Get(namespace, key)
+		return err
+	})
+	return
+}
+
+func (f *archivedFile) Filter(namespace string, c db.Condition, minKey, maxKey []byte) (keys, values [][]byte, err error) {
+	err = f.read(func(kvStore db.KVStore) error {
+		keys, values, err = kvStore.Filter(namespace, c, minKey, maxKey)
+		return err
+	})
+	return
+}
+
+// read reads the archive, and opens it if it is not opened or has been evicted
+func (f *archivedFile) read(readFunc func(db.KVStore) error) error {
+	for {
+		f.mutex.RLock()
+		if f.kvStore != nil {
+			defer f.mutex.RUnlock()
+			return readFunc(f.kvStore)
+		}
+		f.mutex.RUnlock()
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *archivedFile) open() error {
+	f.mutex.Lock()
+	if f.kvStore != nil {
+		f.mutex.Unlock()
+		return nil
+	}
+	if err := f.extract(); err != nil {
+		f.mutex.Unlock()
+		return errors.Wrapf(err, "failed to extract archive of %s", f.name)
+	}
+	kvStore := db.NewBoltDB(f.cfg)
+	if err := kvStore.Start(context.Background()); err != nil {
+		f.mutex.Unlock()
+		return err
+	}
+	f.kvStore = kvStore
+	f.mutex.Unlock()
+	archiveMtc.WithLabelValues("opened").Inc()
+	log.L().Info("Opened archived DB file.", zap.String("file", f.name))
+	if f.onOpen != nil {
+		f.onOpen()
+	}
+	return nil
+}
+
+func (f *archivedFile) extract() error {
+	r, err := f.store.Get(f.name + archiveSuffix)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+	dir := filepath.Dir(f.cfg.DbPath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, f.name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, gr); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.cfg.DbPath)
+}
+
+// sealFilesAsync seals the old DB files in background, unless they are being sealed already
+func (dao *blockDAO) sealFilesAsync() {
+	if dao.archive == nil || !atomic.CompareAndSwapInt32(&dao.sealing, 0, 1) {
+		return
+	}
+	dao.sealWG.Add(1)
+	go func() {
+		defer dao.sealWG.Done()
+		defer atomic.StoreInt32(&dao.sealing, 0)
+		if err := dao.sealFiles(); err != nil {
+			log.L().Error("Failed to seal DB files.", zap.Error(err))
+		}
+	}()
+}
+
+// sealFiles archives the DB files in hot storage except the latest ones
+func (dao *blockDAO) sealFiles() error {
+	topIndex := dao.topIndex.Load().(uint64)
+	hotFiles := dao.cfg.ColdStorage.HotFiles
+	for idx := uint64(1); idx+hotFiles <= topIndex; idx++ {
+		if err := dao.sealFile(idx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sealFile archives the DB file into the cold storage, and removes it from hot storage
+func (dao *blockDAO) sealFile(idx uint64) error {
+	if kv, ok := dao.kvStores.Load(idx); ok && isArchived(kv.(db.KVStore)) {
+		return nil
+	}
+	name := dao.fileName(idx)
+	filePath := dao.filePath(idx)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil
+	}
+	archived, err := dao.archive.Has(name + archiveSuffix)
+	if err != nil {
+		return err
+	}
+	// the archive may exist already if the node stopped before the file was removed last time
+	if !archived {
+		if err := dao.putArchive(name, filePath); err != nil {
+			return errors.Wrapf(err, "failed to archive %s", name)
+		}
+	}
+
+	// reads are served from the archive once the file in hot storage is removed
+	file := dao.newArchivedFile(idx)
+	kv, loaded := dao.kvStores.Load(idx)
+	dao.kvStores.Store(idx, file)
+	if loaded {
+		if hot, ok := kv.(*hotFile); ok {
+			if err := hot.seal(file); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.Remove(filePath); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(filePath)); err != nil {
+		return err
+	}
+	archiveMtc.WithLabelValues("sealed").Inc()
+	log.L().Info("Sealed DB file into cold storage.", zap.String("file", name))
+	return nil
+}
+
+func (dao *blockDAO) putArchive(name, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	pr, pw := io.Pipe()
+	go func() {
+		gw := gzip.NewWriter(pw)
+		_, err := io.Copy(gw, f)
+		if err == nil {
+			err = gw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	err = dao.archive.Put(name+archiveSuffix, pr)
+	// unblock the compression if the archive store fails
+	pr.CloseWithError(err)
+	return err
+}
+
+// getArchivedFile returns the archived DB file of the index, or nil if it is not archived
+func (dao *blockDAO) getArchivedFile(idx uint64) (db.KVStore, error) {
+	if dao.archive == nil {
+		return nil, nil
+	}
+	archived, err := dao.archive.Has(dao.fileName(idx) + archiveSuffix)
+	if err != nil || !archived {
+		return nil, err
+	}
+	kv, _ := dao.kvStores.LoadOrStore(idx, dao.newArchivedFile(idx))
+	return kv.(db.KVStore), nil
+}
+
+// stopFiles closes the split DB files, either in hot storage or extracted from the archives
+func (dao *blockDAO) stopFiles(ctx context.Context) error {
+	var err error
+	dao.kvStores.Range(func(_, v interface{}) bool {
+		if e := v.(db.KVStore).Stop(ctx); e != nil && err == nil {
+			err = e
+		}
+		return true
+	})
+	return err
+}
+
+func (dao *blockDAO) newArchivedFile(idx uint64) *archivedFile {
+	name := dao.fileName(idx)
+	var file *archivedFile
+	file = newArchivedFile(name, dao.archive, dao.cfg, func() {
+		dao.openArchives.Touch(name, file)
+	})
+	return file
+}
+
+// isArchived returns true if the DB file has been sealed into the cold storage
+func isArchived(kvStore db.KVStore) bool {
+	switch f := kvStore.(type) {
+	case *archivedFile:
+		return true
+	case *hotFile:
+		f.mutex.RLock()
+		defer f.mutex.RUnlock()
+		return f.archived != nil
+	default:
+		return false
+	}
+}
+
+func newHotFile(cfg config.DB) *hotFile {
+	return &hotFile{kvStore: db.NewBoltDB(cfg)}
+}
+
+func (f *hotFile) Start(ctx context.Context) error {
+	return f.kvStore.Start(ctx)
+}
+
+// Stop closes the DB file, unless it has been closed by sealing
+func (f *hotFile) Stop(ctx context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.archived != nil {
+		return nil
+	}
+	return f.kvStore.Stop(ctx)
+}
+
+func (f *hotFile) Put(namespace string, key, value []byte) error {
+	return f.store(func(kvStore db.KVStore) error {
+		return kvStore.Put(namespace, key, value)
+	})
+}
+
+func (f *hotFile) Delete(namespace string, key []byte) error {
+	return f.store(func(kvStore db.KVStore) error {
+		return kvStore.Delete(namespace, key)
+	})
+}
+
+func (f *hotFile) WriteBatch(b batch.KVStoreBatch) error {
+	return f.store(func(kvStore db.KVStore) error {
+		return kvStore.WriteBatch(b)
+	})
+}
+
+func (f *hotFile) Get(namespace string, key []byte) (value []byte, err error) {
+	err = f.store(func(kvStore db.KVStore) error {
+		value, err = kvStore.Get(namespace, key)
+		return err
+	})
+	return
+}
+
+func (f *hotFile) Filter(namespace string, c db.Condition, minKey, maxKey []byte) (keys, values [][]byte, err error) {
+	err = f.store(func(kvStore db.KVStore) error {
+		keys, values, err = kvStore.Filter(namespace, c, minKey, maxKey)
+		return err
+	})
+	return
+}
+
+// Snapshot writes a copy of the DB file, which fails once the file is sealed
+func (f *hotFile) Snapshot(w io.Writer) (int64, error) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if f.archived != nil {
+		return 0, errors.Wrapf(ErrArchived, "failed to snapshot %s", f.archived.name)
+	}
+	snapshotter, ok := f.kvStore.(db.KVStoreWithSnapshot)
+	if !ok {
+		return 0, errors.New("DB file does not support snapshot")
+	}
+	return snapshotter.Snapshot(w)
+}
+
+// store runs the function on the DB file, or the archived file if it has been sealed
+func (f *hotFile) store(storeFunc func(db.KVStore) error) error {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if f.archived != nil {
+		return storeFunc(f.archived)
+	}
+	return storeFunc(f.kvStore)
+}
+
+// seal waits for the reads and writes in flight, and closes the DB file
+func (f *hotFile) seal(file *archivedFile) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.archived != nil {
+		return nil
+	}
+	f.archived = file
+	return f.kvStore.Stop(context.Background())
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestColdStorage(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "blockdao")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	cfg := config.Default.DB
+	cfg.DbPath = filepath.Join(dir, "chain.db")
+	cfg.SplitDBSizeMB = 1
+	cfg.SplitDBHeight = 0
+	cfg.ColdStorage.ArchiveDir = filepath.Join(dir, "archive")
+	cfg.ColdStorage.HotFiles = 1
+	cfg.ColdStorage.MaxOpenArchives = 1
+	cfg.MaxCacheSize = 0
+
+	actions := make([]action.SealedEnvelope, 50)
+	for i := range actions {
+		actions[i], err = testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0),
+			uint64(i+1), big.NewInt(1), make([]byte, 100), testutil.TestGasLimit, big.NewInt(0))
+		require.NoError(err)
+	}
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: genesis.Default})
+	newDAO := func() *blockDAO {
+		dao := NewBlockDAO(db.NewBoltDB(cfg), nil, false, cfg).(*blockDAO)
+		require.NoError(dao.Start(ctx))
+		return dao
+	}
+
+	// put blocks until there are 3 db files, and the first 2 are sealed
+	dao := newDAO()
+	prevHash := hash.ZeroHash256
+	heights := map[uint64]uint64{}
+	for height := uint64(1); dao.topIndex.Load().(uint64) < 3; height++ {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(time.Unix(int64(height), 0)).
+			AddActions(actions...).
+			SignAndBuild(identityset.PrivateKey(1))
+		require.NoError(err)
+		require.NoError(dao.PutBlock(ctx, &blk))
+		prevHash = blk.HashBlock()
+		_, idx, err := dao.getDBFromHeight(height)
+		require.NoError(err)
+		if _, ok := heights[idx]; !ok {
+			heights[idx] = height
+		}
+	}
+	dao.sealWG.Wait()
+	for idx := uint64(1); idx <= 2; idx++ {
+		_, err = os.Stat(dao.filePath(idx))
+		require.True(os.IsNotExist(err))
+		archived, err := dao.archive.Has(dao.fileName(idx) + archiveSuffix)
+		require.NoError(err)
+		require.True(archived)
+	}
+	_, err = os.Stat(dao.filePath(3))
+	require.NoError(err)
+
+	// the archives are opened on demand, and only the latest opened is kept
+	extracted := func(idx uint64) bool {
+		_, err := os.Stat(filepath.Join(dir, dao.fileName(idx)+extractedSuffix))
+		return err == nil
+	}
+	blk, err := dao.GetBlockByHeight(heights[1])
+	require.NoError(err)
+	require.Equal(heights[1], blk.Height())
+	require.Len(blk.Actions, len(actions))
+	require.True(extracted(1))
+	_, err = dao.HeaderByHeight(heights[2])
+	require.NoError(err)
+	require.False(extracted(1))
+	require.True(extracted(2))
+	_, err = dao.GetBlockByHeight(heights[1])
+	require.NoError(err)
+	require.False(extracted(2))
+
+	// the archives are read-only
+	kvStore, _, err := dao.getDBFromIndex(1)
+	require.NoError(err)
+	require.Equal(ErrArchived, errors.Cause(kvStore.Put(blockHeaderNS, []byte("key"), []byte("value"))))
+
+	// the blocks in the archives are skipped by recompression, without opening the archives
+	recompressed, err := RecompressBlock(dao, heights[2])
+	require.NoError(err)
+	require.False(recompressed)
+	require.False(extracted(2))
+	recompressed, err = RecompressBlock(dao, heights[3])
+	require.NoError(err)
+	require.True(recompressed)
+
+	// the archives are found after restart
+	require.NoError(dao.Stop(ctx))
+	require.False(extracted(1))
+	dao = newDAO()
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+	blk, err = dao.GetBlockByHeight(heights[2])
+	require.NoError(err)
+	require.Equal(heights[2], blk.Height())
+	blk, err = dao.GetBlockByHeight(heights[3])
+	require.NoError(err)
+	require.Equal(heights[3], blk.Height())
+}
+
+func TestHotFileSeal(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "blockdao")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	cfg := config.Default.DB
+	cfg.DbPath = filepath.Join(dir, "chain-00000001.db")
+
+	hot := newHotFile(cfg)
+	require.NoError(hot.Start(context.Background()))
+	require.NoError(hot.Put(blockHeaderNS, []byte("key"), []byte("value")))
+	require.False(isArchived(hot))
+
+	// archive the snapshot of the file
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err = hot.Snapshot(gw)
+	require.NoError(err)
+	require.NoError(gw.Close())
+	store := NewDirArchiveStore(filepath.Join(dir, "archive"))
+	require.NoError(store.Put("chain-00000001.db"+archiveSuffix, &buf))
+	file := newArchivedFile("chain-00000001.db", store, cfg, nil)
+	defer func() {
+		require.NoError(file.Stop(context.Background()))
+	}()
+
+	// sealing waits for the reads in flight
+	hot.mutex.RLock()
+	sealed := make(chan error, 1)
+	go func() {
+		sealed <- hot.seal(file)
+	}()
+	select {
+	case <-sealed:
+		require.Fail("sealed with a read in flight")
+	case <-time.After(100 * time.Millisecond):
+	}
+	hot.mutex.RUnlock()
+	require.NoError(<-sealed)
+
+	// the later reads are served from the archive
+	require.True(isArchived(hot))
+	value, err := hot.Get(blockHeaderNS, []byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+	require.Equal(ErrArchived, errors.Cause(hot.Put(blockHeaderNS, []byte("key"), []byte("value"))))
+	_, err = hot.Snapshot(ioutil.Discard)
+	require.Equal(ErrArchived, errors.Cause(err))
+	// the file closed by sealing is not closed again
+	require.NoError(hot.Stop(context.Background()))
+}
@@ -107,6 +107,8 @@ type (
 
 	blockDAO struct {
 		compressBlock bool
+		codec         compress.Codec
+		dicts         sync.Map // store like map[dictionary id][]byte
 		kvStore       db.KVStore
 		indexers      []BlockIndexer
 		htf           db.RangeIndex
@@ -120,17 +122,46 @@ type (
 		cfg           config.DB
 		mutex         sync.RWMutex // for create new db file
 		tipHeight     uint64
+		archive       ArchiveStore
+		openArchives  *archivedFiles
+		sealing       int32
+		sealWG        sync.WaitGroup
 	}
+
+	// Option sets the optional parameters of block DAO
+	Option func(*blockDAO)
 )
 
+// WithArchiveStore sets the cold storage of the old DB files, which overrides the archive directory in config
+func WithArchiveStore(store ArchiveStore) Option {
+	return func(dao *blockDAO) {
+		dao.archive = store
+	}
+}
+
 // NewBlockDAO instantiates a block DAO
-func NewBlockDAO(kvStore db.KVStore, indexers []BlockIndexer, compressBlock bool, cfg config.DB) BlockDAO {
+func NewBlockDAO(
+	kvStore db.KVStore,
+	indexers []BlockIndexer,
+	compressBlock bool,
+	cfg config.DB,
+	opts ...Option,
+) BlockDAO {
 	blockDAO := &blockDAO{
 		compressBlock: compressBlock,
 		kvStore:       kvStore,
 		cfg:           cfg,
 		indexers:      indexers,
 	}
+	if cfg.ColdStorage.ArchiveDir != "" {
+		blockDAO.archive = NewDirArchiveStore(cfg.ColdStorage.ArchiveDir)
+	}
+	for _, opt := range opts {
+		opt(blockDAO)
+	}
+	if blockDAO.archive != nil {
+		blockDAO.openArchives = newArchivedFiles(cfg.ColdStorage.MaxOpenArchives)
+	}
 	for _, indexer := range indexers {
 		blockDAO.lifecycle.Add(indexer)
 	}
@@ -172,10 +203,17 @@ func (dao *blockDAO) Start(ctx context.Context) error {
 		return err
 	}
 	atomic.StoreUint64(&dao.tipHeight, tipHeight)
+	if err := dao.putDictionary(); err != nil {
+		return err
+	}
 	if err := dao.initStores(); err != nil {
 		return err
 	}
-	return dao.checkIndexers(ctx)
+	if err := dao.checkIndexers(ctx); err != nil {
+		return err
+	}
+	dao.sealFilesAsync()
+	return nil
 }
 
 func (dao *blockDAO) initStores() error {
@@ -288,7 +326,13 @@ func (dao *blockDAO) checkIndexers(ctx context.Context) error {
 	return nil
 }
 
-func (dao *blockDAO) Stop(ctx context.Context) error { return dao.lifecycle.OnStop(ctx) }
+func (dao *blockDAO) Stop(ctx context.Context) error {
+	dao.sealWG.Wait()
+	if err := dao.lifecycle.OnStop(ctx); err != nil {
+		return err
+	}
+	return dao.stopFiles(ctx)
+}
 
 func (dao *blockDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
 	return dao.getBlockHash(height)
@@ -470,6 +514,23 @@ func (dao *blockDAO) KVStore() db.KVStore {
 	return dao.kvStore
 }
 
+// HotFiles returns the split DB files of the block DAO in hot storage keyed by their indexes. The files sealed into
+// cold storage are not included.
+func HotFiles(dao BlockDAO) map[uint64]db.KVStore {
+	files := map[uint64]db.KVStore{}
+	bd, ok := dao.(*blockDAO)
+	if !ok {
+		return files
+	}
+	bd.kvStores.Range(func(k, v interface{}) bool {
+		if kvStore := v.(db.KVStore); !isArchived(kvStore) {
+			files[k.(uint64)] = kvStore
+		}
+		return true
+	})
+	return files
+}
+
 // getBlockHash returns the block hash by height
 func (dao *blockDAO) getBlockHash(height uint64) (hash.Hash256, error) {
 	h := hash.ZeroHash256
@@ -535,13 +596,11 @@ func (dao *blockDAO) header(h hash.Hash256) (*block.Header, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get block header %x", h)
 	}
-	if dao.compressBlock {
-		timer := dao.timerFactory.NewTimer("decompress_header")
-		value, err = compress.Decompress(value)
-		timer.End()
-		if err != nil {
-			return nil, errors.Wrapf(err, "error when decompressing a block header %x", h)
-		}
+	timer := dao.timerFactory.NewTimer("decompress_header")
+	value, err = compress.Decode(value, dao.dictionary)
+	timer.End()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error when decompressing a block header %x", h)
 	}
 	if len(value) == 0 {
 		return nil, errors.Wrapf(db.ErrNotExist, "block header %x is missing", h)
@@ -569,13 +628,11 @@ func (dao *blockDAO) body(h hash.Hash256) (*block.Body, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get block body %x", h)
 	}
-	if dao.compressBlock {
-		timer := dao.timerFactory.NewTimer("decompress_body")
-		value, err = compress.Decompress(value)
-		timer.End()
-		if err != nil {
-			return nil, errors.Wrapf(err, "error when decompressing a block body %x", h)
-		}
+	timer := dao.timerFactory.NewTimer("decompress_body")
+	value, err = compress.Decode(value, dao.dictionary)
+	timer.End()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error when decompressing a block body %x", h)
 	}
 	if len(value) == 0 {
 		return nil, errors.Wrapf(db.ErrNotExist, "block body %x is missing", h)
@@ -603,13 +660,11 @@ func (dao *blockDAO) footer(h hash.Hash256) (*block.Footer, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get block footer %x", h)
 	}
-	if dao.compressBlock {
-		timer := dao.timerFactory.NewTimer("decompress_footer")
-		value, err = compress.Decompress(value)
-		timer.End()
-		if err != nil {
-			return nil, errors.Wrapf(err, "error when decompressing a block footer %x", h)
-		}
+	timer := dao.timerFactory.NewTimer("decompress_footer")
+	value, err = compress.Decode(value, dao.dictionary)
+	timer.End()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error when decompressing a block footer %x", h)
 	}
 	if len(value) == 0 {
 		return nil, errors.Wrapf(db.ErrNotExist, "block footer %x is missing", h)
@@ -690,21 +745,21 @@ func (dao *blockDAO) putBlock(blk *block.Block) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to serialize block footer")
 	}
-	if dao.compressBlock {
+	if dao.codec != nil || dao.compressBlock {
 		timer := dao.timerFactory.NewTimer("compress_header")
-		serHeader, err = compress.Compress(serHeader)
+		serHeader, err = dao.compress(serHeader)
 		timer.End()
 		if err != nil {
 			return errors.Wrapf(err, "error when compressing a block header")
 		}
 		timer = dao.timerFactory.NewTimer("compress_body")
-		serBody, err = compress.Compress(serBody)
+		serBody, err = dao.compress(serBody)
 		timer.End()
 		if err != nil {
 			return errors.Wrapf(err, "error when compressing a block body")
 		}
 		timer = dao.timerFactory.NewTimer("compress_footer")
-		serFooter, err = compress.Compress(serFooter)
+		serFooter, err = dao.compress(serFooter)
 		timer.End()
 		if err != nil {
 			return errors.Wrapf(err, "error when compressing a block footer")
@@ -828,12 +883,7 @@ func (dao *blockDAO) getTopDB(blkHeight uint64) (kvStore db.KVStore, index uint6
 		return dao.kvStore, 0, nil
 	}
 	topIndex := dao.topIndex.Load().(uint64)
-	file, dir := getFileNameAndDir(dao.cfg.DbPath)
-	if err != nil {
-		return
-	}
-	longFileName := dir + "/" + file + fmt.Sprintf("-%08d", topIndex) + ".db"
-	dat, err := os.Stat(longFileName)
+	dat, err := os.Stat(dao.filePath(topIndex))
 	if err != nil && os.IsNotExist(err) {
 		// index the height --> file index mapping
 		if err = dao.indexFile(blkHeight, byteutil.Uint64ToBytesBigEndian(topIndex)); err != nil {
@@ -852,6 +902,8 @@ func (dao *blockDAO) getTopDB(blkHeight uint64) (kvStore db.KVStore, index uint6
 		dao.topIndex.Store(index)
 		// index the height --> file index mapping
 		err = dao.indexFile(blkHeight, byteutil.Uint64ToBytesBigEndian(index))
+		// the files out of the hot ones are sealed into cold storage
+		dao.sealFilesAsync()
 		return
 	}
 	// db exist,need load from kvStores
@@ -896,15 +948,28 @@ func (dao *blockDAO) getDBFromIndex(idx uint64) (kvStore db.KVStore, index uint6
 		index = idx
 		return
 	}
+	// the file not in hot storage may have been sealed into cold storage
+	if _, err = os.Stat(dao.filePath(idx)); os.IsNotExist(err) {
+		if kvStore, err = dao.getArchivedFile(idx); kvStore != nil || err != nil {
+			index = idx
+			return
+		}
+	}
 	// if user rm some db files manully,then call this method will create new file
 	return dao.openDB(idx)
 }
 
 // getBlockValue get block's data from db,if this db failed,it will try the previous one
 func (dao *blockDAO) getBlockValue(blockNS string, h hash.Hash256) ([]byte, error) {
+	value, _, err := dao.locateBlockValue(blockNS, h)
+	return value, err
+}
+
+// locateBlockValue returns block's data and the db it is stored in
+func (dao *blockDAO) locateBlockValue(blockNS string, h hash.Hash256) ([]byte, db.KVStore, error) {
 	whichDB, index, err := dao.getDBFromHash(h)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	value, err := whichDB.Get(blockNS, h[:])
 	if errors.Cause(err) == db.ErrNotExist {
@@ -912,16 +977,16 @@ func (dao *blockDAO) getBlockValue(blockNS string, h hash.Hash256) ([]byte, erro
 		if index == 0 {
 			idx = 0
 		}
-		db, _, err := dao.getDBFromIndex(idx)
+		whichDB, _, err = dao.getDBFromIndex(idx)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		value, err = db.Get(blockNS, h[:])
+		value, err = whichDB.Get(blockNS, h[:])
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return value, err
+	return value, whichDB, err
 }
 
 // openDB open file if exists, or create new file
@@ -930,24 +995,33 @@ func (dao *blockDAO) openDB(idx uint64) (kvStore db.KVStore, index uint64, err e
 		return dao.kvStore, 0, nil
 	}
 	cfg := dao.cfg
-	model, _ := getFileNameAndDir(cfg.DbPath)
-	name := model + fmt.Sprintf("-%08d", idx) + ".db"
 
 	dao.mutex.Lock()
 	defer dao.mutex.Unlock()
 	// open or create this db file
-	cfg.DbPath = path.Dir(cfg.DbPath) + "/" + name
-	kvStore = db.NewBoltDB(cfg)
+	cfg.DbPath = dao.filePath(idx)
+	// the split DB files are closed by the DAO rather than the lifecycle, as they are closed once sealed
+	kvStore = newHotFile(cfg)
 	dao.kvStores.Store(idx, kvStore)
 	err = kvStore.Start(context.Background())
 	if err != nil {
 		return
 	}
-	dao.lifecycle.Add(kvStore)
 	index = idx
 	return
 }
 
+// fileName returns the name of the db file of the index
+func (dao *blockDAO) fileName(idx uint64) string {
+	model, _ := getFileNameAndDir(dao.cfg.DbPath)
+	return model + fmt.Sprintf("-%08d", idx) + ".db"
+}
+
+// filePath returns the path of the db file of the index in hot storage
+func (dao *blockDAO) filePath(idx uint64) string {
+	return path.Dir(dao.cfg.DbPath) + "/" + dao.fileName(idx)
+}
+
 func getFileNameAndDir(p string) (fileName, dir string) {
 	var withSuffix, suffix string
 	withSuffix = path.Base(p)
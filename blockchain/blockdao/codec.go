@@ -0,0 +1,118 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/compress"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// dictionaryNS keeps the dictionaries of the codecs, which are required to decompress the blocks compressed with them
+const dictionaryNS = "dic"
+
+// WithCodec sets the codec compressing the blocks, which takes precedence over compressBlock. The blocks compressed
+// with any codec, and the blocks written before the codecs are tagged, are always readable.
+func WithCodec(codec compress.Codec) Option {
+	return func(dao *blockDAO) {
+		if codec != nil {
+			dao.codec = codec
+		}
+	}
+}
+
+// RecompressBlock recompresses the block of the height with the codec of the block DAO. The blocks in the cold
+// storage are read-only, so they are skipped without opening the archives, and false is returned.
+func RecompressBlock(dao BlockDAO, height uint64) (bool, error) {
+	bd, ok := dao.(*blockDAO)
+	if !ok {
+		return false, errors.New("block DAO does not support recompression")
+	}
+	h, err := bd.getBlockHash(height)
+	if err != nil {
+		return false, err
+	}
+	kvStore, _, err := bd.getDBFromHeight(height)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get DB file of block %d", height)
+	}
+	if isArchived(kvStore) {
+		return false, nil
+	}
+	for _, ns := range []string{blockHeaderNS, blockBodyNS, blockFooterNS} {
+		value, kvStore, err := bd.locateBlockValue(ns, h)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get block %d", height)
+		}
+		if isArchived(kvStore) {
+			return false, nil
+		}
+		data, err := compress.Decode(value, bd.dictionary)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to decompress block %d", height)
+		}
+		if value, err = bd.compress(data); err != nil {
+			return false, errors.Wrapf(err, "failed to compress block %d", height)
+		}
+		if err := kvStore.Put(ns, h[:], value); err != nil {
+			return false, errors.Wrapf(err, "failed to put block %d", height)
+		}
+	}
+	return true, nil
+}
+
+// compress compresses the block data with the codec, or with gzip without tag as before the codecs are tagged
+func (dao *blockDAO) compress(data []byte) ([]byte, error) {
+	if dao.codec != nil {
+		return compress.Encode(dao.codec, data)
+	}
+	if dao.compressBlock {
+		return compress.Compress(data)
+	}
+	return data, nil
+}
+
+// dictionary returns the dictionary of the ID
+func (dao *blockDAO) dictionary(id uint32) ([]byte, error) {
+	if dict, ok := dao.dicts.Load(id); ok {
+		return dict.([]byte), nil
+	}
+	dict, err := dao.kvStore.Get(dictionaryNS, byteutil.Uint32ToBytesBigEndian(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get dictionary %d", id)
+	}
+	dao.dicts.Store(id, dict)
+	return dict, nil
+}
+
+// putDictionary keeps the dictionary of the codec, so that the blocks are readable after the codec is changed
+func (dao *blockDAO) putDictionary() error {
+	dc, ok := dao.codec.(compress.DictionaryCodec)
+	if !ok {
+		return nil
+	}
+	dict := dc.Dictionary()
+	id := compress.DictionaryID(dict)
+	existing, err := dao.kvStore.Get(dictionaryNS, byteutil.Uint32ToBytesBigEndian(id))
+	switch {
+	case err == nil && !bytes.Equal(existing, dict):
+		return errors.Errorf("dictionary %d conflicts with the one stored", id)
+	case err == nil:
+	case errors.Cause(err) == db.ErrNotExist:
+		if err := dao.kvStore.Put(dictionaryNS, byteutil.Uint32ToBytesBigEndian(id), dict); err != nil {
+			return errors.Wrapf(err, "failed to put dictionary %d", id)
+		}
+	default:
+		return err
+	}
+	dao.dicts.Store(id, dict)
+	return nil
+}
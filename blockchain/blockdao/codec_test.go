@@ -0,0 +1,121 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/compress"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestBlockCodec(t *testing.T) {
+	require := require.New(t)
+
+	actions := make([]action.SealedEnvelope, 5)
+	for i := range actions {
+		var err error
+		actions[i], err = testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0),
+			uint64(i+1), big.NewInt(1), nil, testutil.TestGasLimit, big.NewInt(0))
+		require.NoError(err)
+	}
+	blks := make([]*block.Block, 6)
+	prevHash := hash.ZeroHash256
+	var samples [][]byte
+	for i := range blks {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(uint64(i + 1)).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(time.Unix(int64(i+1), 0)).
+			AddActions(actions...).
+			SignAndBuild(identityset.PrivateKey(1))
+		require.NoError(err)
+		blks[i], prevHash = &blk, blk.HashBlock()
+		body, err := blk.Body.Serialize()
+		require.NoError(err)
+		samples = append(samples, body)
+	}
+	dict := compress.TrainDictionary(samples, 4096)
+	deflateDict, err := compress.NewCodec(compress.DeflateDictName, dict)
+	require.NoError(err)
+	snappy, err := compress.NewCodec(compress.SnappyName, nil)
+	require.NoError(err)
+
+	cfg := config.Default.DB
+	cfg.MaxCacheSize = 0
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: genesis.Default})
+	kvStore := db.NewMemKVStore()
+	newDAO := func(compressBlock bool, opts ...Option) BlockDAO {
+		dao := NewBlockDAO(kvStore, nil, compressBlock, cfg, opts...)
+		require.NoError(dao.Start(ctx))
+		return dao
+	}
+	checkBlocks := func(dao BlockDAO) {
+		for _, blk := range blks {
+			stored, err := dao.GetBlockByHeight(blk.Height())
+			require.NoError(err)
+			require.Equal(blk.HashBlock(), stored.HashBlock())
+			require.Equal(blk.TxRoot(), stored.CalculateTxRoot())
+		}
+	}
+
+	// the blocks written without compression, with gzip, and with the codecs are all readable
+	daos := []BlockDAO{
+		newDAO(false),
+		newDAO(true),
+		newDAO(false, WithCodec(snappy)),
+		newDAO(true, WithCodec(deflateDict)),
+	}
+	for i, blk := range blks[:4] {
+		require.NoError(daos[i].PutBlock(ctx, blk))
+		require.NoError(daos[i].Stop(ctx))
+	}
+	dao := newDAO(false)
+	require.NoError(dao.PutBlock(ctx, blks[4]))
+	require.NoError(dao.PutBlock(ctx, blks[5]))
+	h := blks[3].HashBlock()
+	value, err := kvStore.Get(blockBodyNS, h[:])
+	require.NoError(err)
+	require.Equal([]byte{0, byte(compress.DeflateDict)}, value[:2])
+	checkBlocks(dao)
+	require.NoError(dao.Stop(ctx))
+
+	// the blocks are recompressed with the codec
+	dao = newDAO(false, WithCodec(snappy))
+	for _, blk := range blks {
+		recompressed, err := RecompressBlock(dao, blk.Height())
+		require.NoError(err)
+		require.True(recompressed)
+		h := blk.HashBlock()
+		for _, ns := range []string{blockHeaderNS, blockBodyNS, blockFooterNS} {
+			value, err := kvStore.Get(ns, h[:])
+			require.NoError(err)
+			require.Equal([]byte{0, byte(compress.Snappy)}, value[:2])
+		}
+	}
+	checkBlocks(dao)
+	require.NoError(dao.Stop(ctx))
+
+	// a different dictionary of the same ID is rejected
+	require.NoError(kvStore.Put(dictionaryNS, byteutil.Uint32ToBytesBigEndian(compress.DictionaryID(dict)), []byte("conflict")))
+	require.Error(NewBlockDAO(kvStore, nil, false, cfg, WithCodec(deflateDict)).Start(ctx))
+}
@@ -8,6 +8,7 @@ package genesis
 
 import (
 	"flag"
+	"math"
 	"math/big"
 	"sort"
 	"time"
@@ -39,23 +40,27 @@ func init() {
 func defaultConfig() Genesis {
 	return Genesis{
 		Blockchain: Blockchain{
-			Timestamp:               1546329600,
-			BlockGasLimit:           20000000,
-			ActionGasLimit:          5000000,
-			BlockInterval:           10 * time.Second,
-			NumSubEpochs:            2,
-			DardanellesNumSubEpochs: 30,
-			NumDelegates:            24,
-			NumCandidateDelegates:   36,
-			TimeBasedRotation:       false,
-			PacificBlockHeight:      432001,
-			AleutianBlockHeight:     864001,
-			BeringBlockHeight:       1512001,
-			CookBlockHeight:         1641601,
-			DardanellesBlockHeight:  1816201,
-			DaytonaBlockHeight:      3238921,
-			EasterBlockHeight:       4200841,
-			FairbankBlockHeight:     4339081,
+			Timestamp:                1546329600,
+			BlockGasLimit:            20000000,
+			ActionGasLimit:           5000000,
+			BlockInterval:            10 * time.Second,
+			NumSubEpochs:             2,
+			DardanellesNumSubEpochs:  30,
+			NumDelegates:             24,
+			NumCandidateDelegates:    36,
+			TimeBasedRotation:        false,
+			PacificBlockHeight:       432001,
+			AleutianBlockHeight:      864001,
+			BeringBlockHeight:        1512001,
+			CookBlockHeight:          1641601,
+			DardanellesBlockHeight:   1816201,
+			DaytonaBlockHeight:       3238921,
+			EasterBlockHeight:        4200841,
+			FairbankBlockHeight:      4339081,
+			BLSEndorsementHeight:     math.MaxUint64,
+			DoubleSignEvidenceHeight: math.MaxUint64,
+			GovernanceHeight:         math.MaxUint64,
+			StakingStatsHeight:       math.MaxUint64,
 		},
 		Account: Account{
 			InitBalanceMap: make(map[string]string),
@@ -78,6 +83,7 @@ func defaultConfig() Genesis {
 			FoundationBonusStr:             unit.ConvertIotxToRau(80).String(),
 			NumDelegatesForFoundationBonus: 36,
 			FoundationBonusLastEpoch:       8760,
+			EmissionSchedule:               []EmissionStep{},
 		},
 		Staking: Staking{
 			VoteWeightCalConsts: VoteWeightCalConsts{
@@ -93,6 +99,11 @@ func defaultConfig() Genesis {
 			MinStakeAmount:        unit.ConvertIotxToRau(100).String(),
 			BootstrapCandidates:   []BootstrapCandidate{},
 		},
+		Governance: Governance{
+			VotingPeriod:    24,
+			ActivationDelay: 24,
+			PassThreshold:   67,
+		},
 	}
 }
 
@@ -122,6 +133,7 @@ type (
 		Poll       `yaml:"poll"`
 		Rewarding  `yaml:"rewarding"`
 		Staking    `yaml:"staking"`
+		Governance `yaml:"governance"`
 	}
 	// Blockchain contains blockchain level configs
 	Blockchain struct {
@@ -160,6 +172,15 @@ type (
 		EasterBlockHeight uint64 `yaml:"easterHeight"`
 		// FairbankBlockHeight is the start height to switch to native staking V2
 		FairbankBlockHeight uint64 `yaml:"fairbankHeight"`
+		// BLSEndorsementHeight is the start height of replacing the commit endorsements in block footers with an
+		// aggregate BLS signature of delegates
+		BLSEndorsementHeight uint64 `yaml:"blsEndorsementHeight"`
+		// DoubleSignEvidenceHeight is the start height of committing the evidences of double signs into blocks
+		DoubleSignEvidenceHeight uint64 `yaml:"doubleSignEvidenceHeight"`
+		// GovernanceHeight is the start height of proposing and voting on protocol parameter changes
+		GovernanceHeight uint64 `yaml:"governanceHeight"`
+		// StakingStatsHeight is the start height of keeping the aggregate statistics and voter counts of staking
+		StakingStatsHeight uint64 `yaml:"stakingStatsHeight"`
 	}
 	// Account contains the configs for account protocol
 	Account struct {
@@ -200,6 +221,8 @@ type (
 		ProbationIntensityRate uint32 `yaml:"probationIntensityRate"`
 		// UnproductiveDelegateMaxCacheSize is a max cache size of upd which is stored into state DB (probationEpochPeriod <= UnproductiveDelegateMaxCacheSize)
 		UnproductiveDelegateMaxCacheSize uint64 `yaml:unproductiveDelegateMaxCacheSize`
+		// DoubleSignSlashRate is the percentage range from [0, 100] of self-stake burnt when a delegate double signs
+		DoubleSignSlashRate uint32 `yaml:"doubleSignSlashRate"`
 	}
 	// Delegate defines a delegate with address and votes
 	Delegate struct {
@@ -235,6 +258,26 @@ type (
 		// ProductivityThreshold is the percentage number that a delegate's productivity needs to reach to get the
 		// epoch reward
 		ProductivityThreshold uint64 `yaml:"productivityThreshold"`
+		// EmissionSchedule is the list of block and epoch reward changes in the order of start epoch. It replaces the
+		// rewards switched at Aleutian and Dardanelles height, so it has to start after the epochs of both heights.
+		EmissionSchedule []EmissionStep `yaml:"emissionSchedule"`
+		// MaxTotalRewardStr is the cap of the total reward granted from the fund in decimal string format, empty for
+		// no cap
+		MaxTotalRewardStr string `yaml:"maxTotalReward"`
+	}
+	// EmissionStep defines the block and epoch reward starting from an epoch
+	EmissionStep struct {
+		// StartEpoch is the epoch from which the rewards are in effect
+		StartEpoch uint64 `yaml:"startEpoch"`
+		// BlockRewardStr is the block reward amount in decimal string format
+		BlockRewardStr string `yaml:"blockReward"`
+		// EpochRewardStr is the epoch reward amount in decimal string format
+		EpochRewardStr string `yaml:"epochReward"`
+		// DecayRate is the percentage range from [0, 100) the rewards decrease by every DecayInterval epochs, e.g., 50
+		// for halving
+		DecayRate uint32 `yaml:"decayRate"`
+		// DecayInterval is the number of epochs between two decays, 0 for no decay
+		DecayInterval uint64 `yaml:"decayInterval"`
 	}
 	// Staking contains the configs for staking protocol
 	Staking struct {
@@ -245,6 +288,16 @@ type (
 		BootstrapCandidates   []BootstrapCandidate `yaml:"bootstrapCandidates"`
 	}
 
+	// Governance contains the configs for governance protocol
+	Governance struct {
+		// VotingPeriod is the number of epochs a proposal is open for voting
+		VotingPeriod uint64 `yaml:"votingPeriod"`
+		// ActivationDelay is the number of epochs between the end of voting and the activation of a passed proposal
+		ActivationDelay uint64 `yaml:"activationDelay"`
+		// PassThreshold is the percentage range from (0, 100] of the total delegate votes needed to pass a proposal
+		PassThreshold uint32 `yaml:"passThreshold"`
+	}
+
 	// VoteWeightCalConsts contains the configs for calculating vote weight
 	VoteWeightCalConsts struct {
 		DurationLg float64 `yaml:"durationLg"`
@@ -462,6 +515,36 @@ func (r *Rewarding) DardanellesBlockReward() *big.Int {
 	return val
 }
 
+// MaxTotalReward returns the cap of the total reward, or nil if there is no cap
+func (r *Rewarding) MaxTotalReward() *big.Int {
+	if r.MaxTotalRewardStr == "" {
+		return nil
+	}
+	val, ok := big.NewInt(0).SetString(r.MaxTotalRewardStr, 10)
+	if !ok {
+		log.S().Panicf("Error when casting max total reward string %s into big int", r.MaxTotalRewardStr)
+	}
+	return val
+}
+
+// BlockReward returns the block reward amount of the emission step
+func (s *EmissionStep) BlockReward() *big.Int {
+	val, ok := big.NewInt(0).SetString(s.BlockRewardStr, 10)
+	if !ok {
+		log.S().Panicf("Error when casting block reward string %s into big int", s.BlockRewardStr)
+	}
+	return val
+}
+
+// EpochReward returns the epoch reward amount of the emission step
+func (s *EmissionStep) EpochReward() *big.Int {
+	val, ok := big.NewInt(0).SetString(s.EpochRewardStr, 10)
+	if !ok {
+		log.S().Panicf("Error when casting epoch reward string %s into big int", s.EpochRewardStr)
+	}
+	return val
+}
+
 // ExemptAddrsFromEpochReward returns the list of addresses that exempt from epoch reward
 func (r *Rewarding) ExemptAddrsFromEpochReward() []address.Address {
 	addrs := make([]address.Address, 0)
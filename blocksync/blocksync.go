@@ -7,17 +7,23 @@
 package blocksync
 
 import (
+	"bytes"
 	"context"
+	"time"
 
+	"github.com/facebookgo/clock"
 	"github.com/golang/protobuf/proto"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
@@ -30,6 +36,28 @@ type (
 	Neighbors func(ctx context.Context) ([]peerstore.PeerInfo, error)
 )
 
+const (
+	// maxHeadersPerResponse is the maximal number of headers in a response
+	maxHeadersPerResponse = 500
+	// maxBodiesPerRequest is the maximal number of bodies served for a request
+	maxBodiesPerRequest = 200
+	// maxBodiesSize is the size of the bodies above which the bodies are sent in another message
+	maxBodiesSize = 1 << 20
+	// advertiseRefreshInterval is the interval to advertise an unchanged tip height to a peer again
+	advertiseRefreshInterval = time.Minute
+)
+
+func init() {
+	p2p.RegisterMessage(iotexrpc.MessageType_PEER_STATUS, &blocksyncpb.PeerStatus{})
+	p2p.RegisterMessage(iotexrpc.MessageType_HEADERS_REQUEST, &blocksyncpb.HeadersRequest{})
+	p2p.RegisterMessage(iotexrpc.MessageType_HEADERS, &blocksyncpb.Headers{})
+	p2p.RegisterMessage(iotexrpc.MessageType_BODIES_REQUEST, &blocksyncpb.BodiesRequest{})
+	p2p.RegisterMessage(iotexrpc.MessageType_BODIES, &blocksyncpb.Bodies{})
+	p2p.RegisterMessage(iotexrpc.MessageType_COMPACT_BLOCK, &blocksyncpb.CompactBlock{})
+	p2p.RegisterMessage(iotexrpc.MessageType_ACTIONS_REQUEST, &blocksyncpb.ActionsRequest{})
+	p2p.RegisterMessage(iotexrpc.MessageType_BLOCK_ACTIONS, &blocksyncpb.BlockActions{})
+}
+
 // BlockDAO represents the block data access object
 type BlockDAO interface {
 	GetBlockByHeight(uint64) (*block.Block, error)
@@ -68,6 +96,14 @@ type BlockSync interface {
 	ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error
 	ProcessBlock(ctx context.Context, blk *block.Block) error
 	ProcessBlockSync(ctx context.Context, blk *block.Block) error
+	ProcessPeerStatus(ctx context.Context, peer peerstore.PeerInfo, status *blocksyncpb.PeerStatus) error
+	ProcessHeadersRequest(ctx context.Context, peer peerstore.PeerInfo, req *blocksyncpb.HeadersRequest) error
+	ProcessHeaders(ctx context.Context, peer peerstore.PeerInfo, headers *blocksyncpb.Headers) error
+	ProcessBodiesRequest(ctx context.Context, peer peerstore.PeerInfo, req *blocksyncpb.BodiesRequest) error
+	ProcessBodies(ctx context.Context, peer peerstore.PeerInfo, bodies *blocksyncpb.Bodies) error
+	ProcessCompactBlock(ctx context.Context, cb *blocksyncpb.CompactBlock) error
+	ProcessActionsRequest(ctx context.Context, peer peerstore.PeerInfo, req *blocksyncpb.ActionsRequest) error
+	ProcessBlockActions(ctx context.Context, peer peerstore.PeerInfo, actions *blocksyncpb.BlockActions) error
 }
 
 // blockSyncer implements BlockSync interface
@@ -75,6 +111,7 @@ type blockSyncer struct {
 	commitHeight     uint64 // last commit block height
 	buf              *blockBuffer
 	worker           *syncWorker
+	compact          *compactBlocks
 	bc               blockchain.Blockchain
 	dao              BlockDAO
 	unicastHandler   UnicastOutbound
@@ -112,6 +149,7 @@ func NewBlockSyncer(
 		neighborsHandler: bsCfg.neighborsHandler,
 		worker:           newSyncWorker(chain.ChainID(), cfg, bsCfg.unicastHandler, bsCfg.neighborsHandler, buf),
 	}
+	bs.compact = newCompactBlocks(ap, cfg.BlockSync.CompactBlockTimeout, clock.New(), bs.requestBlock)
 	return bs, nil
 }
 
@@ -136,7 +174,10 @@ func (bs *blockSyncer) Stop(ctx context.Context) error {
 }
 
 // ProcessBlock processes an incoming latest committed block
-func (bs *blockSyncer) ProcessBlock(_ context.Context, blk *block.Block) error {
+func (bs *blockSyncer) ProcessBlock(ctx context.Context, blk *block.Block) error {
+	if peerID, ok := p2p.GetPeerID(ctx); ok && blk != nil {
+		bs.worker.peers.Receive(peerID, blockRequest, blk.Height())
+	}
 	var needSync bool
 	moved, re := bs.buf.Flush(blk)
 	switch re {
@@ -166,8 +207,226 @@ func (bs *blockSyncer) ProcessBlockSync(_ context.Context, blk *block.Block) err
 	return nil
 }
 
+// ProcessPeerStatus processes the status advertised by a peer
+func (bs *blockSyncer) ProcessPeerStatus(_ context.Context, peer peerstore.PeerInfo, status *blocksyncpb.PeerStatus) error {
+	bs.worker.peers.SetHeight(peer, status.TipHeight)
+	bs.worker.SetTargetHeight(status.TipHeight)
+	if bs.worker.headers != nil && status.TipHeight > bs.bc.TipHeight() {
+		// the headers are small enough to request right away
+		bs.worker.Fetch()
+	}
+	return nil
+}
+
+// ProcessHeadersRequest processes a request for the headers of blocks
+func (bs *blockSyncer) ProcessHeadersRequest(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	req *blocksyncpb.HeadersRequest,
+) error {
+	if req.Start == 0 || req.Start > req.End {
+		p2p.ReportPeer(ctx, p2p.InvalidSyncRequest)
+		return errors.Errorf("invalid headers request from %d to %d", req.Start, req.End)
+	}
+	end := bs.bc.TipHeight()
+	if req.End < end {
+		end = req.End
+	}
+	if end >= req.Start+maxHeadersPerResponse {
+		end = req.Start + maxHeadersPerResponse - 1
+	}
+	headers := &blocksyncpb.Headers{}
+	for i := req.Start; i <= end; i++ {
+		header, err := bs.bc.BlockHeaderByHeight(i)
+		if err != nil {
+			return err
+		}
+		footer, err := bs.bc.BlockFooterByHeight(i)
+		if err != nil {
+			return err
+		}
+		footerPb, err := footer.ConvertToBlockFooterPb()
+		if err != nil {
+			return err
+		}
+		headers.Headers = append(headers.Headers, &blocksyncpb.HeaderWithFooter{
+			Header: header.BlockHeaderProto(),
+			Footer: footerPb,
+		})
+	}
+	if len(headers.Headers) == 0 {
+		return nil
+	}
+	return bs.unicastHandler(context.Background(), peer, headers)
+}
+
+// ProcessHeaders processes the headers of blocks in header-first mode
+func (bs *blockSyncer) ProcessHeaders(ctx context.Context, peer peerstore.PeerInfo, headers *blocksyncpb.Headers) error {
+	if bs.worker.headers == nil {
+		return errors.New("header-first sync is disabled")
+	}
+	top, err := bs.worker.headers.AddHeaders(ctx, headers.Headers)
+	if err != nil {
+		log.L().Debug("Failed to add headers.", zap.String("peerID", peer.ID.Pretty()), zap.Error(err))
+	} else if n := len(headers.Headers); n > 0 {
+		bs.worker.peers.Receive(peer.ID.Pretty(), headerRequest, headers.Headers[n-1].GetHeader().GetCore().GetHeight())
+	}
+	log.L().Debug("Received headers.", zap.Int("headers", len(headers.Headers)), zap.Uint64("top", top))
+	bs.worker.Fetch()
+	return err
+}
+
+// ProcessBodiesRequest processes a request for the bodies of blocks
+func (bs *blockSyncer) ProcessBodiesRequest(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	req *blocksyncpb.BodiesRequest,
+) error {
+	if len(req.Heights) > maxBodiesPerRequest {
+		p2p.ReportPeer(ctx, p2p.InvalidSyncRequest)
+		return errors.Errorf("too many bodies requested: %d", len(req.Heights))
+	}
+	var (
+		tipHeight = bs.bc.TipHeight()
+		bodies    = &blocksyncpb.Bodies{}
+		size      int
+	)
+	for _, height := range req.Heights {
+		if height == 0 || height > tipHeight {
+			continue
+		}
+		blk, err := bs.dao.GetBlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		body := &blocksyncpb.BodyWithHeight{Height: height, Body: blk.Body.Proto()}
+		bodies.Bodies = append(bodies.Bodies, body)
+		if size += proto.Size(body); size < maxBodiesSize {
+			continue
+		}
+		if err := bs.unicastHandler(context.Background(), peer, bodies); err != nil {
+			log.L().Debug("Failed to response to ProcessBodiesRequest.", zap.Error(err))
+		}
+		bodies, size = &blocksyncpb.Bodies{}, 0
+	}
+	if len(bodies.Bodies) == 0 {
+		return nil
+	}
+	return bs.unicastHandler(context.Background(), peer, bodies)
+}
+
+// ProcessBodies processes the bodies of blocks in header-first mode
+func (bs *blockSyncer) ProcessBodies(ctx context.Context, peer peerstore.PeerInfo, bodies *blocksyncpb.Bodies) error {
+	if bs.worker.headers == nil {
+		return errors.New("header-first sync is disabled")
+	}
+	for _, blk := range bs.worker.headers.AddBodies(ctx, bodies.Bodies) {
+		bs.worker.peers.Receive(peer.ID.Pretty(), blockRequest, blk.Height())
+		bs.buf.Flush(blk)
+	}
+	bs.worker.Fetch()
+	return nil
+}
+
+// ProcessCompactBlock processes a compact block broadcast by the producer. The block is rebuilt from the actions in
+// the actpool, and the actions missing are requested from the peer sending the compact block.
+func (bs *blockSyncer) ProcessCompactBlock(ctx context.Context, cb *blocksyncpb.CompactBlock) error {
+	height := cb.GetHeader().GetCore().GetHeight()
+	blk, missing, err := bs.compact.Add(ctx, cb, bs.bc.TipHeight())
+	switch {
+	case errors.Cause(err) == ErrTxRootMismatch:
+		bs.requestBlock(height)
+		return err
+	case err != nil:
+		return err
+	case blk == nil:
+		return nil
+	case len(missing) == 0:
+		return bs.ProcessBlock(ctx, blk)
+	}
+	log.L().Debug("Actions missing in compact block.", zap.Uint64("height", height), zap.Int("missing", len(missing)))
+	blkHash := blk.HashBlock()
+	req := &blocksyncpb.ActionsRequest{Height: height, BlockHash: blkHash[:], Indexes: missing}
+	peer, ok := peerstore.PeerInfo{}, false
+	if peerID, known := p2p.GetPeerID(ctx); known {
+		peer, ok = bs.worker.peers.Peer(peerID)
+	}
+	if !ok {
+		// the producer may not be a neighbor, and then the actions are requested from the best peer
+		peers := bs.worker.peers.Pick(syncBlocksInterval{Start: height, End: height}, 1, nil)
+		if len(peers) == 0 {
+			bs.requestBlock(height)
+			return nil
+		}
+		peer = peers[0]
+	}
+	return bs.unicastHandler(context.Background(), peer, req)
+}
+
+// ProcessActionsRequest processes a request for the actions of a block
+func (bs *blockSyncer) ProcessActionsRequest(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	req *blocksyncpb.ActionsRequest,
+) error {
+	if req.Height == 0 || req.Height > bs.bc.TipHeight() {
+		return nil
+	}
+	blk, err := bs.dao.GetBlockByHeight(req.Height)
+	if err != nil {
+		return err
+	}
+	blkHash := blk.HashBlock()
+	if !bytes.Equal(blkHash[:], req.BlockHash) {
+		// the block produced on another branch
+		return nil
+	}
+	actions := &blocksyncpb.BlockActions{Height: req.Height, BlockHash: req.BlockHash}
+	for _, i := range req.Indexes {
+		if i >= uint32(len(blk.Actions)) {
+			p2p.ReportPeer(ctx, p2p.InvalidSyncRequest)
+			return errors.Errorf("action index %d out of range %d", i, len(blk.Actions))
+		}
+		actions.Actions = append(actions.Actions, &blocksyncpb.IndexedAction{Index: i, Action: blk.Actions[i].Proto()})
+	}
+	return bs.unicastHandler(context.Background(), peer, actions)
+}
+
+// ProcessBlockActions processes the actions missing to rebuild a compact block
+func (bs *blockSyncer) ProcessBlockActions(
+	ctx context.Context,
+	_ peerstore.PeerInfo,
+	actions *blocksyncpb.BlockActions,
+) error {
+	blk, err := bs.compact.Fill(ctx, actions)
+	switch {
+	case errors.Cause(err) == ErrTxRootMismatch:
+		bs.requestBlock(actions.Height)
+		return err
+	case err != nil:
+		return err
+	case blk != nil:
+		return bs.ProcessBlock(ctx, blk)
+	}
+	return nil
+}
+
+// requestBlock requests the full block of the height, which cannot be rebuilt from the compact block
+func (bs *blockSyncer) requestBlock(height uint64) {
+	if height <= bs.bc.TipHeight() {
+		return
+	}
+	log.L().Debug("Request the full block instead of the compact block.", zap.Uint64("height", height))
+	bs.worker.SetTargetHeight(height)
+	bs.worker.RequestBlock(height)
+}
+
 // ProcessSyncRequest processes a block sync request
 func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
+	if sync.Start == 0 || sync.Start > sync.End {
+		p2p.ReportPeer(ctx, p2p.InvalidSyncRequest)
+		return errors.Errorf("invalid block sync request from %d to %d", sync.Start, sync.End)
+	}
 	end := bs.bc.TipHeight()
 	switch {
 	case sync.End < end:
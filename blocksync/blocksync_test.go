@@ -8,18 +8,23 @@ package blocksync
 
 import (
 	"context"
+	"math/big"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/facebookgo/clock"
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/proto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
@@ -30,8 +35,10 @@ import (
 	bc "github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
@@ -171,6 +178,15 @@ func TestBlockSyncerProcessSyncRequestError(t *testing.T) {
 		End:   5,
 	}
 	require.Error(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, pbBs))
+
+	// the peer sending an invalid range is reported
+	reputation := p2p.NewReputation(cfg.Network.Reputation)
+	ctx := p2p.WithPeerReputation(context.Background(), "peer", reputation)
+	require.Error(bs.ProcessSyncRequest(ctx, peerstore.PeerInfo{}, &iotexrpc.BlockSync{Start: 5, End: 1}))
+	peers := reputation.Peers()
+	require.Len(peers, 1)
+	require.Equal("peer", peers[0].ID)
+	require.True(peers[0].Score < 0)
 }
 
 func TestBlockSyncerProcessBlockTipHeight(t *testing.T) {
@@ -487,6 +503,293 @@ func TestBlockSyncerSync(t *testing.T) {
 	time.Sleep(time.Millisecond << 7)
 }
 
+func TestBlockSyncerPeerStatus(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mBc := mock_blockchain.NewMockBlockchain(ctrl)
+	mBc.EXPECT().ChainID().AnyTimes().Return(config.Default.Chain.ID)
+	mBc.EXPECT().TipHeight().AnyTimes().Return(uint64(0))
+	cfg, err := newTestConfig()
+	require.NoError(err)
+
+	peers := []peerstore.PeerInfo{{ID: "peer1"}, {ID: "peer2"}}
+	requests := make(map[string][]proto.Message)
+	bs, err := NewBlockSyncer(cfg, mBc, nil, nil, nil,
+		WithUnicastOutBound(func(_ context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			requests[peer.ID.Pretty()] = append(requests[peer.ID.Pretty()], msg)
+			return nil
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) { return peers, nil }),
+	)
+	require.NoError(err)
+	mClock := clock.NewMock()
+	bs.(*blockSyncer).worker.peers.clock = mClock
+
+	// the intervals are requested from the peer having them, and not requested again while in flight
+	require.NoError(bs.ProcessPeerStatus(context.Background(), peers[1], &blocksyncpb.PeerStatus{TipHeight: 50}))
+	require.Equal(uint64(50), bs.TargetHeight())
+	status := &blocksyncpb.PeerStatus{TipHeight: 0}
+	bs.(*blockSyncer).worker.Sync()
+	require.Equal([]proto.Message{status}, requests[peers[0].ID.Pretty()])
+	require.Equal([]proto.Message{
+		status,
+		&iotexrpc.BlockSync{Start: 1, End: 20},
+		&iotexrpc.BlockSync{Start: 21, End: 40},
+		&iotexrpc.BlockSync{Start: 41, End: 50},
+	}, requests[peers[1].ID.Pretty()])
+	// the status is not advertised again until the tip height changes, or the refresh interval passes
+	bs.(*blockSyncer).worker.Sync()
+	require.Len(requests[peers[0].ID.Pretty()], 1)
+	require.Len(requests[peers[1].ID.Pretty()], 4)
+	advertised := func() int {
+		n := 0
+		for _, msg := range requests[peers[0].ID.Pretty()] {
+			if _, ok := msg.(*blocksyncpb.PeerStatus); ok {
+				n++
+			}
+		}
+		return n
+	}
+	mClock.Add(advertiseRefreshInterval / 2)
+	bs.(*blockSyncer).worker.Sync()
+	require.Equal(1, advertised())
+	mClock.Add(advertiseRefreshInterval / 2)
+	bs.(*blockSyncer).worker.Sync()
+	require.Equal(2, advertised())
+}
+
+func TestBlockSyncerHeaderFirst(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	cfg, err := newTestConfig()
+	require.NoError(err)
+	cfg.BlockSync.Interval = 0
+	cfg.BlockSync.HeaderFirst = true
+	cs := mock_consensus.NewMockConsensus(ctrl)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).Return(nil).AnyTimes()
+	cs.EXPECT().Calibrate(gomock.Any()).AnyTimes()
+
+	// the server has 30 blocks, and the client has none
+	serverChain, serverDAO, serverAP := newTestChain(t, cfg)
+	defer func() { require.NoError(serverChain.Stop(ctx)) }()
+	for i := 0; i < 30; i++ {
+		tsf, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0), uint64(i+1),
+			big.NewInt(1), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+		require.NoError(err)
+		blk, err := serverChain.MintNewBlock(map[string][]action.SealedEnvelope{
+			identityset.Address(0).String(): {tsf},
+		}, testutil.TimestampNow())
+		require.NoError(err)
+		require.NoError(serverChain.CommitBlock(blk))
+	}
+	clientChain, clientDAO, clientAP := newTestChain(t, cfg)
+	defer func() { require.NoError(clientChain.Stop(ctx)) }()
+
+	var (
+		server, client         BlockSync
+		serverPeer, clientPeer = peerstore.PeerInfo{ID: "server"}, peerstore.PeerInfo{ID: "client"}
+		mutex                  sync.Mutex
+		msgs                   = make(map[string]int)
+	)
+	// deliver the messages asynchronously as the agent does
+	deliver := func(to BlockSync, from peerstore.PeerInfo) UnicastOutbound {
+		return func(_ context.Context, _ peerstore.PeerInfo, msg proto.Message) error {
+			mutex.Lock()
+			msgs[proto.MessageName(msg)]++
+			mutex.Unlock()
+			go func() {
+				switch m := msg.(type) {
+				case *blocksyncpb.PeerStatus:
+					assert.NoError(t, to.ProcessPeerStatus(ctx, from, m))
+				case *blocksyncpb.HeadersRequest:
+					assert.NoError(t, to.ProcessHeadersRequest(ctx, from, m))
+				case *blocksyncpb.Headers:
+					assert.NoError(t, to.ProcessHeaders(ctx, from, m))
+				case *blocksyncpb.BodiesRequest:
+					assert.NoError(t, to.ProcessBodiesRequest(ctx, from, m))
+				case *blocksyncpb.Bodies:
+					assert.NoError(t, to.ProcessBodies(ctx, from, m))
+				}
+			}()
+			return nil
+		}
+	}
+	server, err = NewBlockSyncer(cfg, serverChain, serverDAO, serverAP, cs,
+		WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			return deliver(client, serverPeer)(ctx, peer, msg)
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) {
+			return []peerstore.PeerInfo{clientPeer}, nil
+		}),
+	)
+	require.NoError(err)
+	client, err = NewBlockSyncer(cfg, clientChain, clientDAO, clientAP, cs,
+		WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			return deliver(server, clientPeer)(ctx, peer, msg)
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) {
+			return []peerstore.PeerInfo{serverPeer}, nil
+		}),
+	)
+	require.NoError(err)
+
+	// the client syncs the headers first and then the bodies in batches once the server advertises its height
+	server.(*blockSyncer).worker.Sync()
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return clientChain.TipHeight() == 30, nil
+	}))
+	for i := uint64(1); i <= 30; i++ {
+		expected, err := serverDAO.GetBlockByHeight(i)
+		require.NoError(err)
+		blk, err := clientDAO.GetBlockByHeight(i)
+		require.NoError(err)
+		require.Equal(expected.HashBlock(), blk.HashBlock())
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Equal(1, msgs["blocksyncpb.HeadersRequest"])
+	require.Equal(2, msgs["blocksyncpb.BodiesRequest"])
+	require.Zero(msgs["iotexrpc.BlockSync"])
+}
+
+func TestBlockSyncerCompactBlock(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	cfg, err := newTestConfig()
+	require.NoError(err)
+	cfg.BlockSync.Interval = 0
+	cfg.ActPool.MinGasPriceStr = "0"
+	cs := mock_consensus.NewMockConsensus(ctrl)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).Return(nil).AnyTimes()
+	cs.EXPECT().Calibrate(gomock.Any()).AnyTimes()
+
+	serverChain, serverDAO, serverAP := newTestChain(t, cfg)
+	defer func() { require.NoError(serverChain.Stop(ctx)) }()
+	clientChain, clientDAO, clientAP := newTestChain(t, cfg)
+	defer func() { require.NoError(clientChain.Stop(ctx)) }()
+	tsfs := make([]action.SealedEnvelope, 3)
+	for i := range tsfs {
+		tsfs[i], err = testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0), uint64(i+1),
+			big.NewInt(1), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+		require.NoError(err)
+	}
+	// the client has received the first transfer only
+	require.NoError(clientAP.Add(ctx, tsfs[0]))
+
+	var (
+		server, client         BlockSync
+		serverPeer, clientPeer = peerstore.PeerInfo{ID: "server"}, peerstore.PeerInfo{ID: "client"}
+		mutex                  sync.Mutex
+		msgs                   = make(map[string]int)
+		dropActionsRequest     bool
+	)
+	deliver := func(to BlockSync, from peerstore.PeerInfo) UnicastOutbound {
+		return func(_ context.Context, _ peerstore.PeerInfo, msg proto.Message) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			msgs[proto.MessageName(msg)]++
+			if _, ok := msg.(*blocksyncpb.ActionsRequest); ok && dropActionsRequest {
+				return nil
+			}
+			go func() {
+				switch m := msg.(type) {
+				case *blocksyncpb.ActionsRequest:
+					assert.NoError(t, to.ProcessActionsRequest(ctx, from, m))
+				case *blocksyncpb.BlockActions:
+					assert.NoError(t, to.ProcessBlockActions(ctx, from, m))
+				case *iotexrpc.BlockSync:
+					assert.NoError(t, to.ProcessSyncRequest(ctx, from, m))
+				case *iotextypes.Block:
+					blk := &block.Block{}
+					assert.NoError(t, blk.ConvertFromBlockPb(m))
+					assert.NoError(t, to.ProcessBlock(ctx, blk))
+				}
+			}()
+			return nil
+		}
+	}
+	server, err = NewBlockSyncer(cfg, serverChain, serverDAO, serverAP, cs,
+		WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			return deliver(client, serverPeer)(ctx, peer, msg)
+		}),
+	)
+	require.NoError(err)
+	client, err = NewBlockSyncer(cfg, clientChain, clientDAO, clientAP, cs,
+		WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			return deliver(server, clientPeer)(ctx, peer, msg)
+		}),
+	)
+	require.NoError(err)
+	client.(*blockSyncer).worker.peers.SetPeers([]peerstore.PeerInfo{serverPeer})
+	c := clock.NewMock()
+	client.(*blockSyncer).compact.clock = c
+	broadcast := func(selps ...action.SealedEnvelope) {
+		blk, err := serverChain.MintNewBlock(map[string][]action.SealedEnvelope{
+			identityset.Address(0).String(): selps,
+		}, testutil.TimestampNow())
+		require.NoError(err)
+		require.NoError(serverChain.CommitBlock(blk))
+		cb, err := NewCompactBlock(blk.ConvertToBlockPb())
+		require.NoError(err)
+		require.NoError(client.ProcessCompactBlock(p2p.WithPeerReputation(ctx, serverPeer.ID.Pretty(), nil), cb))
+	}
+
+	// the client requests the transfer missing in its actpool from the peer broadcasting the compact block
+	broadcast(tsfs[0], tsfs[1])
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return clientChain.TipHeight() == 1, nil
+	}))
+
+	// the client requests the full block if the missing transfer does not arrive in time
+	mutex.Lock()
+	dropActionsRequest = true
+	mutex.Unlock()
+	broadcast(tsfs[2])
+	c.Add(cfg.BlockSync.CompactBlockTimeout)
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return clientChain.TipHeight() == 2, nil
+	}))
+	for i := uint64(1); i <= 2; i++ {
+		expected, err := serverDAO.GetBlockByHeight(i)
+		require.NoError(err)
+		blk, err := clientDAO.GetBlockByHeight(i)
+		require.NoError(err)
+		require.Equal(expected.HashBlock(), blk.HashBlock())
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Equal(2, msgs["blocksyncpb.ActionsRequest"])
+	require.Equal(1, msgs["blocksyncpb.BlockActions"])
+	require.Equal(1, msgs["iotexrpc.BlockSync"])
+	require.Equal(1, msgs["iotextypes.Block"])
+}
+
+func newTestChain(t *testing.T, cfg config.Config) (blockchain.Blockchain, blockdao.BlockDAO, actpool.ActPool) {
+	require := require.New(t)
+	registry := protocol.NewRegistry()
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+	require.NoError(rp.Register(registry))
+	sf, err := factory.NewFactory(cfg, factory.InMemTrieOption(), factory.RegistryOption(registry))
+	require.NoError(err)
+	ap, err := actpool.NewActPool(sf, cfg.ActPool, actpool.EnableExperimentalActions())
+	require.NoError(err)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+	dao := blockdao.NewBlockDAO(db.NewMemKVStore(), []blockdao.BlockIndexer{sf}, cfg.Chain.CompressBlock, cfg.DB)
+	chain := bc.NewBlockchain(cfg, dao, sf, bc.BlockValidatorOption(block.NewValidator(sf, ap)))
+	require.NoError(chain.Start(context.Background()))
+	return chain, dao, ap
+}
+
 func newTestConfig() (config.Config, error) {
 	testTriePath, err := testutil.PathOfTempFile("trie")
 	if err != nil {
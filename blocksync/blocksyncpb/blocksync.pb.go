@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: blocksync.proto
+
+package blocksyncpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type PeerStatus struct {
+	TipHeight            uint64   `protobuf:"varint,1,opt,name=tipHeight,proto3" json:"tipHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerStatus) Reset()         { *m = PeerStatus{} }
+func (m *PeerStatus) String() string { return proto.CompactTextString(m) }
+func (*PeerStatus) ProtoMessage()    {}
+func (*PeerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{0}
+}
+
+func (m *PeerStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerStatus.Unmarshal(m, b)
+}
+func (m *PeerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerStatus.Marshal(b, m, deterministic)
+}
+func (m *PeerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerStatus.Merge(m, src)
+}
+func (m *PeerStatus) XXX_Size() int {
+	return xxx_messageInfo_PeerStatus.Size(m)
+}
+func (m *PeerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerStatus proto.InternalMessageInfo
+
+func (m *PeerStatus) GetTipHeight() uint64 {
+	if m != nil {
+		return m.TipHeight
+	}
+	return 0
+}
+
+type HeadersRequest struct {
+	Start                uint64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  uint64   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeadersRequest) Reset()         { *m = HeadersRequest{} }
+func (m *HeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*HeadersRequest) ProtoMessage()    {}
+func (*HeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{1}
+}
+
+func (m *HeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeadersRequest.Unmarshal(m, b)
+}
+func (m *HeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *HeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadersRequest.Merge(m, src)
+}
+func (m *HeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_HeadersRequest.Size(m)
+}
+func (m *HeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadersRequest proto.InternalMessageInfo
+
+func (m *HeadersRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *HeadersRequest) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type HeaderWithFooter struct {
+	Header               *iotextypes.BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Footer               *iotextypes.BlockFooter `protobuf:"bytes,2,opt,name=footer,proto3" json:"footer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *HeaderWithFooter) Reset()         { *m = HeaderWithFooter{} }
+func (m *HeaderWithFooter) String() string { return proto.CompactTextString(m) }
+func (*HeaderWithFooter) ProtoMessage()    {}
+func (*HeaderWithFooter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{2}
+}
+
+func (m *HeaderWithFooter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeaderWithFooter.Unmarshal(m, b)
+}
+func (m *HeaderWithFooter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeaderWithFooter.Marshal(b, m, deterministic)
+}
+func (m *HeaderWithFooter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderWithFooter.Merge(m, src)
+}
+func (m *HeaderWithFooter) XXX_Size() int {
+	return xxx_messageInfo_HeaderWithFooter.Size(m)
+}
+func (m *HeaderWithFooter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderWithFooter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderWithFooter proto.InternalMessageInfo
+
+func (m *HeaderWithFooter) GetHeader() *iotextypes.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *HeaderWithFooter) GetFooter() *iotextypes.BlockFooter {
+	if m != nil {
+		return m.Footer
+	}
+	return nil
+}
+
+type Headers struct {
+	Headers              []*HeaderWithFooter `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Headers) Reset()         { *m = Headers{} }
+func (m *Headers) String() string { return proto.CompactTextString(m) }
+func (*Headers) ProtoMessage()    {}
+func (*Headers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{3}
+}
+
+func (m *Headers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Headers.Unmarshal(m, b)
+}
+func (m *Headers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Headers.Marshal(b, m, deterministic)
+}
+func (m *Headers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Headers.Merge(m, src)
+}
+func (m *Headers) XXX_Size() int {
+	return xxx_messageInfo_Headers.Size(m)
+}
+func (m *Headers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Headers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Headers proto.InternalMessageInfo
+
+func (m *Headers) GetHeaders() []*HeaderWithFooter {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type BodiesRequest struct {
+	Heights              []uint64 `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BodiesRequest) Reset()         { *m = BodiesRequest{} }
+func (m *BodiesRequest) String() string { return proto.CompactTextString(m) }
+func (*BodiesRequest) ProtoMessage()    {}
+func (*BodiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{4}
+}
+
+func (m *BodiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BodiesRequest.Unmarshal(m, b)
+}
+func (m *BodiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BodiesRequest.Marshal(b, m, deterministic)
+}
+func (m *BodiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BodiesRequest.Merge(m, src)
+}
+func (m *BodiesRequest) XXX_Size() int {
+	return xxx_messageInfo_BodiesRequest.Size(m)
+}
+func (m *BodiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BodiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BodiesRequest proto.InternalMessageInfo
+
+func (m *BodiesRequest) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+type BodyWithHeight struct {
+	Height               uint64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Body                 *iotextypes.BlockBody `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BodyWithHeight) Reset()         { *m = BodyWithHeight{} }
+func (m *BodyWithHeight) String() string { return proto.CompactTextString(m) }
+func (*BodyWithHeight) ProtoMessage()    {}
+func (*BodyWithHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{5}
+}
+
+func (m *BodyWithHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BodyWithHeight.Unmarshal(m, b)
+}
+func (m *BodyWithHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BodyWithHeight.Marshal(b, m, deterministic)
+}
+func (m *BodyWithHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BodyWithHeight.Merge(m, src)
+}
+func (m *BodyWithHeight) XXX_Size() int {
+	return xxx_messageInfo_BodyWithHeight.Size(m)
+}
+func (m *BodyWithHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BodyWithHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BodyWithHeight proto.InternalMessageInfo
+
+func (m *BodyWithHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BodyWithHeight) GetBody() *iotextypes.BlockBody {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type Bodies struct {
+	Bodies               []*BodyWithHeight `protobuf:"bytes,1,rep,name=bodies,proto3" json:"bodies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Bodies) Reset()         { *m = Bodies{} }
+func (m *Bodies) String() string { return proto.CompactTextString(m) }
+func (*Bodies) ProtoMessage()    {}
+func (*Bodies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{6}
+}
+
+func (m *Bodies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bodies.Unmarshal(m, b)
+}
+func (m *Bodies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bodies.Marshal(b, m, deterministic)
+}
+func (m *Bodies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bodies.Merge(m, src)
+}
+func (m *Bodies) XXX_Size() int {
+	return xxx_messageInfo_Bodies.Size(m)
+}
+func (m *Bodies) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bodies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bodies proto.InternalMessageInfo
+
+func (m *Bodies) GetBodies() []*BodyWithHeight {
+	if m != nil {
+		return m.Bodies
+	}
+	return nil
+}
+
+type CompactBlock struct {
+	Header               *iotextypes.BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Footer               *iotextypes.BlockFooter `protobuf:"bytes,2,opt,name=footer,proto3" json:"footer,omitempty"`
+	ShortIDs             []uint64                `protobuf:"varint,3,rep,packed,name=shortIDs,proto3" json:"shortIDs,omitempty"`
+	Prefilled            []*IndexedAction        `protobuf:"bytes,4,rep,name=prefilled,proto3" json:"prefilled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{7}
+}
+
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactBlock.Unmarshal(m, b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return xxx_messageInfo_CompactBlock.Size(m)
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeader() *iotextypes.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetFooter() *iotextypes.BlockFooter {
+	if m != nil {
+		return m.Footer
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetShortIDs() []uint64 {
+	if m != nil {
+		return m.ShortIDs
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetPrefilled() []*IndexedAction {
+	if m != nil {
+		return m.Prefilled
+	}
+	return nil
+}
+
+type IndexedAction struct {
+	Index                uint32             `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Action               *iotextypes.Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *IndexedAction) Reset()         { *m = IndexedAction{} }
+func (m *IndexedAction) String() string { return proto.CompactTextString(m) }
+func (*IndexedAction) ProtoMessage()    {}
+func (*IndexedAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{8}
+}
+
+func (m *IndexedAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexedAction.Unmarshal(m, b)
+}
+func (m *IndexedAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexedAction.Marshal(b, m, deterministic)
+}
+func (m *IndexedAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedAction.Merge(m, src)
+}
+func (m *IndexedAction) XXX_Size() int {
+	return xxx_messageInfo_IndexedAction.Size(m)
+}
+func (m *IndexedAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedAction proto.InternalMessageInfo
+
+func (m *IndexedAction) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *IndexedAction) GetAction() *iotextypes.Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type ActionsRequest struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Indexes              []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionsRequest) Reset()         { *m = ActionsRequest{} }
+func (m *ActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ActionsRequest) ProtoMessage()    {}
+func (*ActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{9}
+}
+
+func (m *ActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionsRequest.Unmarshal(m, b)
+}
+func (m *ActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionsRequest.Merge(m, src)
+}
+func (m *ActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ActionsRequest.Size(m)
+}
+func (m *ActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionsRequest proto.InternalMessageInfo
+
+func (m *ActionsRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ActionsRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ActionsRequest) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type BlockActions struct {
+	Height               uint64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash            []byte           `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Actions              []*IndexedAction `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BlockActions) Reset()         { *m = BlockActions{} }
+func (m *BlockActions) String() string { return proto.CompactTextString(m) }
+func (*BlockActions) ProtoMessage()    {}
+func (*BlockActions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8a51f48e1631f8, []int{10}
+}
+
+func (m *BlockActions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockActions.Unmarshal(m, b)
+}
+func (m *BlockActions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockActions.Marshal(b, m, deterministic)
+}
+func (m *BlockActions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockActions.Merge(m, src)
+}
+func (m *BlockActions) XXX_Size() int {
+	return xxx_messageInfo_BlockActions.Size(m)
+}
+func (m *BlockActions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockActions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockActions proto.InternalMessageInfo
+
+func (m *BlockActions) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockActions) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BlockActions) GetActions() []*IndexedAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PeerStatus)(nil), "blocksyncpb.PeerStatus")
+	proto.RegisterType((*HeadersRequest)(nil), "blocksyncpb.HeadersRequest")
+	proto.RegisterType((*HeaderWithFooter)(nil), "blocksyncpb.HeaderWithFooter")
+	proto.RegisterType((*Headers)(nil), "blocksyncpb.Headers")
+	proto.RegisterType((*BodiesRequest)(nil), "blocksyncpb.BodiesRequest")
+	proto.RegisterType((*BodyWithHeight)(nil), "blocksyncpb.BodyWithHeight")
+	proto.RegisterType((*Bodies)(nil), "blocksyncpb.Bodies")
+	proto.RegisterType((*CompactBlock)(nil), "blocksyncpb.CompactBlock")
+	proto.RegisterType((*IndexedAction)(nil), "blocksyncpb.IndexedAction")
+	proto.RegisterType((*ActionsRequest)(nil), "blocksyncpb.ActionsRequest")
+	proto.RegisterType((*BlockActions)(nil), "blocksyncpb.BlockActions")
+}
+
+func init() { proto.RegisterFile("blocksync.proto", fileDescriptor_0e8a51f48e1631f8) }
+
+var fileDescriptor_0e8a51f48e1631f8 = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0x54, 0x9a, 0x90, 0x90, 0x97, 0x0f, 0xaa, 0x15, 0x1f, 0x56, 0x08, 0x52, 0xb5, 0xa7, 0xb6,
+	0x12, 0xb6, 0xd4, 0x22, 0x11, 0x21, 0x71, 0xc0, 0x20, 0x94, 0xde, 0x60, 0x7b, 0x40, 0xe2, 0x84,
+	0x3f, 0x5e, 0xeb, 0x85, 0xd4, 0x6b, 0xbc, 0x1b, 0xa9, 0xe1, 0x07, 0xf2, 0xbb, 0xd0, 0xbe, 0x5d,
+	0x3b, 0x09, 0xa8, 0x1c, 0x90, 0xb8, 0xf9, 0xcd, 0xce, 0x9b, 0x9d, 0x19, 0x5b, 0x86, 0x07, 0xe9,
+	0x4a, 0x65, 0xdf, 0xf4, 0xa6, 0xcc, 0xc2, 0xaa, 0x56, 0x46, 0xb1, 0x51, 0x0b, 0x54, 0xe9, 0x6c,
+	0x4e, 0x58, 0x64, 0x36, 0x15, 0xea, 0x88, 0x0e, 0xb2, 0x22, 0x91, 0xa5, 0xa3, 0xf2, 0x53, 0x80,
+	0x0f, 0x88, 0xf5, 0xa5, 0x49, 0xcc, 0x5a, 0xb3, 0x39, 0x0c, 0x8d, 0xac, 0x96, 0x28, 0xaf, 0x0b,
+	0x13, 0x74, 0x8e, 0x3a, 0xc7, 0x3d, 0xb1, 0x05, 0xf8, 0x02, 0xa6, 0x4b, 0x4c, 0x72, 0xac, 0xb5,
+	0xc0, 0xef, 0x6b, 0xd4, 0x86, 0x3d, 0x84, 0x7b, 0xda, 0x24, 0x75, 0xc3, 0x75, 0x03, 0x3b, 0x84,
+	0x2e, 0x96, 0x79, 0x70, 0x40, 0x98, 0x7d, 0xe4, 0x06, 0x0e, 0xdd, 0xe6, 0x27, 0x69, 0x8a, 0xf7,
+	0x4a, 0x19, 0xac, 0x59, 0x04, 0xfd, 0x82, 0x30, 0x5a, 0x1e, 0x9d, 0x3d, 0x09, 0xa5, 0x32, 0x78,
+	0x4b, 0x3e, 0xc3, 0xd8, 0xfa, 0x74, 0x2b, 0xc2, 0xd3, 0xec, 0xc2, 0x15, 0xad, 0x06, 0x07, 0x77,
+	0x2c, 0x38, 0x65, 0xe1, 0x69, 0x3c, 0x86, 0x81, 0xf7, 0xcb, 0x5e, 0xc2, 0xc0, 0xa9, 0xe8, 0xa0,
+	0x73, 0xd4, 0x3d, 0x1e, 0x9d, 0x3d, 0x0b, 0x77, 0x3a, 0x0a, 0x7f, 0x37, 0x27, 0x1a, 0x36, 0x3f,
+	0x81, 0x49, 0xac, 0x72, 0x89, 0x6d, 0xe4, 0xc0, 0x2a, 0xd9, 0x3a, 0x9c, 0x52, 0x4f, 0x34, 0x23,
+	0xbf, 0x84, 0x69, 0xac, 0xf2, 0x8d, 0x55, 0x71, 0x85, 0xb1, 0xc7, 0x36, 0xe2, 0x4e, 0x97, 0x7e,
+	0x62, 0x27, 0xd0, 0x4b, 0x55, 0xbe, 0xf1, 0x39, 0x1e, 0xfd, 0x91, 0xc3, 0xca, 0x08, 0xa2, 0xf0,
+	0xd7, 0xd0, 0x77, 0xf7, 0xb3, 0x73, 0xe8, 0xa7, 0xf4, 0xe4, 0x13, 0x3c, 0xdd, 0x4b, 0xb0, 0x7f,
+	0xb3, 0xf0, 0x54, 0xfe, 0xb3, 0x03, 0xe3, 0xb7, 0xea, 0xa6, 0x4a, 0x32, 0x43, 0xca, 0xff, 0xbf,
+	0x75, 0x36, 0x83, 0xfb, 0xba, 0x50, 0xb5, 0xb9, 0x78, 0xa7, 0x83, 0x2e, 0x35, 0xd4, 0xce, 0x6c,
+	0x01, 0xc3, 0xaa, 0xc6, 0x2b, 0xb9, 0x5a, 0x61, 0x1e, 0xf4, 0x28, 0xc6, 0x6c, 0x2f, 0xc6, 0x45,
+	0x99, 0xe3, 0x2d, 0xe6, 0x6f, 0x32, 0x23, 0x55, 0x29, 0xb6, 0x64, 0xfe, 0x11, 0x26, 0x7b, 0x67,
+	0xf6, 0xd3, 0x93, 0x16, 0xa0, 0x1c, 0x13, 0xe1, 0x06, 0x76, 0x0a, 0xfd, 0x84, 0xce, 0xbd, 0x5b,
+	0xb6, 0xeb, 0xd6, 0xab, 0x7a, 0x06, 0xff, 0x02, 0x53, 0x87, 0xb4, 0xef, 0xf6, 0xae, 0xf7, 0x35,
+	0x87, 0x21, 0x99, 0x5c, 0x26, 0xba, 0x20, 0xe1, 0xb1, 0xd8, 0x02, 0xf6, 0x8b, 0xa0, 0xcb, 0xd1,
+	0xe5, 0x9d, 0x88, 0x66, 0xe4, 0x3f, 0x60, 0x4c, 0x0d, 0xf9, 0x6b, 0xfe, 0x51, 0xff, 0x05, 0x0c,
+	0x9c, 0x63, 0xa7, 0xff, 0xf7, 0xca, 0x1a, 0x6a, 0xfc, 0xea, 0xf3, 0xe2, 0x5a, 0x9a, 0x62, 0x9d,
+	0x86, 0x99, 0xba, 0x89, 0xa8, 0x85, 0xaa, 0x56, 0x5f, 0x31, 0x33, 0x6e, 0x78, 0x9e, 0xa9, 0x1a,
+	0xa3, 0x56, 0x28, 0xda, 0x91, 0x4c, 0xfb, 0xf4, 0x6f, 0x38, 0xff, 0x35, 0x00, 0x56, 0xee, 0x11,
+	0xdc, 0x59, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
+	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+// ErrTxRootMismatch indicates the actions of a compact block do not match the tx root of the block, e.g., the short
+// hashes of two actions collide
+var ErrTxRootMismatch = errors.New("actions do not match the tx root")
+
+type (
+	// compactBlock is a compact block waiting for its missing actions
+	compactBlock struct {
+		blk     *block.Block
+		actions []*action.SealedEnvelope
+		missing int
+		timer   *clock.Timer
+	}
+
+	// compactBlocks rebuilds the compact blocks from the actions in the actpool. The compact blocks missing actions
+	// are kept until the actions arrive, or the timeout elapses and the full blocks are requested instead.
+	compactBlocks struct {
+		mu       sync.Mutex
+		clock    clock.Clock
+		ap       actpool.ActPool
+		timeout  time.Duration
+		blocks   map[hash.Hash256]*compactBlock
+		fallback func(height uint64)
+	}
+)
+
+// NewCompactBlock converts a block into a compact block. The actions signed by the producer, e.g., the grant reward
+// action, are not in the actpools of the receivers, so they are carried in full.
+func NewCompactBlock(pb *iotextypes.Block) (*blocksyncpb.CompactBlock, error) {
+	blk := &block.Block{}
+	if err := blk.ConvertFromBlockPb(pb); err != nil {
+		return nil, err
+	}
+	cb := &blocksyncpb.CompactBlock{
+		Header:   pb.GetHeader(),
+		Footer:   pb.GetFooter(),
+		ShortIDs: make([]uint64, len(blk.Actions)),
+	}
+	producer := blk.PublicKey().Bytes()
+	for i, selp := range blk.Actions {
+		cb.ShortIDs[i] = shortID(selp.Hash())
+		if bytes.Equal(selp.SrcPubkey().Bytes(), producer) {
+			cb.Prefilled = append(cb.Prefilled, &blocksyncpb.IndexedAction{Index: uint32(i), Action: selp.Proto()})
+		}
+	}
+	return cb, nil
+}
+
+// shortID returns the short hash of an action in a compact block, which is the first 8 bytes of the action hash
+func shortID(h hash.Hash256) uint64 {
+	return binary.BigEndian.Uint64(h[:8])
+}
+
+func newCompactBlocks(
+	ap actpool.ActPool,
+	timeout time.Duration,
+	c clock.Clock,
+	fallback func(height uint64),
+) *compactBlocks {
+	return &compactBlocks{
+		clock:    c,
+		ap:       ap,
+		timeout:  timeout,
+		blocks:   make(map[hash.Hash256]*compactBlock),
+		fallback: fallback,
+	}
+}
+
+// Add rebuilds the compact block from the actions carried and the actions in the actpool. It returns the block and
+// the indexes of the actions missing, and the block is complete if no action is missing. The compact blocks at or
+// below the tip height, or being rebuilt already, are ignored and nil is returned. ErrTxRootMismatch is returned if
+// the block rebuilt does not match the tx root, and the full block should be requested instead.
+func (cbs *compactBlocks) Add(
+	ctx context.Context,
+	cb *blocksyncpb.CompactBlock,
+	tipHeight uint64,
+) (*block.Block, []uint32, error) {
+	blk := &block.Block{}
+	if err := blk.LoadFromBlockHeaderProto(cb.GetHeader()); err != nil {
+		p2p.ReportPeer(ctx, p2p.MalformedMessage)
+		return nil, nil, err
+	}
+	if err := blk.ConvertFromBlockFooterPb(cb.GetFooter()); err != nil {
+		p2p.ReportPeer(ctx, p2p.MalformedMessage)
+		return nil, nil, err
+	}
+	if !blk.VerifySignature() {
+		p2p.ReportPeer(ctx, p2p.InvalidBlock)
+		return nil, nil, errors.Errorf("failed to verify the signature of the compact block at height %d", blk.Height())
+	}
+	if blk.Height() <= tipHeight {
+		return nil, nil, nil
+	}
+	blkHash := blk.HashBlock()
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+	if _, ok := cbs.blocks[blkHash]; ok {
+		return nil, nil, nil
+	}
+
+	pending := &compactBlock{
+		blk:     blk,
+		actions: make([]*action.SealedEnvelope, len(cb.ShortIDs)),
+		missing: len(cb.ShortIDs),
+	}
+	for _, pb := range cb.Prefilled {
+		if err := pending.fill(pb); err != nil {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			return nil, nil, err
+		}
+	}
+	if pending.missing > 0 {
+		pool := cbs.poolIndex()
+		for i, id := range cb.ShortIDs {
+			if pending.actions[i] != nil {
+				continue
+			}
+			if selp, ok := pool[id]; ok && selp != nil {
+				pending.actions[i] = selp
+				pending.missing--
+			}
+		}
+	}
+	if pending.missing == 0 {
+		if err := pending.assemble(); err != nil {
+			return nil, nil, err
+		}
+		return blk, nil, nil
+	}
+
+	height := blk.Height()
+	pending.timer = cbs.clock.AfterFunc(cbs.timeout, func() {
+		cbs.mu.Lock()
+		_, ok := cbs.blocks[blkHash]
+		delete(cbs.blocks, blkHash)
+		cbs.mu.Unlock()
+		if ok {
+			cbs.fallback(height)
+		}
+	})
+	cbs.blocks[blkHash] = pending
+	missing := make([]uint32, 0, pending.missing)
+	for i, selp := range pending.actions {
+		if selp == nil {
+			missing = append(missing, uint32(i))
+		}
+	}
+	return blk, missing, nil
+}
+
+// Fill fills the missing actions into the compact block, and returns the block once it is rebuilt. The actions
+// arriving after the timeout are ignored.
+func (cbs *compactBlocks) Fill(ctx context.Context, actions *blocksyncpb.BlockActions) (*block.Block, error) {
+	blkHash := hash.BytesToHash256(actions.BlockHash)
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+	pending, ok := cbs.blocks[blkHash]
+	if !ok {
+		return nil, nil
+	}
+	for _, pb := range actions.Actions {
+		if err := pending.fill(pb); err != nil {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			return nil, err
+		}
+	}
+	if pending.missing > 0 {
+		return nil, nil
+	}
+	delete(cbs.blocks, blkHash)
+	pending.timer.Stop()
+	if err := pending.assemble(); err != nil {
+		return nil, err
+	}
+	return pending.blk, nil
+}
+
+// poolIndex indexes the pending actions in the actpool by their short hashes. The short hashes shared by multiple
+// actions are mapped to nil, so that the actions are requested rather than guessed.
+func (cbs *compactBlocks) poolIndex() map[uint64]*action.SealedEnvelope {
+	index := make(map[uint64]*action.SealedEnvelope)
+	for _, selps := range cbs.ap.PendingActionMap() {
+		for i := range selps {
+			id := shortID(selps[i].Hash())
+			if _, ok := index[id]; ok {
+				index[id] = nil
+				continue
+			}
+			index[id] = &selps[i]
+		}
+	}
+	return index
+}
+
+// fill fills the action into the compact block, and ignores the action filled already
+func (cb *compactBlock) fill(pb *blocksyncpb.IndexedAction) error {
+	if pb.Index >= uint32(len(cb.actions)) {
+		return errors.Errorf("action index %d out of range %d", pb.Index, len(cb.actions))
+	}
+	if cb.actions[pb.Index] != nil {
+		return nil
+	}
+	selp := &action.SealedEnvelope{}
+	if err := selp.LoadProto(pb.Action); err != nil {
+		return err
+	}
+	cb.actions[pb.Index] = selp
+	cb.missing--
+	return nil
+}
+
+// assemble puts the actions into the block, and verifies them against the tx root of the block
+func (cb *compactBlock) assemble() error {
+	cb.blk.Actions = make([]action.SealedEnvelope, len(cb.actions))
+	for i, selp := range cb.actions {
+		cb.blk.Actions[i] = *selp
+	}
+	if cb.blk.CalculateTxRoot() != cb.blk.TxRoot() {
+		return errors.Wrapf(ErrTxRootMismatch, "compact block at height %d", cb.blk.Height())
+	}
+	return nil
+}
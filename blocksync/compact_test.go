@@ -0,0 +1,136 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestCompactBlocks(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tsfs := make([]action.SealedEnvelope, 4)
+	for i := range tsfs {
+		// the second transfer is signed by the producer
+		sender := 0
+		if i == 1 {
+			sender = 1
+		}
+		tsf, err := testutil.SignedTransfer(identityset.Address(2).String(), identityset.PrivateKey(sender),
+			uint64(i+1), big.NewInt(10), nil, 10000, big.NewInt(0))
+		require.NoError(err)
+		tsfs[i] = tsf
+	}
+	newBlock := func(height uint64, actions ...action.SealedEnvelope) *block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(time.Unix(int64(height), 0)).
+			AddActions(actions...).
+			SignAndBuild(identityset.PrivateKey(1))
+		require.NoError(err)
+		return &blk
+	}
+	blk := newBlock(2, tsfs[:3]...)
+
+	// the actions signed by the producer are carried in full
+	cb, err := NewCompactBlock(blk.ConvertToBlockPb())
+	require.NoError(err)
+	require.Len(cb.ShortIDs, 3)
+	require.Equal(shortID(tsfs[2].Hash()), cb.ShortIDs[2])
+	require.Len(cb.Prefilled, 1)
+	require.Equal(uint32(1), cb.Prefilled[0].Index)
+
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().PendingActionMap().Return(map[string][]action.SealedEnvelope{
+		identityset.Address(0).String(): {tsfs[0], tsfs[3]},
+	}).AnyTimes()
+	c := clock.NewMock()
+	var fallbacks []uint64
+	cbs := newCompactBlocks(ap, time.Second, c, func(height uint64) { fallbacks = append(fallbacks, height) })
+	ctx := context.Background()
+
+	// the compact blocks at or below the tip height are ignored
+	rebuilt, missing, err := cbs.Add(ctx, cb, 2)
+	require.NoError(err)
+	require.Nil(rebuilt)
+	require.Nil(missing)
+
+	// the actions not in the actpool are requested
+	rebuilt, missing, err = cbs.Add(ctx, cb, 1)
+	require.NoError(err)
+	require.Equal(blk.HashBlock(), rebuilt.HashBlock())
+	require.Equal([]uint32{2}, missing)
+	rebuilt, _, err = cbs.Add(ctx, cb, 1)
+	require.NoError(err)
+	require.Nil(rebuilt)
+	blkHash := blk.HashBlock()
+	rebuilt, err = cbs.Fill(ctx, &blocksyncpb.BlockActions{
+		Height:    2,
+		BlockHash: blkHash[:],
+		Actions:   []*blocksyncpb.IndexedAction{{Index: 2, Action: tsfs[2].Proto()}},
+	})
+	require.NoError(err)
+	require.Equal(blk.TxRoot(), rebuilt.CalculateTxRoot())
+	require.Len(rebuilt.Actions, 3)
+	c.Add(time.Second)
+	require.Empty(fallbacks)
+
+	// the block is rebuilt from the actpool if no action is missing
+	blk = newBlock(3, tsfs[0], tsfs[3])
+	cb, err = NewCompactBlock(blk.ConvertToBlockPb())
+	require.NoError(err)
+	rebuilt, missing, err = cbs.Add(ctx, cb, 2)
+	require.NoError(err)
+	require.Empty(missing)
+	require.Equal(blk.TxRoot(), rebuilt.CalculateTxRoot())
+
+	// the actions not matching the tx root are rejected
+	blk = newBlock(4, tsfs[2])
+	cb, err = NewCompactBlock(blk.ConvertToBlockPb())
+	require.NoError(err)
+	_, missing, err = cbs.Add(ctx, cb, 3)
+	require.NoError(err)
+	require.Equal([]uint32{0}, missing)
+	blkHash = blk.HashBlock()
+	_, err = cbs.Fill(ctx, &blocksyncpb.BlockActions{
+		Height:    4,
+		BlockHash: blkHash[:],
+		Actions:   []*blocksyncpb.IndexedAction{{Index: 0, Action: tsfs[3].Proto()}},
+	})
+	require.Equal(ErrTxRootMismatch, errors.Cause(err))
+
+	// the full block is requested if the missing actions do not arrive in time
+	_, missing, err = cbs.Add(ctx, cb, 3)
+	require.NoError(err)
+	require.Equal([]uint32{0}, missing)
+	c.Add(time.Second)
+	require.Equal([]uint64{4}, fallbacks)
+	rebuilt, err = cbs.Fill(ctx, &blocksyncpb.BlockActions{
+		Height:    4,
+		BlockHash: blkHash[:],
+		Actions:   []*blocksyncpb.IndexedAction{{Index: 0, Action: tsfs[2].Proto()}},
+	})
+	require.NoError(err)
+	require.Nil(rebuilt)
+}
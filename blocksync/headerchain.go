@@ -0,0 +1,193 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
+	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/p2p"
+)
+
+type (
+	// headerChain is the chain of the headers above the tip in header-first mode. A header is accepted only if it is
+	// signed by the producer, links to the previous header, and its footer is endorsed as the consensus requires.
+	// Therefore the bodies could be downloaded from any peer in parallel, and are accepted only if they match the tx
+	// roots of the headers.
+	headerChain struct {
+		mu     sync.Mutex
+		bc     blockchain.Blockchain
+		cs     consensus.Consensus
+		size   uint64
+		tip    uint64
+		top    uint64
+		blocks map[uint64]*headerChainBlock
+	}
+
+	headerChainBlock struct {
+		blk     *block.Block
+		hasBody bool
+	}
+)
+
+func newHeaderChain(bc blockchain.Blockchain, cs consensus.Consensus, size uint64) *headerChain {
+	return &headerChain{
+		bc:     bc,
+		cs:     cs,
+		size:   size,
+		blocks: make(map[uint64]*headerChainBlock),
+	}
+}
+
+// Top returns the height of the last header in the chain, or the tip height if the chain is empty
+func (hc *headerChain) Top() uint64 {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.prune()
+	return hc.top
+}
+
+// AddHeaders appends the headers to the chain, and returns the height of the last header accepted. The headers not
+// linking to the chain are ignored, and the headers after an invalid one are dropped.
+func (hc *headerChain) AddHeaders(ctx context.Context, headers []*blocksyncpb.HeaderWithFooter) (uint64, error) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.prune()
+	for _, pb := range headers {
+		blk := &block.Block{}
+		if err := blk.Header.LoadFromBlockHeaderProto(pb.GetHeader()); err != nil {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			return hc.top, err
+		}
+		height := blk.Height()
+		if height <= hc.top {
+			continue
+		}
+		if height != hc.top+1 || height > hc.tip+hc.size {
+			break
+		}
+		if err := blk.ConvertFromBlockFooterPb(pb.GetFooter()); err != nil {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			return hc.top, err
+		}
+		if !blk.VerifySignature() {
+			p2p.ReportPeer(ctx, p2p.InvalidBlock)
+			return hc.top, errors.Errorf("failed to verify the signature of the header at height %d", height)
+		}
+		// the peer may be on another branch, or the endorsements cannot be verified before the previous blocks are
+		// committed, e.g., the delegates of the next epoch are unknown, so the peer is not reported
+		if prevHash := hc.prevHash(); blk.PrevHash() != prevHash {
+			return hc.top, errors.Errorf(
+				"the header at height %d does not link to the previous hash %x",
+				height,
+				prevHash,
+			)
+		}
+		if err := hc.cs.ValidateBlockFooter(blk); err != nil {
+			return hc.top, errors.Wrapf(err, "failed to validate the footer at height %d", height)
+		}
+		hc.blocks[height] = &headerChainBlock{blk: blk}
+		hc.top = height
+	}
+	return hc.top, nil
+}
+
+// MissingBodies returns the heights of the headers in the chain without bodies
+func (hc *headerChain) MissingBodies() []uint64 {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.prune()
+	var heights []uint64
+	for height, b := range hc.blocks {
+		if !b.hasBody {
+			heights = append(heights, height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
+
+// AddBodies fills the bodies into the headers, and returns the blocks completed in the order of height. The bodies
+// not matching the tx roots of the headers are dropped.
+func (hc *headerChain) AddBodies(ctx context.Context, bodies []*blocksyncpb.BodyWithHeight) []*block.Block {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	var blks []*block.Block
+	for _, pb := range bodies {
+		b, ok := hc.blocks[pb.GetHeight()]
+		if !ok || b.hasBody {
+			continue
+		}
+		body := block.Body{}
+		if err := body.LoadProto(pb.GetBody()); err != nil {
+			p2p.ReportPeer(ctx, p2p.MalformedMessage)
+			continue
+		}
+		if body.CalculateTxRoot() != b.blk.TxRoot() {
+			p2p.ReportPeer(ctx, p2p.InvalidBlock)
+			continue
+		}
+		b.blk.Body = body
+		b.hasBody = true
+		blks = append(blks, b.blk)
+	}
+	sort.Slice(blks, func(i, j int) bool { return blks[i].Height() < blks[j].Height() })
+	return blks
+}
+
+// Block returns the block of the height if its body has been downloaded
+func (hc *headerChain) Block(height uint64) *block.Block {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if b, ok := hc.blocks[height]; ok && b.hasBody {
+		return b.blk
+	}
+	return nil
+}
+
+// prune drops the committed blocks, and starts over if the chain does not link to the tip any more
+func (hc *headerChain) prune() {
+	tip := hc.bc.TipHeight()
+	if tip == hc.tip && hc.top >= tip {
+		return
+	}
+	for height := range hc.blocks {
+		if height <= tip {
+			delete(hc.blocks, height)
+		}
+	}
+	hc.tip = tip
+	if next, ok := hc.blocks[tip+1]; ok && next.blk.PrevHash() != hc.tipHash() {
+		hc.blocks = make(map[uint64]*headerChainBlock)
+	}
+	if len(hc.blocks) == 0 || hc.top < tip {
+		hc.top = tip
+	}
+}
+
+func (hc *headerChain) prevHash() hash.Hash256 {
+	if b, ok := hc.blocks[hc.top]; ok {
+		return b.blk.HashBlock()
+	}
+	return hc.tipHash()
+}
+
+func (hc *headerChain) tipHash() hash.Hash256 {
+	if hc.tip == 0 {
+		g := hc.bc.Genesis()
+		return g.Hash()
+	}
+	return hc.bc.TipHash()
+}
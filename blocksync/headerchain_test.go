@@ -0,0 +1,107 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestHeaderChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genesisHash := config.Default.Genesis.Hash()
+	blks := make([]*block.Block, 4)
+	prevHash := genesisHash
+	for i := range blks {
+		tsf, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0), uint64(i+1),
+			big.NewInt(10), nil, 10000, big.NewInt(0))
+		require.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(uint64(i + 1)).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(time.Unix(int64(i+1), 0)).
+			AddActions(tsf).
+			SignAndBuild(identityset.PrivateKey(1))
+		require.NoError(err)
+		blks[i] = &blk
+		prevHash = blk.HashBlock()
+	}
+	headers := make([]*blocksyncpb.HeaderWithFooter, len(blks))
+	bodies := make([]*blocksyncpb.BodyWithHeight, len(blks))
+	for i, blk := range blks {
+		footer, err := blk.ConvertToBlockFooterPb()
+		require.NoError(err)
+		headers[i] = &blocksyncpb.HeaderWithFooter{Header: blk.BlockHeaderProto(), Footer: footer}
+		bodies[i] = &blocksyncpb.BodyWithHeight{Height: blk.Height(), Body: blk.Body.Proto()}
+	}
+
+	tipHeight, tipHash := uint64(0), hash.ZeroHash256
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().DoAndReturn(func() uint64 { return tipHeight }).AnyTimes()
+	bc.EXPECT().TipHash().DoAndReturn(func() hash.Hash256 { return tipHash }).AnyTimes()
+	bc.EXPECT().Genesis().Return(config.Default.Genesis).AnyTimes()
+	cs := mock_consensus.NewMockConsensus(ctrl)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).DoAndReturn(func(blk *block.Block) error {
+		if blk.Height() == 4 {
+			return errors.New("delegates of the epoch are unknown")
+		}
+		return nil
+	}).AnyTimes()
+	hc := newHeaderChain(bc, cs, 10)
+	ctx := context.Background()
+
+	// the headers not linking to the chain are ignored
+	top, err := hc.AddHeaders(ctx, headers[1:])
+	require.NoError(err)
+	require.Zero(top)
+	// the headers with invalid footers are dropped
+	top, err = hc.AddHeaders(ctx, headers)
+	require.Error(err)
+	require.Equal(uint64(3), top)
+	require.Equal([]uint64{1, 2, 3}, hc.MissingBodies())
+
+	// the bodies not matching the tx roots are dropped
+	require.Empty(hc.AddBodies(ctx, []*blocksyncpb.BodyWithHeight{{Height: 1, Body: bodies[1].Body}}))
+	completed := hc.AddBodies(ctx, []*blocksyncpb.BodyWithHeight{bodies[2], bodies[0], bodies[3]})
+	require.Len(completed, 2)
+	require.Equal(uint64(1), completed[0].Height())
+	require.Equal(uint64(3), completed[1].Height())
+	require.Equal(blks[0].TxRoot(), completed[0].CalculateTxRoot())
+	require.Equal([]uint64{2}, hc.MissingBodies())
+	require.NotNil(hc.Block(1))
+	require.Nil(hc.Block(2))
+
+	// the committed blocks are pruned
+	tipHeight, tipHash = 1, blks[0].HashBlock()
+	require.Equal(uint64(3), hc.Top())
+	require.Nil(hc.Block(1))
+	require.Equal([]uint64{2}, hc.MissingBodies())
+
+	// the chain starts over if it does not link to the tip
+	tipHeight, tipHash = 2, hash.Hash256b([]byte("fork"))
+	require.Equal(uint64(2), hc.Top())
+	require.Empty(hc.MissingBodies())
+	require.Nil(hc.Block(3))
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+)
+
+// statsDecay is the weight of the history in the moving average of the latency and the success rate of a peer
+const statsDecay = 0.7
+
+// requestKind is the kind of a block sync request
+type requestKind int
+
+const (
+	// blockRequest requests the blocks, or the bodies of the blocks in header-first mode
+	blockRequest requestKind = iota
+	// headerRequest requests the headers and footers of the blocks in header-first mode
+	headerRequest
+)
+
+type (
+	// peerStats is what the node knows about a peer. A new peer starts with a perfect success rate and no latency,
+	// so that it is tried as soon as possible.
+	peerStats struct {
+		info        peerstore.PeerInfo
+		height      uint64
+		latency     time.Duration
+		successRate float64
+		inFlight    int
+	}
+
+	// syncRequest is a block sync request in flight. The deadline is extended whenever a block of the request arrives.
+	syncRequest struct {
+		kind     requestKind
+		interval syncBlocksInterval
+		peer     string
+		sent     time.Time
+		deadline time.Time
+	}
+
+	// peerTracker tracks the heights advertised by the peers and how well they serve the block sync requests, and
+	// routes the requests to the peers accordingly
+	peerTracker struct {
+		mu       sync.Mutex
+		clock    clock.Clock
+		timeout  time.Duration
+		peers    map[string]*peerStats
+		requests []*syncRequest
+	}
+)
+
+func newPeerTracker(timeout time.Duration, c clock.Clock) *peerTracker {
+	return &peerTracker{
+		clock:   c,
+		timeout: timeout,
+		peers:   make(map[string]*peerStats),
+	}
+}
+
+// SetPeers sets the neighbors to sync with, and forgets the peers not being neighbors any more
+func (t *peerTracker) SetPeers(peers []peerstore.PeerInfo) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	neighbors := make(map[string]bool, len(peers))
+	for _, p := range peers {
+		id := p.ID.Pretty()
+		neighbors[id] = true
+		if ps, ok := t.peers[id]; ok {
+			ps.info = p
+			continue
+		}
+		t.peers[id] = &peerStats{info: p, successRate: 1}
+	}
+	for id := range t.peers {
+		if !neighbors[id] {
+			delete(t.peers, id)
+		}
+	}
+}
+
+// SetHeight sets the tip height advertised by the peer
+func (t *peerTracker) SetHeight(peer peerstore.PeerInfo, height uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := peer.ID.Pretty()
+	ps, ok := t.peers[id]
+	if !ok {
+		ps = &peerStats{info: peer, successRate: 1}
+		t.peers[id] = ps
+	}
+	ps.height = height
+}
+
+// Receive updates the peer sending the block, or the header of the block, of the height, and completes the request
+// of the peer ending at the height
+func (t *peerTracker) Receive(peerID string, kind requestKind, height uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps, ok := t.peers[peerID]
+	if !ok {
+		return
+	}
+	if height > ps.height {
+		ps.height = height
+	}
+	now := t.clock.Now()
+	for i, r := range t.requests {
+		if r.peer != peerID || r.kind != kind || height < r.interval.Start || height > r.interval.End {
+			continue
+		}
+		if height < r.interval.End {
+			r.deadline = now.Add(t.timeout)
+			continue
+		}
+		ps.update(true, now.Sub(r.sent))
+		t.finish(r)
+		t.requests = append(t.requests[:i], t.requests[i+1:]...)
+		return
+	}
+}
+
+// Expire drops the requests which have timed out, or end below the committed height. The peers of the requests
+// having timed out are penalized, and returned so that the intervals could be requested from the other peers.
+func (t *peerTracker) Expire(committedHeight uint64) []*syncRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var (
+		now     = t.clock.Now()
+		expired []*syncRequest
+		pending = t.requests[:0]
+	)
+	for _, r := range t.requests {
+		switch {
+		case r.interval.End <= committedHeight:
+			// the blocks may come from the other peers, so the request does not count
+			t.finish(r)
+		case !now.Before(r.deadline):
+			if ps := t.finish(r); ps != nil {
+				ps.update(false, t.timeout)
+			}
+			expired = append(expired, r)
+		default:
+			pending = append(pending, r)
+		}
+	}
+	t.requests = pending
+	return expired
+}
+
+// InFlight returns true if the interval has been requested and the request has not timed out yet
+func (t *peerTracker) InFlight(kind requestKind, interval syncBlocksInterval) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, r := range t.requests {
+		if r.kind == kind && r.interval.Start <= interval.Start && r.interval.End >= interval.End {
+			return true
+		}
+	}
+	return false
+}
+
+// Pick returns the peers to request the interval from. It picks the best peer having the whole interval, or
+// otherwise the best peer having a part of it. If no peer has advertised such a height, e.g., the peers are running
+// an older version, it picks up to repeat peers to increase the chance of the interval being served. The excluded
+// peers are picked only if there is no other choice.
+func (t *peerTracker) Pick(interval syncBlocksInterval, repeat int, excluded map[string]bool) []peerstore.PeerInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var whole, part, rest []*peerStats
+	classify := func(excluded map[string]bool) {
+		for id, ps := range t.peers {
+			if excluded[id] {
+				continue
+			}
+			switch {
+			case ps.height >= interval.End:
+				whole = append(whole, ps)
+			case ps.height >= interval.Start:
+				part = append(part, ps)
+			default:
+				rest = append(rest, ps)
+			}
+		}
+	}
+	classify(excluded)
+	if len(whole)+len(part)+len(rest) == 0 {
+		classify(nil)
+	}
+	candidates := whole
+	switch {
+	case len(whole) > 0:
+		repeat = 1
+	case len(part) > 0:
+		candidates, repeat = part, 1
+	default:
+		candidates = rest
+	}
+	// shuffle before sorting to break the ties randomly
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score() > candidates[j].score()
+	})
+	if repeat > len(candidates) {
+		repeat = len(candidates)
+	}
+	picked := make([]peerstore.PeerInfo, 0, repeat)
+	for _, ps := range candidates[:repeat] {
+		picked = append(picked, ps.info)
+	}
+	return picked
+}
+
+// Peer returns the neighbor of the ID
+func (t *peerTracker) Peer(peerID string) (peerstore.PeerInfo, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ps, ok := t.peers[peerID]; ok {
+		return ps.info, true
+	}
+	return peerstore.PeerInfo{}, false
+}
+
+// Requested tracks the request of the interval sent to the peer
+func (t *peerTracker) Requested(peerID string, kind requestKind, interval syncBlocksInterval) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps, ok := t.peers[peerID]
+	if !ok {
+		return
+	}
+	now := t.clock.Now()
+	ps.inFlight++
+	t.requests = append(t.requests, &syncRequest{
+		kind:     kind,
+		interval: interval,
+		peer:     peerID,
+		sent:     now,
+		deadline: now.Add(t.timeout),
+	})
+}
+
+// Failed penalizes the peer failing to serve a request, e.g., the request cannot be sent to the peer
+func (t *peerTracker) Failed(peerID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ps, ok := t.peers[peerID]; ok {
+		ps.update(false, t.timeout)
+	}
+}
+
+// finish finishes the request, and returns the peer of the request, or nil if the peer is not tracked any more
+func (t *peerTracker) finish(r *syncRequest) *peerStats {
+	ps, ok := t.peers[r.peer]
+	if !ok {
+		return nil
+	}
+	if ps.inFlight > 0 {
+		ps.inFlight--
+	}
+	return ps
+}
+
+func (ps *peerStats) update(success bool, latency time.Duration) {
+	result := 0.0
+	if success {
+		result = 1
+	}
+	ps.successRate = statsDecay*ps.successRate + (1-statsDecay)*result
+	ps.latency = time.Duration(statsDecay*float64(ps.latency) + (1-statsDecay)*float64(latency))
+}
+
+// score prefers the peers serving more requests faster, and spreads the requests over the peers
+func (ps *peerStats) score() float64 {
+	return ps.successRate / (1 + ps.latency.Seconds()) / float64(1+ps.inFlight)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerTracker(t *testing.T) {
+	require := require.New(t)
+
+	c := clock.NewMock()
+	tracker := newPeerTracker(5*time.Second, c)
+	peers := []peerstore.PeerInfo{{ID: "peer1"}, {ID: "peer2"}, {ID: "peer3"}}
+	ids := make([]string, len(peers))
+	for i, p := range peers {
+		ids[i] = p.ID.Pretty()
+	}
+	tracker.SetPeers(peers)
+	interval := syncBlocksInterval{Start: 1, End: 20}
+
+	// up to repeat peers are picked if no peer has advertised its height
+	require.Len(tracker.Pick(interval, 2, nil), 2)
+	require.Len(tracker.Pick(interval, 5, nil), 3)
+
+	// the peer having the whole interval is preferred to the peer having a part of it
+	tracker.SetHeight(peers[0], 10)
+	require.Equal([]peerstore.PeerInfo{peers[0]}, tracker.Pick(interval, 2, nil))
+	tracker.SetHeight(peers[1], 30)
+	require.Equal([]peerstore.PeerInfo{peers[1]}, tracker.Pick(interval, 2, nil))
+	// the excluded peer is picked only if there is no other choice
+	require.Equal([]peerstore.PeerInfo{peers[0]}, tracker.Pick(interval, 2, map[string]bool{ids[1]: true}))
+	require.Len(tracker.Pick(interval, 2, map[string]bool{ids[0]: true, ids[1]: true, ids[2]: true}), 1)
+
+	// the request completes with the last block of the interval
+	tracker.Requested(ids[1], blockRequest, interval)
+	require.True(tracker.InFlight(blockRequest, interval))
+	require.True(tracker.InFlight(blockRequest, syncBlocksInterval{Start: 5, End: 20}))
+	require.False(tracker.InFlight(blockRequest, syncBlocksInterval{Start: 21, End: 40}))
+	c.Add(4 * time.Second)
+	tracker.Receive(ids[1], blockRequest, 19)
+	c.Add(4 * time.Second)
+	require.Empty(tracker.Expire(0))
+	tracker.Receive(ids[1], blockRequest, 20)
+	require.False(tracker.InFlight(blockRequest, interval))
+	require.Equal(uint64(30), tracker.peers[ids[1]].height)
+	require.Equal(1.0, tracker.peers[ids[1]].successRate)
+	require.Equal(time.Duration(0.3*float64(8*time.Second)), tracker.peers[ids[1]].latency)
+
+	// the request times out without blocks, and the peer is penalized
+	tracker.Requested(ids[1], blockRequest, interval)
+	c.Add(5 * time.Second)
+	expired := tracker.Expire(0)
+	require.Len(expired, 1)
+	require.Equal(ids[1], expired[0].peer)
+	require.False(tracker.InFlight(blockRequest, interval))
+	require.InDelta(0.7, tracker.peers[ids[1]].successRate, 0.0001)
+	require.Zero(tracker.peers[ids[1]].inFlight)
+
+	// the requests for the committed blocks are dropped without penalty
+	tracker.Requested(ids[0], blockRequest, syncBlocksInterval{Start: 1, End: 10})
+	require.Empty(tracker.Expire(10))
+	require.False(tracker.InFlight(blockRequest, syncBlocksInterval{Start: 1, End: 10}))
+	require.Equal(1.0, tracker.peers[ids[0]].successRate)
+
+	// the requests are spread over the peers having the interval
+	tracker.SetHeight(peers[0], 30)
+	tracker.SetHeight(peers[2], 30)
+	for i := 0; i < 2; i++ {
+		picked := tracker.Pick(interval, 1, nil)
+		require.Len(picked, 1)
+		tracker.Requested(picked[0].ID.Pretty(), blockRequest, interval)
+	}
+	require.Equal(1, tracker.peers[ids[0]].inFlight)
+	require.Zero(tracker.peers[ids[1]].inFlight)
+	require.Equal(1, tracker.peers[ids[2]].inFlight)
+
+	// the peers not being neighbors any more are forgotten
+	tracker.SetPeers(peers[:1])
+	require.Len(tracker.peers, 1)
+	require.Equal([]peerstore.PeerInfo{peers[0]}, tracker.Pick(interval, 3, nil))
+	tracker.Receive(ids[1], blockRequest, 40)
+	require.Len(tracker.peers, 1)
+}
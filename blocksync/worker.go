@@ -8,11 +8,15 @@ package blocksync
 
 import (
 	"context"
-	"math/rand"
 	"sync"
+	"time"
 
+	"github.com/facebookgo/clock"
+	"github.com/golang/protobuf/proto"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/routine"
@@ -24,6 +28,16 @@ type syncBlocksInterval struct {
 	End   uint64
 }
 
+func (i syncBlocksInterval) overlaps(o syncBlocksInterval) bool {
+	return i.Start <= o.End && o.Start <= i.End
+}
+
+// advertisement is the status last advertised to a peer
+type advertisement struct {
+	tipHeight uint64
+	time      time.Time
+}
+
 type syncWorker struct {
 	chainID          uint32
 	mu               sync.RWMutex
@@ -31,6 +45,9 @@ type syncWorker struct {
 	unicastHandler   UnicastOutbound
 	neighborsHandler Neighbors
 	buf              *blockBuffer
+	peers            *peerTracker
+	advertised       map[string]advertisement
+	headers          *headerChain
 	task             *routine.RecurringTask
 	maxRepeat        int
 	repeatDecayStep  int
@@ -48,10 +65,15 @@ func newSyncWorker(
 		unicastHandler:   unicastHandler,
 		neighborsHandler: neighborsHandler,
 		buf:              buf,
+		peers:            newPeerTracker(cfg.BlockSync.RequestTimeout, clock.New()),
+		advertised:       make(map[string]advertisement),
 		targetHeight:     0,
 		maxRepeat:        cfg.BlockSync.MaxRepeat,
 		repeatDecayStep:  cfg.BlockSync.RepeatDecayStep,
 	}
+	if cfg.BlockSync.HeaderFirst {
+		w.headers = newHeaderChain(buf.bc, buf.cs, buf.bufferSize)
+	}
 	if cfg.BlockSync.Interval != 0 {
 		w.task = routine.NewRecurringTask(w.Sync, cfg.BlockSync.Interval)
 	}
@@ -80,7 +102,9 @@ func (w *syncWorker) SetTargetHeight(h uint64) {
 	}
 }
 
-// Sync checks the sliding window and send more sync request if needed
+// Sync advertises the tip height to the peers, checks the sliding window and sends more sync requests if needed.
+// An interval is requested from the peer most likely to serve it, and requested again from another peer if the
+// request times out. In header-first mode, the headers are requested before the bodies.
 func (w *syncWorker) Sync() {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -95,6 +119,20 @@ func (w *syncWorker) Sync() {
 		log.L().Warn("Error when get neighbor peers.", zap.Error(err))
 		return
 	}
+	w.peers.SetPeers(peers)
+	tipHeight := w.buf.bc.TipHeight()
+	w.advertise(ctx, peers, tipHeight)
+	expired := w.peers.Expire(tipHeight)
+	for _, r := range expired {
+		log.L().Debug("Block sync request timed out.",
+			zap.String("peerID", r.peer),
+			zap.Uint64("start", r.interval.Start),
+			zap.Uint64("end", r.interval.End))
+	}
+	if w.headers != nil {
+		w.fetch(ctx, tipHeight, expired)
+		return
+	}
 	intervals := w.buf.GetBlocksIntervalsToSync(w.targetHeight)
 	if intervals != nil {
 		log.L().Info("block sync intervals.",
@@ -103,18 +141,119 @@ func (w *syncWorker) Sync() {
 	}
 
 	for i, interval := range intervals {
+		if w.peers.InFlight(blockRequest, interval) {
+			continue
+		}
 		repeat := w.maxRepeat - i/w.repeatDecayStep
 		if repeat <= 0 {
 			repeat = 1
 		}
-		for j := 0; j < repeat; j++ {
-			rrIdx := rand.Intn(len(peers))
-			p := peers[rrIdx]
-			if err := w.unicastHandler(ctx, p, &iotexrpc.BlockSync{
-				Start: interval.Start, End: interval.End,
-			}); err != nil {
-				log.L().Debug("Failed to sync block.", zap.Error(err))
-			}
+		w.request(ctx, blockRequest, interval, repeat, expired, &iotexrpc.BlockSync{
+			Start: interval.Start, End: interval.End,
+		})
+	}
+}
+
+// Fetch requests more headers and bodies in header-first mode
+func (w *syncWorker) Fetch() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.fetch(context.Background(), w.buf.bc.TipHeight(), nil)
+}
+
+// RequestBlock requests the block of the height right away, e.g., the block cannot be rebuilt from a compact block
+func (w *syncWorker) RequestBlock(height uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	interval := syncBlocksInterval{Start: height, End: height}
+	if w.peers.InFlight(blockRequest, interval) {
+		return
+	}
+	w.request(context.Background(), blockRequest, interval, 1, nil, &iotexrpc.BlockSync{
+		Start: height, End: height,
+	})
+}
+
+// fetch requests the headers above the header chain, and the bodies missing in the header chain in batches from
+// multiple peers
+func (w *syncWorker) fetch(ctx context.Context, tipHeight uint64, expired []*syncRequest) {
+	// commit the next block again in case it failed before
+	if blk := w.headers.Block(tipHeight + 1); blk != nil {
+		w.buf.Flush(blk)
+	}
+	top, end := w.headers.Top(), w.targetHeight
+	if end > tipHeight+w.buf.bufferSize {
+		end = tipHeight + w.buf.bufferSize
+	}
+	if top < end && !w.peers.InFlight(headerRequest, syncBlocksInterval{Start: top + 1, End: top + 1}) {
+		w.request(ctx, headerRequest, syncBlocksInterval{Start: top + 1, End: end}, 1, expired,
+			&blocksyncpb.HeadersRequest{Start: top + 1, End: end},
+		)
+	}
+	var heights, batch []uint64
+	for _, h := range w.headers.MissingBodies() {
+		if !w.peers.InFlight(blockRequest, syncBlocksInterval{Start: h, End: h}) {
+			heights = append(heights, h)
+		}
+	}
+	for i, h := range heights {
+		batch = append(batch, h)
+		if i+1 < len(heights) && heights[i+1] == h+1 && uint64(len(batch)) < w.buf.intervalSize {
+			continue
+		}
+		w.request(ctx, blockRequest, syncBlocksInterval{Start: batch[0], End: h}, 1, expired,
+			&blocksyncpb.BodiesRequest{Heights: batch},
+		)
+		batch = nil
+	}
+}
+
+// request sends the request for the interval to the peers picked, avoiding the peers on which the request has
+// timed out
+func (w *syncWorker) request(
+	ctx context.Context,
+	kind requestKind,
+	interval syncBlocksInterval,
+	repeat int,
+	expired []*syncRequest,
+	msg proto.Message,
+) {
+	excluded := make(map[string]bool)
+	for _, r := range expired {
+		if r.kind == kind && r.interval.overlaps(interval) {
+			excluded[r.peer] = true
+		}
+	}
+	for _, p := range w.peers.Pick(interval, repeat, excluded) {
+		if err := w.unicastHandler(ctx, p, msg); err != nil {
+			log.L().Debug("Failed to sync block.", zap.Error(err))
+			w.peers.Failed(p.ID.Pretty())
+			continue
+		}
+		w.peers.Requested(p.ID.Pretty(), kind, interval)
+	}
+}
+
+// advertise sends the tip height to the peers, so that they could tell whether to request blocks from the node. A
+// peer is sent the tip height once it changes, and again after advertiseRefreshInterval in case the peer restarted.
+func (w *syncWorker) advertise(ctx context.Context, peers []peerstore.PeerInfo, tipHeight uint64) {
+	var (
+		now        = w.peers.clock.Now()
+		status     = &blocksyncpb.PeerStatus{TipHeight: tipHeight}
+		advertised = make(map[string]advertisement, len(peers))
+	)
+	for _, p := range peers {
+		id := p.ID.Pretty()
+		if last, ok := w.advertised[id]; ok && last.tipHeight == tipHeight && now.Sub(last.time) < advertiseRefreshInterval {
+			advertised[id] = last
+			continue
+		}
+		if err := w.unicastHandler(ctx, p, status); err != nil {
+			log.L().Debug("Failed to advertise peer status.", zap.Error(err))
+			continue
 		}
+		advertised[id] = advertisement{tipHeight: tipHeight, time: now}
 	}
+	// the peers no longer neighbors are forgotten
+	w.advertised = advertised
 }
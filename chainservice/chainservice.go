@@ -9,6 +9,7 @@ package chainservice
 import (
 	"context"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -26,24 +27,31 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/actsync"
+	"github.com/iotexproject/iotex-core/actsync/actsyncpb"
 	"github.com/iotexproject/iotex-core/api"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/blockdao"
 	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/blocksync"
+	"github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/consensus"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/dispatcher"
+	"github.com/iotexproject/iotex-core/follower"
 	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/pkg/compress"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/rewardhistory"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/systemlog"
 )
@@ -52,6 +60,7 @@ import (
 type ChainService struct {
 	actpool           actpool.ActPool
 	blocksync         blocksync.BlockSync
+	actsync           actsync.ActionSync
 	consensus         consensus.Consensus
 	chain             blockchain.Blockchain
 	factory           factory.Factory
@@ -62,6 +71,9 @@ type ChainService struct {
 	indexBuilder     *blockindex.IndexBuilder
 	candidateIndexer *poll.CandidateIndexer
 	registry         *protocol.Registry
+	exporter         *follower.Exporter
+	follower         *follower.Follower
+	cfg              config.Config
 }
 
 type optionParams struct {
@@ -100,9 +112,12 @@ func New(
 		indexers         []blockdao.BlockIndexer
 		indexer          blockindex.Indexer
 		systemLogIndex   *systemlog.Indexer
+		rewardHistory    *rewardhistory.Indexer
 		candidateIndexer *poll.CandidateIndexer
 		err              error
 		ops              optionParams
+		// the databases exported to the followers, listed in the reverse order they are written in
+		stores []follower.Store
 	)
 	for _, opt := range opts {
 		if err = opt(&ops); err != nil {
@@ -118,10 +133,13 @@ func New(
 			return nil, errors.Wrapf(err, "Failed to create state factory")
 		}
 	} else {
+		cfg.DB.DbPath = cfg.Chain.TrieDBPath
+		trieDB := db.NewBoltDB(cfg.DB)
+		stores = append(stores, follower.Store{Name: follower.TrieDB, KVStore: trieDB})
 		if cfg.Chain.EnableTrielessStateDB {
-			sf, err = factory.NewStateDB(cfg, factory.DefaultStateDBOption(), factory.RegistryStateDBOption(registry))
+			sf, err = factory.NewStateDB(cfg, factory.PrecreatedStateDBOption(trieDB), factory.RegistryStateDBOption(registry))
 		} else {
-			sf, err = factory.NewFactory(cfg, factory.DefaultTrieOption(), factory.RegistryOption(registry))
+			sf, err = factory.NewFactory(cfg, factory.PrecreatedTrieDBOption(trieDB), factory.RegistryOption(registry))
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "Failed to create state factory")
@@ -159,28 +177,53 @@ func New(
 	_, gateway := cfg.Plugins[config.GatewayPlugin]
 	if gateway {
 		cfg.DB.DbPath = cfg.Chain.IndexDBPath
-		indexer, err = blockindex.NewIndexer(db.NewBoltDB(cfg.DB), cfg.Genesis.Hash())
+		indexDB := db.NewBoltDB(cfg.DB)
+		indexer, err = blockindex.NewIndexer(indexDB, cfg.Genesis.Hash())
 		if err != nil {
 			return nil, err
 		}
+		stores = append([]follower.Store{{Name: follower.IndexDB, KVStore: indexDB}}, stores...)
 		if !cfg.Chain.EnableAsyncIndexWrite {
 			indexers = append(indexers, indexer)
 		}
 		if cfg.Chain.EnableSystemLogIndexer {
 			// create system log indexer
 			cfg.DB.DbPath = cfg.System.SystemLogDBPath
-			systemLogIndex, err = systemlog.NewIndexer(db.NewBoltDB(cfg.DB))
+			systemLogDB := db.NewBoltDB(cfg.DB)
+			systemLogIndex, err = systemlog.NewIndexer(systemLogDB)
 			if err != nil {
 				return nil, err
 			}
+			stores = append([]follower.Store{{Name: follower.SystemLogDB, KVStore: systemLogDB}}, stores...)
 			indexers = append(indexers, systemLogIndex)
 		}
+		if cfg.Chain.EnableRewardHistoryIndexer {
+			// create reward history indexer
+			cfg.DB.DbPath = cfg.System.RewardHistoryDBPath
+			rewardHistoryDB := db.NewBoltDB(cfg.DB)
+			stores = append([]follower.Store{{Name: follower.RewardHistoryDB, KVStore: rewardHistoryDB}}, stores...)
+			rewardHistory, err = rewardhistory.NewIndexer(
+				rewardHistoryDB,
+				rolldpos.NewProtocol(
+					cfg.Genesis.NumCandidateDelegates,
+					cfg.Genesis.NumDelegates,
+					cfg.Genesis.NumSubEpochs,
+					rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
+				),
+			)
+			if err != nil {
+				return nil, err
+			}
+			indexers = append(indexers, rewardHistory)
+		}
 		// create candidate indexer
 		cfg.DB.DbPath = cfg.Chain.CandidateIndexDBPath
-		candidateIndexer, err = poll.NewCandidateIndexer(db.NewBoltDB(cfg.DB))
+		candidateDB := db.NewBoltDB(cfg.DB)
+		candidateIndexer, err = poll.NewCandidateIndexer(candidateDB)
 		if err != nil {
 			return nil, err
 		}
+		stores = append(stores, follower.Store{Name: follower.CandidateIndexDB, KVStore: candidateDB})
 	}
 
 	// create BlockDAO
@@ -191,8 +234,27 @@ func New(
 		cfg.DB.DbPath = cfg.Chain.ChainDBPath
 		kvStore = db.NewBoltDB(cfg.DB)
 	}
+	codec, err := compress.LoadCodec(cfg.Chain.BlockCodec, cfg.Chain.BlockCodecDictFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create block codec")
+	}
 	var dao blockdao.BlockDAO
-	dao = blockdao.NewBlockDAO(kvStore, indexers, cfg.Chain.CompressBlock, cfg.DB)
+	dao = blockdao.NewBlockDAO(kvStore, indexers, cfg.Chain.CompressBlock, cfg.DB, blockdao.WithCodec(codec))
+	stores = append(stores, follower.Store{Name: follower.ChainDB, KVStore: kvStore})
+	exporter := follower.NewExporter(dao, func() []follower.Store {
+		// the split files are listed after the chain DB, which indexes the blocks in them
+		files := blockdao.HotFiles(dao)
+		indexes := make([]uint64, 0, len(files))
+		for idx := range files {
+			indexes = append(indexes, idx)
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+		all := append([]follower.Store{}, stores...)
+		for _, idx := range indexes {
+			all = append(all, follower.Store{Name: follower.SplitFile(idx), KVStore: files[idx]})
+		}
+		return all
+	})
 
 	// Create ActPool
 	actOpts := make([]actpool.Option, 0)
@@ -216,6 +278,13 @@ func New(
 	if chain == nil {
 		panic("failed to create blockchain")
 	}
+	if err := chain.AddSubscriber(exporter); err != nil {
+		log.L().Warn("Failed to add subscriber: follower exporter.", zap.Error(err))
+	}
+	var fol *follower.Follower
+	if cfg.System.Follower.LeaderURL != "" {
+		fol = follower.NewFollower(cfg.System.Follower, chain)
+	}
 	// config asks for a standalone indexer
 	var indexBuilder *blockindex.IndexBuilder
 	if gateway && cfg.Chain.EnableAsyncIndexWrite {
@@ -228,6 +297,13 @@ func New(
 	}
 	copts := []consensus.Option{
 		consensus.WithBroadcast(func(msg proto.Message) error {
+			if blk, ok := msg.(*iotextypes.Block); ok && cfg.BlockSync.CompactBlock {
+				cb, err := blocksync.NewCompactBlock(blk)
+				if err != nil {
+					return err
+				}
+				msg = cb
+			}
 			return p2pAgent.BroadcastOutbound(p2p.WitContext(context.Background(), p2p.Context{ChainID: chain.ChainID()}), msg)
 		}),
 	}
@@ -254,6 +330,29 @@ func New(
 			rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
 		)
 		copts = append(copts, consensus.WithRollDPoSProtocol(rDPoSProtocol))
+		// the evidences are only detected and committed from the genesis DoubleSignEvidenceHeight
+		evidencePool := poll.NewEvidencePool()
+		if err := chain.AddSubscriber(evidencePool); err != nil {
+			log.L().Warn("Failed to add subscriber: evidence pool.", zap.Error(err))
+		}
+		copts = append(copts, consensus.WithEvidencePool(evidencePool))
+		if stakingProtocol != nil {
+			copts = append(copts, consensus.WithBLSKeyFunc(func(operator string, height uint64) ([]byte, error) {
+				addr, err := address.FromString(operator)
+				if err != nil {
+					return nil, err
+				}
+				// the block at height is endorsed with the keys registered before it
+				tip, err := sf.Height()
+				if err != nil {
+					return nil, err
+				}
+				if tip+1 == height {
+					return staking.BLSKeyByOperator(sf, addr)
+				}
+				return staking.BLSKeyByOperator(factory.NewHistoryStateReader(sf, height-1), addr)
+			}))
+		}
 		pollProtocol, err = poll.NewProtocol(
 			cfg,
 			candidateIndexer,
@@ -296,6 +395,7 @@ func New(
 				return blockchain.Productivity(chain, start, end)
 			},
 			dao.GetBlockHash,
+			evidencePool,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to generate poll protocol")
@@ -330,6 +430,41 @@ func New(
 		return nil, errors.Wrap(err, "failed to create blockSyncer")
 	}
 
+	actSync, err := actsync.NewActionSyncer(
+		cfg,
+		actPool,
+		func(ctx context.Context, selp action.SealedEnvelope) error {
+			return addAction(ctx, actPool, registry, selp)
+		},
+		actsync.WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			ctx = p2p.WitContext(ctx, p2p.Context{ChainID: chain.ChainID()})
+			return p2pAgent.UnicastOutbound(ctx, peer, msg)
+		}),
+		actsync.WithNeighbors(p2pAgent.Neighbors),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create actSyncer")
+	}
+
+	apiOpts := []api.Option{
+		api.WithNativeElection(electionCommittee),
+		api.WithRewardHistoryIndexer(rewardHistory),
+	}
+	// the follower does not take part in p2p, so it does not accept actions
+	if fol == nil {
+		apiOpts = append(apiOpts, api.WithBroadcastOutbound(func(ctx context.Context, chainID uint32, msg proto.Message) error {
+			if actPb, ok := msg.(*iotextypes.Action); ok && cfg.ActSync.InventoryGossip {
+				var selp action.SealedEnvelope
+				if err := selp.LoadProto(actPb); err != nil {
+					return err
+				}
+				actSync.Announce(selp.Hash())
+				return nil
+			}
+			ctx = p2p.WitContext(ctx, p2p.Context{ChainID: chainID})
+			return p2pAgent.BroadcastOutbound(ctx, msg)
+		}))
+	}
 	var apiSvr *api.Server
 	apiSvr, err = api.NewServer(
 		cfg,
@@ -340,11 +475,7 @@ func New(
 		systemLogIndex,
 		actPool,
 		registry,
-		api.WithBroadcastOutbound(func(ctx context.Context, chainID uint32, msg proto.Message) error {
-			ctx = p2p.WitContext(ctx, p2p.Context{ChainID: chainID})
-			return p2pAgent.BroadcastOutbound(ctx, msg)
-		}),
-		api.WithNativeElection(electionCommittee),
+		apiOpts...,
 	)
 	if err != nil {
 		return nil, err
@@ -364,6 +495,42 @@ func New(
 		if err = pollProtocol.Register(registry); err != nil {
 			return nil, err
 		}
+		if !ops.isSubchain && p2pAgent != nil && cfg.Network.Allowlist.Consortium {
+			// the peers of the consortium members in the allowlist are allowed only if the members are delegates of
+			// the consortium committee contract at the tip
+			p2pAgent.Allowlist().SetConsortiumMembers(func(ctx context.Context) ([]string, error) {
+				tipHeight := chain.TipHeight()
+				ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: tipHeight})
+				ctx = protocol.WithBlockchainCtx(
+					protocol.WithRegistry(ctx, registry),
+					protocol.BlockchainCtx{
+						Genesis: cfg.Genesis,
+						Tip:     protocol.TipInfo{Height: tipHeight},
+					},
+				)
+				delegates, err := pollProtocol.Delegates(ctx, sf)
+				if err != nil {
+					return nil, err
+				}
+				members := make([]string, 0, len(delegates))
+				for _, d := range delegates {
+					members = append(members, d.Address)
+				}
+				return members, nil
+			})
+		}
+		// the governance protocol takes effect from the genesis GovernanceHeight
+		governanceProtocol, err := governance.NewProtocol(
+			rewarding.DepositGas,
+			pollProtocol.Delegates,
+			cfg.Genesis.Governance,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create governance protocol")
+		}
+		if err = governanceProtocol.Register(registry); err != nil {
+			return nil, err
+		}
 	}
 	executionProtocol := execution.NewProtocol(dao.GetBlockHash, rewarding.DepositGas)
 	if executionProtocol != nil {
@@ -383,17 +550,26 @@ func New(
 		factory:           sf,
 		blockdao:          dao,
 		blocksync:         bs,
+		actsync:           actSync,
 		consensus:         consensus,
 		electionCommittee: electionCommittee,
 		indexBuilder:      indexBuilder,
 		candidateIndexer:  candidateIndexer,
 		api:               apiSvr,
 		registry:          registry,
+		exporter:          exporter,
+		follower:          fol,
+		cfg:               cfg,
 	}, nil
 }
 
 // Start starts the server
 func (cs *ChainService) Start(ctx context.Context) error {
+	if cs.follower != nil {
+		if err := cs.follower.Bootstrap(ctx, cs.cfg); err != nil {
+			return errors.Wrap(err, "error when bootstrapping follower")
+		}
+	}
 	if cs.electionCommittee != nil {
 		if err := cs.electionCommittee.Start(ctx); err != nil {
 			return errors.Wrap(err, "error when starting election committee")
@@ -407,16 +583,28 @@ func (cs *ChainService) Start(ctx context.Context) error {
 	if err := cs.chain.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting blockchain")
 	}
-	if err := cs.consensus.Start(ctx); err != nil {
-		return errors.Wrap(err, "error when starting consensus")
+	if cs.follower == nil {
+		if err := cs.consensus.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting consensus")
+		}
 	}
 	if cs.indexBuilder != nil {
 		if err := cs.indexBuilder.Start(ctx); err != nil {
 			return errors.Wrap(err, "error when starting index builder")
 		}
 	}
-	if err := cs.blocksync.Start(ctx); err != nil {
-		return errors.Wrap(err, "error when starting blocksync")
+	if cs.follower != nil {
+		// the follower commits the blocks of the node it follows instead of syncing from the peers
+		if err := cs.follower.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting follower")
+		}
+	} else {
+		if err := cs.blocksync.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting blocksync")
+		}
+		if err := cs.actsync.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting actsync")
+		}
 	}
 	// TODO: explorer dependency deleted at #1085, need to revive by migrating to api
 	if cs.api != nil {
@@ -444,11 +632,20 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 			return errors.Wrap(err, "error when stopping API server")
 		}
 	}
-	if err := cs.consensus.Stop(ctx); err != nil {
-		return errors.Wrap(err, "error when stopping consensus")
-	}
-	if err := cs.blocksync.Stop(ctx); err != nil {
-		return errors.Wrap(err, "error when stopping blocksync")
+	if cs.follower != nil {
+		if err := cs.follower.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping follower")
+		}
+	} else {
+		if err := cs.consensus.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping consensus")
+		}
+		if err := cs.actsync.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping actsync")
+		}
+		if err := cs.blocksync.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping blocksync")
+		}
 	}
 	if err := cs.chain.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blockchain")
@@ -465,11 +662,20 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 func (cs *ChainService) HandleAction(ctx context.Context, actPb *iotextypes.Action) error {
 	var act action.SealedEnvelope
 	if err := act.LoadProto(actPb); err != nil {
+		p2p.ReportPeer(ctx, p2p.MalformedMessage)
 		return err
 	}
-	ctx = protocol.WithRegistry(ctx, cs.registry)
-	err := cs.actpool.Add(ctx, act)
+	return addAction(ctx, cs.actpool, cs.registry, act)
+}
+
+// addAction adds an action received from a peer into the actpool
+func addAction(ctx context.Context, ap actpool.ActPool, registry *protocol.Registry, act action.SealedEnvelope) error {
+	ctx = protocol.WithRegistry(ctx, registry)
+	err := ap.Add(ctx, act)
 	if err != nil {
+		if errors.Cause(err) == action.ErrAction {
+			p2p.ReportPeer(ctx, p2p.InvalidAction)
+		}
 		log.L().Debug(err.Error())
 	}
 	return err
@@ -477,8 +683,8 @@ func (cs *ChainService) HandleAction(ctx context.Context, actPb *iotextypes.Acti
 
 // HandleBlock handles incoming block request.
 func (cs *ChainService) HandleBlock(ctx context.Context, pbBlock *iotextypes.Block) error {
-	blk := &block.Block{}
-	if err := blk.ConvertFromBlockPb(pbBlock); err != nil {
+	blk, err := cs.loadBlock(ctx, pbBlock)
+	if err != nil {
 		return err
 	}
 	return cs.blocksync.ProcessBlock(ctx, blk)
@@ -486,13 +692,27 @@ func (cs *ChainService) HandleBlock(ctx context.Context, pbBlock *iotextypes.Blo
 
 // HandleBlockSync handles incoming block sync request.
 func (cs *ChainService) HandleBlockSync(ctx context.Context, pbBlock *iotextypes.Block) error {
-	blk := &block.Block{}
-	if err := blk.ConvertFromBlockPb(pbBlock); err != nil {
+	blk, err := cs.loadBlock(ctx, pbBlock)
+	if err != nil {
 		return err
 	}
 	return cs.blocksync.ProcessBlockSync(ctx, blk)
 }
 
+// loadBlock loads the block from a peer, and reports the peer if the block is malformed or invalid
+func (cs *ChainService) loadBlock(ctx context.Context, pbBlock *iotextypes.Block) (*block.Block, error) {
+	blk := &block.Block{}
+	if err := blk.ConvertFromBlockPb(pbBlock); err != nil {
+		p2p.ReportPeer(ctx, p2p.MalformedMessage)
+		return nil, err
+	}
+	if err := block.VerifyBlock(blk); err != nil {
+		p2p.ReportPeer(ctx, p2p.InvalidBlock)
+		return nil, err
+	}
+	return blk, nil
+}
+
 // HandleSyncRequest handles incoming sync request.
 func (cs *ChainService) HandleSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
 	return cs.blocksync.ProcessSyncRequest(ctx, peer, sync)
@@ -503,6 +723,36 @@ func (cs *ChainService) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) err
 	return cs.consensus.HandleConsensusMsg(msg)
 }
 
+// HandleMessage handles incoming message of a protocol extension.
+func (cs *ChainService) HandleMessage(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+	switch m := msg.(type) {
+	case *blocksyncpb.PeerStatus:
+		return cs.blocksync.ProcessPeerStatus(ctx, peer, m)
+	case *blocksyncpb.HeadersRequest:
+		return cs.blocksync.ProcessHeadersRequest(ctx, peer, m)
+	case *blocksyncpb.Headers:
+		return cs.blocksync.ProcessHeaders(ctx, peer, m)
+	case *blocksyncpb.BodiesRequest:
+		return cs.blocksync.ProcessBodiesRequest(ctx, peer, m)
+	case *blocksyncpb.Bodies:
+		return cs.blocksync.ProcessBodies(ctx, peer, m)
+	case *blocksyncpb.CompactBlock:
+		return cs.blocksync.ProcessCompactBlock(ctx, m)
+	case *blocksyncpb.ActionsRequest:
+		return cs.blocksync.ProcessActionsRequest(ctx, peer, m)
+	case *blocksyncpb.BlockActions:
+		return cs.blocksync.ProcessBlockActions(ctx, peer, m)
+	case *actsyncpb.ActionInventory:
+		return cs.actsync.ProcessInventory(ctx, peer, m)
+	case *actsyncpb.ActionRequest:
+		return cs.actsync.ProcessActionRequest(ctx, peer, m)
+	case *actsyncpb.Actions:
+		return cs.actsync.ProcessActions(ctx, peer, m)
+	default:
+		return errors.Errorf("unexpected message %T", msg)
+	}
+}
+
 // ChainID returns ChainID.
 func (cs *ChainService) ChainID() uint32 { return cs.chain.ChainID() }
 
@@ -531,6 +781,16 @@ func (cs *ChainService) APIServer() *api.Server {
 	return cs.api
 }
 
+// Exporter returns the exporter of the databases to the followers
+func (cs *ChainService) Exporter() *follower.Exporter {
+	return cs.exporter
+}
+
+// Follower returns the follower, or nil if the node is not a follower
+func (cs *ChainService) Follower() *follower.Follower {
+	return cs.follower
+}
+
 // Consensus returns the consensus instance
 func (cs *ChainService) Consensus() consensus.Consensus {
 	return cs.consensus
@@ -10,6 +10,8 @@ import (
 	"crypto/ecdsa"
 	"flag"
 	"math/big"
+	"net"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -101,6 +103,17 @@ var (
 			RateLimit:         p2p.DefaultRatelimitConfig,
 			EnableRateLimit:   true,
 			PrivateNetworkPSK: "",
+			Reputation: Reputation{
+				BanThreshold:  -100,
+				BanDuration:   30 * time.Minute,
+				ScoreHalfLife: 10 * time.Minute,
+			},
+			Allowlist: Allowlist{
+				Peers:          []string{},
+				File:           "",
+				ReloadInterval: time.Minute,
+				Consortium:     false,
+			},
 		},
 		Chain: Chain{
 			ChainDBPath:          "/var/data/chain.db",
@@ -119,8 +132,11 @@ var (
 			EnableTrielessStateDB:         true,
 			EnableAsyncIndexWrite:         true,
 			EnableSystemLogIndexer:        false,
+			EnableRewardHistoryIndexer:    false,
 			EnableStakingProtocol:         true,
 			CompressBlock:                 false,
+			BlockCodec:                    "",
+			BlockCodecDictFile:            "",
 			AllowedBlockGasResidue:        10000,
 			MaxCacheSize:                  0,
 			PollInitialCandidatesInterval: 10 * time.Second,
@@ -150,14 +166,24 @@ var (
 				ToleratedOvertime: 2 * time.Second,
 				Delay:             5 * time.Second,
 				ConsensusDBPath:   "/var/data/consensus.db",
+				RoundHistorySize:  100,
 			},
 		},
 		BlockSync: BlockSync{
-			Interval:        10 * time.Second,
-			BufferSize:      200,
-			IntervalSize:    20,
-			MaxRepeat:       3,
-			RepeatDecayStep: 1,
+			Interval:            10 * time.Second,
+			BufferSize:          200,
+			IntervalSize:        20,
+			MaxRepeat:           3,
+			RepeatDecayStep:     1,
+			RequestTimeout:      5 * time.Second,
+			CompactBlock:        false,
+			CompactBlockTimeout: 2 * time.Second,
+		},
+		ActSync: ActSync{
+			InventoryGossip:  false,
+			AnnounceInterval: 100 * time.Millisecond,
+			RequestTimeout:   2 * time.Second,
+			SeenCacheSize:    10000,
 		},
 		Dispatcher: Dispatcher{
 			EventChanSize: 10000,
@@ -180,6 +206,15 @@ var (
 			HTTPAdminPort:         9009,
 			StartSubChainInterval: 10 * time.Second,
 			SystemLogDBPath:       "/var/data/systemlog.db",
+			RewardHistoryDBPath:   "/var/data/rewardhistory.db",
+			HA: HA{
+				LeaseTTL: 10 * time.Second,
+			},
+			Follower: Follower{
+				ExportHost:         "127.0.0.1",
+				ExportWriteTimeout: 30 * time.Second,
+				RetryInterval:      5 * time.Second,
+			},
 		},
 		DB: DB{
 			NumRetries:   3,
@@ -190,6 +225,12 @@ var (
 			SplitDBSizeMB:         0,
 			SplitDBHeight:         900000,
 			HistoryStateRetention: 2000,
+			ColdStorage: ColdStorage{
+				ArchiveDir:      "",
+				CacheDir:        "",
+				HotFiles:        2,
+				MaxOpenArchives: 2,
+			},
 		},
 		Genesis: genesis.Default,
 	}
@@ -204,6 +245,12 @@ var (
 		ValidateDispatcher,
 		ValidateAPI,
 		ValidateActPool,
+		ValidateHA,
+		ValidateAllowlist,
+		ValidateColdStorage,
+		ValidateBlockCodec,
+		ValidateFollower,
+		ValidateEmissionSchedule,
 	}
 )
 
@@ -222,6 +269,33 @@ type (
 		RateLimit         p2p.RateLimitConfig `yaml:"rateLimit"`
 		EnableRateLimit   bool                `yaml:"enableRateLimit"`
 		PrivateNetworkPSK string              `yaml:"privateNetworkPSK"`
+		Reputation        Reputation          `yaml:"reputation"`
+		Allowlist         Allowlist           `yaml:"allowlist"`
+	}
+
+	// Allowlist is the config of the peers allowed in a permissioned network. All peers are allowed if neither peers
+	// nor file is given.
+	Allowlist struct {
+		// Peers are the IDs or the hex encoded public keys of the peers allowed
+		Peers []string `yaml:"peers"`
+		// File is a yaml file of the peers allowed, and of the peers of the consortium members keyed by the operator
+		// addresses. It is reloaded every reload interval, so that a peer could be added or revoked without restart.
+		File string `yaml:"file"`
+		// ReloadInterval is the interval to reload the file and the consortium committee
+		ReloadInterval time.Duration `yaml:"reloadInterval"`
+		// Consortium restricts the members in the file to the delegates of the consortium committee contract
+		Consortium bool `yaml:"consortium"`
+	}
+
+	// Reputation is the config of the peer reputation. The score of a peer starts at 0, drops when the peer
+	// misbehaves, and recovers towards 0 over time.
+	Reputation struct {
+		// BanThreshold is the score below which a peer is banned. Peers are never banned if it is 0
+		BanThreshold float64 `yaml:"banThreshold"`
+		// BanDuration is how long a peer is banned
+		BanDuration time.Duration `yaml:"banDuration"`
+		// ScoreHalfLife is how long it takes for a score to recover by half
+		ScoreHalfLife time.Duration `yaml:"scoreHalfLife"`
 	}
 
 	// Chain is the config struct for blockchain package
@@ -245,10 +319,17 @@ type (
 		EnableAsyncIndexWrite bool `yaml:"enableAsyncIndexWrite"`
 		// EnableSystemLogIndexer enables system log indexer
 		EnableSystemLogIndexer bool `yaml:"enableSystemLog"`
+		// EnableRewardHistoryIndexer enables the per-epoch reward history indexer
+		EnableRewardHistoryIndexer bool `yaml:"enableRewardHistory"`
 		// EnableStakingProtocol enables staking protocol
 		EnableStakingProtocol bool `yaml: "enableStakingProtocol"`
 		// CompressBlock enables gzip compression on block data
 		CompressBlock bool `yaml:"compressBlock"`
+		// BlockCodec is the codec compressing the block data, which is tagged along with the data. It takes
+		// precedence over CompressBlock, and could be none, gzip, snappy or deflateDict.
+		BlockCodec string `yaml:"blockCodec"`
+		// BlockCodecDictFile is the dictionary file of the deflateDict codec, which could be trained by iomigrater
+		BlockCodecDictFile string `yaml:"blockCodecDictFile"`
 		// AllowedBlockGasResidue is the amount of gas remained when block producer could stop processing more actions
 		AllowedBlockGasResidue uint64 `yaml:"allowedBlockGasResidue"`
 		// MaxCacheSize is the max number of blocks that will be put into an LRU cache. 0 means disabled
@@ -262,8 +343,18 @@ type (
 	// Consensus is the config struct for consensus package
 	Consensus struct {
 		// There are three schemes that are supported
-		Scheme   string   `yaml:"scheme"`
-		RollDPoS RollDPoS `yaml:"rollDPoS"`
+		Scheme     string     `yaml:"scheme"`
+		RollDPoS   RollDPoS   `yaml:"rollDPoS"`
+		Standalone Standalone `yaml:"standalone"`
+	}
+
+	// Standalone is the config struct for the standalone consensus scheme
+	Standalone struct {
+		// DevMode mints a block as soon as there are pending actions in the actpool instead of every block interval,
+		// and enables the endpoints on the admin port to mint blocks and to move the block time forward
+		DevMode bool `yaml:"devMode"`
+		// MaxIdleInterval is the longest time without a block in dev mode, or 0 to mint blocks only on demand
+		MaxIdleInterval time.Duration `yaml:"maxIdleInterval"`
 	}
 
 	// BlockSync is the config struct for the BlockSync
@@ -271,10 +362,36 @@ type (
 		Interval     time.Duration `yaml:"interval"` // update duration
 		BufferSize   uint64        `yaml:"bufferSize"`
 		IntervalSize uint64        `yaml:"intervalSize"`
-		// MaxRepeat is the maximal number of repeat of a block sync request
+		// MaxRepeat is the maximal number of repeat of a block sync request, which applies only if no peer has
+		// advertised a height to serve the request
 		MaxRepeat int `yaml:"maxRepeat"`
 		// RepeatDecayStep is the step for repeat number decreasing by 1
 		RepeatDecayStep int `yaml:"repeatDecayStep"`
+		// RequestTimeout is the time to wait for the next block of a block sync request before retrying the request
+		// with another peer
+		RequestTimeout time.Duration `yaml:"requestTimeout"`
+		// HeaderFirst fetches and verifies the headers of the blocks first, and then downloads the bodies of the
+		// blocks from multiple peers in parallel
+		HeaderFirst bool `yaml:"headerFirst"`
+		// CompactBlock broadcasts the blocks produced as compact blocks, which the receivers rebuild from the actions
+		// in their actpools. It should be enabled only if the peers support compact blocks.
+		CompactBlock bool `yaml:"compactBlock"`
+		// CompactBlockTimeout is the time to wait for the actions missing to rebuild a compact block before
+		// requesting the full block
+		CompactBlockTimeout time.Duration `yaml:"compactBlockTimeout"`
+	}
+
+	// ActSync is the config struct for the action gossip
+	ActSync struct {
+		// InventoryGossip announces the hashes of the actions to the neighbors instead of broadcasting the actions,
+		// and the neighbors request the actions they do not have. It should be enabled only if the peers support it.
+		InventoryGossip bool `yaml:"inventoryGossip"`
+		// AnnounceInterval is the interval to announce the hashes of the new actions in batches
+		AnnounceInterval time.Duration `yaml:"announceInterval"`
+		// RequestTimeout is the time to wait for an action requested before requesting it from another peer
+		RequestTimeout time.Duration `yaml:"requestTimeout"`
+		// SeenCacheSize is the number of the action hashes remembered to be known by each peer
+		SeenCacheSize int `yaml:"seenCacheSize"`
 	}
 
 	// RollDPoS is the config struct for RollDPoS consensus package
@@ -283,6 +400,11 @@ type (
 		ToleratedOvertime time.Duration   `yaml:"toleratedOvertime"`
 		Delay             time.Duration   `yaml:"delay"`
 		ConsensusDBPath   string          `yaml:"consensusDBPath"`
+		// WALPath is the path of the write-ahead log of the rounds entered and the messages signed by this node,
+		// empty to disable the log
+		WALPath string `yaml:"walPath"`
+		// RoundHistorySize is the number of recent rounds kept for introspection
+		RoundHistorySize int `yaml:"roundHistorySize"`
 	}
 
 	// ConsensusTiming defines a set of time durations used in fsm and event queue size
@@ -298,7 +420,16 @@ type (
 
 	// Dispatcher is the dispatcher config
 	Dispatcher struct {
+		// EventChanSize is the size of each queue of the events whose size is not set
 		EventChanSize uint `yaml:"eventChanSize"`
+		// ConsensusChanSize is the size of the queue of the consensus messages
+		ConsensusChanSize uint `yaml:"consensusChanSize"`
+		// BlockChanSize is the size of the queue of the blocks broadcast
+		BlockChanSize uint `yaml:"blockChanSize"`
+		// BlockSyncChanSize is the size of the queue of the block sync requests and responses
+		BlockSyncChanSize uint `yaml:"blockSyncChanSize"`
+		// ActionChanSize is the size of the queue of the actions
+		ActionChanSize uint `yaml:"actionChanSize"`
 		// TODO: explorer dependency deleted at #1085, need to revive by migrating to api
 	}
 
@@ -329,6 +460,47 @@ type (
 		HTTPStatsPort         int           `yaml:"httpStatsPort"`
 		StartSubChainInterval time.Duration `yaml:"startSubChainInterval"`
 		SystemLogDBPath       string        `yaml:"systemLogDBPath"`
+		RewardHistoryDBPath   string        `yaml:"rewardHistoryDBPath"`
+		// HA is the config of the automatic failover among the nodes sharing the producer key
+		HA HA `yaml:"ha"`
+		// Follower is the config of the read-only followers, and of exporting the databases to them
+		Follower Follower `yaml:"follower"`
+	}
+
+	// HA is the config of the lease based active/stand-by failover. The node holding the lease is active, and the
+	// others stay stand-by until the lease expires. Active is ignored when the failover is enabled.
+	HA struct {
+		// LeasePath is the path of the lease file shared by the nodes. It is empty by default, meaning the failover
+		// has been disabled
+		LeasePath string `yaml:"leasePath"`
+		// LeaseTTL is how long the lease lasts without renewal
+		LeaseTTL time.Duration `yaml:"leaseTTL"`
+		// NodeID identifies the node holding the lease. It is the host name and the process id by default
+		NodeID string `yaml:"nodeID"`
+	}
+
+	// Follower is the config of the read-only follower, which opens the snapshots of the databases of the node it
+	// follows, tails the blocks the node commits, and serves the API without taking part in p2p or consensus. The
+	// follower has to share the chain and the db configs with the node.
+	Follower struct {
+		// ExportPort is the port number to export the databases and the blocks to the followers. It is 0 by default,
+		// meaning the databases are not exported
+		ExportPort int `yaml:"exportPort"`
+		// ExportHost is the host the export endpoint listens on. It is the loopback address by default, and a token
+		// is required to listen on other hosts
+		ExportHost string `yaml:"exportHost"`
+		// ExportWriteTimeout is how long a write to a follower may block before the connection is closed. It applies
+		// to each write instead of the whole response, as the snapshots and the block feed are streamed as long as
+		// they take
+		ExportWriteTimeout time.Duration `yaml:"exportWriteTimeout"`
+		// Token is the bearer token the export endpoint requires, and the follower sends to the node it follows. It
+		// is empty by default, meaning no token is required
+		Token string `yaml:"token"`
+		// LeaderURL is the export endpoint of the node to follow, e.g. http://127.0.0.1:9010. It is empty by
+		// default, meaning the node is not a follower
+		LeaderURL string `yaml:"leaderURL"`
+		// RetryInterval is the interval to reconnect to the node after the block feed breaks
+		RetryInterval time.Duration `yaml:"retryInterval"`
 	}
 
 	// ActPool is the actpool config
@@ -364,6 +536,22 @@ type (
 		SplitDBHeight uint64 `yaml:"splitDBHeight"`
 		// HistoryStateRetention is the number of blocks account/contract state will be retained
 		HistoryStateRetention uint64 `yaml:"historyStateRetention"`
+		// ColdStorage is the config for archiving the old split DB files of blocks
+		ColdStorage ColdStorage `yaml:"coldStorage"`
+	}
+
+	// ColdStorage is the config of the cold storage, where the split DB files of blocks except the latest ones are
+	// sealed into compressed read-only archives. The archives are extracted and opened on demand.
+	ColdStorage struct {
+		// ArchiveDir is the local directory keeping the archives, which stands in for an object store. Archiving is
+		// disabled if it is empty.
+		ArchiveDir string `yaml:"archiveDir"`
+		// CacheDir is the directory where the archives are extracted. The directory of the DB is used if it is empty.
+		CacheDir string `yaml:"cacheDir"`
+		// HotFiles is the number of the latest split DB files kept in hot storage
+		HotFiles uint64 `yaml:"hotFiles"`
+		// MaxOpenArchives is the max number of archives kept extracted and opened
+		MaxOpenArchives int `yaml:"maxOpenArchives"`
 	}
 
 	// RDS is the cloud rds config
@@ -394,6 +582,7 @@ type (
 		ActPool    ActPool                     `yaml:"actPool"`
 		Consensus  Consensus                   `yaml:"consensus"`
 		BlockSync  BlockSync                   `yaml:"blockSync"`
+		ActSync    ActSync                     `yaml:"actSync"`
 		Dispatcher Dispatcher                  `yaml:"dispatcher"`
 		API        API                         `yaml:"api"`
 		System     System                      `yaml:"system"`
@@ -607,6 +796,123 @@ func ValidateAPI(cfg Config) error {
 	return nil
 }
 
+// ValidateHA validates the failover configs
+func ValidateHA(cfg Config) error {
+	if cfg.System.HA.LeasePath == "" {
+		return nil
+	}
+	if cfg.System.HA.LeaseTTL < time.Second {
+		return errors.Wrap(ErrInvalidCfg, "lease ttl should be at least 1 second")
+	}
+	return nil
+}
+
+// ValidateAllowlist validates the peer allowlist configs
+func ValidateAllowlist(cfg Config) error {
+	allowlist := cfg.Network.Allowlist
+	if allowlist.File != "" && allowlist.ReloadInterval <= 0 {
+		return errors.Wrap(ErrInvalidCfg, "allowlist reload interval should be greater than 0")
+	}
+	if allowlist.Consortium && (allowlist.File == "" || cfg.Genesis.PollMode != "consortium") {
+		return errors.Wrap(ErrInvalidCfg, "consortium allowlist requires the allowlist file and the consortium poll mode")
+	}
+	return nil
+}
+
+// ValidateColdStorage validates the cold storage configs
+func ValidateColdStorage(cfg Config) error {
+	coldStorage := cfg.DB.ColdStorage
+	if coldStorage.ArchiveDir == "" {
+		return nil
+	}
+	if cfg.DB.SplitDBSizeMB == 0 {
+		return errors.Wrap(ErrInvalidCfg, "cold storage requires split DB files")
+	}
+	if coldStorage.HotFiles == 0 || coldStorage.MaxOpenArchives <= 0 {
+		return errors.Wrap(ErrInvalidCfg, "hot files and max open archives should be greater than 0")
+	}
+	return nil
+}
+
+// ValidateBlockCodec validates the block codec configs
+func ValidateBlockCodec(cfg Config) error {
+	switch cfg.Chain.BlockCodec {
+	case "", "none", "gzip", "snappy":
+		return nil
+	case "deflateDict":
+		if cfg.Chain.BlockCodecDictFile == "" {
+			return errors.Wrap(ErrInvalidCfg, "deflateDict block codec requires a dictionary file")
+		}
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidCfg, "unknown block codec %s", cfg.Chain.BlockCodec)
+	}
+}
+
+// ValidateEmissionSchedule validates the emission schedule does not overlap the reward switches at Aleutian and
+// Dardanelles height, which would be overridden by the schedule silently
+func ValidateEmissionSchedule(cfg Config) error {
+	schedule := cfg.Genesis.EmissionSchedule
+	if len(schedule) == 0 {
+		return nil
+	}
+	if cfg.Genesis.NumDelegates == 0 || cfg.Genesis.NumSubEpochs == 0 || cfg.Genesis.DardanellesNumSubEpochs == 0 {
+		return errors.Wrap(ErrInvalidCfg, "emission schedule requires the number of delegates and sub epochs")
+	}
+	for _, height := range []uint64{cfg.Genesis.AleutianBlockHeight, cfg.Genesis.DardanellesBlockHeight} {
+		if epoch := epochNum(cfg.Genesis.Blockchain, height); schedule[0].StartEpoch <= epoch {
+			return errors.Wrapf(
+				ErrInvalidCfg,
+				"emission schedule starting at epoch %d overlaps the reward switch at height %d of epoch %d",
+				schedule[0].StartEpoch,
+				height,
+				epoch,
+			)
+		}
+	}
+	return nil
+}
+
+// epochNum returns the epoch number of the height, the same as the roll-DPoS protocol does
+func epochNum(g genesis.Blockchain, height uint64) uint64 {
+	if height == 0 {
+		return 0
+	}
+	if height <= g.DardanellesBlockHeight {
+		return (height-1)/g.NumDelegates/g.NumSubEpochs + 1
+	}
+	dardanellesEpoch := epochNum(g, g.DardanellesBlockHeight)
+	dardanellesEpochHeight := (dardanellesEpoch-1)*g.NumDelegates*g.NumSubEpochs + 1
+	return dardanellesEpoch + (height-dardanellesEpochHeight)/g.NumDelegates/g.DardanellesNumSubEpochs
+}
+
+// ValidateFollower validates the read-only follower configs
+func ValidateFollower(cfg Config) error {
+	follower := cfg.System.Follower
+	if follower.ExportPort > 0 {
+		if ip := net.ParseIP(follower.ExportHost); follower.Token == "" && (ip == nil || !ip.IsLoopback()) {
+			return errors.Wrapf(ErrInvalidCfg, "exporting on host %s requires a token", follower.ExportHost)
+		}
+		if follower.ExportWriteTimeout <= 0 {
+			return errors.Wrap(ErrInvalidCfg, "export write timeout should be greater than 0")
+		}
+	}
+	if follower.LeaderURL == "" {
+		return nil
+	}
+	u, err := url.Parse(follower.LeaderURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Wrapf(ErrInvalidCfg, "invalid leader url %s", follower.LeaderURL)
+	}
+	if follower.RetryInterval <= 0 {
+		return errors.Wrap(ErrInvalidCfg, "follower retry interval should be greater than 0")
+	}
+	if cfg.System.HA.LeasePath != "" {
+		return errors.Wrap(ErrInvalidCfg, "follower cannot take part in the failover")
+	}
+	return nil
+}
+
 // ValidateActPool validates the given config
 func ValidateActPool(cfg Config) error {
 	maxNumActPerPool := cfg.ActPool.MaxNumActsPerPool
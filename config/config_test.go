@@ -14,11 +14,14 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/go-pkgs/crypto"
+
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 )
 
 const (
@@ -253,6 +256,113 @@ func TestValidateArchiveMode(t *testing.T) {
 	require.NoError(t, errors.Cause(ValidateArchiveMode(cfg)))
 }
 
+func TestValidateHA(t *testing.T) {
+	cfg := Default
+	cfg.System.HA.LeaseTTL = 0
+	require.NoError(t, ValidateHA(cfg))
+	cfg.System.HA.LeasePath = "lease.json"
+	err := ValidateHA(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "lease ttl should be at least 1 second")
+	cfg.System.HA.LeaseTTL = Default.System.HA.LeaseTTL
+	require.NoError(t, ValidateHA(cfg))
+}
+
+func TestValidateAllowlist(t *testing.T) {
+	cfg := Default
+	cfg.Network.Allowlist.ReloadInterval = 0
+	require.NoError(t, ValidateAllowlist(cfg))
+	cfg.Network.Allowlist.File = "allowlist.yaml"
+	err := ValidateAllowlist(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "allowlist reload interval should be greater than 0")
+	cfg.Network.Allowlist.ReloadInterval = Default.Network.Allowlist.ReloadInterval
+	require.NoError(t, ValidateAllowlist(cfg))
+	cfg.Network.Allowlist.Consortium = true
+	err = ValidateAllowlist(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	cfg.Genesis.PollMode = "consortium"
+	require.NoError(t, ValidateAllowlist(cfg))
+}
+
+func TestValidateColdStorage(t *testing.T) {
+	cfg := Default
+	cfg.DB.ColdStorage.HotFiles = 0
+	require.NoError(t, ValidateColdStorage(cfg))
+	cfg.DB.ColdStorage.ArchiveDir = "archive"
+	err := ValidateColdStorage(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "cold storage requires split DB files")
+	cfg.DB.SplitDBSizeMB = 1
+	err = ValidateColdStorage(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	cfg.DB.ColdStorage.HotFiles = Default.DB.ColdStorage.HotFiles
+	require.NoError(t, ValidateColdStorage(cfg))
+}
+
+func TestValidateBlockCodec(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateBlockCodec(cfg))
+	cfg.Chain.BlockCodec = "snappy"
+	require.NoError(t, ValidateBlockCodec(cfg))
+	cfg.Chain.BlockCodec = "deflateDict"
+	err := ValidateBlockCodec(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "deflateDict block codec requires a dictionary file")
+	cfg.Chain.BlockCodecDictFile = "block.dict"
+	require.NoError(t, ValidateBlockCodec(cfg))
+	cfg.Chain.BlockCodec = "zip"
+	err = ValidateBlockCodec(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+}
+
+func TestValidateFollower(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateFollower(cfg))
+	cfg.System.Follower.LeaderURL = "127.0.0.1:9009"
+	err := ValidateFollower(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "invalid leader url")
+	cfg.System.Follower.LeaderURL = "http://127.0.0.1:9009"
+	require.NoError(t, ValidateFollower(cfg))
+	cfg.System.Follower.RetryInterval = 0
+	err = ValidateFollower(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	cfg.System.Follower.RetryInterval = time.Second
+	cfg.System.HA.LeasePath = "/var/lease"
+	err = ValidateFollower(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "follower cannot take part in the failover")
+
+	cfg = Default
+	cfg.System.Follower.ExportPort = 9010
+	require.NoError(t, ValidateFollower(cfg))
+	cfg.System.Follower.ExportHost = "0.0.0.0"
+	err = ValidateFollower(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "requires a token")
+	cfg.System.Follower.Token = "secret"
+	require.NoError(t, ValidateFollower(cfg))
+	cfg.System.Follower.ExportWriteTimeout = 0
+	err = ValidateFollower(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+}
+
+func TestValidateEmissionSchedule(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateEmissionSchedule(cfg))
+	// Dardanelles height 1816201 is in epoch 37838
+	require.Equal(t, uint64(18001), epochNum(cfg.Genesis.Blockchain, cfg.Genesis.AleutianBlockHeight))
+	require.Equal(t, uint64(37838), epochNum(cfg.Genesis.Blockchain, cfg.Genesis.DardanellesBlockHeight))
+	require.Equal(t, uint64(37839), epochNum(cfg.Genesis.Blockchain, cfg.Genesis.DardanellesBlockHeight+720))
+	cfg.Genesis.EmissionSchedule = []genesis.EmissionStep{{StartEpoch: 37838}}
+	err := ValidateEmissionSchedule(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.Contains(t, err.Error(), "overlaps the reward switch")
+	cfg.Genesis.EmissionSchedule[0].StartEpoch = 37839
+	require.NoError(t, ValidateEmissionSchedule(cfg))
+}
+
 func TestValidateActPool(t *testing.T) {
 	cfg := Default
 	cfg.ActPool.MaxNumActsPerAcct = 0
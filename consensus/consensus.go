@@ -8,6 +8,7 @@ package consensus
 
 import (
 	"context"
+	"time"
 
 	"github.com/facebookgo/clock"
 	"github.com/pkg/errors"
@@ -39,6 +40,9 @@ type Consensus interface {
 	Metrics() (scheme.ConsensusMetrics, error)
 	Activate(bool)
 	Active() bool
+	// SetLease sets the term and expiry of the lease of the producer key shared by the nodes, and the node signs
+	// nothing after the lease expires or changes hands
+	SetLease(uint64, time.Time)
 }
 
 // IotxConsensus implements Consensus
@@ -51,6 +55,8 @@ type optionParams struct {
 	broadcastHandler scheme.Broadcast
 	pp               poll.Protocol
 	rp               *rp.Protocol
+	evidencePool     *poll.EvidencePool
+	blsKeyFunc       rolldpos.BLSKeyFunc
 }
 
 // Option sets Consensus construction parameter.
@@ -80,6 +86,22 @@ func WithPollProtocol(pp poll.Protocol) Option {
 	}
 }
 
+// WithBLSKeyFunc is an option to look up the registered BLS keys of delegates
+func WithBLSKeyFunc(blsKeyFunc rolldpos.BLSKeyFunc) Option {
+	return func(ops *optionParams) error {
+		ops.blsKeyFunc = blsKeyFunc
+		return nil
+	}
+}
+
+// WithEvidencePool is an option to collect double sign evidences detected by consensus
+func WithEvidencePool(ep *poll.EvidencePool) Option {
+	return func(ops *optionParams) error {
+		ops.evidencePool = ep
+		return nil
+	}
+}
+
 // NewConsensus creates a IotxConsensus struct.
 func NewConsensus(
 	cfg config.Config,
@@ -141,6 +163,12 @@ func NewConsensus(
 				return addrs, nil
 			}).
 			RegisterProtocol(ops.rp)
+		if ops.evidencePool != nil {
+			bd = bd.SetDoubleSignHandler(ops.evidencePool.Add)
+		}
+		if ops.blsKeyFunc != nil {
+			bd = bd.SetBLSKeyFunc(ops.blsKeyFunc)
+		}
 		// TODO: explorer dependency deleted here at #1085, need to revive by migrating to api
 		cs.scheme, err = bd.Build()
 		if err != nil {
@@ -149,10 +177,10 @@ func NewConsensus(
 	case config.NOOPScheme:
 		cs.scheme = scheme.NewNoop()
 	case config.StandaloneScheme:
-		mintBlockCB := func() (*block.Block, error) {
+		mintBlockCB := func(timestamp time.Time) (*block.Block, error) {
 			actionMap := ap.PendingActionMap()
 			log.Logger("consensus").Debug("Pick actions.", zap.Int("actions", len(actionMap)))
-			blk, err := bc.MintNewBlock(actionMap, clock.Now())
+			blk, err := bc.MintNewBlock(actionMap, timestamp)
 			if err != nil {
 				log.Logger("consensus").Error("Failed to mint a block.", zap.Error(err))
 				return nil, err
@@ -177,12 +205,22 @@ func NewConsensus(
 			}
 			return nil
 		}
+		standaloneOpts := []scheme.StandaloneOption{scheme.WithStandaloneClock(clock)}
+		if cfg.Consensus.Standalone.DevMode {
+			standaloneOpts = append(standaloneOpts, scheme.WithOnDemandMinting(
+				func() bool {
+					return len(ap.PendingActionMap()) > 0
+				},
+				cfg.Consensus.Standalone.MaxIdleInterval,
+			))
+		}
 		cs.scheme = scheme.NewStandalone(
 			mintBlockCB,
 			commitBlockCB,
 			broadcastBlockCB,
 			bc,
 			cfg.Genesis.BlockInterval,
+			standaloneOpts...,
 		)
 	default:
 		return nil, errors.Errorf("unexpected IotxConsensus scheme %s", cfg.Consensus.Scheme)
@@ -245,3 +283,6 @@ func (c *IotxConsensus) Activate(active bool) {
 
 // Active returns true if the consensus component is active or false if it stands by
 func (c *IotxConsensus) Active() bool { return c.scheme.Active() }
+
+// SetLease sets the lease of the producer key shared by the nodes
+func (c *IotxConsensus) SetLease(term uint64, expiry time.Time) { c.scheme.SetLease(term, expiry) }
@@ -12,6 +12,14 @@ import (
 	"github.com/iotexproject/iotex-core/config"
 )
 
+// The timeouts of a round, one for each TTL, which are reported to Context.OnTimeout when hit
+const (
+	AcceptBlockTimeout               = "AcceptBlockTTL"
+	AcceptProposalEndorsementTimeout = "AcceptProposalEndorsementTTL"
+	AcceptLockEndorsementTimeout     = "AcceptLockEndorsementTTL"
+	CommitTimeout                    = "CommitTTL"
+)
+
 type (
 	// ConsensusConfig defines a set of time durations used in fsm
 	ConsensusConfig interface {
@@ -30,6 +30,8 @@ type Context interface {
 	Broadcast(interface{})
 
 	Prepare() error
+	LogState(fsm.State) error
+	LoggedState() fsm.State
 	IsDelegate() bool
 	Proposal() (interface{}, error)
 	WaitUntilRoundStart() time.Duration
@@ -38,5 +40,6 @@ type Context interface {
 	NewLockEndorsement(interface{}) (interface{}, error)
 	NewPreCommitEndorsement(interface{}) (interface{}, error)
 	Commit(interface{}) (bool, error)
+	OnTimeout(string)
 	ConsensusConfig
 }
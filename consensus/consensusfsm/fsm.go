@@ -8,6 +8,7 @@ package consensusfsm
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -94,6 +95,15 @@ type ConsensusFSM struct {
 	clock clock.Clock
 	ctx   Context
 	wg    sync.WaitGroup
+	// a stepping fsm only handles events in Step, and keeps the delayed events sorted by due time in delayed
+	stepping bool
+	mutex    sync.Mutex
+	delayed  []*delayedEvent
+}
+
+type delayedEvent struct {
+	at  time.Time
+	evt *ConsensusEvent
 }
 
 // NewConsensusFSM returns a new fsm
@@ -115,6 +125,8 @@ func NewConsensusFSM(ctx Context, clock clock.Clock) (*ConsensusFSM, error) {
 		AddTransition(sPrepare, ePrepare, cm.prepare, []fsm.State{
 			sPrepare,
 			sAcceptBlockProposal,
+			sAcceptProposalEndorsement, // resume from the state logged before a restart
+			sAcceptLockEndorsement,     // resume from the state logged before a restart
 			sAcceptPreCommitEndorsement,
 		}).
 		AddTransition(
@@ -215,8 +227,18 @@ func NewConsensusFSM(ctx Context, clock clock.Clock) (*ConsensusFSM, error) {
 	return cm, nil
 }
 
+// EnableStepping makes the fsm handle events only when Step is called instead of in a goroutine of its own, and keep
+// the delayed events until Step finds them due on the clock, so that the fsm can be driven deterministically with a
+// mock clock. It must be called before Start.
+func (m *ConsensusFSM) EnableStepping() {
+	m.stepping = true
+}
+
 // Start starts the fsm and get in initial state
 func (m *ConsensusFSM) Start(c context.Context) error {
+	if m.stepping {
+		return nil
+	}
 	m.wg.Add(1)
 	go func() {
 		running := true
@@ -245,6 +267,30 @@ func (m *ConsensusFSM) Stop(_ context.Context) error {
 	return nil
 }
 
+// Step handles the pending events and the delayed events which are due, as well as the events they produce in turn,
+// until there is none left
+func (m *ConsensusFSM) Step() {
+	for {
+		select {
+		case <-m.close:
+			return
+		default:
+		}
+		m.queueDueEvents()
+		select {
+		case evt := <-m.evtq:
+			if err := m.handle(evt); err != nil {
+				m.ctx.Logger().Error(
+					"consensus state transition fails",
+					zap.Error(err),
+				)
+			}
+		default:
+			return
+		}
+	}
+}
+
 // CurrentState returns the current state
 func (m *ConsensusFSM) CurrentState() fsm.State {
 	return m.fsm.CurrentState()
@@ -299,6 +345,10 @@ func (m *ConsensusFSM) produce(evt *ConsensusEvent, delay time.Duration) {
 		return
 	}
 	consensusEvtsMtc.WithLabelValues(string(evt.Type()), "produced").Inc()
+	if delay > 0 && m.stepping {
+		m.delay(evt, delay)
+		return
+	}
 	if delay > 0 {
 		m.wg.Add(1)
 		go func() {
@@ -314,6 +364,31 @@ func (m *ConsensusFSM) produce(evt *ConsensusEvent, delay time.Duration) {
 	}
 }
 
+// delay keeps the event until it is due, after the events due at the same time produced before it
+func (m *ConsensusFSM) delay(evt *ConsensusEvent, delay time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	at := m.clock.Now().Add(delay)
+	i := sort.Search(len(m.delayed), func(i int) bool {
+		return m.delayed[i].at.After(at)
+	})
+	m.delayed = append(m.delayed, nil)
+	copy(m.delayed[i+1:], m.delayed[i:])
+	m.delayed[i] = &delayedEvent{at: at, evt: evt}
+}
+
+// queueDueEvents moves the delayed events which are due into the event queue
+func (m *ConsensusFSM) queueDueEvents() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	now := m.clock.Now()
+	i := 0
+	for ; i < len(m.delayed) && !m.delayed[i].at.After(now); i++ {
+		m.evtq <- m.delayed[i].evt
+	}
+	m.delayed = m.delayed[i:]
+}
+
 func (m *ConsensusFSM) handle(evt *ConsensusEvent) error {
 	if m.ctx.IsStaleEvent(evt) {
 		m.ctx.Logger().Debug("stale event", zap.Any("event", evt.Type()))
@@ -331,6 +406,11 @@ func (m *ConsensusFSM) handle(evt *ConsensusEvent) error {
 	err := m.fsm.Handle(evt)
 	switch errors.Cause(err) {
 	case nil:
+		if dst := m.fsm.CurrentState(); dst != src {
+			if err := m.ctx.LogState(dst); err != nil {
+				m.ctx.Logger().Error("failed to log consensus state", zap.Error(err))
+			}
+		}
 		m.ctx.Logger().Debug(
 			"consensus state transition happens",
 			zap.String("src", string(src)),
@@ -435,6 +515,11 @@ func (m *ConsensusFSM) prepare(evt fsm.Event) (fsm.State, error) {
 	m.produceConsensusEvent(eStopReceivingLockEndorsement, ttl)
 	ttl += m.ctx.CommitTTL(h)
 	m.produceConsensusEvent(eStopReceivingPreCommitEndorsement, ttl)
+	switch state := m.ctx.LoggedState(); state {
+	case sAcceptProposalEndorsement, sAcceptLockEndorsement, sAcceptPreCommitEndorsement:
+		// the node restarts in the middle of the round, resume from the state it has reached
+		return state, nil
+	}
 	return sAcceptBlockProposal, nil
 }
 
@@ -465,6 +550,7 @@ func (m *ConsensusFSM) processBlock(block interface{}) error {
 
 func (m *ConsensusFSM) onFailedToReceiveBlock(evt fsm.Event) (fsm.State, error) {
 	m.ctx.Logger().Warn("didn't receive the proposed block before timeout")
+	m.ctx.OnTimeout(AcceptBlockTimeout)
 	if err := m.processBlock(nil); err != nil {
 		m.ctx.Logger().Debug("Failed to generate proposal endorsement", zap.Error(err))
 	}
@@ -493,6 +579,7 @@ func (m *ConsensusFSM) onReceiveProposalEndorsement(evt fsm.Event) (fsm.State, e
 
 func (m *ConsensusFSM) onStopReceivingProposalEndorsement(evt fsm.Event) (fsm.State, error) {
 	m.ctx.Logger().Warn("Not enough proposal endorsements")
+	m.ctx.OnTimeout(AcceptProposalEndorsementTimeout)
 
 	return sAcceptLockEndorsement, nil
 }
@@ -528,6 +615,7 @@ func (m *ConsensusFSM) onBroadcastPreCommitEndorsement(evt fsm.Event) (fsm.State
 
 func (m *ConsensusFSM) onStopReceivingLockEndorsement(evt fsm.Event) (fsm.State, error) {
 	m.ctx.Logger().Warn("Not enough lock endorsements")
+	m.ctx.OnTimeout(AcceptLockEndorsementTimeout)
 
 	return m.BackToPrepare(0)
 }
@@ -546,6 +634,7 @@ func (m *ConsensusFSM) onReceivePreCommitEndorsement(evt fsm.Event) (fsm.State,
 
 func (m *ConsensusFSM) onStopReceivingPreCommitEndorsement(evt fsm.Event) (fsm.State, error) {
 	m.ctx.Logger().Warn("Not enough pre-commit endorsements")
+	m.ctx.OnTimeout(CommitTimeout)
 
 	return m.BackToPrepare(0)
 }
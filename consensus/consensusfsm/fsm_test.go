@@ -32,6 +32,7 @@ func TestBackdoorEvt(t *testing.T) {
 	mockCtx.EXPECT().EventChanSize().Return(uint(10)).AnyTimes()
 	mockCtx.EXPECT().Logger().Return(log.Logger("consensus")).AnyTimes()
 	mockCtx.EXPECT().Prepare().Return(nil).AnyTimes()
+	mockCtx.EXPECT().LogState(gomock.Any()).Return(nil).AnyTimes()
 	mockCtx.EXPECT().NewConsensusEvent(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(eventType fsm.EventType, data interface{}) *ConsensusEvent {
 			return &ConsensusEvent{
@@ -61,6 +62,81 @@ func TestBackdoorEvt(t *testing.T) {
 	}
 }
 
+func TestResumeLoggedState(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCtx := NewMockContext(ctrl)
+	mockCtx.EXPECT().IsFutureEvent(gomock.Any()).Return(false).AnyTimes()
+	mockCtx.EXPECT().IsStaleEvent(gomock.Any()).Return(false).AnyTimes()
+	mockCtx.EXPECT().EventChanSize().Return(uint(10)).AnyTimes()
+	mockCtx.EXPECT().Logger().Return(log.Logger("consensus")).AnyTimes()
+	mockCtx.EXPECT().AcceptBlockTTL(gomock.Any()).Return(4 * time.Second).AnyTimes()
+	mockCtx.EXPECT().AcceptProposalEndorsementTTL(gomock.Any()).Return(2 * time.Second).AnyTimes()
+	mockCtx.EXPECT().AcceptLockEndorsementTTL(gomock.Any()).Return(2 * time.Second).AnyTimes()
+	mockCtx.EXPECT().CommitTTL(gomock.Any()).Return(2 * time.Second).AnyTimes()
+	mockCtx.EXPECT().NewConsensusEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(eventType fsm.EventType, data interface{}) *ConsensusEvent {
+			return &ConsensusEvent{
+				eventType: eventType,
+				data:      data,
+			}
+		}).AnyTimes()
+	mockCtx.EXPECT().Prepare().Return(nil).Times(1)
+	mockCtx.EXPECT().Proposal().Return(nil, nil).Times(1)
+	mockCtx.EXPECT().WaitUntilRoundStart().Return(time.Duration(0)).Times(1)
+	mockCtx.EXPECT().IsDelegate().Return(true).Times(1)
+	mockCtx.EXPECT().PreCommitEndorsement().Return(nil).Times(1)
+	// the node has reached the lock step of the round before restarting
+	mockCtx.EXPECT().LoggedState().Return(sAcceptLockEndorsement).Times(1)
+	mockCtx.EXPECT().LogState(sAcceptLockEndorsement).Return(nil).Times(1)
+	cfsm, err := NewConsensusFSM(mockCtx, clock.NewMock())
+	require.NoError(err)
+
+	require.NoError(cfsm.handle(&ConsensusEvent{eventType: ePrepare}))
+	require.Equal(sAcceptLockEndorsement, cfsm.CurrentState())
+}
+
+func TestStepping(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCtx := NewMockContext(ctrl)
+	mockCtx.EXPECT().IsFutureEvent(gomock.Any()).Return(false).AnyTimes()
+	mockCtx.EXPECT().IsStaleEvent(gomock.Any()).Return(false).AnyTimes()
+	mockCtx.EXPECT().EventChanSize().Return(uint(10)).AnyTimes()
+	mockCtx.EXPECT().Logger().Return(log.Logger("consensus")).AnyTimes()
+	mockCtx.EXPECT().LogState(gomock.Any()).Return(nil).AnyTimes()
+	mockClock := clock.NewMock()
+	cfsm, err := NewConsensusFSM(mockCtx, mockClock)
+	require.NoError(err)
+	cfsm.EnableStepping()
+	require.NoError(cfsm.Start(context.Background()))
+
+	// the delayed events are handled in the order of due time once due
+	cfsm.produce(&ConsensusEvent{eventType: BackdoorEvent, data: sAcceptLockEndorsement}, 2*time.Second)
+	cfsm.produce(&ConsensusEvent{eventType: BackdoorEvent, data: sAcceptBlockProposal}, time.Second)
+	cfsm.Step()
+	require.Equal(sPrepare, cfsm.CurrentState())
+	mockClock.Add(time.Second)
+	cfsm.Step()
+	require.Equal(sAcceptBlockProposal, cfsm.CurrentState())
+	cfsm.produce(&ConsensusEvent{eventType: BackdoorEvent, data: sAcceptProposalEndorsement}, 0)
+	cfsm.Step()
+	require.Equal(sAcceptProposalEndorsement, cfsm.CurrentState())
+	mockClock.Add(time.Second)
+	cfsm.Step()
+	require.Equal(sAcceptLockEndorsement, cfsm.CurrentState())
+
+	// a stopped fsm does not handle events any more
+	require.NoError(cfsm.Stop(context.Background()))
+	cfsm.produce(&ConsensusEvent{eventType: BackdoorEvent, data: sPrepare}, 0)
+	cfsm.Step()
+	require.Equal(sAcceptLockEndorsement, cfsm.CurrentState())
+}
+
 func TestStateTransitionFunctions(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
@@ -75,6 +151,8 @@ func TestStateTransitionFunctions(t *testing.T) {
 	mockCtx.EXPECT().AcceptLockEndorsementTTL(gomock.Any()).Return(2 * time.Second).AnyTimes()
 	mockCtx.EXPECT().CommitTTL(gomock.Any()).Return(2 * time.Second).AnyTimes()
 	mockCtx.EXPECT().UnmatchedEventInterval(gomock.Any()).Return(100 * time.Millisecond).AnyTimes()
+	mockCtx.EXPECT().LogState(gomock.Any()).Return(nil).AnyTimes()
+	mockCtx.EXPECT().LoggedState().Return(fsm.State("")).AnyTimes()
 	mockCtx.EXPECT().NewConsensusEvent(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(eventType fsm.EventType, data interface{}) *ConsensusEvent {
 			return &ConsensusEvent{
@@ -248,6 +326,7 @@ func TestStateTransitionFunctions(t *testing.T) {
 	})
 	t.Run("onFailedToReceiveBlock", func(t *testing.T) {
 		mockCtx.EXPECT().NewProposalEndorsement(nil).Return(NewMockEndorsement(ctrl), nil).Times(1)
+		mockCtx.EXPECT().OnTimeout(AcceptBlockTimeout).Times(1)
 		mockCtx.EXPECT().Broadcast(gomock.Any()).Return().Times(1)
 		state, err := cfsm.onFailedToReceiveBlock(nil)
 		require.NoError(err)
@@ -308,6 +387,7 @@ func TestStateTransitionFunctions(t *testing.T) {
 		})
 	})
 	t.Run("onStopReceivingProposalEndorsement", func(t *testing.T) {
+		mockCtx.EXPECT().OnTimeout(AcceptProposalEndorsementTimeout).Times(1)
 		state, err := cfsm.onStopReceivingProposalEndorsement(nil)
 		require.NoError(err)
 		require.Equal(sAcceptLockEndorsement, state)
@@ -357,6 +437,7 @@ func TestStateTransitionFunctions(t *testing.T) {
 		})
 	})
 	t.Run("onStopReceivingLockEndorsement", func(t *testing.T) {
+		mockCtx.EXPECT().OnTimeout(AcceptLockEndorsementTimeout).Times(1)
 		mockCtx.EXPECT().Active().Return(true).Times(1)
 		state, err := cfsm.onStopReceivingLockEndorsement(nil)
 		require.NoError(err)
@@ -185,6 +185,34 @@ func (mr *MockContextMockRecorder) Prepare() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockContext)(nil).Prepare))
 }
 
+// LogState mocks base method
+func (m *MockContext) LogState(arg0 go_fsm.State) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogState", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogState indicates an expected call of LogState
+func (mr *MockContextMockRecorder) LogState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogState", reflect.TypeOf((*MockContext)(nil).LogState), arg0)
+}
+
+// LoggedState mocks base method
+func (m *MockContext) LoggedState() go_fsm.State {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoggedState")
+	ret0, _ := ret[0].(go_fsm.State)
+	return ret0
+}
+
+// LoggedState indicates an expected call of LoggedState
+func (mr *MockContextMockRecorder) LoggedState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoggedState", reflect.TypeOf((*MockContext)(nil).LoggedState))
+}
+
 // IsDelegate mocks base method
 func (m *MockContext) IsDelegate() bool {
 	m.ctrl.T.Helper()
@@ -302,6 +330,18 @@ func (mr *MockContextMockRecorder) Commit(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockContext)(nil).Commit), arg0)
 }
 
+// OnTimeout mocks base method
+func (m *MockContext) OnTimeout(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnTimeout", arg0)
+}
+
+// OnTimeout indicates an expected call of OnTimeout
+func (mr *MockContextMockRecorder) OnTimeout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnTimeout", reflect.TypeOf((*MockContext)(nil).OnTimeout), arg0)
+}
+
 // EventChanSize mocks base method
 func (m *MockContext) EventChanSize() uint {
 	m.ctrl.T.Helper()
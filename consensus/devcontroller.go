@@ -0,0 +1,111 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// MaxDevMintBlocks is the maximum number of blocks minted by one request, so that the request finishes before the
+// write timeout of the admin server
+const MaxDevMintBlocks = 100
+
+type devScheme interface {
+	Mint(int) ([]*block.Block, error)
+	JumpTime(time.Duration) (time.Time, error)
+}
+
+// DevController serves the endpoints of the standalone dev mode on the admin port
+type DevController struct {
+	c Consensus
+}
+
+// NewDevController constructs a dev controller
+func NewDevController(c Consensus) *DevController {
+	return &DevController{
+		c: c,
+	}
+}
+
+// Handle handles admin request. Path /mint mints the number of blocks given by "blocks", 1 by default, with the
+// pending actions. Path /time moves the time of the blocks minted from now on forward by "jump", e.g., 72h to pass a
+// staking withdraw waiting period.
+func (dc *DevController) Handle(w http.ResponseWriter, r *http.Request) {
+	ds := dc.devScheme()
+	if ds == nil {
+		http.Error(w, "consensus scheme does not support dev mode", http.StatusNotFound)
+		return
+	}
+	var payload interface{}
+	query := r.URL.Query()
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/mint"):
+		n := 1
+		if v := query.Get("blocks"); v != "" {
+			var err error
+			if n, err = strconv.Atoi(v); err != nil || n <= 0 || n > MaxDevMintBlocks {
+				http.Error(w, "invalid number of blocks "+v, http.StatusBadRequest)
+				return
+			}
+		}
+		blks, err := ds.Mint(n)
+		if err != nil {
+			log.L().Error("Failed to mint blocks in dev mode.", zap.Int("minted", len(blks)), zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		heights := make([]uint64, 0, len(blks))
+		for _, blk := range blks {
+			heights = append(heights, blk.Height())
+		}
+		payload = struct {
+			Heights []uint64 `json:"heights"`
+		}{heights}
+	case strings.HasSuffix(r.URL.Path, "/time"):
+		jump, err := time.ParseDuration(query.Get("jump"))
+		if err != nil {
+			http.Error(w, "invalid time jump "+query.Get("jump"), http.StatusBadRequest)
+			return
+		}
+		next, err := ds.JumpTime(jump)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		payload = struct {
+			NextBlockTime time.Time `json:"nextBlockTime"`
+		}{next}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (dc *DevController) devScheme() devScheme {
+	ic, ok := dc.c.(*IotxConsensus)
+	if !ok {
+		return nil
+	}
+	ds, ok := ic.scheme.(devScheme)
+	if !ok {
+		return nil
+	}
+	return ds
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos"
+)
+
+// MaxRoundEventsWait is the longest time a request for round events waits for a new event, which is shorter than the
+// write timeout of the admin server
+const MaxRoundEventsWait = 3 * time.Second
+
+type roundHistory interface {
+	RecentRounds() []*rolldpos.RoundRecord
+	RoundEvents(uint64) []*rolldpos.RoundEvent
+	SubscribeRoundEvents() (<-chan *rolldpos.RoundEvent, func())
+}
+
+// RoundInspector serves the recent consensus rounds and their events on the admin port
+type RoundInspector struct {
+	c Consensus
+}
+
+// NewRoundInspector constructs a round inspector
+func NewRoundInspector(c Consensus) *RoundInspector {
+	return &RoundInspector{
+		c: c,
+	}
+}
+
+// Handle handles admin request. Path /rounds returns the records of the recent rounds. Path /events returns the
+// events after the sequence number "after", and waits up to "wait" for one if there is none yet, so that the events
+// are streamed by polling with the sequence number of the last event received.
+func (ri *RoundInspector) Handle(w http.ResponseWriter, r *http.Request) {
+	rh := ri.roundHistory()
+	if rh == nil {
+		http.Error(w, "consensus scheme does not keep round history", http.StatusNotFound)
+		return
+	}
+	var payload interface{}
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/rounds"):
+		payload = rh.RecentRounds()
+	case strings.HasSuffix(r.URL.Path, "/events"):
+		var (
+			after uint64
+			wait  time.Duration
+			err   error
+		)
+		query := r.URL.Query()
+		if v := query.Get("after"); v != "" {
+			if after, err = strconv.ParseUint(v, 10, 64); err != nil {
+				http.Error(w, "invalid sequence number "+v, http.StatusBadRequest)
+				return
+			}
+		}
+		if v := query.Get("wait"); v != "" {
+			if wait, err = time.ParseDuration(v); err != nil {
+				http.Error(w, "invalid wait duration "+v, http.StatusBadRequest)
+				return
+			}
+		}
+		if wait > MaxRoundEventsWait {
+			wait = MaxRoundEventsWait
+		}
+		payload = waitRoundEvents(r, rh, after, wait)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (ri *RoundInspector) roundHistory() roundHistory {
+	ic, ok := ri.c.(*IotxConsensus)
+	if !ok {
+		return nil
+	}
+	rh, ok := ic.scheme.(roundHistory)
+	if !ok {
+		return nil
+	}
+	return rh
+}
+
+func waitRoundEvents(r *http.Request, rh roundHistory, after uint64, wait time.Duration) []*rolldpos.RoundEvent {
+	// subscribe before reading the history, so that no event is missed in between
+	events, cancel := rh.SubscribeRoundEvents()
+	defer cancel()
+	if evts := rh.RoundEvents(after); len(evts) > 0 || wait <= 0 {
+		return evts
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-events:
+	case <-timer.C:
+	case <-r.Context().Done():
+	}
+	return rh.RoundEvents(after)
+}
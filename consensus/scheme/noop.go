@@ -8,6 +8,7 @@ package scheme
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -61,3 +62,6 @@ func (n *Noop) Activate(_ bool) {
 
 // Active is always true for noop scheme
 func (n *Noop) Active() bool { return true }
+
+// SetLease does nothing for noop scheme, which signs nothing
+func (n *Noop) SetLease(uint64, time.Time) {}
@@ -18,6 +18,8 @@ type EndorsedConsensusMessage struct {
 	height      uint64
 	message     endorsement.Document
 	endorsement *endorsement.Endorsement
+	// blsSignature is the BLS signature of a commit vote, which is aggregated into the block footer
+	blsSignature []byte
 }
 
 // NewEndorsedConsensusMessage creates an EndorsedConsensusMessage for an consensus vote
@@ -43,6 +45,11 @@ func (ecm *EndorsedConsensusMessage) Endorsement() *endorsement.Endorsement {
 	return ecm.endorsement
 }
 
+// BLSSignature returns the BLS signature of the document, or nil if it is not signed with a BLS key
+func (ecm *EndorsedConsensusMessage) BLSSignature() []byte {
+	return ecm.blsSignature
+}
+
 // Height returns the height of this message
 func (ecm *EndorsedConsensusMessage) Height() uint64 {
 	return ecm.height
@@ -55,8 +62,9 @@ func (ecm *EndorsedConsensusMessage) Proto() (*iotextypes.ConsensusMessage, erro
 		return nil, err
 	}
 	cmsg := &iotextypes.ConsensusMessage{
-		Height:      ecm.height,
-		Endorsement: ebp,
+		Height:       ecm.height,
+		Endorsement:  ebp,
+		BlsSignature: ecm.blsSignature,
 	}
 	switch message := ecm.message.(type) {
 	case *ConsensusVote:
@@ -97,6 +105,7 @@ func (ecm *EndorsedConsensusMessage) LoadProto(msg *iotextypes.ConsensusMessage)
 		return errors.New("unknown message")
 	}
 	ecm.height = msg.Height
+	ecm.blsSignature = msg.GetBlsSignature()
 	ecm.endorsement = &endorsement.Endorsement{}
 
 	return ecm.endorsement.LoadProto(msg.GetEndorsement())
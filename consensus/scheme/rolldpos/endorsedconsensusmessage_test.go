@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/bls"
 	"github.com/iotexproject/iotex-core/test/identityset"
 )
 
@@ -44,3 +45,27 @@ func TestEndorsedConsensusMessage(t *testing.T) {
 	require.True(now.Equal(cen.Timestamp()))
 	require.Equal(priKey.PublicKey().HexString(), en.Endorser().HexString())
 }
+
+func TestEndorsedConsensusMessageBLSSignature(t *testing.T) {
+	require := require.New(t)
+	vote := NewConsensusVote([]byte("abcdefg"), COMMIT)
+	en := endorsement.NewEndorsement(time.Now(), identityset.PrivateKey(0).PublicKey(), []byte("signature"))
+	endorsedMessage := NewEndorsedConsensusMessage(10, vote, en)
+	sk, err := bls.DerivePrivateKey(identityset.PrivateKey(0).Bytes())
+	require.NoError(err)
+	endorsedMessage.blsSignature, err = endorsement.BLSSign(sk, vote)
+	require.NoError(err)
+	pb, err := endorsedMessage.Proto()
+	require.NoError(err)
+	cem := &EndorsedConsensusMessage{}
+	require.NoError(cem.LoadProto(pb))
+	require.Equal(endorsedMessage.BLSSignature(), cem.BLSSignature())
+	require.True(endorsement.VerifyBLSSignature(cem.Document(), sk.PublicKey(), cem.BLSSignature()))
+
+	// messages without BLS signature are unchanged
+	pb, err = NewEndorsedConsensusMessage(10, vote, en).Proto()
+	require.NoError(err)
+	require.Empty(pb.BlsSignature)
+	require.NoError(cem.LoadProto(pb))
+	require.Empty(cem.BLSSignature())
+}
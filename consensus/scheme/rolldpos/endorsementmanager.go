@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
@@ -367,6 +368,34 @@ func (m *endorsementManager) AddVoteEndorsement(
 	return nil
 }
 
+// ConflictingEndorsement returns the block hash and the endorsement of another block endorsed by the same endorser on
+// the same topic at the same time as the given vote, which proves that the endorser signs two conflicting blocks
+func (m *endorsementManager) ConflictingEndorsement(
+	vote *ConsensusVote,
+	en *endorsement.Endorsement,
+) ([]byte, *endorsement.Endorsement) {
+	if len(vote.BlockHash()) == 0 {
+		return nil, nil
+	}
+	encoded := encodeToString(vote.BlockHash())
+	endorser := en.Endorser().HexString()
+	for encodedHash, c := range m.collections {
+		if encodedHash == encoded || encodedHash == "" {
+			continue
+		}
+		e := c.Endorsement(endorser, vote.Topic())
+		if e == nil || !e.Timestamp().Equal(en.Timestamp()) {
+			continue
+		}
+		blkHash, err := hex.DecodeString(encodedHash)
+		if err != nil {
+			continue
+		}
+		return blkHash, e
+	}
+	return nil, nil
+}
+
 func (m *endorsementManager) Cleanup(timestamp time.Time) error {
 	if !timestamp.IsZero() {
 		for encoded, c := range m.collections {
@@ -381,6 +410,25 @@ func (m *endorsementManager) Cleanup(timestamp time.Time) error {
 	return nil
 }
 
+// Endorsers returns the addresses of the endorsers of each block on each topic, keyed by the encoded block hash
+func (m *endorsementManager) Endorsers() map[string]map[ConsensusVoteTopic][]string {
+	endorsers := make(map[string]map[ConsensusVoteTopic][]string, len(m.collections))
+	for encoded, c := range m.collections {
+		topics := map[ConsensusVoteTopic][]string{}
+		for _, ee := range c.endorsers {
+			for topic, en := range ee.endorsements {
+				addr, err := address.FromBytes(en.Endorser().Hash())
+				if err != nil {
+					continue
+				}
+				topics[topic] = append(topics[topic], addr.String())
+			}
+		}
+		endorsers[encoded] = topics
+	}
+	return endorsers
+}
+
 func (m *endorsementManager) Log(
 	logger *zap.Logger,
 	delegates []string,
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/test/identityset"
@@ -190,3 +191,52 @@ func TestEndorsementManagerProto(t *testing.T) {
 	encoded := encodeToString(cv.BlockHash())
 	require.Equal(em.collections[encoded].endorsers, em2.collections[encoded].endorsers)
 }
+
+func TestConflictingEndorsement(t *testing.T) {
+	require := require.New(t)
+	em, err := newEndorsementManager(nil)
+	require.NoError(err)
+	priKey := identityset.PrivateKey(0)
+	now := time.Now()
+
+	vote1 := NewConsensusVote([]byte{1, 2, 3}, COMMIT)
+	en1, err := endorsement.Endorse(priKey, vote1, now)
+	require.NoError(err)
+	require.NoError(em.AddVoteEndorsement(vote1, en1))
+	blkHash, conflicting := em.ConflictingEndorsement(vote1, en1)
+	require.Nil(blkHash)
+	require.Nil(conflicting)
+
+	// endorse another block in a different round
+	vote2 := NewConsensusVote([]byte{4, 5, 6}, COMMIT)
+	en2, err := endorsement.Endorse(priKey, vote2, now.Add(time.Second))
+	require.NoError(err)
+	require.NoError(em.AddVoteEndorsement(vote2, en2))
+	blkHash, conflicting = em.ConflictingEndorsement(vote2, en2)
+	require.Nil(blkHash)
+	require.Nil(conflicting)
+
+	// endorse another block in the same round
+	vote3 := NewConsensusVote([]byte{7, 8, 9}, COMMIT)
+	en3, err := endorsement.Endorse(priKey, vote3, now)
+	require.NoError(err)
+	require.NoError(em.AddVoteEndorsement(vote3, en3))
+	blkHash, conflicting = em.ConflictingEndorsement(vote3, en3)
+	require.Equal([]byte{1, 2, 3}, blkHash)
+	require.Equal(en1, conflicting)
+
+	// the evidence built from the two votes is verifiable on chain
+	msg1, err := NewEndorsedConsensusMessage(10, vote3, en3).Proto()
+	require.NoError(err)
+	msg2, err := NewEndorsedConsensusMessage(10, NewConsensusVote(blkHash, COMMIT), conflicting).Proto()
+	require.NoError(err)
+	require.NoError(action.NewDoubleSignEvidence(0, msg1, msg2).Verify())
+
+	// a different endorser is not conflicting
+	vote4 := NewConsensusVote([]byte{4, 5, 6}, COMMIT)
+	en4, err := endorsement.Endorse(identityset.PrivateKey(1), vote4, now)
+	require.NoError(err)
+	blkHash, conflicting = em.ConflictingEndorsement(vote4, en4)
+	require.Nil(blkHash)
+	require.Nil(conflicting)
+}
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: wal.proto
+
+package endorsementpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type WalRecordType int32
+
+const (
+	WalRecordType_ROUND          WalRecordType = 0
+	WalRecordType_BLOCK_PROPOSAL WalRecordType = 1
+	WalRecordType_VOTE           WalRecordType = 2
+	WalRecordType_LOCK           WalRecordType = 3
+	WalRecordType_STATE          WalRecordType = 4
+)
+
+var WalRecordType_name = map[int32]string{
+	0: "ROUND",
+	1: "BLOCK_PROPOSAL",
+	2: "VOTE",
+	3: "LOCK",
+	4: "STATE",
+}
+
+var WalRecordType_value = map[string]int32{
+	"ROUND":          0,
+	"BLOCK_PROPOSAL": 1,
+	"VOTE":           2,
+	"LOCK":           3,
+	"STATE":          4,
+}
+
+func (x WalRecordType) String() string {
+	return proto.EnumName(WalRecordType_name, int32(x))
+}
+
+func (WalRecordType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae6364fc8077884f, []int{0}
+}
+
+type WalRecord struct {
+	Type                 WalRecordType             `protobuf:"varint,1,opt,name=type,proto3,enum=endorsementpb.WalRecordType" json:"type,omitempty"`
+	Height               uint64                    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round                uint32                    `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Topic                uint32                    `protobuf:"varint,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	BlockHash            []byte                    `protobuf:"bytes,5,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	ProofOfLock          []*iotextypes.Endorsement `protobuf:"bytes,6,rep,name=proofOfLock,proto3" json:"proofOfLock,omitempty"`
+	State                string                    `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *WalRecord) Reset()         { *m = WalRecord{} }
+func (m *WalRecord) String() string { return proto.CompactTextString(m) }
+func (*WalRecord) ProtoMessage()    {}
+func (*WalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6364fc8077884f, []int{0}
+}
+
+func (m *WalRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalRecord.Unmarshal(m, b)
+}
+func (m *WalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalRecord.Marshal(b, m, deterministic)
+}
+func (m *WalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalRecord.Merge(m, src)
+}
+func (m *WalRecord) XXX_Size() int {
+	return xxx_messageInfo_WalRecord.Size(m)
+}
+func (m *WalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalRecord proto.InternalMessageInfo
+
+func (m *WalRecord) GetType() WalRecordType {
+	if m != nil {
+		return m.Type
+	}
+	return WalRecordType_ROUND
+}
+
+func (m *WalRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WalRecord) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *WalRecord) GetTopic() uint32 {
+	if m != nil {
+		return m.Topic
+	}
+	return 0
+}
+
+func (m *WalRecord) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *WalRecord) GetProofOfLock() []*iotextypes.Endorsement {
+	if m != nil {
+		return m.ProofOfLock
+	}
+	return nil
+}
+
+func (m *WalRecord) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("endorsementpb.WalRecordType", WalRecordType_name, WalRecordType_value)
+	proto.RegisterType((*WalRecord)(nil), "endorsementpb.walRecord")
+}
+
+func init() { proto.RegisterFile("wal.proto", fileDescriptor_ae6364fc8077884f) }
+
+var fileDescriptor_ae6364fc8077884f = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x41, 0x4f, 0xf2, 0x40,
+	0x10, 0x86, 0xbf, 0x85, 0xc2, 0x67, 0x17, 0x21, 0xcd, 0xc6, 0x68, 0x63, 0x30, 0x69, 0x3c, 0x35,
+	0x26, 0xb6, 0x06, 0x4f, 0x1e, 0x41, 0x49, 0x8c, 0x56, 0x4b, 0x96, 0xea, 0xc1, 0x8b, 0xa1, 0xdb,
+	0x85, 0x56, 0x4a, 0x67, 0xb3, 0xbb, 0x44, 0xf9, 0xd7, 0xfe, 0x04, 0xd3, 0xd6, 0x08, 0xdc, 0xfa,
+	0xbc, 0xf3, 0x4c, 0x77, 0x26, 0x83, 0xcd, 0xcf, 0x59, 0xee, 0x09, 0x09, 0x1a, 0x48, 0x97, 0x17,
+	0x09, 0x48, 0xc5, 0x57, 0xbc, 0xd0, 0x22, 0x3e, 0x3d, 0xab, 0x52, 0x5f, 0x6f, 0x04, 0x57, 0xfe,
+	0x4e, 0xa9, 0xb6, 0xcf, 0xbf, 0x51, 0xd5, 0x4b, 0x39, 0x03, 0x99, 0x90, 0x2b, 0x6c, 0x94, 0xa2,
+	0x8d, 0x1c, 0xe4, 0xf6, 0x06, 0x7d, 0x6f, 0xef, 0x57, 0xde, 0x9f, 0x17, 0x6d, 0x04, 0xa7, 0x95,
+	0x49, 0x8e, 0x71, 0x3b, 0xe5, 0xd9, 0x22, 0xd5, 0x76, 0xc3, 0x41, 0xae, 0x41, 0x7f, 0x89, 0x1c,
+	0xe1, 0x96, 0x84, 0x75, 0x91, 0xd8, 0x4d, 0x07, 0xb9, 0x5d, 0x5a, 0x43, 0x99, 0x6a, 0x10, 0x19,
+	0xb3, 0x8d, 0x3a, 0xad, 0x80, 0xf4, 0xb1, 0x19, 0xe7, 0xc0, 0x96, 0xf7, 0x33, 0x95, 0xda, 0x2d,
+	0x07, 0xb9, 0x87, 0x74, 0x1b, 0x90, 0x1b, 0xdc, 0x11, 0x12, 0x60, 0x1e, 0xce, 0x03, 0x60, 0x4b,
+	0xbb, 0xed, 0x34, 0xdd, 0xce, 0xe0, 0xc4, 0xcb, 0x40, 0xf3, 0xaf, 0x6a, 0x2b, 0x6f, 0xbc, 0x9d,
+	0x92, 0xee, 0xba, 0xe5, 0x73, 0x4a, 0xcf, 0x34, 0xb7, 0xff, 0x3b, 0xc8, 0x35, 0x69, 0x0d, 0x17,
+	0x4f, 0xb8, 0xbb, 0xb7, 0x09, 0x31, 0x71, 0x8b, 0x86, 0x2f, 0xcf, 0x77, 0xd6, 0x3f, 0x42, 0x70,
+	0x6f, 0x14, 0x84, 0xb7, 0x8f, 0xef, 0x13, 0x1a, 0x4e, 0xc2, 0xe9, 0x30, 0xb0, 0x10, 0x39, 0xc0,
+	0xc6, 0x6b, 0x18, 0x8d, 0xad, 0x46, 0xf9, 0x55, 0x16, 0xad, 0x66, 0xd9, 0x32, 0x8d, 0x86, 0xd1,
+	0xd8, 0x32, 0x46, 0xc1, 0xdb, 0xc3, 0x22, 0xd3, 0xe9, 0x3a, 0xf6, 0x18, 0xac, 0xfc, 0x6a, 0x2c,
+	0x21, 0xe1, 0x83, 0x33, 0x5d, 0xc3, 0x25, 0x03, 0xc9, 0x7d, 0x06, 0x85, 0xe2, 0x85, 0x5a, 0x2b,
+	0x5f, 0xb1, 0x94, 0xaf, 0xb8, 0x2f, 0x21, 0xcf, 0x13, 0x01, 0x7b, 0x37, 0x11, 0x71, 0xdc, 0xae,
+	0xce, 0x72, 0xfd, 0x33, 0x00, 0x39, 0x70, 0x9e, 0x50, 0xd1, 0x01, 0x00, 0x00,
+}
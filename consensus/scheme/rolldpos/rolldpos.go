@@ -26,6 +26,7 @@ import (
 	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
 	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/bls"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -175,6 +176,9 @@ func (r *RollDPoS) ValidateBlockFooter(blk *block.Block) error {
 	if err := round.AddBlock(blk); err != nil {
 		return err
 	}
+	if r.ctx.blsEnabled(height) {
+		return r.ctx.verifyAggregateEndorsement(round, blk)
+	}
 	blkHash := blk.HashBlock()
 	for _, en := range blk.Endorsements() {
 		if err := round.AddVoteEndorsement(
@@ -218,8 +222,9 @@ func (r *RollDPoS) CurrentState() fsm.State {
 	return r.cfsm.CurrentState()
 }
 
-// Activate activates or pauses the roll-DPoS consensus. When it is deactivated, the node will finish the current
-// consensus round if it is doing the work and then return the the initial state
+// Activate activates or pauses the roll-DPoS consensus. When it is deactivated, the node stops signing and
+// broadcasting right away, and returns to the initial state after the current consensus round. When it is activated,
+// the node starts signing from the next round.
 func (r *RollDPoS) Activate(active bool) {
 	r.ctx.Activate(active)
 	// reactivate cfsm if the node is reactivated
@@ -233,6 +238,27 @@ func (r *RollDPoS) Active() bool {
 	return r.ctx.Active() || r.cfsm.CurrentState() != consensusfsm.InitState
 }
 
+// SetLease sets the lease of the producer key shared by the nodes, which fences the signing of the node
+func (r *RollDPoS) SetLease(term uint64, expiry time.Time) {
+	r.ctx.SetLease(term, expiry)
+}
+
+// RecentRounds returns the records of the recent consensus rounds, the oldest first
+func (r *RollDPoS) RecentRounds() []*RoundRecord {
+	return r.ctx.history.Records()
+}
+
+// RoundEvents returns the recent events of the consensus rounds after the given sequence number, the oldest first
+func (r *RollDPoS) RoundEvents(after uint64) []*RoundEvent {
+	return r.ctx.history.Events(after)
+}
+
+// SubscribeRoundEvents returns a channel of the events of the consensus rounds, and a function to cancel the
+// subscription. The events are dropped if the subscriber falls behind.
+func (r *RollDPoS) SubscribeRoundEvents() (<-chan *RoundEvent, func()) {
+	return r.ctx.history.Subscribe()
+}
+
 // Builder is the builder for RollDPoS
 type Builder struct {
 	cfg config.Config
@@ -246,6 +272,8 @@ type Builder struct {
 	// TODO: explorer dependency deleted at #1085, need to add api params
 	rp                   *rolldpos.Protocol
 	delegatesByEpochFunc DelegatesByEpochFunc
+	doubleSignHandler    DoubleSignHandler
+	blsKeyFunc           BLSKeyFunc
 }
 
 // NewRollDPoSBuilder instantiates a Builder instance
@@ -303,6 +331,20 @@ func (b *Builder) SetDelegatesByEpochFunc(
 	return b
 }
 
+// SetDoubleSignHandler sets doubleSignHandler
+func (b *Builder) SetDoubleSignHandler(
+	doubleSignHandler DoubleSignHandler,
+) *Builder {
+	b.doubleSignHandler = doubleSignHandler
+	return b
+}
+
+// SetBLSKeyFunc sets blsKeyFunc, without which the BLS endorsements of delegates cannot be verified
+func (b *Builder) SetBLSKeyFunc(blsKeyFunc BLSKeyFunc) *Builder {
+	b.blsKeyFunc = blsKeyFunc
+	return b
+}
+
 // RegisterProtocol sets the rolldpos protocol
 func (b *Builder) RegisterProtocol(rp *rolldpos.Protocol) *Builder {
 	b.rp = rp
@@ -343,6 +385,22 @@ func (b *Builder) Build() (*RollDPoS, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error when constructing consensus context")
 	}
+	ctx.doubleSignHandler = b.doubleSignHandler
+	ctx.evidenceHeight = b.cfg.Genesis.DoubleSignEvidenceHeight
+	ctx.blsKeyFunc = b.blsKeyFunc
+	ctx.blsHeight = b.cfg.Genesis.BLSEndorsementHeight
+	if b.priKey != nil {
+		// the BLS key of a delegate is derived from its block producer key
+		if ctx.blsKey, err = bls.DerivePrivateKey(b.priKey.Bytes()); err != nil {
+			return nil, errors.Wrap(err, "error when deriving the BLS key")
+		}
+	}
+	if walPath := b.cfg.Consensus.RollDPoS.WALPath; walPath != "" {
+		ctx.wal = newConsensusWAL(walPath)
+	}
+	if size := b.cfg.Consensus.RollDPoS.RoundHistorySize; size > 0 {
+		ctx.history = newRoundHistory(size)
+	}
 	cfsm, err := consensusfsm.NewConsensusFSM(ctx, b.clock)
 	if err != nil {
 		return nil, errors.Wrap(err, "error when constructing the consensus FSM")
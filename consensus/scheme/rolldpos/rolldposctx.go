@@ -7,6 +7,7 @@
 package rolldpos
 
 import (
+	"bytes"
 	"context"
 	"sync"
 	"time"
@@ -19,14 +20,17 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
 	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/bls"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -73,6 +77,14 @@ func init() {
 
 // DelegatesByEpochFunc defines a function to overwrite candidates
 type DelegatesByEpochFunc func(uint64) ([]string, error)
+
+// DoubleSignHandler defines a function to handle the evidence of a delegate signing two conflicting blocks
+type DoubleSignHandler func(*action.DoubleSignEvidence) error
+
+// BLSKeyFunc defines a function to get the BLS public key a delegate endorses the block at a height with, i.e., the
+// key registered in the state before that block
+type BLSKeyFunc func(string, uint64) ([]byte, error)
+
 type rollDPoSCtx struct {
 	consensusfsm.ConsensusConfig
 
@@ -82,6 +94,7 @@ type rollDPoSCtx struct {
 	broadcastHandler  scheme.Broadcast
 	roundCalc         *roundCalculator
 	eManagerDB        db.KVStore
+	wal               *consensusWAL
 	toleratedOvertime time.Duration
 
 	encodedAddr string
@@ -89,7 +102,23 @@ type rollDPoSCtx struct {
 	round       *roundCtx
 	clock       clock.Clock
 	active      bool
+	// activatedAt is the time the node switched from stand-by to active mode
+	activatedAt time.Time
+	// the lease of the producer key shared by the nodes, and the node signs nothing after it expires
+	leaseTerm   uint64
+	leaseExpiry time.Time
 	mutex       sync.RWMutex
+
+	// the double signs are reported to doubleSignHandler from evidenceHeight
+	doubleSignHandler DoubleSignHandler
+	evidenceHeight    uint64
+	history           *roundHistory
+
+	// the commit votes are signed with BLS keys from blsHeight
+	blsKey     *bls.PrivateKey
+	blsHeight  uint64
+	blsKeyFunc BLSKeyFunc
+	blsKeys    sync.Map
 }
 
 func newRollDPoSCtx(
@@ -153,6 +182,7 @@ func newRollDPoSCtx(
 		roundCalc:         roundCalc,
 		eManagerDB:        eManagerDB,
 		toleratedOvertime: toleratedOvertime,
+		history:           newRoundHistory(defaultRoundHistorySize),
 	}, nil
 }
 
@@ -164,12 +194,25 @@ func (ctx *rollDPoSCtx) Start(c context.Context) (err error) {
 		}
 		eManager, err = newEndorsementManager(ctx.eManagerDB)
 	}
+	if ctx.wal != nil {
+		if err := ctx.wal.Start(); err != nil {
+			return errors.Wrap(err, "error when replaying the consensus wal")
+		}
+		if err := ctx.wal.Prune(ctx.chain.TipHeight() + 1); err != nil {
+			return errors.Wrap(err, "error when pruning the consensus wal")
+		}
+	}
 	ctx.round, err = ctx.roundCalc.NewRoundWithToleration(0, ctx.BlockInterval(0), ctx.clock.Now(), eManager, ctx.toleratedOvertime)
 
 	return err
 }
 
 func (ctx *rollDPoSCtx) Stop(c context.Context) error {
+	if ctx.wal != nil {
+		if err := ctx.wal.Stop(); err != nil {
+			return errors.Wrap(err, "error when closing the consensus wal")
+		}
+	}
 	if ctx.eManagerDB != nil {
 		return ctx.eManagerDB.Stop(c)
 	}
@@ -300,6 +343,24 @@ func (ctx *rollDPoSCtx) Prepare() error {
 	if err != nil {
 		return err
 	}
+	if ctx.wal != nil {
+		if newRound.height != ctx.round.height {
+			if err := ctx.wal.Prune(newRound.height); err != nil {
+				return err
+			}
+			// restore the lock of a height left in the middle by a restart
+			blkHash, proofOfLock, ok, err := ctx.wal.Lock(newRound.height)
+			if err != nil {
+				return err
+			}
+			if ok {
+				newRound.setLock(blkHash, proofOfLock)
+			}
+		}
+		if err := ctx.wal.LogRound(newRound.height, newRound.roundNum); err != nil {
+			return err
+		}
+	}
 	ctx.logger().Debug(
 		"new round",
 		zap.Uint64("height", newRound.height),
@@ -310,11 +371,30 @@ func (ctx *rollDPoSCtx) Prepare() error {
 		zap.String("roundStartTime", newRound.roundStartTime.String()),
 	)
 	ctx.round = newRound
+	ctx.history.StartRound(newRound, ctx.clock.Now())
 	consensusHeightMtc.WithLabelValues().Set(float64(ctx.round.height))
 	timeSlotMtc.WithLabelValues().Set(float64(ctx.round.roundNum))
 	return nil
 }
 
+func (ctx *rollDPoSCtx) LogState(state fsm.State) error {
+	ctx.mutex.RLock()
+	defer ctx.mutex.RUnlock()
+	if ctx.wal == nil {
+		return nil
+	}
+	return ctx.wal.LogState(ctx.round.Height(), ctx.round.Number(), string(state))
+}
+
+func (ctx *rollDPoSCtx) LoggedState() fsm.State {
+	ctx.mutex.RLock()
+	defer ctx.mutex.RUnlock()
+	if ctx.wal == nil {
+		return ""
+	}
+	return fsm.State(ctx.wal.State(ctx.round.Height(), ctx.round.Number()))
+}
+
 func (ctx *rollDPoSCtx) IsDelegate() bool {
 	ctx.mutex.RLock()
 	defer ctx.mutex.RUnlock()
@@ -343,12 +423,12 @@ func (ctx *rollDPoSCtx) WaitUntilRoundStart() time.Duration {
 	now := ctx.clock.Now()
 	startTime := ctx.round.StartTime()
 	if now.Before(startTime) {
-		time.Sleep(startTime.Sub(now))
+		ctx.clock.Sleep(startTime.Sub(now))
 		return 0
 	}
 	overTime := now.Sub(startTime)
 	if !ctx.isDelegate() && ctx.toleratedOvertime > overTime {
-		time.Sleep(ctx.toleratedOvertime - overTime)
+		ctx.clock.Sleep(ctx.toleratedOvertime - overTime)
 		return 0
 	}
 	return overTime
@@ -386,6 +466,7 @@ func (ctx *rollDPoSCtx) NewProposalEndorsement(msg interface{}) (interface{}, er
 		if err := ctx.round.AddBlock(proposal.block); err != nil {
 			return nil, err
 		}
+		ctx.history.AddProposal(blockHash, ctx.clock.Now())
 		ctx.loggerWithStats().Debug("accept block proposal", log.Hex("block", blockHash))
 	} else if ctx.round.IsLocked() {
 		blockHash = ctx.round.HashOfBlockInLock()
@@ -469,22 +550,39 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 	if pendingBlock == nil {
 		return false, nil
 	}
-	ctx.logger().Info("consensus reached", zap.Uint64("blockHeight", ctx.round.Height()))
-	if err := pendingBlock.Finalize(
-		ctx.round.Endorsements(blkHash, []ConsensusVoteTopic{COMMIT}),
-		ctx.round.StartTime().Add(
-			ctx.AcceptBlockTTL(ctx.round.height)+ctx.AcceptProposalEndorsementTTL(ctx.round.height)+ctx.AcceptLockEndorsementTTL(ctx.round.height),
-		),
-	); err != nil {
-		return false, errors.Wrap(err, "failed to add endorsements to block")
+	commitTime := ctx.round.StartTime().Add(
+		ctx.AcceptBlockTTL(ctx.round.height) + ctx.AcceptProposalEndorsementTTL(ctx.round.height) + ctx.AcceptLockEndorsementTTL(ctx.round.height),
+	)
+	if ctx.blsEnabled(pendingBlock.Height()) {
+		aggregate, err := ctx.round.AggregateEndorsement(blkHash, commitTime)
+		switch errors.Cause(err) {
+		case ErrInsufficientEndorsements:
+			return false, nil
+		case nil:
+		default:
+			return false, errors.Wrap(err, "failed to aggregate endorsements")
+		}
+		ctx.logger().Info("consensus reached", zap.Uint64("blockHeight", ctx.round.Height()))
+		if err := pendingBlock.FinalizeWithAggregate(aggregate, commitTime); err != nil {
+			return false, errors.Wrap(err, "failed to add aggregate endorsement to block")
+		}
+	} else {
+		ctx.logger().Info("consensus reached", zap.Uint64("blockHeight", ctx.round.Height()))
+		if err := pendingBlock.Finalize(
+			ctx.round.Endorsements(blkHash, []ConsensusVoteTopic{COMMIT}),
+			commitTime,
+		); err != nil {
+			return false, errors.Wrap(err, "failed to add endorsements to block")
+		}
 	}
 
 	// Commit and broadcast the pending block
 	switch err := ctx.chain.CommitBlock(pendingBlock); errors.Cause(err) {
 	case blockchain.ErrInvalidTipHeight:
+		ctx.history.FinishRound(OutcomeSynced, ctx.clock.Now())
 		return true, nil
 	case nil:
-		break
+		ctx.history.FinishRound(OutcomeCommitted, ctx.clock.Now())
 	default:
 		return false, errors.Wrap(err, "error when committing a block")
 	}
@@ -524,6 +622,10 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 	return true, nil
 }
 
+func (ctx *rollDPoSCtx) OnTimeout(timeout string) {
+	ctx.history.AddTimeout(timeout, ctx.clock.Now())
+}
+
 func (ctx *rollDPoSCtx) Broadcast(endorsedMsg interface{}) {
 	ctx.mutex.RLock()
 	defer ctx.mutex.RUnlock()
@@ -532,6 +634,11 @@ func (ctx *rollDPoSCtx) Broadcast(endorsedMsg interface{}) {
 		ctx.loggerWithStats().Error("invalid message type", zap.Any("message", ecm))
 		return
 	}
+	if !ctx.active {
+		// the message may have been signed before the node went stand-by, and the active node may sign another one
+		ctx.loggerWithStats().Info("drop the message in standby mode")
+		return
+	}
 	msg, err := ecm.Proto()
 	if err != nil {
 		ctx.loggerWithStats().Error("failed to generate protobuf message", zap.Error(err))
@@ -574,9 +681,28 @@ func (ctx *rollDPoSCtx) Activate(active bool) {
 	ctx.mutex.Lock()
 	defer ctx.mutex.Unlock()
 
+	if active && !ctx.active {
+		ctx.activatedAt = ctx.clock.Now()
+	}
 	ctx.active = active
 }
 
+// SetLease sets the lease of the producer key. The node signs nothing after the lease expires, and a lease of a new
+// term takes effect as an activation, as another node may have signed with the key in between.
+func (ctx *rollDPoSCtx) SetLease(term uint64, expiry time.Time) {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	if term < ctx.leaseTerm {
+		return
+	}
+	if term > ctx.leaseTerm && ctx.active {
+		ctx.activatedAt = ctx.clock.Now()
+	}
+	ctx.leaseTerm = term
+	ctx.leaseExpiry = expiry
+}
+
 func (ctx *rollDPoSCtx) Active() bool {
 	ctx.mutex.RLock()
 	defer ctx.mutex.RUnlock()
@@ -614,6 +740,15 @@ func (ctx *rollDPoSCtx) isDelegate() bool {
 }
 
 func (ctx *rollDPoSCtx) endorseBlockProposal(proposal *blockProposal) (*EndorsedConsensusMessage, error) {
+	if err := ctx.checkSigning(); err != nil {
+		return nil, err
+	}
+	if ctx.wal != nil {
+		blkHash := proposal.block.HashBlock()
+		if err := ctx.wal.LogBlockProposal(proposal.block.Height(), ctx.round.Number(), blkHash[:]); err != nil {
+			return nil, err
+		}
+	}
 	en, err := endorsement.Endorse(ctx.priKey, proposal, ctx.round.StartTime())
 	if err != nil {
 		return nil, err
@@ -621,6 +756,27 @@ func (ctx *rollDPoSCtx) endorseBlockProposal(proposal *blockProposal) (*Endorsed
 	return NewEndorsedConsensusMessage(proposal.block.Height(), proposal, en), nil
 }
 
+// checkSigning guards against double signing when another node with the same key hands over: a stand-by node signs
+// nothing, a node signs nothing after its lease expires, and a node just activated or holding a lease of a new term
+// signs nothing in the round which had started before that, because the other node may have signed in it.
+func (ctx *rollDPoSCtx) checkSigning() error {
+	if !ctx.active {
+		return errors.New("current node is in standby mode")
+	}
+	if !ctx.leaseExpiry.IsZero() && !ctx.clock.Now().Before(ctx.leaseExpiry) {
+		return errors.Errorf("lease of term %d expired at %s", ctx.leaseTerm, ctx.leaseExpiry)
+	}
+	if ctx.round.StartTime().Before(ctx.activatedAt) {
+		return errors.Errorf(
+			"current node was activated at %s, after round %d of height %d started",
+			ctx.activatedAt,
+			ctx.round.Number(),
+			ctx.round.Height(),
+		)
+	}
+	return nil
+}
+
 func (ctx *rollDPoSCtx) logger() *zap.Logger {
 	return ctx.round.Log(log.Logger("consensus"))
 }
@@ -680,9 +836,26 @@ func (ctx *rollDPoSCtx) verifyVote(
 	}
 	blkHash := vote.BlockHash()
 	endorsement := consensusMsg.Endorsement()
+	var endorser string
+	blsEnabled := vote.Topic() == COMMIT && ctx.blsEnabled(ctx.round.Height())
+	if blsEnabled {
+		var err error
+		if endorser, err = ctx.verifyBLSSignature(consensusMsg); err != nil {
+			return blkHash, err
+		}
+	}
+	locked, unlocked, blockInLock := ctx.round.IsLocked(), ctx.round.IsUnlocked(), ctx.round.HashOfBlockInLock()
 	if err := ctx.round.AddVoteEndorsement(vote, endorsement); err != nil {
 		return blkHash, err
 	}
+	if err := ctx.logLock(locked, unlocked, blockInLock); err != nil {
+		return blkHash, err
+	}
+	if blsEnabled {
+		ctx.round.AddBLSSignature(blkHash, endorser, consensusMsg.BLSSignature())
+	}
+	ctx.recordEndorsement(vote, endorsement)
+	ctx.checkDoubleSign(vote, endorsement)
 	ctx.loggerWithStats().Debug(
 		"verified consensus vote",
 		log.Hex("block", blkHash),
@@ -695,11 +868,62 @@ func (ctx *rollDPoSCtx) verifyVote(
 	return blkHash, nil
 }
 
+// logLock logs the lock of the round if it has changed
+func (ctx *rollDPoSCtx) logLock(locked, unlocked bool, blockInLock []byte) error {
+	if ctx.wal == nil {
+		return nil
+	}
+	if locked == ctx.round.IsLocked() &&
+		unlocked == ctx.round.IsUnlocked() &&
+		bytes.Equal(blockInLock, ctx.round.HashOfBlockInLock()) {
+		return nil
+	}
+	return ctx.wal.LogLock(ctx.round.Height(), ctx.round.Number(), ctx.round.HashOfBlockInLock(), ctx.round.ProofOfLock())
+}
+
+func (ctx *rollDPoSCtx) recordEndorsement(vote *ConsensusVote, en *endorsement.Endorsement) {
+	endorser := en.Endorser().HexString()
+	if addr, err := address.FromBytes(en.Endorser().Hash()); err == nil {
+		endorser = addr.String()
+	}
+	ctx.history.AddEndorsement(vote.BlockHash(), vote.Topic(), endorser, ctx.round.Endorsers(), ctx.clock.Now())
+}
+
+func (ctx *rollDPoSCtx) checkDoubleSign(vote *ConsensusVote, en *endorsement.Endorsement) {
+	if ctx.doubleSignHandler == nil || ctx.round.Height() < ctx.evidenceHeight {
+		return
+	}
+	evidence, err := ctx.round.DoubleSignEvidence(vote, en)
+	if err != nil {
+		ctx.logger().Error("Failed to build double sign evidence.", zap.Error(err))
+		return
+	}
+	if evidence == nil {
+		return
+	}
+	ctx.loggerWithStats().Warn(
+		"delegate signs conflicting blocks",
+		zap.Uint8("topic", uint8(vote.Topic())),
+		zap.String("endorser", en.Endorser().HexString()),
+	)
+	if err := ctx.doubleSignHandler(evidence); err != nil {
+		ctx.logger().Error("Failed to handle double sign evidence.", zap.Error(err))
+	}
+}
+
 func (ctx *rollDPoSCtx) newEndorsement(
 	blkHash []byte,
 	topic ConsensusVoteTopic,
 	timestamp time.Time,
 ) (*EndorsedConsensusMessage, error) {
+	if err := ctx.checkSigning(); err != nil {
+		return nil, err
+	}
+	if ctx.wal != nil {
+		if err := ctx.wal.LogVote(ctx.round.Height(), ctx.round.Number(), topic, blkHash); err != nil {
+			return nil, err
+		}
+	}
 	vote := NewConsensusVote(
 		blkHash,
 		topic,
@@ -708,6 +932,84 @@ func (ctx *rollDPoSCtx) newEndorsement(
 	if err != nil {
 		return nil, err
 	}
+	ecm := NewEndorsedConsensusMessage(ctx.round.Height(), vote, en)
+	if topic == COMMIT && ctx.blsEnabled(ctx.round.Height()) {
+		if ecm.blsSignature, err = endorsement.BLSSign(ctx.blsKey, vote); err != nil {
+			return nil, err
+		}
+	}
+
+	return ecm, nil
+}
+
+func (ctx *rollDPoSCtx) blsEnabled(height uint64) bool {
+	return height >= ctx.blsHeight
+}
+
+// blsPublicKey returns the BLS public key a delegate endorses the block at height with
+func (ctx *rollDPoSCtx) blsPublicKey(delegate string, height uint64) (*bls.PublicKey, error) {
+	if ctx.blsKeyFunc == nil {
+		return nil, errors.Errorf("no BLS key lookup for %s", delegate)
+	}
+	b, err := ctx.blsKeyFunc(delegate, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the BLS key of %s", delegate)
+	}
+	if pk, ok := ctx.blsKeys.Load(string(b)); ok {
+		return pk.(*bls.PublicKey), nil
+	}
+	pk, err := bls.PublicKeyFromBytes(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid BLS key of %s", delegate)
+	}
+	ctx.blsKeys.Store(string(b), pk)
+	return pk, nil
+}
 
-	return NewEndorsedConsensusMessage(ctx.round.Height(), vote, en), nil
+// verifyBLSSignature checks the BLS signature of a commit vote, and returns the address of the endorser
+func (ctx *rollDPoSCtx) verifyBLSSignature(ecm *EndorsedConsensusMessage) (string, error) {
+	endorserAddr, err := address.FromBytes(ecm.Endorsement().Endorser().Hash())
+	if err != nil {
+		return "", err
+	}
+	if len(ecm.BLSSignature()) == 0 {
+		return "", errors.Errorf("commit vote of %s is not signed with BLS key", endorserAddr.String())
+	}
+	pk, err := ctx.blsPublicKey(endorserAddr.String(), ctx.round.Height())
+	if err != nil {
+		return "", err
+	}
+	if !endorsement.VerifyBLSSignature(ecm.Document(), pk, ecm.BLSSignature()) {
+		return "", errors.Errorf("invalid BLS signature of %s", endorserAddr.String())
+	}
+	return endorserAddr.String(), nil
+}
+
+// verifyAggregateEndorsement checks that the aggregate endorsement of a block is signed by a majority of delegates
+func (ctx *rollDPoSCtx) verifyAggregateEndorsement(round *roundCtx, blk *block.Block) error {
+	aggregate := blk.AggregateEndorsement()
+	if aggregate == nil {
+		return errors.Wrap(ErrInsufficientEndorsements, "missing aggregate endorsement")
+	}
+	delegates := round.Delegates()
+	signers := aggregate.Signers()
+	if 3*len(signers) <= 2*len(delegates) {
+		return ErrInsufficientEndorsements
+	}
+	pks := make([]*bls.PublicKey, 0, len(signers))
+	for _, i := range signers {
+		if i >= len(delegates) {
+			return errors.Errorf("signer %d of aggregate endorsement is not a delegate", i)
+		}
+		pk, err := ctx.blsPublicKey(delegates[i], blk.Height())
+		if err != nil {
+			return err
+		}
+		pks = append(pks, pk)
+	}
+	blkHash := blk.HashBlock()
+	if !endorsement.VerifyAggregateEndorsement(NewConsensusVote(blkHash[:], COMMIT), aggregate, pks) {
+		return errors.New("invalid aggregate endorsement")
+	}
+	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/facebookgo/clock"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
@@ -294,3 +295,60 @@ func getBlockforctx(t *testing.T, i int, sign bool) block.Block {
 	b := block.Block{Header: header}
 	return b
 }
+
+func TestSigningGuard(t *testing.T) {
+	require := require.New(t)
+
+	c := clock.NewMock()
+	eManager, err := newEndorsementManager(nil)
+	require.NoError(err)
+	broadcasted := 0
+	rctx := &rollDPoSCtx{
+		clock:  c,
+		priKey: identityset.PrivateKey(0),
+		round:  &roundCtx{height: 10, roundStartTime: c.Now(), eManager: eManager},
+		broadcastHandler: func(proto.Message) error {
+			broadcasted++
+			return nil
+		},
+	}
+	_, err = rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.Error(err)
+
+	// the node activated in the middle of the round does not sign in the round
+	c.Add(time.Second)
+	rctx.Activate(true)
+	_, err = rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.Error(err)
+	rctx.Activate(true)
+	rctx.round = &roundCtx{height: 10, roundNum: 1, roundStartTime: c.Now().Add(time.Second), eManager: eManager}
+	ecm, err := rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.NoError(err)
+	rctx.Broadcast(ecm)
+	require.Equal(1, broadcasted)
+
+	// the message signed before going stand-by is dropped
+	rctx.Activate(false)
+	rctx.Broadcast(ecm)
+	require.Equal(1, broadcasted)
+
+	// the node signs nothing after the lease expires
+	rctx.SetLease(1, c.Now().Add(10*time.Second))
+	rctx.Activate(true)
+	rctx.round = &roundCtx{height: 11, roundStartTime: c.Now().Add(time.Second), eManager: eManager}
+	_, err = rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.NoError(err)
+	c.Add(10 * time.Second)
+	_, err = rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.Error(err)
+
+	// the lease of a new term takes effect as an activation, and the stale lease is ignored
+	rctx.SetLease(3, c.Now().Add(10*time.Second))
+	_, err = rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.Error(err)
+	rctx.SetLease(2, c.Now().Add(20*time.Second))
+	require.Equal(c.Now().Add(10*time.Second), rctx.leaseExpiry)
+	rctx.round = &roundCtx{height: 12, roundStartTime: c.Now().Add(time.Second), eManager: eManager}
+	_, err = rctx.newEndorsement([]byte("block hash"), PROPOSAL, c.Now())
+	require.NoError(err)
+}
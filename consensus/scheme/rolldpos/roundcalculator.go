@@ -58,6 +58,7 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 	var status status
 	var blockInLock []byte
 	var proofOfLock []*endorsement.Endorsement
+	var blsSignatures map[string]map[string][]byte
 	if height == round.Height() {
 		err = round.eManager.Cleanup(roundStartTime)
 		if err != nil {
@@ -66,6 +67,7 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 		status = round.status
 		blockInLock = round.blockInLock
 		proofOfLock = round.proofOfLock
+		blsSignatures = round.blsSignatures
 	} else {
 		err = round.eManager.Cleanup(time.Time{})
 		if err != nil {
@@ -87,6 +89,7 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 		status:             status,
 		blockInLock:        blockInLock,
 		proofOfLock:        proofOfLock,
+		blsSignatures:      blsSignatures,
 	}, nil
 }
 
@@ -7,11 +7,14 @@
 package rolldpos
 
 import (
+	"encoding/hex"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/endorsement"
 )
@@ -44,6 +47,9 @@ type roundCtx struct {
 	proofOfLock []*endorsement.Endorsement
 	status      status
 	eManager    *endorsementManager
+	// blsSignatures are the BLS signatures of commit votes, by block hash and endorser address. Unlike the
+	// endorsements, they are not persisted, and are collected again after a restart.
+	blsSignatures map[string]map[string][]byte
 }
 
 func (ctx *roundCtx) Log(l *zap.Logger) *zap.Logger {
@@ -113,6 +119,19 @@ func (ctx *roundCtx) Endorsements(blkHash []byte, topics []ConsensusVoteTopic) [
 	return ctx.endorsements(blkHash, topics)
 }
 
+// Endorsers returns the sorted endorsers of each block on each topic, keyed by the encoded block hash and the topic name
+func (ctx *roundCtx) Endorsers() map[string]map[string][]string {
+	endorsers := map[string]map[string][]string{}
+	for encoded, topics := range ctx.eManager.Endorsers() {
+		endorsers[encoded] = make(map[string][]string, len(topics))
+		for topic, addrs := range topics {
+			sort.Strings(addrs)
+			endorsers[encoded][topicName(topic)] = addrs
+		}
+	}
+	return endorsers
+}
+
 func (ctx *roundCtx) IsLocked() bool {
 	return ctx.status == locked
 }
@@ -218,6 +237,13 @@ func (ctx *roundCtx) AddVoteEndorsement(
 	if !ctx.isMajority(endorsements) {
 		return nil
 	}
+	ctx.setLock(blockHash, endorsements)
+
+	return nil
+}
+
+// setLock locks the block with its proof of lock, or unlocks if the block hash is empty
+func (ctx *roundCtx) setLock(blockHash []byte, proofOfLock []*endorsement.Endorsement) {
 	if len(blockHash) == 0 {
 		// TODO: (zhi) look into details of unlock
 		ctx.status = unlocked
@@ -225,9 +251,62 @@ func (ctx *roundCtx) AddVoteEndorsement(
 		ctx.status = locked
 	}
 	ctx.blockInLock = blockHash
-	ctx.proofOfLock = endorsements
+	ctx.proofOfLock = proofOfLock
+}
 
-	return nil
+// AddBLSSignature adds the verified BLS signature of a commit vote
+func (ctx *roundCtx) AddBLSSignature(blkHash []byte, endorser string, sig []byte) {
+	if ctx.blsSignatures == nil {
+		ctx.blsSignatures = map[string]map[string][]byte{}
+	}
+	key := hex.EncodeToString(blkHash)
+	if _, ok := ctx.blsSignatures[key]; !ok {
+		ctx.blsSignatures[key] = map[string][]byte{}
+	}
+	ctx.blsSignatures[key][endorser] = sig
+}
+
+// AggregateEndorsement aggregates the BLS signatures of the commit endorsers of a block. ErrInsufficientEndorsements
+// is returned if the endorsers with BLS signatures are not a majority of delegates.
+func (ctx *roundCtx) AggregateEndorsement(blkHash []byte, ts time.Time) (*endorsement.AggregateEndorsement, error) {
+	sigs := ctx.blsSignatures[hex.EncodeToString(blkHash)]
+	signers := []int{}
+	signatures := [][]byte{}
+	for i, delegate := range ctx.delegates {
+		if sig, ok := sigs[delegate]; ok {
+			signers = append(signers, i)
+			signatures = append(signatures, sig)
+		}
+	}
+	if 3*len(signers) <= 2*len(ctx.delegates) {
+		return nil, ErrInsufficientEndorsements
+	}
+	return endorsement.AggregateBLSSignatures(ts, signers, signatures)
+}
+
+// DoubleSignEvidence returns an evidence if the endorser of the vote has endorsed another block on the same topic in
+// this round, or nil if there is no such endorsement
+func (ctx *roundCtx) DoubleSignEvidence(
+	vote *ConsensusVote,
+	en *endorsement.Endorsement,
+) (*action.DoubleSignEvidence, error) {
+	blkHash, conflicting := ctx.eManager.ConflictingEndorsement(vote, en)
+	if conflicting == nil {
+		return nil, nil
+	}
+	msg1, err := NewEndorsedConsensusMessage(ctx.height, vote, en).Proto()
+	if err != nil {
+		return nil, err
+	}
+	msg2, err := NewEndorsedConsensusMessage(
+		ctx.height,
+		NewConsensusVote(blkHash, vote.Topic()),
+		conflicting,
+	).Proto()
+	if err != nil {
+		return nil, err
+	}
+	return action.NewDoubleSignEvidence(0, msg1, msg2), nil
 }
 
 // private functions
@@ -11,9 +11,11 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/bls"
 )
 
 func TestRoundCtx(t *testing.T) {
@@ -94,3 +96,41 @@ func TestRoundCtx(t *testing.T) {
 	})
 	// TODO: add more unit tests
 }
+
+func TestRoundCtxAggregateEndorsement(t *testing.T) {
+	require := require.New(t)
+
+	delegates := []string{"delegate0", "delegate1", "delegate2", "delegate3"}
+	round := &roundCtx{height: 10, delegates: delegates}
+	blkHash := []byte("Some block hash")
+	vote := NewConsensusVote(blkHash, COMMIT)
+	keys := make([]*bls.PrivateKey, len(delegates))
+	for i := range delegates {
+		sk, err := bls.DerivePrivateKey([]byte(delegates[i]))
+		require.NoError(err)
+		keys[i] = sk
+	}
+	now := time.Now()
+	// signatures of a majority are required
+	for _, i := range []int{3, 1} {
+		sig, err := endorsement.BLSSign(keys[i], vote)
+		require.NoError(err)
+		round.AddBLSSignature(blkHash, delegates[i], sig)
+	}
+	_, err := round.AggregateEndorsement(blkHash, now)
+	require.Equal(ErrInsufficientEndorsements, errors.Cause(err))
+	sig, err := endorsement.BLSSign(keys[0], vote)
+	require.NoError(err)
+	round.AddBLSSignature(blkHash, delegates[0], sig)
+	_, err = round.AggregateEndorsement([]byte("Another block hash"), now)
+	require.Equal(ErrInsufficientEndorsements, errors.Cause(err))
+	aggregate, err := round.AggregateEndorsement(blkHash, now)
+	require.NoError(err)
+	require.Equal([]int{0, 1, 3}, aggregate.Signers())
+	require.True(now.UTC().Equal(aggregate.Timestamp()))
+	pks := []*bls.PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[3].PublicKey()}
+	require.True(endorsement.VerifyAggregateEndorsement(vote, aggregate, pks))
+	require.False(endorsement.VerifyAggregateEndorsement(NewConsensusVote(blkHash, LOCK), aggregate, pks))
+	pks[2] = keys[2].PublicKey()
+	require.False(endorsement.VerifyAggregateEndorsement(vote, aggregate, pks))
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RoundEventType is the type of an event in a consensus round
+type RoundEventType string
+
+const (
+	// RoundStarted is the event of entering a round
+	RoundStarted RoundEventType = "started"
+	// ProposalReceived is the event of accepting the proposed block
+	ProposalReceived RoundEventType = "proposal"
+	// EndorsementReceived is the event of accepting an endorsement of a block
+	EndorsementReceived RoundEventType = "endorsement"
+	// TimeoutHit is the event of running out of a TTL of the round
+	TimeoutHit RoundEventType = "timeout"
+	// RoundFinished is the event of leaving a round
+	RoundFinished RoundEventType = "finished"
+)
+
+const (
+	// OutcomeCommitted means that the block of the round is committed by this node
+	OutcomeCommitted = "committed"
+	// OutcomeSynced means that the block of the height is received from the network before the round reached consensus
+	OutcomeSynced = "synced"
+	// OutcomeFailed means that the round ended without consensus, and the height moves on to the next round
+	OutcomeFailed = "failed"
+
+	// the number of rounds kept in the history, unless configured otherwise
+	defaultRoundHistorySize = 100
+	// the number of recent events kept in the history
+	roundEventHistorySize = 1024
+	// the size of the event channel of a subscriber, the events are dropped if the subscriber falls behind
+	roundEventBufferSize = 256
+)
+
+type (
+	// RoundEvent is an event in a consensus round. Seq numbers the events in the order they happen.
+	RoundEvent struct {
+		Seq       uint64         `json:"seq"`
+		Type      RoundEventType `json:"type"`
+		Height    uint64         `json:"height"`
+		Round     uint32         `json:"round"`
+		Time      time.Time      `json:"time"`
+		Proposer  string         `json:"proposer,omitempty"`
+		BlockHash string         `json:"blockHash,omitempty"`
+		Topic     string         `json:"topic,omitempty"`
+		Endorser  string         `json:"endorser,omitempty"`
+		Timeout   string         `json:"timeout,omitempty"`
+		Outcome   string         `json:"outcome,omitempty"`
+	}
+
+	// RoundRecord summarizes a consensus round. Endorsements are the endorsers of each block on each topic, keyed by
+	// the hex encoded block hash, where the empty hash stands for the endorsements of no block.
+	RoundRecord struct {
+		Height       uint64                         `json:"height"`
+		Round        uint32                         `json:"round"`
+		Proposer     string                         `json:"proposer"`
+		StartTime    time.Time                      `json:"startTime"`
+		ProposalTime *time.Time                     `json:"proposalTime,omitempty"`
+		BlockHash    string                         `json:"blockHash,omitempty"`
+		Endorsements map[string]map[string][]string `json:"endorsements,omitempty"`
+		Timeouts     []string                       `json:"timeouts,omitempty"`
+		Outcome      string                         `json:"outcome,omitempty"`
+		EndTime      *time.Time                     `json:"endTime,omitempty"`
+	}
+
+	// roundHistory keeps the records of the recent rounds, and publishes the round events to the subscribers
+	roundHistory struct {
+		mutex       sync.RWMutex
+		size        int
+		records     []*RoundRecord
+		current     *RoundRecord
+		events      []*RoundEvent
+		seq         uint64
+		subscribers map[chan *RoundEvent]struct{}
+	}
+)
+
+func newRoundHistory(size int) *roundHistory {
+	return &roundHistory{
+		size:        size,
+		subscribers: map[chan *RoundEvent]struct{}{},
+	}
+}
+
+// StartRound finishes the current round if it is a different one, and starts recording the new round
+func (h *roundHistory) StartRound(round *roundCtx, ts time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.current != nil && h.current.Height == round.Height() && h.current.Round == round.Number() {
+		return
+	}
+	if h.current != nil && h.current.Outcome == "" {
+		outcome := OutcomeFailed
+		if round.Height() > h.current.Height {
+			outcome = OutcomeSynced
+		}
+		h.finish(outcome, ts)
+	}
+	h.current = &RoundRecord{
+		Height:    round.Height(),
+		Round:     round.Number(),
+		Proposer:  round.Proposer(),
+		StartTime: round.StartTime(),
+	}
+	h.records = append(h.records, h.current)
+	if len(h.records) > h.size {
+		h.records = h.records[len(h.records)-h.size:]
+	}
+	h.publish(&RoundEvent{Type: RoundStarted, Proposer: round.Proposer()}, ts)
+}
+
+// AddProposal records the proposed block accepted in the current round
+func (h *roundHistory) AddProposal(blkHash []byte, ts time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.current == nil {
+		return
+	}
+	if h.current.ProposalTime == nil {
+		h.current.ProposalTime = &ts
+		h.current.BlockHash = hex.EncodeToString(blkHash)
+	}
+	h.publish(&RoundEvent{Type: ProposalReceived, BlockHash: hex.EncodeToString(blkHash)}, ts)
+}
+
+// AddEndorsement records an endorsement accepted in the current round, with all the endorsements collected so far
+func (h *roundHistory) AddEndorsement(
+	blkHash []byte,
+	topic ConsensusVoteTopic,
+	endorser string,
+	endorsements map[string]map[string][]string,
+	ts time.Time,
+) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.current == nil {
+		return
+	}
+	h.current.Endorsements = endorsements
+	h.publish(&RoundEvent{
+		Type:      EndorsementReceived,
+		BlockHash: hex.EncodeToString(blkHash),
+		Topic:     topicName(topic),
+		Endorser:  endorser,
+	}, ts)
+}
+
+// AddTimeout records a TTL of the current round running out
+func (h *roundHistory) AddTimeout(timeout string, ts time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.current == nil || h.current.Outcome != "" {
+		return
+	}
+	h.current.Timeouts = append(h.current.Timeouts, timeout)
+	h.publish(&RoundEvent{Type: TimeoutHit, Timeout: timeout}, ts)
+}
+
+// FinishRound records the outcome of the current round
+func (h *roundHistory) FinishRound(outcome string, ts time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.current == nil || h.current.Outcome != "" {
+		return
+	}
+	h.finish(outcome, ts)
+}
+
+// Records returns copies of the recent round records, the oldest first
+func (h *roundHistory) Records() []*RoundRecord {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	records := make([]*RoundRecord, 0, len(h.records))
+	for _, r := range h.records {
+		records = append(records, r.clone())
+	}
+	return records
+}
+
+// Events returns the recent events after the given sequence number, the oldest first
+func (h *roundHistory) Events(after uint64) []*RoundEvent {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	i := sort.Search(len(h.events), func(i int) bool {
+		return h.events[i].Seq > after
+	})
+	return append([]*RoundEvent{}, h.events[i:]...)
+}
+
+// Subscribe returns a channel of the round events, and a function to cancel the subscription
+func (h *roundHistory) Subscribe() (<-chan *RoundEvent, func()) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	c := make(chan *RoundEvent, roundEventBufferSize)
+	h.subscribers[c] = struct{}{}
+	var once sync.Once
+	return c, func() {
+		once.Do(func() {
+			h.mutex.Lock()
+			defer h.mutex.Unlock()
+			delete(h.subscribers, c)
+			close(c)
+		})
+	}
+}
+
+func (h *roundHistory) finish(outcome string, ts time.Time) {
+	h.current.Outcome = outcome
+	h.current.EndTime = &ts
+	h.publish(&RoundEvent{Type: RoundFinished, BlockHash: h.current.BlockHash, Outcome: outcome}, ts)
+}
+
+func (h *roundHistory) publish(evt *RoundEvent, ts time.Time) {
+	evt.Height = h.current.Height
+	evt.Round = h.current.Round
+	evt.Time = ts
+	h.seq++
+	evt.Seq = h.seq
+	h.events = append(h.events, evt)
+	if len(h.events) > roundEventHistorySize {
+		h.events = h.events[len(h.events)-roundEventHistorySize:]
+	}
+	for c := range h.subscribers {
+		select {
+		case c <- evt:
+		default:
+		}
+	}
+}
+
+func (r *RoundRecord) clone() *RoundRecord {
+	cloned := *r
+	if r.Endorsements != nil {
+		cloned.Endorsements = make(map[string]map[string][]string, len(r.Endorsements))
+		for blkHash, topics := range r.Endorsements {
+			cloned.Endorsements[blkHash] = make(map[string][]string, len(topics))
+			for topic, endorsers := range topics {
+				cloned.Endorsements[blkHash][topic] = append([]string{}, endorsers...)
+			}
+		}
+	}
+	cloned.Timeouts = append([]string(nil), r.Timeouts...)
+	return &cloned
+}
+
+func topicName(topic ConsensusVoteTopic) string {
+	switch topic {
+	case PROPOSAL:
+		return "PROPOSAL"
+	case LOCK:
+		return "LOCK"
+	case COMMIT:
+		return "COMMIT"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
+)
+
+func TestRoundHistory(t *testing.T) {
+	require := require.New(t)
+
+	h := newRoundHistory(2)
+	events, cancel := h.Subscribe()
+	now := time.Now()
+	blkHash := []byte("block hash")
+	newRound := func(height uint64, num uint32) *roundCtx {
+		return &roundCtx{height: height, roundNum: num, proposer: "delegate", roundStartTime: now}
+	}
+
+	// round 0 of height 10 fails after the lock timeout
+	h.StartRound(newRound(10, 0), now)
+	h.StartRound(newRound(10, 0), now)
+	h.AddProposal(blkHash, now.Add(time.Second))
+	endorsements := map[string]map[string][]string{
+		hex.EncodeToString(blkHash): {"PROPOSAL": {"delegate"}},
+	}
+	h.AddEndorsement(blkHash, PROPOSAL, "delegate", endorsements, now.Add(2*time.Second))
+	h.AddTimeout(consensusfsm.AcceptLockEndorsementTimeout, now.Add(3*time.Second))
+	// round 1 of height 10 commits
+	h.StartRound(newRound(10, 1), now.Add(4*time.Second))
+	h.FinishRound(OutcomeCommitted, now.Add(5*time.Second))
+	h.AddTimeout(consensusfsm.CommitTimeout, now.Add(6*time.Second))
+	// height 11 is synced from the network
+	h.StartRound(newRound(11, 0), now.Add(7*time.Second))
+	h.StartRound(newRound(12, 0), now.Add(8*time.Second))
+
+	records := h.Records()
+	require.Len(records, 2)
+	require.Equal(uint64(11), records[0].Height)
+	require.Equal(OutcomeSynced, records[0].Outcome)
+	require.Equal(uint64(12), records[1].Height)
+	require.Empty(records[1].Outcome)
+	require.Nil(records[1].EndTime)
+
+	var types []RoundEventType
+	for i := 0; i < 10; i++ {
+		evt := <-events
+		require.Equal(uint64(i+1), evt.Seq)
+		types = append(types, evt.Type)
+	}
+	require.Equal([]RoundEventType{
+		RoundStarted, ProposalReceived, EndorsementReceived, TimeoutHit, RoundFinished,
+		RoundStarted, RoundFinished,
+		RoundStarted, RoundFinished,
+		RoundStarted,
+	}, types)
+
+	evts := h.Events(3)
+	require.Len(evts, 7)
+	require.Equal(TimeoutHit, evts[0].Type)
+	require.Equal(consensusfsm.AcceptLockEndorsementTimeout, evts[0].Timeout)
+	require.Equal(uint64(10), evts[1].Height)
+	require.Equal(OutcomeFailed, evts[1].Outcome)
+	require.Equal(hex.EncodeToString(blkHash), evts[1].BlockHash)
+	require.Equal(OutcomeCommitted, evts[3].Outcome)
+	require.Empty(h.Events(10))
+
+	cancel()
+	cancel()
+	_, ok := <-events
+	require.False(ok)
+	h.StartRound(newRound(13, 0), now.Add(9*time.Second))
+	require.Len(h.Events(10), 2)
+
+	// the records are copies
+	h = newRoundHistory(2)
+	h.StartRound(newRound(10, 0), now)
+	h.AddEndorsement(blkHash, PROPOSAL, "delegate", endorsements, now)
+	h.AddTimeout(consensusfsm.AcceptBlockTimeout, now)
+	records = h.Records()
+	records[0].Endorsements[hex.EncodeToString(blkHash)]["PROPOSAL"][0] = "another"
+	records[0].Timeouts[0] = "another"
+	records = h.Records()
+	require.Equal([]string{"delegate"}, records[0].Endorsements[hex.EncodeToString(blkHash)]["PROPOSAL"])
+	require.Equal([]string{consensusfsm.AcceptBlockTimeout}, records[0].Timeouts)
+}
@@ -0,0 +1,716 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/bls"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+)
+
+// The simulation runs a number of in-process RollDPoS delegates sharing a mock clock. Messages are exchanged through an
+// in-memory network, which delays, drops, reorders and partitions them according to a seeded random source. Delegates
+// can crash and recover, and Byzantine delegates send conflicting votes to different peers. The time only moves when
+// the simulation advances the mock clock, and the delegates handle their events one after another on every tick, so a
+// run is deterministic for a seed and takes much less wall time than the block time it covers.
+
+// simTick is the step of the mock clock
+const simTick = 50 * time.Millisecond
+
+type (
+	// simConfig defines the faults injected into a simulation run
+	simConfig struct {
+		numNodes int
+		seed     int64
+		duration time.Duration
+		// messages are delayed by a random duration in [0, maxDelay], which also reorders them
+		maxDelay time.Duration
+		// dropRate is the probability in [0, 1) that a message to a peer is lost
+		dropRate   float64
+		byzantine  []int
+		crashes    []simCrash
+		partitions []simPartition
+		// blsHeight activates the aggregate BLS endorsements of commit votes, which are off if it is 0
+		blsHeight uint64
+	}
+
+	// simCrash stops a delegate at the given time, and restarts it at recover unless it is 0
+	simCrash struct {
+		node    int
+		at      time.Duration
+		recover time.Duration
+	}
+
+	// simPartition splits the delegates into groups which cannot talk to each other during [from, to)
+	simPartition struct {
+		from   time.Duration
+		to     time.Duration
+		groups [][]int
+	}
+
+	simMessage struct {
+		deliverAt time.Time
+		seq       uint64
+		from      int
+		to        int
+		msg       proto.Message
+	}
+
+	simNode struct {
+		idx        int
+		addr       string
+		priKey     crypto.PrivateKey
+		chain      *simChain
+		consensus  *RollDPoS
+		generation int
+		crashed    bool
+		byzantine  bool
+		// a delegate sleeping on the clock yields true, and waits on wake until the clock reaches wakeAt
+		sleeping bool
+		wakeAt   time.Time
+		wake     chan struct{}
+		yield    chan bool
+	}
+
+	simulation struct {
+		t         *testing.T
+		cfg       simConfig
+		rollDPoS  config.Config
+		clock     *simClock
+		start     time.Time
+		rand      *rand.Rand
+		ctrl      *gomock.Controller
+		rp        *rolldpos.Protocol
+		delegates []string
+		blsKeys   map[string][]byte
+		walDir    string
+
+		mutex sync.Mutex
+		nodes []*simNode
+		queue []*simMessage
+		seq   uint64
+	}
+)
+
+func newSimulation(t *testing.T, ctrl *gomock.Controller, walDir string, cfg simConfig) *simulation {
+	require := require.New(t)
+	c := config.Default
+	c.Consensus.RollDPoS.ConsensusDBPath = ""
+	c.Consensus.RollDPoS.Delay = 300 * time.Millisecond
+	c.Consensus.RollDPoS.FSM.AcceptBlockTTL = 800 * time.Millisecond
+	c.Consensus.RollDPoS.FSM.AcceptProposalEndorsementTTL = 400 * time.Millisecond
+	c.Consensus.RollDPoS.FSM.AcceptLockEndorsementTTL = 400 * time.Millisecond
+	c.Consensus.RollDPoS.FSM.CommitTTL = 400 * time.Millisecond
+	c.Consensus.RollDPoS.FSM.UnmatchedEventTTL = time.Second
+	c.Consensus.RollDPoS.FSM.UnmatchedEventInterval = 10 * time.Millisecond
+	c.Consensus.RollDPoS.ToleratedOvertime = 200 * time.Millisecond
+	c.Genesis.Timestamp = 0
+	c.Genesis.BlockInterval = 2 * time.Second
+	c.Genesis.NumDelegates = uint64(cfg.numNodes)
+	c.Genesis.NumCandidateDelegates = uint64(cfg.numNodes)
+	c.Genesis.NumSubEpochs = 1
+	c.Genesis.EnableGravityChainVoting = false
+	// a crashed proposer only delays the block by one round
+	c.Genesis.TimeBasedRotation = true
+	if cfg.blsHeight > 0 {
+		c.Genesis.BLSEndorsementHeight = cfg.blsHeight
+	}
+
+	mock := newSimClock()
+	// the genesis block is at the time 0 of the mock clock
+	mock.Add(time.Second)
+	s := &simulation{
+		t:        t,
+		cfg:      cfg,
+		rollDPoS: c,
+		clock:    mock,
+		start:    mock.Now(),
+		rand:     rand.New(rand.NewSource(cfg.seed)),
+		ctrl:     ctrl,
+		rp:       rolldpos.NewProtocol(c.Genesis.NumCandidateDelegates, c.Genesis.NumDelegates, c.Genesis.NumSubEpochs),
+		blsKeys:  map[string][]byte{},
+		walDir:   walDir,
+	}
+	for i := 0; i < cfg.numNodes; i++ {
+		sk, err := bls.DerivePrivateKey(identityset.PrivateKey(i).Bytes())
+		require.NoError(err)
+		s.blsKeys[identityset.Address(i).String()] = sk.PublicKey().Bytes()
+		s.nodes = append(s.nodes, &simNode{
+			idx:    i,
+			addr:   identityset.Address(i).String(),
+			priKey: identityset.PrivateKey(i),
+			chain:  &simChain{genesis: c.Genesis},
+			wake:   make(chan struct{}),
+			yield:  make(chan bool),
+		})
+		s.delegates = append(s.delegates, identityset.Address(i).String())
+	}
+	for _, i := range cfg.byzantine {
+		s.nodes[i].byzantine = true
+	}
+	for _, n := range s.nodes {
+		require.NoError(s.startNode(n))
+	}
+	return s
+}
+
+func (s *simulation) startNode(n *simNode) error {
+	ap := mock_actpool.NewMockActPool(s.ctrl)
+	ap.EXPECT().PendingActionMap().Return(map[string][]action.SealedEnvelope{}).AnyTimes()
+	ap.EXPECT().Reset().AnyTimes()
+
+	cfg := s.rollDPoS
+	cfg.Chain.ProducerPrivKey = n.priKey.HexString()
+	cfg.Consensus.RollDPoS.WALPath = filepath.Join(s.walDir, fmt.Sprintf("node%d.wal", n.idx))
+	n.generation++
+	generation := n.generation
+	chain := &simChainView{simChain: n.chain, priKey: n.priKey, alive: func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return !n.crashed && n.generation == generation
+	}}
+	cs, err := NewRollDPoSBuilder().
+		SetAddr(n.addr).
+		SetPriKey(n.priKey).
+		SetConfig(cfg).
+		SetChainManager(chain).
+		SetActPool(ap).
+		SetBroadcast(func(msg proto.Message) error {
+			s.broadcast(n, generation, msg)
+			return nil
+		}).
+		SetClock(&simNodeClock{simClock: s.clock, node: n}).
+		SetDelegatesByEpochFunc(func(uint64) ([]string, error) {
+			return s.delegates, nil
+		}).
+		SetBLSKeyFunc(func(delegate string, _ uint64) ([]byte, error) {
+			return s.blsKeys[delegate], nil
+		}).
+		RegisterProtocol(s.rp).
+		Build()
+	if err != nil {
+		return err
+	}
+	cs.cfsm.EnableStepping()
+	if err := cs.Start(context.Background()); err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	n.consensus = cs
+	n.crashed = false
+	s.mutex.Unlock()
+	return nil
+}
+
+// run advances the mock clock tick by tick until the configured duration has passed
+func (s *simulation) run() {
+	for elapsed := time.Duration(0); elapsed < s.cfg.duration; elapsed += simTick {
+		s.injectCrashes(elapsed)
+		s.clock.Add(simTick)
+		s.deliver()
+		for _, n := range s.nodes {
+			if !n.crashed {
+				s.step(n)
+			}
+		}
+	}
+	for _, n := range s.nodes {
+		if !n.crashed {
+			s.stopNode(n)
+		}
+	}
+}
+
+// step lets the delegate handle its events until it has no more, or sleeps on the clock. A sleeping delegate is
+// woken up once the clock has reached the end of its sleep.
+func (s *simulation) step(n *simNode) {
+	if n.sleeping {
+		if s.clock.Now().Before(n.wakeAt) {
+			return
+		}
+		n.wake <- struct{}{}
+	} else {
+		go func(cs *RollDPoS) {
+			cs.cfsm.Step()
+			n.yield <- false
+		}(n.consensus)
+	}
+	n.sleeping = <-n.yield
+}
+
+// stopNode stops the delegate, and wakes it up if it is sleeping so that it returns from the stopped fsm
+func (s *simulation) stopNode(n *simNode) {
+	require.NoError(s.t, n.consensus.Stop(context.Background()))
+	for n.sleeping {
+		n.wake <- struct{}{}
+		n.sleeping = <-n.yield
+	}
+}
+
+func (s *simulation) injectCrashes(elapsed time.Duration) {
+	for _, c := range s.cfg.crashes {
+		n := s.nodes[c.node]
+		switch {
+		case c.at == elapsed:
+			s.mutex.Lock()
+			n.crashed = true
+			s.mutex.Unlock()
+			s.stopNode(n)
+		case c.recover != 0 && c.recover == elapsed:
+			require.NoError(s.t, s.startNode(n))
+		}
+	}
+}
+
+// broadcast sends the message to the peers reachable from the sender, each with a random delay
+func (s *simulation) broadcast(from *simNode, generation int, msg proto.Message) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if from.crashed || from.generation != generation {
+		return
+	}
+	now := s.clock.Now()
+	for _, to := range s.nodes {
+		if to == from || !s.connected(from.idx, to.idx, now.Sub(s.start)) {
+			continue
+		}
+		if s.rand.Float64() < s.cfg.dropRate {
+			continue
+		}
+		m := msg
+		if from.byzantine && to.idx%2 == 0 {
+			m = s.equivocate(from, msg)
+		}
+		delay := time.Duration(0)
+		if s.cfg.maxDelay > 0 {
+			delay = time.Duration(s.rand.Int63n(int64(s.cfg.maxDelay) + 1))
+		}
+		s.seq++
+		s.queue = append(s.queue, &simMessage{
+			deliverAt: now.Add(delay),
+			seq:       s.seq,
+			from:      from.idx,
+			to:        to.idx,
+			msg:       m,
+		})
+	}
+}
+
+// equivocate replaces a vote of the Byzantine delegate with a vote of the same topic for a random block
+func (s *simulation) equivocate(from *simNode, msg proto.Message) proto.Message {
+	cm, ok := msg.(*iotextypes.ConsensusMessage)
+	if !ok {
+		return msg
+	}
+	ecm := &EndorsedConsensusMessage{}
+	if err := ecm.LoadProto(cm); err != nil {
+		return msg
+	}
+	vote, ok := ecm.Document().(*ConsensusVote)
+	if !ok {
+		return msg
+	}
+	fake := hash.Hash256b([]byte(fmt.Sprintf("%d", s.rand.Int63())))
+	forged := NewConsensusVote(fake[:], vote.Topic())
+	en, err := endorsement.Endorse(from.priKey, forged, ecm.Endorsement().Timestamp())
+	if err != nil {
+		return msg
+	}
+	forgedMsg, err := NewEndorsedConsensusMessage(ecm.Height(), forged, en).Proto()
+	if err != nil {
+		return msg
+	}
+	return forgedMsg
+}
+
+func (s *simulation) connected(from, to int, elapsed time.Duration) bool {
+	if s.nodes[from].crashed || s.nodes[to].crashed {
+		return false
+	}
+	for _, p := range s.cfg.partitions {
+		if elapsed < p.from || elapsed >= p.to {
+			continue
+		}
+		for _, group := range p.groups {
+			in := 0
+			for _, idx := range group {
+				if idx == from || idx == to {
+					in++
+				}
+			}
+			if in == 1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// deliver hands the due messages to the receivers in the order of delivery time
+func (s *simulation) deliver() {
+	s.mutex.Lock()
+	now := s.clock.Now()
+	sort.Slice(s.queue, func(i, j int) bool {
+		if s.queue[i].deliverAt.Equal(s.queue[j].deliverAt) {
+			return s.queue[i].seq < s.queue[j].seq
+		}
+		return s.queue[i].deliverAt.Before(s.queue[j].deliverAt)
+	})
+	var due []*simMessage
+	for len(s.queue) > 0 && !s.queue[0].deliverAt.After(now) {
+		due = append(due, s.queue[0])
+		s.queue = s.queue[1:]
+	}
+	s.mutex.Unlock()
+
+	for _, m := range due {
+		s.mutex.Lock()
+		to := s.nodes[m.to]
+		crashed, cs := to.crashed, to.consensus
+		s.mutex.Unlock()
+		if crashed {
+			continue
+		}
+		switch msg := m.msg.(type) {
+		case *iotextypes.ConsensusMessage:
+			_ = cs.HandleConsensusMsg(msg)
+		case *iotextypes.Block:
+			s.syncBlock(to, cs, s.nodes[m.from], msg)
+		}
+	}
+}
+
+// syncBlock commits a block received from a peer, as block sync does. The blocks missed by a delegate, e.g., while
+// it was down, are fetched from the peer.
+func (s *simulation) syncBlock(n *simNode, cs *RollDPoS, peer *simNode, pb *iotextypes.Block) {
+	blk := &block.Block{}
+	if err := blk.ConvertFromBlockPb(pb); err != nil {
+		return
+	}
+	for h := n.chain.TipHeight() + 1; h <= blk.Height(); h++ {
+		next := blk
+		if h < blk.Height() {
+			if next = peer.chain.block(h); next == nil {
+				return
+			}
+		}
+		if err := cs.ValidateBlockFooter(next); err != nil {
+			return
+		}
+		if err := n.chain.CommitBlock(next); err != nil {
+			return
+		}
+		cs.Calibrate(h)
+	}
+}
+
+// checkSafety asserts that no two delegates have committed different blocks at the same height
+func (s *simulation) checkSafety() {
+	committed := map[uint64]hash.Hash256{}
+	for _, n := range s.nodes {
+		for h := uint64(1); h <= n.chain.TipHeight(); h++ {
+			blkHash := n.chain.block(h).HashBlock()
+			if prev, ok := committed[h]; ok {
+				require.Equal(s.t, prev, blkHash, "seed %d: conflicting blocks at height %d", s.cfg.seed, h)
+			}
+			committed[h] = blkHash
+		}
+	}
+}
+
+// checkLiveness asserts that every running honest delegate has reached the height
+func (s *simulation) checkLiveness(height uint64) {
+	for _, n := range s.nodes {
+		if n.crashed || n.byzantine {
+			continue
+		}
+		require.True(
+			s.t,
+			n.chain.TipHeight() >= height,
+			"seed %d: delegate %d only reached height %d", s.cfg.seed, n.idx, n.chain.TipHeight(),
+		)
+		committed := 0
+		for _, r := range n.consensus.RecentRounds() {
+			if r.Outcome == OutcomeCommitted {
+				committed++
+			}
+		}
+		require.True(s.t, committed > 0, "seed %d: delegate %d has no committed round in history", s.cfg.seed, n.idx)
+	}
+}
+
+// checkAggregateEndorsements checks that the blocks from the activation height are endorsed by aggregate signatures
+func (s *simulation) checkAggregateEndorsements() {
+	for _, n := range s.nodes {
+		for h := uint64(1); h <= n.chain.TipHeight(); h++ {
+			blk := n.chain.block(h)
+			if h < s.cfg.blsHeight {
+				require.Nil(s.t, blk.AggregateEndorsement(), "seed %d: block %d has aggregate endorsement", s.cfg.seed, h)
+				continue
+			}
+			require.NotNil(s.t, blk.AggregateEndorsement(), "seed %d: block %d has no aggregate endorsement", s.cfg.seed, h)
+			require.Empty(s.t, blk.Endorsements())
+		}
+	}
+}
+
+// simClock is the mock clock shared by the delegates. The consensus only uses Now and Sleep with a stepping fsm, the
+// other methods fall back to a mock clock.
+type simClock struct {
+	clock.Clock
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newSimClock() *simClock {
+	mock := clock.NewMock()
+	return &simClock{Clock: mock, now: mock.Now()}
+}
+
+func (c *simClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Add moves the clock forward
+func (c *simClock) Add(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// simNodeClock is the clock of a delegate, which yields to the simulation while the delegate sleeps
+type simNodeClock struct {
+	*simClock
+	node *simNode
+}
+
+func (c *simNodeClock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	c.node.wakeAt = c.Now().Add(d)
+	c.node.yield <- true
+	<-c.node.wake
+}
+
+// simChain is an in-memory chain of empty blocks
+type simChain struct {
+	mutex   sync.RWMutex
+	genesis genesis.Genesis
+	blocks  []*block.Block
+}
+
+func (c *simChain) Genesis() genesis.Genesis { return c.genesis }
+
+func (c *simChain) BlockHeaderByHeight(height uint64) (*block.Header, error) {
+	blk := c.block(height)
+	if blk == nil {
+		return nil, errors.Errorf("block %d does not exist", height)
+	}
+	return &blk.Header, nil
+}
+
+func (c *simChain) BlockFooterByHeight(height uint64) (*block.Footer, error) {
+	blk := c.block(height)
+	if blk == nil {
+		return nil, errors.Errorf("block %d does not exist", height)
+	}
+	return &blk.Footer, nil
+}
+
+func (c *simChain) ValidateBlock(blk *block.Block) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.validate(blk)
+}
+
+func (c *simChain) CommitBlock(blk *block.Block) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := c.validate(blk); err != nil {
+		return err
+	}
+	c.blocks = append(c.blocks, blk)
+	return nil
+}
+
+func (c *simChain) TipHeight() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return uint64(len(c.blocks))
+}
+
+func (c *simChain) ChainAddress() string { return "" }
+
+func (c *simChain) block(height uint64) *block.Block {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if height == 0 || height > uint64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[height-1]
+}
+
+func (c *simChain) tipHash() hash.Hash256 {
+	if len(c.blocks) == 0 {
+		return hash.ZeroHash256
+	}
+	return c.blocks[len(c.blocks)-1].HashBlock()
+}
+
+func (c *simChain) validate(blk *block.Block) error {
+	if blk.Height() != uint64(len(c.blocks))+1 {
+		return errors.Wrapf(blockchain.ErrInvalidTipHeight, "block height %d, tip height %d", blk.Height(), len(c.blocks))
+	}
+	if blk.PrevHash() != c.tipHash() {
+		return errors.Errorf("invalid previous hash of block %d", blk.Height())
+	}
+	return nil
+}
+
+// simChainView is the chain seen by one run of a delegate, which stops committing blocks once the delegate crashes
+type simChainView struct {
+	*simChain
+	priKey crypto.PrivateKey
+	alive  func() bool
+}
+
+func (v *simChainView) MintNewBlock(_ map[string][]action.SealedEnvelope, timestamp time.Time) (*block.Block, error) {
+	v.mutex.RLock()
+	height, prevHash := uint64(len(v.blocks))+1, v.tipHash()
+	v.mutex.RUnlock()
+	blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().Build()).
+		SetHeight(height).
+		SetPrevBlockHash(prevHash).
+		SetTimestamp(timestamp).
+		SignAndBuild(v.priKey)
+	if err != nil {
+		return nil, err
+	}
+	return &blk, nil
+}
+
+func (v *simChainView) CommitBlock(blk *block.Block) error {
+	if !v.alive() {
+		return errors.New("delegate has crashed")
+	}
+	return v.simChain.CommitBlock(blk)
+}
+
+func TestConsensusSimulation(t *testing.T) {
+	seeds := []int64{1, 2, 3}
+	tests := []struct {
+		name   string
+		cfg    simConfig
+		height uint64
+	}{
+		{
+			name:   "healthy network",
+			cfg:    simConfig{numNodes: 4, duration: 20 * time.Second, maxDelay: 100 * time.Millisecond},
+			height: 6,
+		},
+		{
+			name:   "lossy network",
+			cfg:    simConfig{numNodes: 4, duration: 30 * time.Second, maxDelay: 200 * time.Millisecond, dropRate: 0.05},
+			height: 4,
+		},
+		{
+			name: "byzantine delegate",
+			cfg: simConfig{
+				numNodes:  4,
+				duration:  30 * time.Second,
+				maxDelay:  100 * time.Millisecond,
+				byzantine: []int{3},
+			},
+			height: 4,
+		},
+		{
+			name: "crash and recovery",
+			cfg: simConfig{
+				numNodes: 4,
+				duration: 40 * time.Second,
+				maxDelay: 100 * time.Millisecond,
+				crashes:  []simCrash{{node: 1, at: 6 * time.Second, recover: 16 * time.Second}},
+			},
+			height: 6,
+		},
+		{
+			name: "healed partition",
+			cfg: simConfig{
+				numNodes: 4,
+				duration: 40 * time.Second,
+				maxDelay: 100 * time.Millisecond,
+				partitions: []simPartition{{
+					from:   6 * time.Second,
+					to:     16 * time.Second,
+					groups: [][]int{{0, 1}, {2, 3}},
+				}},
+			},
+			height: 6,
+		},
+		{
+			name: "aggregate endorsements",
+			cfg: simConfig{
+				numNodes:  4,
+				duration:  30 * time.Second,
+				maxDelay:  100 * time.Millisecond,
+				crashes:   []simCrash{{node: 2, at: 10 * time.Second, recover: 18 * time.Second}},
+				blsHeight: 3,
+			},
+			height: 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, seed := range seeds {
+				ctrl := gomock.NewController(t)
+				walDir, err := ioutil.TempDir("", "consensus-sim")
+				require.NoError(t, err)
+				cfg := test.cfg
+				cfg.seed = seed
+				sim := newSimulation(t, ctrl, walDir, cfg)
+				sim.run()
+				sim.checkSafety()
+				sim.checkLiveness(test.height)
+				if cfg.blsHeight > 0 {
+					sim.checkAggregateEndorsements()
+				}
+				ctrl.Finish()
+				require.NoError(t, os.RemoveAll(walDir))
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos/endorsementpb"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// ErrConflictingSignature indicates that a message to sign contradicts one signed at the same height and round
+var ErrConflictingSignature = errors.New("conflicting with a signed message")
+
+const (
+	// walRecordHeaderSize is the size of the length and the checksum preceding each record
+	walRecordHeaderSize = 8
+	// walMaxRecordSize is the upper bound of the size of a record, beyond which the length must be corrupted
+	walMaxRecordSize = 1 << 16
+)
+
+type (
+	// walKey identifies the message signed for a height, round and topic
+	walKey struct {
+		kind   endorsementpb.WalRecordType
+		height uint64
+		round  uint32
+		topic  uint32
+	}
+
+	// consensusWAL is the write-ahead log of the rounds entered, the messages signed, the blocks locked and the
+	// consensus states entered by this node. Every record is synced to disk before the message is broadcast, and the
+	// log is replayed on start, so that a node restarting in the middle of a round never signs a message contradicting
+	// one it has signed before, and resumes the round with the lock and the state it had.
+	consensusWAL struct {
+		mutex     sync.Mutex
+		path      string
+		file      *os.File
+		height    uint64
+		round     uint32
+		signed    map[walKey][]byte
+		lock      *endorsementpb.WalRecord
+		state     *endorsementpb.WalRecord
+		numRecord int
+	}
+)
+
+func newConsensusWAL(path string) *consensusWAL {
+	return &consensusWAL{
+		path:   path,
+		signed: map[walKey][]byte{},
+	}
+}
+
+// Start replays the log and opens it for appending
+func (w *consensusWAL) Start() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	records, valid, err := readWALRecords(w.path)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		w.apply(r)
+	}
+	w.numRecord = len(records)
+	if w.file, err = os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY, 0600); err != nil {
+		return errors.Wrapf(err, "failed to open consensus wal %s", w.path)
+	}
+	// discard the tail of a record partially written before a crash
+	if err := w.file.Truncate(valid); err != nil {
+		return errors.Wrap(err, "failed to truncate consensus wal")
+	}
+	if _, err := w.file.Seek(valid, io.SeekStart); err != nil {
+		return errors.Wrap(err, "failed to seek consensus wal")
+	}
+	log.L().Info("Replayed consensus wal.",
+		zap.Int("records", len(records)),
+		zap.Uint64("height", w.height),
+		zap.Uint32("round", w.round),
+	)
+	return nil
+}
+
+// Stop closes the log
+func (w *consensusWAL) Stop() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// Round returns the last round entered
+func (w *consensusWAL) Round() (uint64, uint32) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.height, w.round
+}
+
+// LogRound logs the entering of a round
+func (w *consensusWAL) LogRound(height uint64, round uint32) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if height == w.height && round == w.round {
+		return nil
+	}
+	return w.append(&endorsementpb.WalRecord{
+		Type:   endorsementpb.WalRecordType_ROUND,
+		Height: height,
+		Round:  round,
+	})
+}
+
+// LogBlockProposal logs the block proposal to sign, and returns ErrConflictingSignature if a different block has been
+// proposed in the same round
+func (w *consensusWAL) LogBlockProposal(height uint64, round uint32, blkHash []byte) error {
+	return w.logSignature(&endorsementpb.WalRecord{
+		Type:      endorsementpb.WalRecordType_BLOCK_PROPOSAL,
+		Height:    height,
+		Round:     round,
+		BlockHash: blkHash,
+	})
+}
+
+// LogVote logs the consensus vote to sign, and returns ErrConflictingSignature if a vote of the same topic for a
+// different block has been signed in the same round
+func (w *consensusWAL) LogVote(height uint64, round uint32, topic ConsensusVoteTopic, blkHash []byte) error {
+	return w.logSignature(&endorsementpb.WalRecord{
+		Type:      endorsementpb.WalRecordType_VOTE,
+		Height:    height,
+		Round:     round,
+		Topic:     uint32(topic),
+		BlockHash: blkHash,
+	})
+}
+
+// LogLock logs the lock of a block with its proof of lock, or an unlock if the block hash is empty
+func (w *consensusWAL) LogLock(height uint64, round uint32, blkHash []byte, proofOfLock []*endorsement.Endorsement) error {
+	r := &endorsementpb.WalRecord{
+		Type:      endorsementpb.WalRecordType_LOCK,
+		Height:    height,
+		Round:     round,
+		BlockHash: blkHash,
+	}
+	for _, en := range proofOfLock {
+		enPb, err := en.Proto()
+		if err != nil {
+			return errors.Wrap(err, "failed to convert proof of lock")
+		}
+		r.ProofOfLock = append(r.ProofOfLock, enPb)
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.append(r)
+}
+
+// Lock returns the hash and the proof of lock of the last block locked or unlocked at height, if any
+func (w *consensusWAL) Lock(height uint64) ([]byte, []*endorsement.Endorsement, bool, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.lock == nil || w.lock.Height != height {
+		return nil, nil, false, nil
+	}
+	proofOfLock := make([]*endorsement.Endorsement, 0, len(w.lock.ProofOfLock))
+	for _, enPb := range w.lock.ProofOfLock {
+		en := &endorsement.Endorsement{}
+		if err := en.LoadProto(enPb); err != nil {
+			return nil, nil, false, errors.Wrap(err, "failed to load proof of lock")
+		}
+		proofOfLock = append(proofOfLock, en)
+	}
+	return w.lock.BlockHash, proofOfLock, true, nil
+}
+
+// LogState logs the consensus state entered in a round
+func (w *consensusWAL) LogState(height uint64, round uint32, state string) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.state != nil && w.state.Height == height && w.state.Round == round && w.state.State == state {
+		return nil
+	}
+	return w.append(&endorsementpb.WalRecord{
+		Type:   endorsementpb.WalRecordType_STATE,
+		Height: height,
+		Round:  round,
+		State:  state,
+	})
+}
+
+// State returns the last consensus state entered in a round, or an empty string if none
+func (w *consensusWAL) State(height uint64, round uint32) string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.state == nil || w.state.Height != height || w.state.Round != round {
+		return ""
+	}
+	return w.state.State
+}
+
+// Prune removes the records below height, which have been committed
+func (w *consensusWAL) Prune(height uint64) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	records := make([]*endorsementpb.WalRecord, 0, len(w.signed)+1)
+	for k, blkHash := range w.signed {
+		if k.height < height {
+			delete(w.signed, k)
+			continue
+		}
+		records = append(records, &endorsementpb.WalRecord{
+			Type:      k.kind,
+			Height:    k.height,
+			Round:     k.round,
+			Topic:     k.topic,
+			BlockHash: blkHash,
+		})
+	}
+	if w.lock != nil && w.lock.Height < height {
+		w.lock = nil
+	}
+	if w.lock != nil {
+		records = append(records, w.lock)
+	}
+	if w.state != nil && w.state.Height < height {
+		w.state = nil
+	}
+	if w.state != nil {
+		records = append(records, w.state)
+	}
+	if w.height >= height {
+		records = append(records, &endorsementpb.WalRecord{
+			Type:   endorsementpb.WalRecordType_ROUND,
+			Height: w.height,
+			Round:  w.round,
+		})
+	}
+	if len(records) == w.numRecord {
+		// nothing to prune
+		return nil
+	}
+	return w.rewrite(records)
+}
+
+func (w *consensusWAL) logSignature(r *endorsementpb.WalRecord) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	k := walKey{kind: r.Type, height: r.Height, round: r.Round, topic: r.Topic}
+	if blkHash, ok := w.signed[k]; ok {
+		if !bytes.Equal(blkHash, r.BlockHash) {
+			return errors.Wrapf(
+				ErrConflictingSignature,
+				"%s at height %d round %d topic %d",
+				r.Type,
+				r.Height,
+				r.Round,
+				r.Topic,
+			)
+		}
+		return nil
+	}
+	return w.append(r)
+}
+
+func (w *consensusWAL) append(r *endorsementpb.WalRecord) error {
+	if w.file == nil {
+		return errors.New("consensus wal is not started")
+	}
+	data, err := encodeWALRecord(r)
+	if err != nil {
+		return err
+	}
+	if _, err := w.file.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write consensus wal")
+	}
+	if err := w.file.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync consensus wal")
+	}
+	w.apply(r)
+	w.numRecord++
+	return nil
+}
+
+// rewrite replaces the log with the records, via a temporary file so that a crash leaves either the old or the new log
+func (w *consensusWAL) rewrite(records []*endorsementpb.WalRecord) error {
+	var buf bytes.Buffer
+	for _, r := range records {
+		data, err := encodeWALRecord(r)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+	}
+	tmpPath := w.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to create consensus wal")
+	}
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to write consensus wal")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to sync consensus wal")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to close consensus wal")
+	}
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return errors.Wrap(err, "failed to close consensus wal")
+		}
+		w.file = nil
+	}
+	if err := os.Rename(tmpPath, w.path); err != nil {
+		return errors.Wrap(err, "failed to replace consensus wal")
+	}
+	if w.file, err = os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND, 0600); err != nil {
+		return errors.Wrapf(err, "failed to open consensus wal %s", w.path)
+	}
+	w.numRecord = len(records)
+	// the renaming is only durable once the directory is synced
+	return syncDir(filepath.Dir(w.path))
+}
+
+func (w *consensusWAL) apply(r *endorsementpb.WalRecord) {
+	if r.Height > w.height || (r.Height == w.height && r.Round > w.round) {
+		w.height, w.round = r.Height, r.Round
+	}
+	switch r.Type {
+	case endorsementpb.WalRecordType_ROUND:
+		return
+	case endorsementpb.WalRecordType_LOCK:
+		w.lock = r
+		return
+	case endorsementpb.WalRecordType_STATE:
+		w.state = r
+		return
+	}
+	w.signed[walKey{kind: r.Type, height: r.Height, round: r.Round, topic: r.Topic}] = r.BlockHash
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open directory %s", dir)
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to sync directory %s", dir)
+	}
+	return nil
+}
+
+func encodeWALRecord(r *endorsementpb.WalRecord) ([]byte, error) {
+	payload, err := proto.Marshal(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal consensus wal record")
+	}
+	data := make([]byte, walRecordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(data, uint32(len(payload)))
+	binary.BigEndian.PutUint32(data[4:], crc32.ChecksumIEEE(payload))
+	copy(data[walRecordHeaderSize:], payload)
+	return data, nil
+}
+
+// readWALRecords reads the records in the log file, and returns the size of the valid part of the file. Reading stops
+// at the first incomplete or corrupted record, which can only be the last one written before a crash.
+func readWALRecords(path string) ([]*endorsementpb.WalRecord, int64, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to open consensus wal %s", path)
+	}
+	defer f.Close()
+
+	var (
+		records []*endorsementpb.WalRecord
+		valid   int64
+		header  = make([]byte, walRecordHeaderSize)
+		reader  = bufio.NewReader(f)
+	)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			break
+		}
+		size := binary.BigEndian.Uint32(header)
+		if size > walMaxRecordSize {
+			break
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			break
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			break
+		}
+		r := &endorsementpb.WalRecord{}
+		if err := proto.Unmarshal(payload, r); err != nil {
+			break
+		}
+		records = append(records, r)
+		valid += int64(walRecordHeaderSize + len(payload))
+	}
+	return records, valid, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestConsensusWAL(t *testing.T) {
+	require := require.New(t)
+	path, err := testutil.PathOfTempFile("wal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, path)
+
+	wal := newConsensusWAL(path)
+	require.NoError(wal.Start())
+	require.NoError(wal.LogRound(10, 0))
+	require.NoError(wal.LogBlockProposal(10, 0, []byte("block1")))
+	require.NoError(wal.LogVote(10, 0, PROPOSAL, []byte("block1")))
+	require.NoError(wal.LogVote(10, 0, LOCK, []byte("block1")))
+	// signing the same message again is fine
+	require.NoError(wal.LogVote(10, 0, PROPOSAL, []byte("block1")))
+	require.NoError(wal.LogRound(10, 1))
+	require.NoError(wal.LogVote(10, 1, PROPOSAL, []byte("block2")))
+	require.NoError(wal.Stop())
+
+	// the signed messages survive a restart
+	wal = newConsensusWAL(path)
+	require.NoError(wal.Start())
+	height, round := wal.Round()
+	require.Equal(uint64(10), height)
+	require.Equal(uint32(1), round)
+	require.Equal(ErrConflictingSignature, errors.Cause(wal.LogBlockProposal(10, 0, []byte("block2"))))
+	require.Equal(ErrConflictingSignature, errors.Cause(wal.LogVote(10, 0, LOCK, []byte("block2"))))
+	require.Equal(ErrConflictingSignature, errors.Cause(wal.LogVote(10, 1, PROPOSAL, []byte("block1"))))
+	require.NoError(wal.LogVote(10, 1, LOCK, []byte("block2")))
+	require.NoError(wal.LogVote(10, 0, COMMIT, []byte("block1")))
+
+	// committed heights are pruned
+	require.NoError(wal.LogVote(11, 0, PROPOSAL, []byte("block3")))
+	require.NoError(wal.Prune(11))
+	require.NoError(wal.LogVote(10, 1, PROPOSAL, []byte("block1")))
+	require.Equal(ErrConflictingSignature, errors.Cause(wal.LogVote(11, 0, PROPOSAL, []byte("block4"))))
+	require.NoError(wal.Stop())
+	records, _, err := readWALRecords(path)
+	require.NoError(err)
+	require.Len(records, 3)
+
+	// a record partially written before a crash is discarded
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(err)
+	_, err = f.Write([]byte{0, 0, 0, 10, 1, 2})
+	require.NoError(err)
+	require.NoError(f.Close())
+	wal = newConsensusWAL(path)
+	require.NoError(wal.Start())
+	require.Equal(ErrConflictingSignature, errors.Cause(wal.LogVote(11, 0, PROPOSAL, []byte("block4"))))
+	require.NoError(wal.LogVote(11, 0, LOCK, []byte("block3")))
+	require.NoError(wal.Stop())
+	records, _, err = readWALRecords(path)
+	require.NoError(err)
+	require.Len(records, 4)
+}
+
+func TestConsensusWALLockAndState(t *testing.T) {
+	require := require.New(t)
+	path, err := testutil.PathOfTempFile("wal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, path)
+
+	en, err := endorsement.Endorse(identityset.PrivateKey(1), NewConsensusVote([]byte("block1"), PROPOSAL), time.Now())
+	require.NoError(err)
+	wal := newConsensusWAL(path)
+	require.NoError(wal.Start())
+	_, _, ok, err := wal.Lock(10)
+	require.NoError(err)
+	require.False(ok)
+	require.Equal("", wal.State(10, 0))
+	require.NoError(wal.LogRound(10, 0))
+	require.NoError(wal.LogState(10, 0, "S_ACCEPT_BLOCK_PROPOSAL"))
+	require.NoError(wal.LogLock(10, 0, []byte("block1"), []*endorsement.Endorsement{en}))
+	require.NoError(wal.LogState(10, 0, "S_ACCEPT_LOCK_ENDORSEMENT"))
+	require.NoError(wal.Stop())
+
+	// the lock and the state survive a restart
+	wal = newConsensusWAL(path)
+	require.NoError(wal.Start())
+	blkHash, proofOfLock, ok, err := wal.Lock(10)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal([]byte("block1"), blkHash)
+	require.Len(proofOfLock, 1)
+	require.Equal(en.Endorser().HexString(), proofOfLock[0].Endorser().HexString())
+	require.Equal(en.Signature(), proofOfLock[0].Signature())
+	require.Equal("S_ACCEPT_LOCK_ENDORSEMENT", wal.State(10, 0))
+	require.Equal("", wal.State(10, 1))
+
+	// an unlock replaces the lock
+	require.NoError(wal.LogRound(10, 1))
+	require.NoError(wal.LogLock(10, 1, nil, nil))
+	blkHash, proofOfLock, ok, err = wal.Lock(10)
+	require.NoError(err)
+	require.True(ok)
+	require.Empty(blkHash)
+	require.Empty(proofOfLock)
+
+	// the lock and the state of committed heights are pruned
+	require.NoError(wal.Prune(11))
+	_, _, ok, err = wal.Lock(10)
+	require.NoError(err)
+	require.False(ok)
+	require.Equal("", wal.State(10, 0))
+	require.NoError(wal.Stop())
+	records, _, err := readWALRecords(path)
+	require.NoError(err)
+	require.Empty(records)
+}
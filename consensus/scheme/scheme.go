@@ -7,6 +7,8 @@
 package scheme
 
 import (
+	"time"
+
 	"github.com/golang/protobuf/proto"
 
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -14,8 +16,8 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
-// CreateBlockCB defines the callback to create a new block
-type CreateBlockCB func() (*block.Block, error)
+// CreateBlockCB defines the callback to create a new block at the given time
+type CreateBlockCB func(time.Time) (*block.Block, error)
 
 // TellPeerCB defines the callback to tell (which is a unicast) message to peers on P2P network
 type TellPeerCB func(proto.Message) error
@@ -39,6 +41,7 @@ type Scheme interface {
 	Metrics() (ConsensusMetrics, error)
 	Activate(bool)
 	Active() bool
+	SetLease(uint64, time.Time)
 }
 
 // ConsensusMetrics contains consensus metrics to expose
@@ -8,8 +8,10 @@ package scheme
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/facebookgo/clock"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
@@ -20,45 +22,103 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
+// onDemandPollInterval is the interval to check the pending actions in on-demand mode
+const onDemandPollInterval = 100 * time.Millisecond
+
 // Standalone is the consensus scheme that periodically create blocks
 type Standalone struct {
-	task *routine.RecurringTask
+	task    *routine.RecurringTask
+	handler *standaloneHandler
+}
+
+// StandaloneOption sets standalone scheme construction parameter
+type StandaloneOption func(*standaloneHandler)
+
+// WithOnDemandMinting mints a block as soon as there are pending actions, instead of every block interval. If maxIdle
+// is not 0, a block is also minted when there has been no block for maxIdle.
+func WithOnDemandMinting(hasPending func() bool, maxIdle time.Duration) StandaloneOption {
+	return func(h *standaloneHandler) {
+		h.hasPending = hasPending
+		h.maxIdle = maxIdle
+	}
+}
+
+// WithStandaloneClock sets the clock of the block time
+func WithStandaloneClock(c clock.Clock) StandaloneOption {
+	return func(h *standaloneHandler) {
+		h.clock = c
+	}
 }
 
 type standaloneHandler struct {
+	mutex    sync.Mutex
 	bc       blockchain.Blockchain
 	createCb CreateBlockCB
 	commitCb ConsensusDoneCB
 	pubCb    BroadcastCB
+	clock    clock.Clock
+	// offset is added to the time of the clock as the block time
+	offset   time.Duration
+	lastMint time.Time
+	// hasPending is set in on-demand mode
+	hasPending func() bool
+	maxIdle    time.Duration
 }
 
 func (s *standaloneHandler) Run() {
-	blk, err := s.createCb()
-	if err != nil {
-		log.L().Error("Failed to create.", zap.Error(err))
-		return
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.hasPending != nil && !s.hasPending() {
+		if s.maxIdle == 0 || s.clock.Now().Sub(s.lastMint) < s.maxIdle {
+			return
+		}
 	}
+	if _, err := s.mint(); err != nil {
+		log.L().Error("Failed to mint a block.", zap.Error(err))
+	}
+}
 
+func (s *standaloneHandler) mint() (*block.Block, error) {
+	s.lastMint = s.clock.Now()
+	blk, err := s.createCb(s.lastMint.Add(s.offset))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create")
+	}
 	if err := s.commitCb(blk); err != nil {
-		log.L().Error("Failed to commit.", zap.Error(err))
-		return
+		return nil, errors.Wrap(err, "failed to commit")
 	}
 	if err := s.pubCb(blk); err != nil {
 		log.L().Error("Failed to publish event.", zap.Error(err))
-		return
 	}
+	return blk, nil
 }
 
 // NewStandalone creates a Standalone struct.
-func NewStandalone(create CreateBlockCB, commit ConsensusDoneCB, pub BroadcastCB, bc blockchain.Blockchain, interval time.Duration) Scheme {
+func NewStandalone(
+	create CreateBlockCB,
+	commit ConsensusDoneCB,
+	pub BroadcastCB,
+	bc blockchain.Blockchain,
+	interval time.Duration,
+	opts ...StandaloneOption,
+) Scheme {
 	h := &standaloneHandler{
 		bc:       bc,
 		createCb: create,
 		commitCb: commit,
 		pubCb:    pub,
+		clock:    clock.New(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	if h.hasPending != nil {
+		interval = onDemandPollInterval
 	}
+	h.lastMint = h.clock.Now()
 	return &Standalone{
-		task: routine.NewRecurringTask(h.Run, interval),
+		task:    routine.NewRecurringTask(h.Run, interval),
+		handler: h,
 	}
 }
 
@@ -72,6 +132,32 @@ func (s *Standalone) Stop(ctx context.Context) error {
 	return s.task.Stop(ctx)
 }
 
+// Mint mints n blocks right away, with the pending actions if any, and returns the blocks minted
+func (s *Standalone) Mint(n int) ([]*block.Block, error) {
+	s.handler.mutex.Lock()
+	defer s.handler.mutex.Unlock()
+	blks := make([]*block.Block, 0, n)
+	for i := 0; i < n; i++ {
+		blk, err := s.handler.mint()
+		if err != nil {
+			return blks, err
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+// JumpTime moves the time of the blocks minted from now on forward by d, and returns the time of the next block
+func (s *Standalone) JumpTime(d time.Duration) (time.Time, error) {
+	if d < 0 {
+		return time.Time{}, errors.Errorf("cannot move the block time backward by %s", d)
+	}
+	s.handler.mutex.Lock()
+	defer s.handler.mutex.Unlock()
+	s.handler.offset += d
+	return s.handler.clock.Now().Add(s.handler.offset), nil
+}
+
 // HandleConsensusMsg handles incoming consensus message
 func (s *Standalone) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
 	log.L().Warn("Noop scheme does not handle incoming block propose requests.")
@@ -102,3 +188,8 @@ func (s *Standalone) Activate(_ bool) {
 
 // Active is always true for standalone scheme
 func (s *Standalone) Active() bool { return true }
+
+// SetLease is not implemented for standalone scheme
+func (s *Standalone) SetLease(uint64, time.Time) {
+	log.S().Warn("Standalone scheme could not support lease")
+}
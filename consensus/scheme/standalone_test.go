@@ -0,0 +1,96 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package scheme
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+)
+
+func TestStandaloneOnDemand(t *testing.T) {
+	require := require.New(t)
+
+	mockClock := clock.NewMock()
+	var (
+		pending    bool
+		timestamps []time.Time
+		failCommit bool
+	)
+	create := func(ts time.Time) (*block.Block, error) {
+		timestamps = append(timestamps, ts)
+		return &block.Block{}, nil
+	}
+	commit := func(*block.Block) error {
+		if failCommit {
+			return errors.New("mock error")
+		}
+		return nil
+	}
+	pub := func(*block.Block) error { return nil }
+	s := NewStandalone(
+		create, commit, pub, nil, 10*time.Second,
+		WithStandaloneClock(mockClock),
+		WithOnDemandMinting(func() bool { return pending }, time.Minute),
+	).(*Standalone)
+
+	// no block without pending actions before the max idle interval
+	s.handler.Run()
+	mockClock.Add(30 * time.Second)
+	s.handler.Run()
+	require.Empty(timestamps)
+	pending = true
+	s.handler.Run()
+	require.Equal([]time.Time{mockClock.Now()}, timestamps)
+	pending = false
+	mockClock.Add(59 * time.Second)
+	s.handler.Run()
+	require.Len(timestamps, 1)
+	mockClock.Add(time.Second)
+	s.handler.Run()
+	require.Len(timestamps, 2)
+
+	// mint blocks and jump the block time
+	_, err := s.JumpTime(-time.Hour)
+	require.Error(err)
+	next, err := s.JumpTime(72 * time.Hour)
+	require.NoError(err)
+	require.Equal(mockClock.Now().Add(72*time.Hour), next)
+	blks, err := s.Mint(3)
+	require.NoError(err)
+	require.Len(blks, 3)
+	require.Len(timestamps, 5)
+	require.Equal(next, timestamps[4])
+	failCommit = true
+	blks, err = s.Mint(2)
+	require.Error(err)
+	require.Empty(blks)
+}
+
+func TestStandalonePeriodic(t *testing.T) {
+	require := require.New(t)
+
+	minted := 0
+	s := NewStandalone(
+		func(time.Time) (*block.Block, error) {
+			minted++
+			return &block.Block{}, nil
+		},
+		func(*block.Block) error { return nil },
+		func(*block.Block) error { return nil },
+		nil,
+		10*time.Second,
+	).(*Standalone)
+	s.handler.Run()
+	s.handler.Run()
+	require.Equal(2, minted)
+}
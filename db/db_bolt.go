@@ -9,6 +9,7 @@ package db
 import (
 	"bytes"
 	"context"
+	"io"
 
 	"github.com/pkg/errors"
 	bolt "go.etcd.io/bbolt"
@@ -311,6 +312,17 @@ func (b *boltDB) WriteBatch(kvsb batch.KVStoreBatch) (err error) {
 	return err
 }
 
+// Snapshot writes a consistent copy of the DB file within a read transaction, which does not block the writes
+func (b *boltDB) Snapshot(w io.Writer) (n int64, err error) {
+	if err = b.db.View(func(tx *bolt.Tx) error {
+		n, err = tx.WriteTo(w)
+		return err
+	}); err != nil {
+		err = errors.Wrap(ErrIO, err.Error())
+	}
+	return n, err
+}
+
 // SetBucketFillPercent sets specified fill percent for a bucket
 func (b *boltDB) SetBucketFillPercent(namespace string, percent float64) error {
 	b.fillPercent[namespace] = percent
@@ -9,6 +9,7 @@ package db
 import (
 	"bytes"
 	"context"
+	"io/ioutil"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -427,3 +428,39 @@ func TestFilter(t *testing.T) {
 		testFunc(NewBoltDB(cfg), t)
 	})
 }
+
+func TestBoltDBSnapshot(t *testing.T) {
+	require := require.New(t)
+
+	testPath, err := testutil.PathOfTempFile("test-snapshot.bolt")
+	require.NoError(err)
+	snapshotPath, err := testutil.PathOfTempFile("test-snapshot-copy.bolt")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, testPath)
+	defer testutil.CleanupPath(t, snapshotPath)
+	cfg.DbPath = testPath
+	kv := NewBoltDB(cfg)
+	require.NoError(kv.Start(context.Background()))
+	require.NoError(kv.Put(bucket1, testK1[0], testV1[0]))
+
+	// the snapshot is taken while the db is open
+	var bb bytes.Buffer
+	n, err := kv.(KVStoreWithSnapshot).Snapshot(&bb)
+	require.NoError(err)
+	require.Equal(int64(bb.Len()), n)
+	require.NoError(kv.Put(bucket1, testK1[1], testV1[1]))
+	require.NoError(kv.Stop(context.Background()))
+
+	require.NoError(ioutil.WriteFile(snapshotPath, bb.Bytes(), 0600))
+	cfg.DbPath = snapshotPath
+	snapshot := NewBoltDB(cfg)
+	require.NoError(snapshot.Start(context.Background()))
+	defer func() {
+		require.NoError(snapshot.Stop(context.Background()))
+	}()
+	v, err := snapshot.Get(bucket1, testK1[0])
+	require.NoError(err)
+	require.Equal(testV1[0], v)
+	_, err = snapshot.Get(bucket1, testK1[1])
+	require.Equal(ErrNotExist, errors.Cause(err))
+}
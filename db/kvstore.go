@@ -7,6 +7,8 @@
 package db
 
 import (
+	"io"
+
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 
 	"github.com/iotexproject/iotex-core/db/batch"
@@ -51,6 +53,13 @@ type (
 		SetBucketFillPercent(string, float64) error
 	}
 
+	// KVStoreWithSnapshot is KVStore with Snapshot() API
+	KVStoreWithSnapshot interface {
+		KVStore
+		// Snapshot writes a consistent copy of the whole store, which is readable while the store is being written
+		Snapshot(io.Writer) (int64, error)
+	}
+
 	// KVStoreForRangeIndex is KVStore for range index
 	KVStoreForRangeIndex interface {
 		KVStore
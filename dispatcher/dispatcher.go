@@ -19,9 +19,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
-	goproto "github.com/iotexproject/iotex-proto/golang"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -33,6 +33,9 @@ type Subscriber interface {
 	HandleBlockSync(context.Context, *iotextypes.Block) error
 	HandleSyncRequest(context.Context, peerstore.PeerInfo, *iotexrpc.BlockSync) error
 	HandleConsensusMsg(*iotextypes.ConsensusMessage) error
+	// HandleMessage handles the messages of the protocol extensions registered in p2p. The peer is empty for a
+	// broadcast message.
+	HandleMessage(context.Context, peerstore.PeerInfo, proto.Message) error
 }
 
 // Dispatcher is used by peers, handles incoming block and header notifications and relays announcements of new blocks.
@@ -49,16 +52,82 @@ type Dispatcher interface {
 	HandleTell(context.Context, uint32, peerstore.PeerInfo, proto.Message)
 }
 
-var requestMtc = prometheus.NewCounterVec(
-	prometheus.CounterOpts{
-		Name: "iotex_dispatch_request",
-		Help: "Dispatcher request counter.",
-	},
-	[]string{"method", "succeed"},
+var (
+	requestMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_dispatch_request",
+			Help: "Dispatcher request counter.",
+		},
+		[]string{"method", "succeed"},
+	)
+	queueDepthMtc = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_dispatch_queue_depth",
+			Help: "Dispatcher queue depth.",
+		},
+		[]string{"queue"},
+	)
+	queueDropMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_dispatch_queue_drop",
+			Help: "Dispatcher queue drop counter.",
+		},
+		[]string{"queue"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(requestMtc)
+	prometheus.MustRegister(queueDepthMtc)
+	prometheus.MustRegister(queueDropMtc)
+}
+
+// The queues of the dispatcher in the order of priority. The worker of a queue handles an event only if the queues of
+// higher priorities have no event pending, so that the consensus and the blocks are not delayed by a flood of actions.
+const (
+	consensusQueue = iota
+	blockQueue
+	blockSyncQueue
+	actionQueue
+	numQueues
+)
+
+// dropPolicy decides which event to drop if a queue is full
+type dropPolicy int
+
+const (
+	// dropNewest drops the incoming event, which pushes back on the peers flooding the node
+	dropNewest dropPolicy = iota
+	// dropOldest drops the oldest event in the queue, which is likely obsoleted by the incoming event
+	dropOldest
+)
+
+// eventQueue is a bounded queue of events handled by its own worker
+type eventQueue struct {
+	name    string
+	policy  dropPolicy
+	events  chan interface{}
+	dropped uint64
+	// pending is the number of the events in the queue or being handled
+	pending int64
+}
+
+// QueueStats is the stats of a dispatcher queue
+type QueueStats struct {
+	Depth    int    `json:"depth"`
+	Capacity int    `json:"capacity"`
+	Dropped  uint64 `json:"dropped"`
+}
+
+// consensusMsg packages a proto consensus message.
+type consensusMsg struct {
+	ctx     context.Context
+	chainID uint32
+	msg     *iotextypes.ConsensusMessage
+}
+
+func (m consensusMsg) ChainID() uint32 {
+	return m.chainID
 }
 
 // blockMsg packages a proto block message.
@@ -95,11 +164,28 @@ func (m actionMsg) ChainID() uint32 {
 	return m.chainID
 }
 
+// extensionMsg packages a message of a protocol extension.
+type extensionMsg struct {
+	ctx     context.Context
+	chainID uint32
+	msgType iotexrpc.MessageType
+	msg     proto.Message
+	peer    peerstore.PeerInfo
+}
+
+func (m extensionMsg) ChainID() uint32 {
+	return m.chainID
+}
+
 // IotxDispatcher is the request and event dispatcher for iotx node.
 type IotxDispatcher struct {
-	started        int32
-	shutdown       int32
-	eventChan      chan interface{}
+	started  int32
+	shutdown int32
+	queues   [numQueues]*eventQueue
+	// idle is closed and renewed whenever a queue has no event pending any more, which wakes up the workers of lower
+	// priorities waiting for the queue
+	idle           chan struct{}
+	idleLock       sync.Mutex
 	eventAudit     map[iotexrpc.MessageType]int
 	eventAuditLock sync.RWMutex
 	wg             sync.WaitGroup
@@ -112,14 +198,30 @@ type IotxDispatcher struct {
 // NewDispatcher creates a new Dispatcher
 func NewDispatcher(cfg config.Config) (Dispatcher, error) {
 	d := &IotxDispatcher{
-		eventChan:   make(chan interface{}, cfg.Dispatcher.EventChanSize),
+		idle:        make(chan struct{}),
 		eventAudit:  make(map[iotexrpc.MessageType]int),
 		quit:        make(chan struct{}),
 		subscribers: make(map[uint32]Subscriber),
 	}
+	dc := cfg.Dispatcher
+	d.queues[consensusQueue] = newEventQueue("consensus", dropOldest, dc.ConsensusChanSize, dc.EventChanSize)
+	d.queues[blockQueue] = newEventQueue("block", dropOldest, dc.BlockChanSize, dc.EventChanSize)
+	d.queues[blockSyncQueue] = newEventQueue("blockSync", dropNewest, dc.BlockSyncChanSize, dc.EventChanSize)
+	d.queues[actionQueue] = newEventQueue("action", dropNewest, dc.ActionChanSize, dc.EventChanSize)
 	return d, nil
 }
 
+func newEventQueue(name string, policy dropPolicy, size, defaultSize uint) *eventQueue {
+	if size == 0 {
+		size = defaultSize
+	}
+	return &eventQueue{
+		name:   name,
+		policy: policy,
+		events: make(chan interface{}, size),
+	}
+}
+
 // AddSubscriber adds a subscriber to dispatcher
 func (d *IotxDispatcher) AddSubscriber(
 	chainID uint32,
@@ -136,8 +238,10 @@ func (d *IotxDispatcher) Start(ctx context.Context) error {
 		return errors.New("Dispatcher already started")
 	}
 	log.L().Info("Starting dispatcher.")
-	d.wg.Add(1)
-	go d.newsHandler()
+	for i := range d.queues {
+		d.wg.Add(1)
+		go d.newsHandler(i)
+	}
 	return nil
 }
 
@@ -153,11 +257,6 @@ func (d *IotxDispatcher) Stop(ctx context.Context) error {
 	return nil
 }
 
-// EventChan returns the event chan
-func (d *IotxDispatcher) EventChan() *chan interface{} {
-	return &d.eventChan
-}
-
 // EventAudit returns the event audit map
 func (d *IotxDispatcher) EventAudit() map[iotexrpc.MessageType]int {
 	d.eventAuditLock.RLock()
@@ -169,36 +268,111 @@ func (d *IotxDispatcher) EventAudit() map[iotexrpc.MessageType]int {
 	return snapshot
 }
 
-// newsHandler is the main handler for handling all news from peers.
-func (d *IotxDispatcher) newsHandler() {
+// QueueAudit returns the depth, the capacity and the number of events dropped of each queue
+func (d *IotxDispatcher) QueueAudit() map[string]QueueStats {
+	audit := make(map[string]QueueStats, len(d.queues))
+	for _, q := range d.queues {
+		audit[q.name] = QueueStats{
+			Depth:    len(q.events),
+			Capacity: cap(q.events),
+			Dropped:  atomic.LoadUint64(&q.dropped),
+		}
+	}
+	return audit
+}
+
+// newsHandler is the handler for handling the news of a queue from peers.
+func (d *IotxDispatcher) newsHandler(i int) {
+	q := d.queues[i]
 loop:
 	for {
 		select {
-		case m := <-d.eventChan:
+		case m := <-q.events:
+			queueDepthMtc.WithLabelValues(q.name).Set(float64(len(q.events)))
+			if !d.waitForHigherPriorities(i) {
+				break loop
+			}
 			switch msg := m.(type) {
+			case *consensusMsg:
+				d.handleConsensusMsg(msg)
 			case *actionMsg:
 				d.handleActionMsg(msg)
 			case *blockMsg:
 				d.handleBlockMsg(msg)
 			case *blockSyncMsg:
 				d.handleBlockSyncMsg(msg)
+			case *extensionMsg:
+				d.handleExtensionMsg(msg)
 
 			default:
 				log.L().Warn("Invalid message type in block handler.", zap.Any("msg", msg))
 			}
-
+			d.done(q)
 		case <-d.quit:
 			break loop
 		}
 	}
 
 	d.wg.Done()
-	log.L().Info("News handler done.")
+	log.L().Info("News handler done.", zap.String("queue", q.name))
+}
+
+// waitForHigherPriorities waits until the queues of higher priorities than the i-th queue have no event pending, and
+// returns false if the dispatcher is shutting down. The worker only wakes up when one of the queues becomes idle,
+// rather than on every event handled.
+func (d *IotxDispatcher) waitForHigherPriorities(i int) bool {
+	for {
+		d.idleLock.Lock()
+		idle := d.idle
+		d.idleLock.Unlock()
+		pending := false
+		for _, q := range d.queues[:i] {
+			if atomic.LoadInt64(&q.pending) > 0 {
+				pending = true
+				break
+			}
+		}
+		if !pending {
+			return true
+		}
+		select {
+		case <-idle:
+		case <-d.quit:
+			return false
+		}
+	}
+}
+
+// done marks an event of the queue handled or dropped, and wakes up the workers waiting if the queue becomes idle
+func (d *IotxDispatcher) done(q *eventQueue) {
+	if atomic.AddInt64(&q.pending, -1) > 0 {
+		return
+	}
+	d.idleLock.Lock()
+	close(d.idle)
+	d.idle = make(chan struct{})
+	d.idleLock.Unlock()
+}
+
+// handleConsensusMsg handles consensusMsg from peers.
+func (d *IotxDispatcher) handleConsensusMsg(m *consensusMsg) {
+	d.updateEventAudit(iotexrpc.MessageType_CONSENSUS)
+	d.subscribersMU.RLock()
+	defer d.subscribersMU.RUnlock()
+	if subscriber, ok := d.subscribers[m.ChainID()]; ok {
+		if err := subscriber.HandleConsensusMsg(m.msg); err != nil {
+			log.L().Debug("Failed to handle consensus message.", zap.Error(err))
+		}
+	} else {
+		log.L().Info("No subscriber specified in the dispatcher.", zap.Uint32("chainID", m.ChainID()))
+	}
 }
 
 // handleActionMsg handles actionMsg from all peers.
 func (d *IotxDispatcher) handleActionMsg(m *actionMsg) {
 	d.updateEventAudit(iotexrpc.MessageType_ACTION)
+	d.subscribersMU.RLock()
+	defer d.subscribersMU.RUnlock()
 	if subscriber, ok := d.subscribers[m.ChainID()]; ok {
 		if err := subscriber.HandleAction(m.ctx, m.action); err != nil {
 			requestMtc.WithLabelValues("AddAction", "false").Inc()
@@ -233,6 +407,8 @@ func (d *IotxDispatcher) handleBlockSyncMsg(m *blockSyncMsg) {
 		zap.Uint64("end", m.sync.End))
 
 	d.updateEventAudit(iotexrpc.MessageType_BLOCK_REQUEST)
+	d.subscribersMU.RLock()
+	defer d.subscribersMU.RUnlock()
 	if subscriber, ok := d.subscribers[m.ChainID()]; ok {
 		// dispatch to block sync
 		if err := subscriber.HandleSyncRequest(m.ctx, m.peer, m.sync); err != nil {
@@ -243,12 +419,38 @@ func (d *IotxDispatcher) handleBlockSyncMsg(m *blockSyncMsg) {
 	}
 }
 
+// handleExtensionMsg handles the messages of the protocol extensions from peers.
+func (d *IotxDispatcher) handleExtensionMsg(m *extensionMsg) {
+	d.updateEventAudit(m.msgType)
+	d.subscribersMU.RLock()
+	defer d.subscribersMU.RUnlock()
+	if subscriber, ok := d.subscribers[m.ChainID()]; ok {
+		if err := subscriber.HandleMessage(m.ctx, m.peer, m.msg); err != nil {
+			log.L().Debug("Failed to handle message.", zap.Any("msgType", m.msgType), zap.Error(err))
+		}
+	} else {
+		log.L().Info("No subscriber specified in the dispatcher.", zap.Uint32("chainID", m.ChainID()))
+	}
+}
+
+// dispatchConsensus adds the passed consensus message to the news handling queue.
+func (d *IotxDispatcher) dispatchConsensus(ctx context.Context, chainID uint32, msg proto.Message) {
+	if atomic.LoadInt32(&d.shutdown) != 0 {
+		return
+	}
+	d.enqueueEvent(consensusQueue, &consensusMsg{
+		ctx:     ctx,
+		chainID: chainID,
+		msg:     (msg).(*iotextypes.ConsensusMessage),
+	})
+}
+
 // dispatchAction adds the passed action message to the news handling queue.
 func (d *IotxDispatcher) dispatchAction(ctx context.Context, chainID uint32, msg proto.Message) {
 	if atomic.LoadInt32(&d.shutdown) != 0 {
 		return
 	}
-	d.enqueueEvent(&actionMsg{
+	d.enqueueEvent(actionQueue, &actionMsg{
 		ctx:     ctx,
 		chainID: chainID,
 		action:  (msg).(*iotextypes.Action),
@@ -256,11 +458,11 @@ func (d *IotxDispatcher) dispatchAction(ctx context.Context, chainID uint32, msg
 }
 
 // dispatchBlockCommit adds the passed block message to the news handling queue.
-func (d *IotxDispatcher) dispatchBlockCommit(ctx context.Context, chainID uint32, msg proto.Message) {
+func (d *IotxDispatcher) dispatchBlockCommit(ctx context.Context, chainID uint32, queue int, msg proto.Message) {
 	if atomic.LoadInt32(&d.shutdown) != 0 {
 		return
 	}
-	d.enqueueEvent(&blockMsg{
+	d.enqueueEvent(queue, &blockMsg{
 		ctx:     ctx,
 		chainID: chainID,
 		block:   (msg).(*iotextypes.Block),
@@ -272,7 +474,7 @@ func (d *IotxDispatcher) dispatchBlockSyncReq(ctx context.Context, chainID uint3
 	if atomic.LoadInt32(&d.shutdown) != 0 {
 		return
 	}
-	d.enqueueEvent(&blockSyncMsg{
+	d.enqueueEvent(blockSyncQueue, &blockSyncMsg{
 		ctx:     ctx,
 		chainID: chainID,
 		peer:    peer,
@@ -280,38 +482,72 @@ func (d *IotxDispatcher) dispatchBlockSyncReq(ctx context.Context, chainID uint3
 	})
 }
 
+// dispatchExtension adds the passed message of a protocol extension to the news handling queue.
+func (d *IotxDispatcher) dispatchExtension(
+	ctx context.Context,
+	chainID uint32,
+	msgType iotexrpc.MessageType,
+	peer peerstore.PeerInfo,
+	msg proto.Message,
+) {
+	if atomic.LoadInt32(&d.shutdown) != 0 {
+		return
+	}
+	d.enqueueEvent(extensionQueue(msgType), &extensionMsg{
+		ctx:     ctx,
+		chainID: chainID,
+		msgType: msgType,
+		msg:     msg,
+		peer:    peer,
+	})
+}
+
+// extensionQueue returns the queue of the messages of a protocol extension. The messages relaying the new blocks go
+// with the blocks, the messages gossiping the actions go with the actions, and the others go with the block sync.
+func extensionQueue(msgType iotexrpc.MessageType) int {
+	switch msgType {
+	case iotexrpc.MessageType_COMPACT_BLOCK, iotexrpc.MessageType_ACTIONS_REQUEST, iotexrpc.MessageType_BLOCK_ACTIONS:
+		return blockQueue
+	case iotexrpc.MessageType_ACTION_INVENTORY, iotexrpc.MessageType_ACTION_REQUEST, iotexrpc.MessageType_ACTIONS:
+		return actionQueue
+	default:
+		return blockSyncQueue
+	}
+}
+
 // HandleBroadcast handles incoming broadcast message
 func (d *IotxDispatcher) HandleBroadcast(ctx context.Context, chainID uint32, message proto.Message) {
-	msgType, err := goproto.GetTypeFromRPCMsg(message)
+	msgType, err := p2p.MessageType(message)
 	if err != nil {
 		log.L().Warn("Unexpected message handled by HandleBroadcast.", zap.Error(err))
 	}
 	d.subscribersMU.RLock()
-	subscriber, ok := d.subscribers[chainID]
+	_, ok := d.subscribers[chainID]
+	d.subscribersMU.RUnlock()
 	if !ok {
 		log.L().Warn("chainID has not been registered in dispatcher.", zap.Uint32("chainID", chainID))
-		d.subscribersMU.RUnlock()
 		return
 	}
-	d.subscribersMU.RUnlock()
 
 	switch msgType {
 	case iotexrpc.MessageType_CONSENSUS:
-		if err := subscriber.HandleConsensusMsg(message.(*iotextypes.ConsensusMessage)); err != nil {
-			log.L().Debug("Failed to handle consensus message.", zap.Error(err))
-		}
+		d.dispatchConsensus(ctx, chainID, message)
 	case iotexrpc.MessageType_ACTION:
 		d.dispatchAction(ctx, chainID, message)
 	case iotexrpc.MessageType_BLOCK:
-		d.dispatchBlockCommit(ctx, chainID, message)
+		d.dispatchBlockCommit(ctx, chainID, blockQueue, message)
 	default:
+		if p2p.IsExtension(msgType) {
+			d.dispatchExtension(ctx, chainID, msgType, peerstore.PeerInfo{}, message)
+			return
+		}
 		log.L().Warn("Unexpected msgType handled by HandleBroadcast.", zap.Any("msgType", msgType))
 	}
 }
 
 // HandleTell handles incoming unicast message
 func (d *IotxDispatcher) HandleTell(ctx context.Context, chainID uint32, peer peerstore.PeerInfo, message proto.Message) {
-	msgType, err := goproto.GetTypeFromRPCMsg(message)
+	msgType, err := p2p.MessageType(message)
 	if err != nil {
 		log.L().Warn("Unexpected message handled by HandleTell.", zap.Error(err))
 	}
@@ -319,20 +555,49 @@ func (d *IotxDispatcher) HandleTell(ctx context.Context, chainID uint32, peer pe
 	case iotexrpc.MessageType_BLOCK_REQUEST:
 		d.dispatchBlockSyncReq(ctx, chainID, peer, message)
 	case iotexrpc.MessageType_BLOCK:
-		d.dispatchBlockCommit(ctx, chainID, message)
+		// the blocks told are the responses to the block sync requests
+		d.dispatchBlockCommit(ctx, chainID, blockSyncQueue, message)
 	default:
+		if p2p.IsExtension(msgType) {
+			d.dispatchExtension(ctx, chainID, msgType, peer, message)
+			return
+		}
 		log.L().Warn("Unexpected msgType handled by HandleTell.", zap.Any("msgType", msgType))
 	}
 }
 
-func (d *IotxDispatcher) enqueueEvent(event interface{}) {
-	go func() {
-		if len(d.eventChan) == cap(d.eventChan) {
-			log.L().Debug("dispatcher event chan is full, drop an event.")
+// enqueueEvent adds the event to the queue without blocking, and drops an event as the policy of the queue if the
+// queue is full
+func (d *IotxDispatcher) enqueueEvent(queue int, event interface{}) {
+	q := d.queues[queue]
+	atomic.AddInt64(&q.pending, 1)
+	for {
+		select {
+		case q.events <- event:
+			queueDepthMtc.WithLabelValues(q.name).Set(float64(len(q.events)))
 			return
+		default:
 		}
-		d.eventChan <- event
-	}()
+		if q.policy == dropNewest {
+			d.drop(q)
+			log.L().Debug("Dispatcher queue is full, drop the event.", zap.String("queue", q.name))
+			return
+		}
+		// the queue may have been drained by the worker in between, in which case nothing is dropped
+		select {
+		case <-q.events:
+			d.drop(q)
+			log.L().Debug("Dispatcher queue is full, drop the oldest event.", zap.String("queue", q.name))
+		default:
+		}
+	}
+}
+
+// drop counts an event of the queue dropped
+func (d *IotxDispatcher) drop(q *eventQueue) {
+	atomic.AddUint64(&q.dropped, 1)
+	queueDropMtc.WithLabelValues(q.name).Inc()
+	d.done(q)
 }
 
 func (d *IotxDispatcher) updateEventAudit(t iotexrpc.MessageType) {
@@ -8,7 +8,9 @@ package dispatcher
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/proto"
@@ -16,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/iotexproject/iotex-proto/golang/testingpb"
@@ -86,6 +89,110 @@ func TestHandleTell(t *testing.T) {
 	}
 }
 
+func TestHandleExtension(t *testing.T) {
+	const msgTest iotexrpc.MessageType = 60002
+	p2p.RegisterMessage(msgTest, &iotextypes.Receipt{})
+
+	ctx := context.Background()
+	d, err := NewDispatcher(config.Default)
+	assert.NoError(t, err)
+	subscriber := &extensionSubscriber{msgs: make(chan proto.Message, 2)}
+	d.AddSubscriber(config.Default.Chain.ID, subscriber)
+	assert.NoError(t, d.Start(ctx))
+	defer stopDispatcher(ctx, d, t)
+
+	d.HandleBroadcast(ctx, config.Default.Chain.ID, &iotextypes.Receipt{BlkHeight: 1})
+	d.HandleTell(ctx, config.Default.Chain.ID, peerstore.PeerInfo{}, &iotextypes.Receipt{BlkHeight: 2})
+	// the messages neither of iotex-proto nor registered are dropped
+	d.HandleTell(ctx, config.Default.Chain.ID, peerstore.PeerInfo{}, &iotextypes.Log{})
+	heights := map[uint64]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-subscriber.msgs:
+			heights[msg.(*iotextypes.Receipt).BlkHeight] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("message is not dispatched")
+		}
+	}
+	assert.Equal(t, map[uint64]bool{1: true, 2: true}, heights)
+	assert.Equal(t, 2, d.(*IotxDispatcher).EventAudit()[msgTest])
+}
+
+func TestQueuePriority(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.Dispatcher.BlockChanSize = 2
+	cfg.Dispatcher.ActionChanSize = 2
+	d, err := NewDispatcher(cfg)
+	assert.NoError(t, err)
+	subscriber := &recordingSubscriber{handled: make(chan string, 10)}
+	d.AddSubscriber(config.Default.Chain.ID, subscriber)
+
+	// the full queues drop the events as their policies
+	for i := uint64(1); i <= 3; i++ {
+		d.HandleBroadcast(ctx, config.Default.Chain.ID, &iotextypes.Action{Core: &iotextypes.ActionCore{Nonce: i}})
+		d.HandleBroadcast(ctx, config.Default.Chain.ID, &iotextypes.Block{
+			Header: &iotextypes.BlockHeader{Core: &iotextypes.BlockHeaderCore{Height: i}},
+		})
+	}
+	d.HandleTell(ctx, config.Default.Chain.ID, peerstore.PeerInfo{}, &iotexrpc.BlockSync{Start: 1, End: 1})
+	d.HandleBroadcast(ctx, config.Default.Chain.ID, &iotextypes.ConsensusMessage{Height: 4})
+	audit := d.(*IotxDispatcher).QueueAudit()
+	assert.Equal(t, QueueStats{Depth: 1, Capacity: 10000, Dropped: 0}, audit["consensus"])
+	assert.Equal(t, QueueStats{Depth: 2, Capacity: 2, Dropped: 1}, audit["block"])
+	assert.Equal(t, QueueStats{Depth: 1, Capacity: 10000, Dropped: 0}, audit["blockSync"])
+	assert.Equal(t, QueueStats{Depth: 2, Capacity: 2, Dropped: 1}, audit["action"])
+
+	// the queues are handled in the order of priority, the oldest block and the newest action are dropped
+	assert.NoError(t, d.Start(ctx))
+	defer stopDispatcher(ctx, d, t)
+	var handled []string
+	for i := 0; i < 6; i++ {
+		select {
+		case h := <-subscriber.handled:
+			handled = append(handled, h)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message is not dispatched")
+		}
+	}
+	assert.Equal(t, []string{"consensus 4", "block 2", "block 3", "sync 1", "action 1", "action 2"}, handled)
+}
+
+type recordingSubscriber struct {
+	DummySubscriber
+	handled chan string
+}
+
+func (s *recordingSubscriber) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
+	s.handled <- fmt.Sprintf("consensus %d", msg.Height)
+	return nil
+}
+
+func (s *recordingSubscriber) HandleBlock(_ context.Context, blk *iotextypes.Block) error {
+	s.handled <- fmt.Sprintf("block %d", blk.GetHeader().GetCore().GetHeight())
+	return nil
+}
+
+func (s *recordingSubscriber) HandleSyncRequest(_ context.Context, _ peerstore.PeerInfo, req *iotexrpc.BlockSync) error {
+	s.handled <- fmt.Sprintf("sync %d", req.Start)
+	return nil
+}
+
+func (s *recordingSubscriber) HandleAction(_ context.Context, act *iotextypes.Action) error {
+	s.handled <- fmt.Sprintf("action %d", act.GetCore().GetNonce())
+	return nil
+}
+
+type extensionSubscriber struct {
+	DummySubscriber
+	msgs chan proto.Message
+}
+
+func (s *extensionSubscriber) HandleMessage(_ context.Context, _ peerstore.PeerInfo, msg proto.Message) error {
+	s.msgs <- msg
+	return nil
+}
+
 type DummySubscriber struct{}
 
 func (s *DummySubscriber) HandleBlock(context.Context, *iotextypes.Block) error { return nil }
@@ -99,3 +206,7 @@ func (s *DummySubscriber) HandleSyncRequest(context.Context, peerstore.PeerInfo,
 func (s *DummySubscriber) HandleAction(context.Context, *iotextypes.Action) error { return nil }
 
 func (s *DummySubscriber) HandleConsensusMsg(*iotextypes.ConsensusMessage) error { return nil }
+
+func (s *DummySubscriber) HandleMessage(context.Context, peerstore.PeerInfo, proto.Message) error {
+	return nil
+}
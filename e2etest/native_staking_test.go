@@ -12,6 +12,7 @@ import (
 	"github.com/iotexproject/go-pkgs/byteutil"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -206,6 +207,25 @@ func TestNativeStaking(t *testing.T) {
 		expectedVotes, _ = big.NewInt(0).SetString(initVotes, 10)
 		require.NoError(checkCandidateState(sf, candidate1Name, cand1Addr.String(), selfStake, expectedVotes.String(), cand1Addr))
 
+		// nominate the auto-stake bucket for compounding, submitted through the API
+		cps, err := testutil.SignedCompoundStake(5, voter2BucketIndex, true, nil, gasLimit, gasPrice, voter2PriKey)
+		require.NoError(err)
+		_, err = svr.ChainService(chainID).APIServer().SendAction(ctx, &iotexapi.SendActionRequest{Action: cps.Proto()})
+		require.NoError(err)
+		ap := svr.ChainService(chainID).ActionPool()
+		blk, err := bc.MintNewBlock(ap.PendingActionMap(), fixedTime)
+		require.NoError(err)
+		require.Equal(2, len(blk.Actions))
+		require.Equal(cps.Hash(), blk.Actions[0].Hash())
+		require.NoError(bc.CommitBlock(blk))
+		r, err = dao.GetReceiptByActionHash(cps.Hash(), blk.Height())
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+		compound, ok, err := staking.CompoundBucket(sf, voter2Addr)
+		require.NoError(err)
+		require.True(ok)
+		require.Equal(voter2BucketIndex, compound)
+
 		// unstake self stake
 		us, err = testutil.SignedReclaimStake(false, 2, 0, nil, gasLimit, gasPrice, cand1PriKey)
 		require.NoError(err)
@@ -221,7 +241,7 @@ func TestNativeStaking(t *testing.T) {
 		ws, err := testutil.SignedReclaimStake(true, 3, 0, nil, gasLimit, gasPrice, cand1PriKey)
 		require.NoError(err)
 		require.NoError(createAndCommitBlock(bc, []address.Address{cand1Addr}, []action.SealedEnvelope{ws}, fixedTime))
-		r, err = dao.GetReceiptByActionHash(ws.Hash(), 11)
+		r, err = dao.GetReceiptByActionHash(ws.Hash(), 12)
 		require.NoError(err)
 		require.Equal(uint64(iotextypes.ReceiptStatus_ErrWithdrawBeforeMaturity), r.Status)
 
@@ -270,6 +290,7 @@ func TestNativeStaking(t *testing.T) {
 	cfg.System.SystemLogDBPath = testSystemLogPath
 	cfg.Consensus.Scheme = config.NOOPScheme
 	cfg.Chain.EnableAsyncIndexWrite = false
+	cfg.ActPool.MinGasPriceStr = "0"
 
 	t.Run("test native staking", func(t *testing.T) {
 		testNativeStaking(cfg, t)
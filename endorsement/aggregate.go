@@ -0,0 +1,154 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package endorsement
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/bls"
+)
+
+// AggregateEndorsement is a BLS signature aggregated from the signatures of a document by a set of signers. The
+// signers are the indexes in an ordered list of endorsers, e.g., the delegates of an epoch.
+type AggregateEndorsement struct {
+	ts        time.Time
+	signers   []byte
+	signature []byte
+}
+
+// NewAggregateEndorsement creates an aggregate endorsement of the signers
+func NewAggregateEndorsement(ts time.Time, signers []int, sig []byte) *AggregateEndorsement {
+	bitmap := []byte{}
+	for _, i := range signers {
+		for len(bitmap) <= i/8 {
+			bitmap = append(bitmap, 0)
+		}
+		bitmap[i/8] |= 1 << uint(i%8)
+	}
+	cs := make([]byte, len(sig))
+	copy(cs, sig)
+	return &AggregateEndorsement{
+		ts:        ts.UTC(),
+		signers:   bitmap,
+		signature: cs,
+	}
+}
+
+// BLSSign signs a document with a BLS key. The signature does not cover a timestamp, so that the signatures of the
+// document by different signers can be aggregated.
+func BLSSign(signer *bls.PrivateKey, doc Document) ([]byte, error) {
+	h, err := doc.Hash()
+	if err != nil {
+		return nil, err
+	}
+	return signer.Sign(h).Bytes(), nil
+}
+
+// VerifyBLSSignature checks the BLS signature of a document
+func VerifyBLSSignature(doc Document, pk *bls.PublicKey, sig []byte) bool {
+	h, err := doc.Hash()
+	if err != nil {
+		return false
+	}
+	s, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	return pk.Verify(h, s)
+}
+
+// AggregateBLSSignatures aggregates the BLS signatures of a document into an endorsement of the signers
+func AggregateBLSSignatures(ts time.Time, signers []int, sigs [][]byte) (*AggregateEndorsement, error) {
+	if len(signers) != len(sigs) {
+		return nil, errors.Errorf("%d signers vs %d signatures", len(signers), len(sigs))
+	}
+	blsSigs := make([]*bls.Signature, 0, len(sigs))
+	for _, sig := range sigs {
+		s, err := bls.SignatureFromBytes(sig)
+		if err != nil {
+			return nil, err
+		}
+		blsSigs = append(blsSigs, s)
+	}
+	agg, err := bls.AggregateSignatures(blsSigs)
+	if err != nil {
+		return nil, err
+	}
+	return NewAggregateEndorsement(ts, signers, agg.Bytes()), nil
+}
+
+// VerifyAggregateEndorsement checks the aggregate endorsement of a document, given the public keys of the signers
+func VerifyAggregateEndorsement(doc Document, en *AggregateEndorsement, pks []*bls.PublicKey) bool {
+	h, err := doc.Hash()
+	if err != nil {
+		return false
+	}
+	sig, err := bls.SignatureFromBytes(en.signature)
+	if err != nil {
+		return false
+	}
+	pk, err := bls.AggregatePublicKeys(pks)
+	if err != nil {
+		return false
+	}
+	return pk.Verify(h, sig)
+}
+
+// Timestamp returns the time of the aggregate endorsement
+func (en *AggregateEndorsement) Timestamp() time.Time {
+	return en.ts
+}
+
+// Signers returns the indexes of the signers in ascending order
+func (en *AggregateEndorsement) Signers() []int {
+	signers := []int{}
+	for i, b := range en.signers {
+		for j := 0; j < 8; j++ {
+			if b&(1<<uint(j)) != 0 {
+				signers = append(signers, i*8+j)
+			}
+		}
+	}
+	return signers
+}
+
+// Signature returns the aggregate signature
+func (en *AggregateEndorsement) Signature() []byte {
+	signature := make([]byte, len(en.signature))
+	copy(signature, en.signature)
+
+	return signature
+}
+
+// Proto converts an aggregate endorsement to protobuf message
+func (en *AggregateEndorsement) Proto() (*iotextypes.AggregateEndorsement, error) {
+	ts, err := ptypes.TimestampProto(en.ts)
+	if err != nil {
+		return nil, err
+	}
+	return &iotextypes.AggregateEndorsement{
+		Timestamp: ts,
+		Signers:   append([]byte{}, en.signers...),
+		Signature: en.Signature(),
+	}, nil
+}
+
+// LoadProto converts a protobuf message to aggregate endorsement
+func (en *AggregateEndorsement) LoadProto(ePb *iotextypes.AggregateEndorsement) (err error) {
+	if en.ts, err = ptypes.Timestamp(ePb.Timestamp); err != nil {
+		return err
+	}
+	en.signers = append([]byte{}, ePb.Signers...)
+	en.signature = make([]byte, len(ePb.Signature))
+	copy(en.signature, ePb.Signature)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package endorsement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/pkg/bls"
+)
+
+type testDoc []byte
+
+func (d testDoc) Hash() ([]byte, error) {
+	h := hash.Hash256b(d)
+	return h[:], nil
+}
+
+type invalidDoc struct{}
+
+func (invalidDoc) Hash() ([]byte, error) {
+	return nil, errors.New("invalid document")
+}
+
+func TestAggregateEndorsement(t *testing.T) {
+	require := require.New(t)
+
+	ts := time.Unix(1000, 0)
+	en := NewAggregateEndorsement(ts, []int{9, 0, 2, 9}, []byte{1, 2, 3})
+	require.Equal(ts.UTC(), en.Timestamp())
+	require.Equal([]int{0, 2, 9}, en.Signers())
+	require.Equal([]byte{1, 2, 3}, en.Signature())
+	// the signature returned is a copy
+	en.Signature()[0] = 0
+	require.Equal([]byte{1, 2, 3}, en.Signature())
+
+	pb, err := en.Proto()
+	require.NoError(err)
+	require.Equal([]byte{0x05, 0x02}, pb.Signers)
+	en2 := &AggregateEndorsement{}
+	require.NoError(en2.LoadProto(pb))
+	require.Equal(en, en2)
+
+	require.Empty(NewAggregateEndorsement(ts, nil, nil).Signers())
+}
+
+func TestAggregateBLSSignatures(t *testing.T) {
+	require := require.New(t)
+
+	doc := testDoc("block")
+	var (
+		pks  []*bls.PublicKey
+		sigs [][]byte
+	)
+	for i := 0; i < 4; i++ {
+		sk, err := bls.GenerateKey()
+		require.NoError(err)
+		sig, err := BLSSign(sk, doc)
+		require.NoError(err)
+		require.True(VerifyBLSSignature(doc, sk.PublicKey(), sig))
+		require.False(VerifyBLSSignature(testDoc("another block"), sk.PublicKey(), sig))
+		require.False(VerifyBLSSignature(invalidDoc{}, sk.PublicKey(), sig))
+		require.False(VerifyBLSSignature(doc, sk.PublicKey(), sig[1:]))
+		_, err = BLSSign(sk, invalidDoc{})
+		require.Error(err)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sig)
+	}
+
+	ts := time.Unix(1000, 0)
+	en, err := AggregateBLSSignatures(ts, []int{1, 3, 4, 6}, sigs)
+	require.NoError(err)
+	require.Equal([]int{1, 3, 4, 6}, en.Signers())
+	require.True(VerifyAggregateEndorsement(doc, en, pks))
+	require.False(VerifyAggregateEndorsement(testDoc("another block"), en, pks))
+	require.False(VerifyAggregateEndorsement(invalidDoc{}, en, pks))
+	// the keys of all signers are required
+	require.False(VerifyAggregateEndorsement(doc, en, pks[:3]))
+	require.False(VerifyAggregateEndorsement(doc, en, nil))
+
+	// the aggregate survives a round trip through the proto
+	pb, err := en.Proto()
+	require.NoError(err)
+	en2 := &AggregateEndorsement{}
+	require.NoError(en2.LoadProto(pb))
+	require.True(VerifyAggregateEndorsement(doc, en2, pks))
+
+	// a signature missing from the aggregate
+	en, err = AggregateBLSSignatures(ts, []int{1, 3, 4}, sigs[:3])
+	require.NoError(err)
+	require.False(VerifyAggregateEndorsement(doc, en, pks))
+
+	_, err = AggregateBLSSignatures(ts, []int{1, 3}, sigs)
+	require.Error(err)
+	_, err = AggregateBLSSignatures(ts, nil, nil)
+	require.Equal(bls.ErrInvalidSignature, errors.Cause(err))
+	_, err = AggregateBLSSignatures(ts, []int{1}, [][]byte{{1, 2, 3}})
+	require.Equal(bls.ErrInvalidSignature, errors.Cause(err))
+	require.False(VerifyAggregateEndorsement(doc, NewAggregateEndorsement(ts, []int{1}, []byte{1, 2, 3}), pks[:1]))
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package follower
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	// SnapshotsPath lists the databases exported, and serves the snapshot of a database under it
+	SnapshotsPath = "/follower/snapshots"
+	// BlocksPath serves the feed of the blocks committed
+	BlocksPath = "/follower/blocks"
+
+	// snapshotSizeTrailer is sent after the snapshot, so that a truncated snapshot is detected
+	snapshotSizeTrailer = "X-Snapshot-Size"
+	// heartbeatInterval is the interval of the empty frames sent while no block is committed
+	heartbeatInterval = 10 * time.Second
+)
+
+// Names of the databases exported
+const (
+	ChainDB          = "chain"
+	TrieDB           = "trie"
+	IndexDB          = "index"
+	SystemLogDB      = "systemlog"
+	RewardHistoryDB  = "rewardhistory"
+	CandidateIndexDB = "candidate"
+)
+
+type (
+	// Store is a database exported to the followers
+	Store struct {
+		Name    string
+		KVStore db.KVStore
+	}
+
+	// Stores returns the databases exported. The followers download them in order, so a database has to be listed
+	// before the ones written earlier when a block is committed, e.g. the indexers before the chain DB. Then none of
+	// the snapshots is ahead of the chain DB, and the indexers behind are caught up from it once opened.
+	Stores func() []Store
+
+	// Exporter exports the consistent snapshots of the databases, and the feed of the blocks committed, to the
+	// followers through the export endpoint
+	Exporter struct {
+		stores Stores
+		dao    blockdao.BlockDAO
+		mu     sync.Mutex
+		// committed is closed and renewed once a block is committed
+		committed chan struct{}
+	}
+)
+
+// SplitFile returns the name of the split block DB file of the index
+func SplitFile(idx uint64) string {
+	return fmt.Sprintf("%s-%08d", ChainDB, idx)
+}
+
+// NewExporter creates an exporter of the databases and the blocks of the block DAO
+func NewExporter(dao blockdao.BlockDAO, stores Stores) *Exporter {
+	return &Exporter{
+		stores:    stores,
+		dao:       dao,
+		committed: make(chan struct{}),
+	}
+}
+
+// ReceiveBlock wakes up the block feeds once a block is committed
+func (e *Exporter) ReceiveBlock(_ *block.Block) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	close(e.committed)
+	e.committed = make(chan struct{})
+	return nil
+}
+
+// Handle serves the snapshots and the block feed
+func (e *Exporter) Handle(w http.ResponseWriter, req *http.Request) {
+	switch p := req.URL.Path; {
+	case p == SnapshotsPath:
+		names := []string{}
+		for _, s := range e.stores() {
+			names = append(names, s.Name)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(names); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case strings.HasPrefix(p, SnapshotsPath+"/"):
+		e.serveSnapshot(w, strings.TrimPrefix(p, SnapshotsPath+"/"))
+	case p == BlocksPath:
+		from, err := strconv.ParseUint(req.URL.Query().Get("from"), 10, 64)
+		if err != nil || from == 0 {
+			http.Error(w, "invalid start height", http.StatusBadRequest)
+			return
+		}
+		if err := e.serveBlocks(w, req, from); err != nil {
+			log.L().Info("Block feed stopped.", zap.Uint64("from", from), zap.Error(err))
+		}
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (e *Exporter) serveSnapshot(w http.ResponseWriter, name string) {
+	var store db.KVStore
+	for _, s := range e.stores() {
+		if s.Name == name {
+			store = s.KVStore
+			break
+		}
+	}
+	if store == nil {
+		http.Error(w, fmt.Sprintf("database %s is not exported", name), http.StatusNotFound)
+		return
+	}
+	snapshotter, ok := store.(db.KVStoreWithSnapshot)
+	if !ok {
+		http.Error(w, fmt.Sprintf("database %s does not support snapshot", name), http.StatusNotImplemented)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Trailer", snapshotSizeTrailer)
+	n, err := snapshotter.Snapshot(w)
+	if err != nil {
+		// the status is sent already, and the follower rejects the snapshot without the size
+		log.L().Error("Failed to export snapshot.", zap.String("db", name), zap.Error(err))
+		return
+	}
+	w.Header().Set(snapshotSizeTrailer, strconv.FormatInt(n, 10))
+	log.L().Info("Exported snapshot.", zap.String("db", name), zap.Int64("size", n))
+}
+
+// serveBlocks streams the blocks from the height, and the blocks committed afterwards until the follower leaves.
+// Every block is framed by its length, and an empty frame is sent as heartbeat.
+func (e *Exporter) serveBlocks(w http.ResponseWriter, req *http.Request, from uint64) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return errors.New("streaming is not supported")
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for next := from; ; {
+		e.mu.Lock()
+		committed := e.committed
+		e.mu.Unlock()
+		tip, err := e.dao.Height()
+		if err != nil {
+			return err
+		}
+		for ; next <= tip; next++ {
+			blk, err := e.dao.GetBlockByHeight(next)
+			if err != nil {
+				return err
+			}
+			data, err := blk.Serialize()
+			if err != nil {
+				return err
+			}
+			if err := writeFrame(w, data); err != nil {
+				return err
+			}
+		}
+		flusher.Flush()
+		select {
+		case <-committed:
+		case <-heartbeat.C:
+			if err := writeFrame(w, nil); err != nil {
+				return err
+			}
+		case <-req.Context().Done():
+			return req.Context().Err()
+		}
+	}
+}
+
+func writeFrame(w http.ResponseWriter, data []byte) error {
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(data)))
+	if _, err := w.Write(size[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package follower
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	// feedTimeout is how long the follower waits for a frame before it reconnects
+	feedTimeout = 3 * heartbeatInterval
+	// maxFrameSize bounds the size of a block in the feed
+	maxFrameSize = 64 << 20
+	tmpSuffix    = ".tmp"
+)
+
+var followerMtc = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "iotex_follower_blocks",
+		Help: "IoTeX follower block counter.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(followerMtc)
+}
+
+// Follower is a read-only follower of another node. It bootstraps the databases from the snapshots of the node, and
+// commits the blocks the node streams, without taking part in p2p or consensus.
+type Follower struct {
+	cfg    config.Follower
+	chain  blockchain.Blockchain
+	client *http.Client
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewFollower creates a follower committing the blocks of the node into the chain
+func NewFollower(cfg config.Follower, chain blockchain.Blockchain) *Follower {
+	return &Follower{
+		cfg:    cfg,
+		chain:  chain,
+		client: &http.Client{},
+	}
+}
+
+// Bootstrap downloads the snapshots of the databases from the node, unless the databases exist already. It has to
+// be called before the databases are opened.
+func (f *Follower) Bootstrap(ctx context.Context, cfg config.Config) error {
+	var names []string
+	if err := f.get(ctx, SnapshotsPath, func(resp *http.Response) error {
+		return json.NewDecoder(resp.Body).Decode(&names)
+	}); err != nil {
+		return errors.Wrap(err, "failed to list snapshots")
+	}
+	files := map[string]string{}
+	for _, name := range names {
+		p := LocalPath(cfg, name)
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); err == nil {
+			// the databases opened before are tailed from their tip
+			log.L().Info("Databases exist, skip bootstrap.", zap.String("db", p))
+			return nil
+		}
+		files[name] = p
+	}
+	// the snapshots are renamed into place once all of them are downloaded
+	defer func() {
+		for _, p := range files {
+			os.Remove(p + tmpSuffix)
+		}
+	}()
+	for _, name := range names {
+		p, ok := files[name]
+		if !ok {
+			continue
+		}
+		if err := f.download(ctx, name, p+tmpSuffix); err != nil {
+			return errors.Wrapf(err, "failed to download snapshot %s", name)
+		}
+	}
+	for _, name := range names {
+		if p, ok := files[name]; ok {
+			if err := os.Rename(p+tmpSuffix, p); err != nil {
+				return err
+			}
+		}
+	}
+	log.L().Info("Bootstrapped databases from snapshots.", zap.Int("num", len(files)))
+	return nil
+}
+
+// Start starts tailing the blocks of the node
+func (f *Follower) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	f.done = make(chan struct{})
+	go func() {
+		defer close(f.done)
+		for {
+			err := f.tail(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			log.L().Warn("Block feed broke.", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(f.cfg.RetryInterval):
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops tailing the blocks
+func (f *Follower) Stop(_ context.Context) error {
+	if f.cancel != nil {
+		f.cancel()
+		<-f.done
+	}
+	return nil
+}
+
+// LocalPath returns the path of the database exported with the name, or empty if the database is not used locally
+func LocalPath(cfg config.Config, name string) string {
+	_, gateway := cfg.Plugins[config.GatewayPlugin]
+	switch name {
+	case ChainDB:
+		return cfg.Chain.ChainDBPath
+	case TrieDB:
+		return cfg.Chain.TrieDBPath
+	case IndexDB:
+		if gateway {
+			return cfg.Chain.IndexDBPath
+		}
+	case SystemLogDB:
+		if gateway && cfg.Chain.EnableSystemLogIndexer {
+			return cfg.System.SystemLogDBPath
+		}
+	case RewardHistoryDB:
+		if gateway && cfg.Chain.EnableRewardHistoryIndexer {
+			return cfg.System.RewardHistoryDBPath
+		}
+	case CandidateIndexDB:
+		if gateway {
+			return cfg.Chain.CandidateIndexDBPath
+		}
+	default:
+		if !strings.HasPrefix(name, ChainDB+"-") || cfg.DB.SplitDBSizeMB == 0 {
+			return ""
+		}
+		idx, err := strconv.ParseUint(strings.TrimPrefix(name, ChainDB+"-"), 10, 64)
+		if err != nil {
+			return ""
+		}
+		p := cfg.Chain.ChainDBPath
+		return fmt.Sprintf("%s-%08d.db", strings.TrimSuffix(p, path.Ext(p)), idx)
+	}
+	return ""
+}
+
+func (f *Follower) download(ctx context.Context, name, p string) error {
+	return f.get(ctx, SnapshotsPath+"/"+name, func(resp *http.Response) error {
+		file, err := os.OpenFile(p, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		n, err := io.Copy(file, resp.Body)
+		if err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		// the trailer is read after the body
+		if size := resp.Trailer.Get(snapshotSizeTrailer); size != strconv.FormatInt(n, 10) {
+			return errors.Errorf("snapshot is incomplete, received %d bytes of %s", n, size)
+		}
+		return nil
+	})
+}
+
+// tail commits the blocks the node streams after the tip
+func (f *Follower) tail(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	from := f.chain.TipHeight() + 1
+	return f.get(ctx, fmt.Sprintf("%s?from=%d", BlocksPath, from), func(resp *http.Response) error {
+		watchdog := time.AfterFunc(feedTimeout, cancel)
+		defer watchdog.Stop()
+		log.L().Info("Tailing blocks.", zap.Uint64("from", from))
+		var size [4]byte
+		for {
+			if _, err := io.ReadFull(resp.Body, size[:]); err != nil {
+				return err
+			}
+			watchdog.Reset(feedTimeout)
+			n := binary.BigEndian.Uint32(size[:])
+			if n == 0 {
+				continue
+			}
+			if n > maxFrameSize {
+				return errors.Errorf("block of %d bytes is too large", n)
+			}
+			data := make([]byte, n)
+			if _, err := io.ReadFull(resp.Body, data); err != nil {
+				return err
+			}
+			// the time spent on committing the block does not count
+			watchdog.Stop()
+			if err := f.commit(data); err != nil {
+				followerMtc.WithLabelValues("failed").Inc()
+				return err
+			}
+			followerMtc.WithLabelValues("committed").Inc()
+			watchdog.Reset(feedTimeout)
+		}
+	})
+}
+
+func (f *Follower) commit(data []byte) error {
+	blk := &block.Block{}
+	if err := blk.Deserialize(data); err != nil {
+		return errors.Wrap(err, "failed to deserialize block")
+	}
+	// the block is validated as the blocks synced, which also generates the receipts
+	if err := f.chain.ValidateBlock(blk); err != nil {
+		return errors.Wrapf(err, "failed to validate block %d", blk.Height())
+	}
+	if err := f.chain.CommitBlock(blk); err != nil {
+		return errors.Wrapf(err, "failed to commit block %d", blk.Height())
+	}
+	return nil
+}
+
+func (f *Follower) get(ctx context.Context, p string, handle func(*http.Response) error) error {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(f.cfg.LeaderURL, "/")+p, nil)
+	if err != nil {
+		return err
+	}
+	if f.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.cfg.Token)
+	}
+	resp, err := f.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return handle(resp)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package follower
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestFollower(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "follower")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	newConfig := func(name string) config.Config {
+		cfg := config.Default
+		cfg.Chain.ChainDBPath = filepath.Join(dir, name+"-chain.db")
+		cfg.Chain.TrieDBPath = filepath.Join(dir, name+"-trie.db")
+		cfg.Consensus.Scheme = config.NOOPScheme
+		cfg.Genesis.EnableGravityChainVoting = false
+		cfg.System.Follower.RetryInterval = 100 * time.Millisecond
+		return cfg
+	}
+	newChain := func(cfg config.Config) (blockchain.Blockchain, blockdao.BlockDAO, factory.Factory, []Store) {
+		registry := protocol.NewRegistry()
+		require.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+		rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+		require.NoError(rp.Register(registry))
+		cfg.DB.DbPath = cfg.Chain.TrieDBPath
+		trieDB := db.NewBoltDB(cfg.DB)
+		sf, err := factory.NewFactory(cfg, factory.PrecreatedTrieDBOption(trieDB), factory.RegistryOption(registry))
+		require.NoError(err)
+		ap, err := actpool.NewActPool(sf, cfg.ActPool)
+		require.NoError(err)
+		ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+		cfg.DB.DbPath = cfg.Chain.ChainDBPath
+		chainDB := db.NewBoltDB(cfg.DB)
+		dao := blockdao.NewBlockDAO(chainDB, []blockdao.BlockIndexer{sf}, cfg.Chain.CompressBlock, cfg.DB)
+		chain := blockchain.NewBlockchain(cfg, dao, sf, blockchain.BlockValidatorOption(block.NewValidator(sf, ap)))
+		return chain, dao, sf, []Store{{Name: TrieDB, KVStore: trieDB}, {Name: ChainDB, KVStore: chainDB}}
+	}
+	nonce := uint64(0)
+	mint := func(chain blockchain.Blockchain) {
+		nonce++
+		tsf, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0), nonce,
+			big.NewInt(1), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+		require.NoError(err)
+		blk, err := chain.MintNewBlock(map[string][]action.SealedEnvelope{
+			identityset.Address(0).String(): {tsf},
+		}, testutil.TimestampNow())
+		require.NoError(err)
+		require.NoError(chain.ValidateBlock(blk))
+		require.NoError(chain.CommitBlock(blk))
+	}
+
+	// the leader exports its databases while committing blocks
+	leaderCfg := newConfig("leader")
+	leader, leaderDAO, _, stores := newChain(leaderCfg)
+	require.NoError(leader.Start(ctx))
+	defer func() {
+		require.NoError(leader.Stop(ctx))
+	}()
+	exporter := NewExporter(leaderDAO, func() []Store {
+		return stores
+	})
+	require.NoError(leader.AddSubscriber(exporter))
+	svr := httptest.NewServer(http.HandlerFunc(exporter.Handle))
+	defer svr.Close()
+	mint(leader)
+	mint(leader)
+
+	// the follower bootstraps from the snapshots, and tails the blocks committed afterwards
+	cfg := newConfig("follower")
+	cfg.System.Follower.LeaderURL = svr.URL
+	chain, _, sf, _ := newChain(cfg)
+	fol := NewFollower(cfg.System.Follower, chain)
+	require.NoError(fol.Bootstrap(ctx, cfg))
+	require.NoError(chain.Start(ctx))
+	defer func() {
+		require.NoError(chain.Stop(ctx))
+	}()
+	require.Equal(uint64(2), chain.TipHeight())
+	height, err := sf.Height()
+	require.NoError(err)
+	require.Equal(uint64(2), height)
+	require.NoError(fol.Start(ctx))
+	defer func() {
+		require.NoError(fol.Stop(ctx))
+	}()
+	mint(leader)
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return chain.TipHeight() == 3, nil
+	}))
+	leaderTip, err := leader.BlockHeaderByHeight(3)
+	require.NoError(err)
+	tip, err := chain.BlockHeaderByHeight(3)
+	require.NoError(err)
+	require.Equal(leaderTip.HashBlock(), tip.HashBlock())
+	height, err = sf.Height()
+	require.NoError(err)
+	require.Equal(uint64(3), height)
+	state, err := accountutil.AccountState(sf, identityset.Address(0).String())
+	require.NoError(err)
+	require.Equal(uint64(3), state.Nonce)
+
+	// the databases opened before are not bootstrapped again
+	require.NoError(fol.Bootstrap(ctx, cfg))
+	resp, err := http.Get(svr.URL + SnapshotsPath + "/index")
+	require.NoError(err)
+	resp.Body.Close()
+	require.Equal(http.StatusNotFound, resp.StatusCode)
+	resp, err = http.Get(svr.URL + BlocksPath)
+	require.NoError(err)
+	resp.Body.Close()
+	require.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestExportServer(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	cfg := config.Default.System.Follower
+	cfg.ExportPort = testutil.RandomPort()
+	cfg.Token = "secret"
+	svr := NewExportServer(cfg, NewExporter(nil, func() []Store {
+		return []Store{{Name: ChainDB}}
+	}))
+	require.NoError(svr.Start(ctx))
+	defer func() {
+		require.NoError(svr.Stop(ctx))
+	}()
+
+	// the requests without the token are rejected
+	cfg.LeaderURL = fmt.Sprintf("http://127.0.0.1:%d", cfg.ExportPort)
+	resp, err := http.Get(cfg.LeaderURL + SnapshotsPath)
+	require.NoError(err)
+	resp.Body.Close()
+	require.Equal(http.StatusUnauthorized, resp.StatusCode)
+	var names []string
+	require.NoError(NewFollower(cfg, nil).get(ctx, SnapshotsPath, func(resp *http.Response) error {
+		return json.NewDecoder(resp.Body).Decode(&names)
+	}))
+	require.Equal([]string{ChainDB}, names)
+}
+
+func TestLocalPath(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default
+	cfg.Chain.ChainDBPath = "/data/chain.db"
+	require.Equal("/data/chain.db", LocalPath(cfg, ChainDB))
+	require.Equal(cfg.Chain.TrieDBPath, LocalPath(cfg, TrieDB))
+	require.Empty(LocalPath(cfg, IndexDB))
+	require.Empty(LocalPath(cfg, SplitFile(1)))
+	cfg.Plugins = map[int]interface{}{config.GatewayPlugin: nil}
+	cfg.DB.SplitDBSizeMB = 100
+	require.Equal(cfg.Chain.IndexDBPath, LocalPath(cfg, IndexDB))
+	require.Empty(LocalPath(cfg, SystemLogDB))
+	require.Equal("/data/chain-00000001.db", LocalPath(cfg, SplitFile(1)))
+	require.Empty(LocalPath(cfg, "unknown"))
+}
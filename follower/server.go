@@ -0,0 +1,114 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package follower
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/netutil"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	// maxExportConns is the max number of the connections to the export endpoint
+	maxExportConns    = 32
+	exportReadTimeout = 5 * time.Second
+	exportIdleTimeout = 120 * time.Second
+)
+
+type (
+	// ExportServer serves the export endpoint of the exporter to the followers
+	ExportServer struct {
+		cfg config.Follower
+		svr *http.Server
+	}
+
+	// writeTimeoutListener sets the write deadline of the connections accepted on every write
+	writeTimeoutListener struct {
+		net.Listener
+		timeout time.Duration
+	}
+
+	writeTimeoutConn struct {
+		net.Conn
+		timeout time.Duration
+	}
+)
+
+// NewExportServer creates the server of the export endpoint, which requires the token in config if it is set
+func NewExportServer(cfg config.Follower, exporter *Exporter) *ExportServer {
+	mux := http.NewServeMux()
+	mux.Handle("/follower/", http.HandlerFunc(exporter.Handle))
+	s := &ExportServer{cfg: cfg}
+	s.svr = &http.Server{
+		Addr:        net.JoinHostPort(cfg.ExportHost, strconv.Itoa(cfg.ExportPort)),
+		Handler:     s.authorize(mux),
+		ReadTimeout: exportReadTimeout,
+		IdleTimeout: exportIdleTimeout,
+		// the write timeout is set on every write by the listener instead
+		WriteTimeout: 0,
+	}
+	return s
+}
+
+// Start starts listening on the export endpoint
+func (s *ExportServer) Start(_ context.Context) error {
+	ln, err := net.Listen("tcp", s.svr.Addr)
+	if err != nil {
+		return err
+	}
+	ln = netutil.LimitListener(writeTimeoutListener{Listener: ln, timeout: s.cfg.ExportWriteTimeout}, maxExportConns)
+	go func() {
+		if err := s.svr.Serve(ln); err != nil && err != http.ErrServerClosed {
+			log.L().Error("Error when exporting to followers.", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop stops the export endpoint
+func (s *ExportServer) Stop(ctx context.Context) error {
+	return s.svr.Shutdown(ctx)
+}
+
+func (s *ExportServer) authorize(next http.Handler) http.Handler {
+	if s.cfg.Token == "" {
+		return next
+	}
+	expected := []byte("Bearer " + s.cfg.Token)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(req.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (ln writeTimeoutListener) Accept() (net.Conn, error) {
+	conn, err := ln.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &writeTimeoutConn{Conn: conn, timeout: ln.timeout}, nil
+}
+
+// Write fails if the follower does not read the data within the timeout, e.g., it hangs
+func (c *writeTimeoutConn) Write(b []byte) (int, error) {
+	if err := c.Conn.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Write(b)
+}
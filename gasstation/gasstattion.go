@@ -56,6 +56,8 @@ func (gs *GasStation) IsSystemAction(act action.SealedEnvelope) bool {
 		return true
 	case *action.PutPollResult:
 		return true
+	case *action.DoubleSignEvidence:
+		return true
 	default:
 		return false
 	}
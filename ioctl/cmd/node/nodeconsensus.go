@@ -0,0 +1,220 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// Multi-language support
+var (
+	consensusCmdUses = map[config.Language]string{
+		config.English: "consensus [--admin-endpoint ADDRESS] [-f]",
+		config.Chinese: "consensus [--admin-endpoint 地址] [-f]",
+	}
+	consensusCmdShorts = map[config.Language]string{
+		config.English: "Print the recent consensus rounds of a node",
+		config.Chinese: "打印节点最近的共识轮次",
+	}
+	consensusCmdLong = map[config.Language]string{
+		config.English: "ioctl node consensus returns the recent consensus rounds of a node from its admin port, with the proposer, the time the proposal is received, the endorsements of the proposed block on each topic, the timeouts hit and the outcome. With -f, it keeps printing the round events as they happen.",
+		config.Chinese: "ioctl node consensus 从节点的管理端口返回其最近的共识轮次, 包括提议者, 收到提议的时间, 提议区块在各主题上的背书, 触发的超时和结果. 使用 -f 时持续打印发生的轮次事件.",
+	}
+	flagAdminEndpointUsages = map[config.Language]string{
+		config.English: "set admin endpoint of the node",
+		config.Chinese: "设置节点的管理端点",
+	}
+	flagFollowUsages = map[config.Language]string{
+		config.English: "keep printing the round events",
+		config.Chinese: "持续打印轮次事件",
+	}
+)
+
+const (
+	// consensusEventsWait is the time a poll of the round events waits for a new event
+	consensusEventsWait = 3 * time.Second
+	consensusTopics     = "PROPOSAL LOCK COMMIT"
+)
+
+var (
+	consensusAdminEndpoint string
+	consensusFollow        bool
+)
+
+// nodeConsensusCmd represents the node consensus command
+var nodeConsensusCmd = &cobra.Command{
+	Use:   config.TranslateInLang(consensusCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(consensusCmdShorts, config.UILanguage),
+	Long:  config.TranslateInLang(consensusCmdLong, config.UILanguage),
+	Args:  cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		var err error
+		if consensusFollow {
+			err = followConsensus()
+		} else {
+			err = consensusRounds()
+		}
+		return output.PrintError(err)
+	},
+}
+
+type consensusRound struct {
+	Height       uint64                         `json:"height"`
+	Round        uint32                         `json:"round"`
+	Proposer     string                         `json:"proposer"`
+	StartTime    time.Time                      `json:"startTime"`
+	ProposalTime *time.Time                     `json:"proposalTime,omitempty"`
+	BlockHash    string                         `json:"blockHash,omitempty"`
+	Endorsements map[string]map[string][]string `json:"endorsements,omitempty"`
+	Timeouts     []string                       `json:"timeouts,omitempty"`
+	Outcome      string                         `json:"outcome,omitempty"`
+	EndTime      *time.Time                     `json:"endTime,omitempty"`
+}
+
+type consensusEvent struct {
+	Seq       uint64    `json:"seq"`
+	Type      string    `json:"type"`
+	Height    uint64    `json:"height"`
+	Round     uint32    `json:"round"`
+	Time      time.Time `json:"time"`
+	Proposer  string    `json:"proposer,omitempty"`
+	BlockHash string    `json:"blockHash,omitempty"`
+	Topic     string    `json:"topic,omitempty"`
+	Endorser  string    `json:"endorser,omitempty"`
+	Timeout   string    `json:"timeout,omitempty"`
+	Outcome   string    `json:"outcome,omitempty"`
+}
+
+type consensusMessage struct {
+	Rounds []*consensusRound `json:"rounds"`
+}
+
+func (m *consensusMessage) String() string {
+	if output.Format == "" {
+		if len(m.Rounds) == 0 {
+			return "no consensus round"
+		}
+		formatTitleString := "%-10s   %-5s   %-41s   %-8s   %-14s   %-9s   %s"
+		formatDataString := "%-10d   %-5d   %-41s   %-8s   %-14s   %-9s   %s"
+		lines := []string{fmt.Sprintf(formatTitleString, "HEIGHT", "ROUND", "PROPOSER", "PROPOSAL", consensusTopics, "OUTCOME", "TIMEOUTS")}
+		for _, r := range m.Rounds {
+			proposal := "-"
+			if r.ProposalTime != nil {
+				proposal = r.ProposalTime.Sub(r.StartTime).Round(time.Millisecond).String()
+			}
+			endorsements := r.Endorsements[r.BlockHash]
+			counts := []string{}
+			for _, topic := range strings.Fields(consensusTopics) {
+				counts = append(counts, fmt.Sprintf("%d", len(endorsements[topic])))
+			}
+			outcome := r.Outcome
+			if outcome == "" {
+				outcome = "ongoing"
+			}
+			lines = append(lines, fmt.Sprintf(formatDataString, r.Height, r.Round, r.Proposer, proposal,
+				strings.Join(counts, "/"), outcome, strings.Join(r.Timeouts, ",")))
+		}
+		return strings.Join(lines, "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func (e *consensusEvent) String() string {
+	if output.Format == "" {
+		line := fmt.Sprintf("%s  height %d  round %d  %s", e.Time.Format(time.RFC3339Nano), e.Height, e.Round, e.Type)
+		switch e.Type {
+		case "started":
+			line += "  proposer " + e.Proposer
+		case "proposal":
+			line += "  block " + e.BlockHash
+		case "endorsement":
+			line += fmt.Sprintf("  %s by %s on block %s", e.Topic, e.Endorser, e.BlockHash)
+		case "timeout":
+			line += "  " + e.Timeout
+		case "finished":
+			line += "  " + e.Outcome
+		}
+		return line
+	}
+	return output.FormatString(output.Result, e)
+}
+
+func init() {
+	nodeConsensusCmd.Flags().StringVar(&consensusAdminEndpoint, "admin-endpoint", "127.0.0.1:9009",
+		config.TranslateInLang(flagAdminEndpointUsages, config.UILanguage))
+	nodeConsensusCmd.Flags().BoolVarP(&consensusFollow, "follow", "f", false,
+		config.TranslateInLang(flagFollowUsages, config.UILanguage))
+}
+
+func consensusRounds() error {
+	message := consensusMessage{}
+	if err := getConsensus("/consensus/rounds", &message.Rounds); err != nil {
+		return err
+	}
+	fmt.Println(message.String())
+	return nil
+}
+
+func followConsensus() error {
+	// skip the events happened before
+	var events []*consensusEvent
+	if err := getConsensus("/consensus/events", &events); err != nil {
+		return err
+	}
+	var after uint64
+	if len(events) > 0 {
+		after = events[len(events)-1].Seq
+	}
+	for {
+		events = nil
+		if err := getConsensus(fmt.Sprintf("/consensus/events?after=%d&wait=%s", after, consensusEventsWait), &events); err != nil {
+			return err
+		}
+		for _, e := range events {
+			if e.Seq > after+1 {
+				fmt.Printf("%d events missed\n", e.Seq-after-1)
+			}
+			fmt.Println(e.String())
+			after = e.Seq
+		}
+	}
+}
+
+func getConsensus(path string, v interface{}) error {
+	client := &http.Client{Timeout: consensusEventsWait + 5*time.Second}
+	endpoint := consensusAdminEndpoint
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "http://" + endpoint
+	}
+	resp, err := client.Get(endpoint + path)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to admin endpoint", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to read response", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return output.NewError(output.APIError, strings.TrimSpace(string(body)), nil)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return output.NewError(output.SerializationError, "failed to deserialize consensus rounds", err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/action/protocol/poll/pollpb"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/bc"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	probationCmdUses = map[config.Language]string{
+		config.English: "probation [-e epoch-num]",
+		config.Chinese: "probation [-e epoch数]",
+	}
+	probationCmdShorts = map[config.Language]string{
+		config.English: "Print delegates on probation in certain epoch",
+		config.Chinese: "打印在特定epoch内被试用的委托",
+	}
+	probationCmdLong = map[config.Language]string{
+		config.English: "ioctl node probation returns the delegates on probation in an epoch, by default the current epoch. For the current epoch, REMAINING is the number of epochs after the current one the delegate stays on probation if it is productive from now on.",
+		config.Chinese: "ioctl node probation 返回在某个epoch内被试用的委托, 默认为当前epoch. 对于当前epoch, REMAINING 是委托从现在起保持生产力的情况下在当前epoch之后仍被试用的epoch数.",
+	}
+)
+
+var probationEpochNum uint64
+
+// nodeProbationCmd represents the node probation command
+var nodeProbationCmd = &cobra.Command{
+	Use:   config.TranslateInLang(probationCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(probationCmdShorts, config.UILanguage),
+	Long:  config.TranslateInLang(probationCmdLong, config.UILanguage),
+	Args:  cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := probation()
+		return output.PrintError(err)
+	},
+}
+
+type probationMessage struct {
+	Epoch         uint64           `json:"epoch"`
+	IntensityRate uint32           `json:"intensityRate"`
+	Delegates     []*probationInfo `json:"delegates"`
+}
+
+type probationInfo struct {
+	Address         string `json:"address"`
+	Count           uint32 `json:"count"`
+	RemainingEpochs uint64 `json:"remainingEpochs"`
+}
+
+func (m *probationMessage) String() string {
+	if output.Format == "" {
+		lines := []string{fmt.Sprintf("Epoch: %d  IntensityRate: %d%%\n", m.Epoch, m.IntensityRate)}
+		if len(m.Delegates) == 0 {
+			lines = append(lines, "no delegate on probation")
+			return strings.Join(lines, "\n")
+		}
+		formatTitleString := "%-41s   %-8s   %s"
+		formatDataString := "%-41s   %-8d   %d"
+		lines = append(lines, fmt.Sprintf(formatTitleString, "ADDRESS", "COUNT", "REMAINING"))
+		for _, d := range m.Delegates {
+			lines = append(lines, fmt.Sprintf(formatDataString, d.Address, d.Count, d.RemainingEpochs))
+		}
+		return strings.Join(lines, "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func init() {
+	nodeProbationCmd.Flags().Uint64VarP(&probationEpochNum, "epoch-num", "e", 0,
+		config.TranslateInLang(flagEpochNumUsages, config.UILanguage))
+}
+
+func probation() error {
+	if probationEpochNum == 0 {
+		chainMeta, err := bc.GetChainMeta()
+		if err != nil {
+			return output.NewError(0, "failed to get chain meta", err)
+		}
+		probationEpochNum = chainMeta.Epoch.Num
+	}
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+
+	jwtMD, err := util.JwtAuth()
+	if err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	request := &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("poll"),
+		MethodName: []byte("ProbationInfo"),
+		Arguments:  [][]byte{[]byte(strconv.FormatUint(probationEpochNum, 10))},
+	}
+	response, err := cli.ReadState(ctx, request)
+	if err != nil {
+		sta, ok := status.FromError(err)
+		if ok {
+			return output.NewError(output.APIError, sta.Message(), nil)
+		}
+		return output.NewError(output.NetworkError, "failed to invoke ReadState api", err)
+	}
+	infos := &pollpb.ProbationInfoList{}
+	if err := proto.Unmarshal(response.Data, infos); err != nil {
+		return output.NewError(output.SerializationError, "failed to deserialize probation info", err)
+	}
+	message := probationMessage{
+		Epoch:         infos.Epoch,
+		IntensityRate: infos.IntensityRate,
+	}
+	for _, info := range infos.ProbationInfos {
+		message.Delegates = append(message.Delegates, &probationInfo{
+			Address:         info.Address,
+			Count:           info.Count,
+			RemainingEpochs: info.RemainingEpochs,
+		})
+	}
+	fmt.Println(message.String())
+	return nil
+}
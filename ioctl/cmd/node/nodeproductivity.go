@@ -0,0 +1,179 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/action/protocol/poll/pollpb"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/bc"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	productivityCmdUses = map[config.Language]string{
+		config.English: "productivity [-e start-epoch] [-c count]",
+		config.Chinese: "productivity [-e 起始epoch] [-c 数量]",
+	}
+	productivityCmdShorts = map[config.Language]string{
+		config.English: "Print block production of active delegates per epoch",
+		config.Chinese: "打印每个epoch内活跃委托的出块情况",
+	}
+	productivityCmdLong = map[config.Language]string{
+		config.English: "ioctl node productivity returns the number of blocks produced and expected to be produced by each active delegate per epoch, by default in the last 10 epochs.",
+		config.Chinese: "ioctl node productivity 返回每个epoch内各活跃委托的出块数和应出块数, 默认为最近10个epoch.",
+	}
+	flagStartEpochUsages = map[config.Language]string{
+		config.English: "specify start epoch",
+		config.Chinese: "指定起始epoch",
+	}
+	flagEpochCountUsages = map[config.Language]string{
+		config.English: "specify number of epochs",
+		config.Chinese: "指定epoch数量",
+	}
+)
+
+var (
+	productivityStartEpoch uint64
+	productivityCount      uint64
+)
+
+// nodeProductivityCmd represents the node productivity command
+var nodeProductivityCmd = &cobra.Command{
+	Use:   config.TranslateInLang(productivityCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(productivityCmdShorts, config.UILanguage),
+	Long:  config.TranslateInLang(productivityCmdLong, config.UILanguage),
+	Args:  cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := productivity()
+		return output.PrintError(err)
+	},
+}
+
+type productivityMessage struct {
+	Epochs []*epochProductivity `json:"epochs"`
+}
+
+type epochProductivity struct {
+	Epoch       uint64                  `json:"epoch"`
+	TotalBlocks uint64                  `json:"totalBlocks"`
+	Delegates   []*delegateProductivity `json:"delegates"`
+}
+
+type delegateProductivity struct {
+	Address            string `json:"address"`
+	Production         uint64 `json:"production"`
+	ExpectedProduction uint64 `json:"expectedProduction"`
+}
+
+func (m *productivityMessage) String() string {
+	if output.Format == "" {
+		var lines []string
+		formatTitleString := "%-41s   %-10s   %s"
+		formatDataString := "%-41s   %-10d   %d"
+		for _, e := range m.Epochs {
+			if len(lines) != 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, fmt.Sprintf("Epoch: %d  TotalBlocks: %d\n", e.Epoch, e.TotalBlocks))
+			lines = append(lines, fmt.Sprintf(formatTitleString, "ADDRESS", "PRODUCED", "EXPECTED"))
+			for _, d := range e.Delegates {
+				lines = append(lines, fmt.Sprintf(formatDataString, d.Address, d.Production, d.ExpectedProduction))
+			}
+		}
+		return strings.Join(lines, "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func init() {
+	nodeProductivityCmd.Flags().Uint64VarP(&productivityStartEpoch, "start-epoch", "e", 0,
+		config.TranslateInLang(flagStartEpochUsages, config.UILanguage))
+	nodeProductivityCmd.Flags().Uint64VarP(&productivityCount, "count", "c", 10,
+		config.TranslateInLang(flagEpochCountUsages, config.UILanguage))
+}
+
+func productivity() error {
+	if productivityCount == 0 {
+		return output.NewError(output.ValidationError, "invalid count", nil)
+	}
+	if productivityStartEpoch == 0 {
+		chainMeta, err := bc.GetChainMeta()
+		if err != nil {
+			return output.NewError(0, "failed to get chain meta", err)
+		}
+		productivityStartEpoch = 1
+		if chainMeta.Epoch.Num > productivityCount {
+			productivityStartEpoch = chainMeta.Epoch.Num - productivityCount + 1
+		}
+	}
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+
+	jwtMD, err := util.JwtAuth()
+	if err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	request := &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("poll"),
+		MethodName: []byte("Productivity"),
+		Arguments: [][]byte{
+			[]byte(strconv.FormatUint(productivityStartEpoch, 10)),
+			[]byte(strconv.FormatUint(productivityCount, 10)),
+		},
+	}
+	response, err := cli.ReadState(ctx, request)
+	if err != nil {
+		sta, ok := status.FromError(err)
+		if ok {
+			return output.NewError(output.APIError, sta.Message(), nil)
+		}
+		return output.NewError(output.NetworkError, "failed to invoke ReadState api", err)
+	}
+	epochs := &pollpb.EpochProductivityList{}
+	if err := proto.Unmarshal(response.Data, epochs); err != nil {
+		return output.NewError(output.SerializationError, "failed to deserialize productivity", err)
+	}
+	message := productivityMessage{}
+	for _, e := range epochs.EpochProductivities {
+		ep := &epochProductivity{
+			Epoch:       e.Epoch,
+			TotalBlocks: e.TotalBlocks,
+		}
+		for _, p := range e.Productivities {
+			ep.Delegates = append(ep.Delegates, &delegateProductivity{
+				Address:            p.Address,
+				Production:         p.Production,
+				ExpectedProduction: p.ExpectedProduction,
+			})
+		}
+		message.Epochs = append(message.Epochs, ep)
+	}
+	fmt.Println(message.String())
+	return nil
+}
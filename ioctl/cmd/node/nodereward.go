@@ -10,31 +10,36 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 
+	"github.com/iotexproject/iotex-core/ioctl/cmd/bc"
 	"github.com/iotexproject/iotex-core/ioctl/config"
 	"github.com/iotexproject/iotex-core/ioctl/output"
 	"github.com/iotexproject/iotex-core/ioctl/util"
+	"github.com/iotexproject/iotex-core/rewardhistory/rewardhistorypb"
 )
 
 // Multi-language support
 var (
 	rewardCmdUses = map[config.Language]string{
-		config.English: "reward unclaimed|pool [ALIAS|DELEGATE_ADDRESS]",
-		config.Chinese: "reward 未支取|奖金池 [别名|委托地址]",
+		config.English: "reward unclaimed|pool|history [ALIAS|DELEGATE_ADDRESS] [START_EPOCH] [COUNT]",
+		config.Chinese: "reward 未支取|奖金池|历史 [别名|委托地址] [起始EPOCH] [数量]",
 	}
 	rewardCmdShorts = map[config.Language]string{
 		config.English: "Query rewards",
 		config.Chinese: "查询奖励",
 	}
 	rewardPoolLong = map[config.Language]string{
-		config.English: "ioctl node reward returns unclaimed and available Rewards in fund pool. TotalUnclaimed is the amount of all delegates that have been issued but are not claimed; TotalAvailable is the amount of balance that has not been issued to anyone.\n\nioctl node [ALIAS|DELEGATE_ADDRESS] returns unclaimed rewards of a specific delegate.",
-		config.Chinese: "ioctl node reward 返回奖金池中的未支取奖励和可获取的奖励. TotalUnclaimed是所有代表已被发放但未支取的奖励的总和; TotalAvailable 是奖金池中未被发放的奖励的总和.\n\nioctl node [ALIAS|DELEGATE_ADDRESS] 返回特定代表的已被发放但未支取的奖励.",
+		config.English: "ioctl node reward returns unclaimed and available Rewards in fund pool. TotalUnclaimed is the amount of all delegates that have been issued but are not claimed; TotalAvailable is the amount of balance that has not been issued to anyone.\n\nioctl node [ALIAS|DELEGATE_ADDRESS] returns unclaimed rewards of a specific delegate.\n\nioctl node reward history [ALIAS|DELEGATE_ADDRESS] [START_EPOCH] [COUNT] returns the block reward, epoch reward and foundation bonus granted to a specific delegate per epoch, by default in the last 10 epochs.",
+		config.Chinese: "ioctl node reward 返回奖金池中的未支取奖励和可获取的奖励. TotalUnclaimed是所有代表已被发放但未支取的奖励的总和; TotalAvailable 是奖金池中未被发放的奖励的总和.\n\nioctl node [ALIAS|DELEGATE_ADDRESS] 返回特定代表的已被发放但未支取的奖励.\n\nioctl node reward history [ALIAS|DELEGATE_ADDRESS] [START_EPOCH] [COUNT] 返回特定代表每个EPOCH获得的区块奖励, EPOCH奖励和基金会奖励, 默认为最近10个EPOCH.",
 	}
 )
 
@@ -42,7 +47,7 @@ var (
 var nodeRewardCmd = &cobra.Command{
 	Use:   config.TranslateInLang(rewardCmdUses, config.UILanguage),
 	Short: config.TranslateInLang(rewardCmdShorts, config.UILanguage),
-	Args:  cobra.MaximumNArgs(2),
+	Args:  cobra.MaximumNArgs(4),
 	Long:  config.TranslateInLang(rewardPoolLong, config.UILanguage),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
@@ -55,6 +60,11 @@ var nodeRewardCmd = &cobra.Command{
 			err = rewardPool()
 		case "unclaimed":
 			err = reward(args[1])
+		case "history":
+			if len(args) < 2 {
+				return output.PrintError(output.NewError(output.InputError, "delegate address is required", nil))
+			}
+			err = rewardHistory(args[1], args[2:]...)
 		default:
 			return output.PrintError(err)
 		}
@@ -92,6 +102,40 @@ func (m *rewardMessage) String() string {
 	return output.FormatString(output.Result, m)
 }
 
+type rewardHistoryMessage struct {
+	Address   string               `json:"address"`
+	Histories []*rewardHistoryInfo `json:"histories"`
+}
+
+type rewardHistoryInfo struct {
+	Epoch           uint64 `json:"epoch"`
+	BlockReward     string `json:"blockReward"`
+	NumBlocks       uint64 `json:"numBlocks"`
+	EpochReward     string `json:"epochReward"`
+	FoundationBonus string `json:"foundationBonus"`
+	Total           string `json:"total"`
+}
+
+func (m *rewardHistoryMessage) String() string {
+	if output.Format == "" {
+		lines := []string{fmt.Sprintf("%s:", m.Address)}
+		if len(m.Histories) == 0 {
+			lines = append(lines, "no reward in the epochs")
+			return strings.Join(lines, "\n")
+		}
+		formatTitleString := "%-8s   %-20s   %-8s   %-20s   %-20s   %s"
+		formatDataString := "%-8d   %-20s   %-8d   %-20s   %-20s   %s"
+		lines = append(lines, fmt.Sprintf(formatTitleString,
+			"EPOCH", "BLOCK REWARD", "BLOCKS", "EPOCH REWARD", "FOUNDATION BONUS", "TOTAL"))
+		for _, h := range m.Histories {
+			lines = append(lines, fmt.Sprintf(formatDataString,
+				h.Epoch, h.BlockReward, h.NumBlocks, h.EpochReward, h.FoundationBonus, h.Total))
+		}
+		return strings.Join(lines, "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
 func rewardPool() error {
 	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
 	if err != nil {
@@ -186,3 +230,93 @@ func reward(arg string) error {
 	fmt.Println(message.String())
 	return nil
 }
+
+func rewardHistory(arg string, epochArgs ...string) error {
+	address, err := util.Address(arg)
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get address", err)
+	}
+	count := uint64(10)
+	if len(epochArgs) > 1 {
+		if count, err = strconv.ParseUint(epochArgs[1], 10, 64); err != nil || count == 0 {
+			return output.NewError(output.ValidationError, "invalid count", err)
+		}
+	}
+	var startEpoch uint64
+	if len(epochArgs) > 0 {
+		if startEpoch, err = strconv.ParseUint(epochArgs[0], 10, 64); err != nil || startEpoch == 0 {
+			return output.NewError(output.ValidationError, "invalid start epoch", err)
+		}
+	} else {
+		chainMeta, err := bc.GetChainMeta()
+		if err != nil {
+			return output.NewError(0, "failed to get chain meta", err)
+		}
+		startEpoch = 1
+		if chainMeta.Epoch.Num > count {
+			startEpoch = chainMeta.Epoch.Num - count + 1
+		}
+	}
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+
+	jwtMD, err := util.JwtAuth()
+	if err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	request := &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("rewarding"),
+		MethodName: []byte("RewardHistory"),
+		Arguments: [][]byte{
+			[]byte(address),
+			[]byte(strconv.FormatUint(startEpoch, 10)),
+			[]byte(strconv.FormatUint(count, 10)),
+		},
+	}
+	response, err := cli.ReadState(ctx, request)
+	if err != nil {
+		sta, ok := status.FromError(err)
+		if ok {
+			return output.NewError(output.APIError, sta.Message(), nil)
+		}
+		return output.NewError(output.NetworkError, "failed to invoke ReadState api", err)
+	}
+	histories := &rewardhistorypb.RewardHistoryList{}
+	if err := proto.Unmarshal(response.Data, histories); err != nil {
+		return output.NewError(output.SerializationError, "failed to deserialize reward history", err)
+	}
+	message := rewardHistoryMessage{Address: address}
+	for _, h := range histories.RewardHistories {
+		info := &rewardHistoryInfo{
+			Epoch:     h.Epoch,
+			NumBlocks: h.NumBlocks,
+		}
+		total := big.NewInt(0)
+		for _, r := range []struct {
+			amount string
+			str    *string
+		}{
+			{h.BlockReward, &info.BlockReward},
+			{h.EpochReward, &info.EpochReward},
+			{h.FoundationBonus, &info.FoundationBonus},
+		} {
+			rau, ok := big.NewInt(0).SetString(r.amount, 10)
+			if !ok {
+				return output.NewError(output.ConvertError, "failed to convert string into big int", nil)
+			}
+			*r.str = util.RauToString(rau, util.IotxDecimalNum)
+			total.Add(total, rau)
+		}
+		info.Total = util.RauToString(total, util.IotxDecimalNum)
+		message.Histories = append(message.Histories, info)
+	}
+	fmt.Println(message.String())
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	p2p "github.com/iotexproject/go-p2p"
+	net "github.com/libp2p/go-libp2p-net"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	multiaddr "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -28,7 +29,6 @@ import (
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/cache"
 	"github.com/iotexproject/iotex-core/pkg/log"
-	goproto "github.com/iotexproject/iotex-proto/golang"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
 )
 
@@ -67,6 +67,7 @@ const (
 	numDialRetries    = 8
 	dialRetryInterval = 2 * time.Second
 	blackListLen      = 1000
+	peerConnsLen      = 1000
 	blackListTTL      = 15 * time.Minute
 )
 
@@ -86,6 +87,9 @@ type Agent struct {
 	unicastInboundAsyncHandler HandleUnicastInboundAsync
 	host                       *p2p.Host
 	unicastBlacklist           *cache.ThreadSafeLruCache
+	peerConns                  *cache.ThreadSafeLruCache
+	reputation                 *Reputation
+	allowlist                  *Allowlist
 }
 
 // NewAgent instantiates a local P2P agent instance
@@ -98,12 +102,18 @@ func NewAgent(cfg config.Config, broadcastHandler HandleBroadcastInbound, unicas
 		broadcastInboundHandler:    broadcastHandler,
 		unicastInboundAsyncHandler: unicastHandler,
 		unicastBlacklist:           cache.NewThreadSafeLruCache(blackListLen),
+		peerConns:                  cache.NewThreadSafeLruCache(peerConnsLen),
+		reputation:                 NewReputation(cfg.Network.Reputation),
+		allowlist:                  NewAllowlist(cfg.Network.Allowlist),
 	}
 }
 
 // Start connects into P2P network
 func (p *Agent) Start(ctx context.Context) error {
 	ready := make(chan interface{})
+	if err := p.allowlist.Start(ctx); err != nil {
+		return errors.Wrap(err, "error when starting peer allowlist")
+	}
 	p2p.SetLogger(log.L())
 	opts := []p2p.Option{
 		p2p.HostName(p.cfg.Host),
@@ -149,31 +159,41 @@ func (p *Agent) Start(ctx context.Context) error {
 			p2pMsgCounter.WithLabelValues("broadcast", strconv.Itoa(int(broadcast.MsgType)), "in", peerID, status).Inc()
 			p2pMsgLatency.WithLabelValues("broadcast", strconv.Itoa(int(broadcast.MsgType)), status).Observe(float64(latency))
 		}()
-		if err = proto.Unmarshal(data, &broadcast); err != nil {
-			err = errors.Wrap(err, "error when marshaling broadcast message")
-			return
-		}
-		// Skip the broadcast message if it's from the node itself
+		// Skip the broadcast message if it's from the node itself, a banned peer or a peer not allowed
 		rawmsg, ok := p2p.GetBroadcastMsg(ctx)
 		if !ok {
 			err = errors.New("error when asserting broadcast msg context")
 			return
 		}
 		peerID = rawmsg.GetFrom().Pretty()
-		if p.host.HostIdentity() == peerID {
+		if p.host.HostIdentity() == peerID || p.reputation.Banned(peerID) {
 			skip = true
 			return
 		}
+		if !p.allowlist.Allowed(peerID) {
+			rejectedPeerCounter.WithLabelValues("in").Inc()
+			skip = true
+			return
+		}
+		if err = proto.Unmarshal(data, &broadcast); err != nil {
+			p.reputation.Report(peerID, MalformedMessage)
+			err = errors.Wrap(err, "error when marshaling broadcast message")
+			return
+		}
 
 		t, _ := ptypes.Timestamp(broadcast.GetTimestamp())
 		latency = time.Since(t).Nanoseconds() / time.Millisecond.Nanoseconds()
 
-		msg, err := goproto.TypifyRPCMsg(broadcast.MsgType, broadcast.MsgBody)
+		msg, err := typifyMessage(broadcast.MsgType, broadcast.MsgBody)
 		if err != nil {
+			// Messages of unknown protocol extensions are ignored without penalty for compatibility with newer nodes
+			if errors.Cause(err) != ErrUnknownMessageType {
+				p.reputation.Report(peerID, MalformedMessage)
+			}
 			err = errors.Wrap(err, "error when typifying broadcast message")
 			return
 		}
-		p.broadcastInboundHandler(ctx, broadcast.ChainId, msg)
+		p.broadcastInboundHandler(WithPeerReputation(ctx, peerID, p.reputation), broadcast.ChainId, msg)
 		return
 	}); err != nil {
 		return errors.Wrap(err, "error when adding broadcast pubsub")
@@ -187,7 +207,12 @@ func (p *Agent) Start(ctx context.Context) error {
 			peerID  string
 			latency int64
 		)
+		skip := false
 		defer func() {
+			// Skip accounting if the unicast message is not handled
+			if skip {
+				return
+			}
 			status := successStr
 			if err != nil {
 				status = failureStr
@@ -195,12 +220,37 @@ func (p *Agent) Start(ctx context.Context) error {
 			p2pMsgCounter.WithLabelValues("unicast", strconv.Itoa(int(unicast.MsgType)), "in", peerID, status).Inc()
 			p2pMsgLatency.WithLabelValues("unicast", strconv.Itoa(int(unicast.MsgType)), status).Observe(float64(latency))
 		}()
+		stream, ok := p2p.GetUnicastStream(ctx)
+		if !ok {
+			err = errors.New("error when asserting unicast stream context")
+			return
+		}
+		peerID = stream.Conn().RemotePeer().Pretty()
+		// Skip the unicast message if it's from a banned peer or a peer not allowed
+		if p.reputation.Banned(peerID) {
+			p.closeConn(peerID, stream.Conn())
+			skip = true
+			return
+		}
+		if !p.allowlist.Allowed(peerID) {
+			// Cut off the connection of the peer not allowed, besides dropping its message
+			rejectedPeerCounter.WithLabelValues("in").Inc()
+			p.closeConn(peerID, stream.Conn())
+			skip = true
+			return
+		}
+		p.peerConns.Add(peerID, stream.Conn())
 		if err = proto.Unmarshal(data, &unicast); err != nil {
+			p.reputation.Report(peerID, MalformedMessage)
 			err = errors.Wrap(err, "error when marshaling unicast message")
 			return
 		}
-		msg, err := goproto.TypifyRPCMsg(unicast.MsgType, unicast.MsgBody)
+		msg, err := typifyMessage(unicast.MsgType, unicast.MsgBody)
 		if err != nil {
+			// Messages of unknown protocol extensions are ignored without penalty for compatibility with newer nodes
+			if errors.Cause(err) != ErrUnknownMessageType {
+				p.reputation.Report(peerID, MalformedMessage)
+			}
 			err = errors.Wrap(err, "error when typifying unicast message")
 			return
 		}
@@ -208,17 +258,11 @@ func (p *Agent) Start(ctx context.Context) error {
 		t, _ := ptypes.Timestamp(unicast.GetTimestamp())
 		latency = time.Since(t).Nanoseconds() / time.Millisecond.Nanoseconds()
 
-		stream, ok := p2p.GetUnicastStream(ctx)
-		if !ok {
-			err = errors.Wrap(err, "error when typifying unicast message")
-			return
-		}
-		peerID = stream.Conn().RemotePeer().Pretty()
 		peerInfo := peerstore.PeerInfo{
 			ID:    stream.Conn().RemotePeer(),
 			Addrs: []multiaddr.Multiaddr{stream.Conn().RemoteMultiaddr()},
 		}
-		p.unicastInboundAsyncHandler(ctx, unicast.ChainId, peerInfo, msg)
+		p.unicastInboundAsyncHandler(WithPeerReputation(ctx, peerID, p.reputation), unicast.ChainId, peerInfo, msg)
 		return
 	}); err != nil {
 		return errors.Wrap(err, "error when adding unicast pubsub")
@@ -274,17 +318,39 @@ func (p *Agent) Start(ctx context.Context) error {
 			}
 		}
 	}
+	p.allowlist.OnRevoke(p.closePeer)
+	p.reputation.OnBan(p.closePeer)
 	host.JoinOverlay(ctx)
 	p.host = host
 	close(ready)
 	return nil
 }
 
+// closePeer closes the last connection the peer sent a unicast message over. The host does not expose its
+// connections, so the agent keeps track of the ones it sees, and drops the messages of the peer coming otherwise.
+func (p *Agent) closePeer(peerID string) {
+	conn, ok := p.peerConns.Get(peerID)
+	if !ok {
+		return
+	}
+	p.closeConn(peerID, conn.(net.Conn))
+}
+
+func (p *Agent) closeConn(peerID string, conn net.Conn) {
+	p.peerConns.Remove(peerID)
+	if err := conn.Close(); err != nil {
+		log.L().Debug("Error when closing the connection with a peer.", zap.String("peer", peerID), zap.Error(err))
+	}
+}
+
 // Stop disconnects from P2P network
 func (p *Agent) Stop(ctx context.Context) error {
 	if p.host == nil {
 		return nil
 	}
+	if err := p.allowlist.Stop(ctx); err != nil {
+		return errors.Wrap(err, "error when stopping peer allowlist")
+	}
 	if err := p.host.Close(); err != nil {
 		return errors.Wrap(err, "error when closing Agent host")
 	}
@@ -351,6 +417,15 @@ func (p *Agent) UnicastOutbound(ctx context.Context, peer peerstore.PeerInfo, ms
 	if err != nil {
 		return
 	}
+	if p.reputation.Banned(peer.ID.Pretty()) {
+		err = errors.Errorf("peer %s is banned", peer.ID.Pretty())
+		return
+	}
+	if !p.allowlist.Allowed(peer.ID.Pretty()) {
+		rejectedPeerCounter.WithLabelValues("out").Inc()
+		err = errors.Errorf("peer %s is not allowed", peer.ID.Pretty())
+		return
+	}
 	p2pCtx, ok := GetContext(ctx)
 	if !ok {
 		err = errors.New("P2P context doesn't exist")
@@ -382,6 +457,12 @@ func (p *Agent) Info() peerstore.PeerInfo { return p.host.Info() }
 // Self returns the self network address
 func (p *Agent) Self() []multiaddr.Multiaddr { return p.host.Addresses() }
 
+// Reputation returns the reputation of the peers
+func (p *Agent) Reputation() *Reputation { return p.reputation }
+
+// Allowlist returns the allowlist of the peers
+func (p *Agent) Allowlist() *Allowlist { return p.allowlist }
+
 // Neighbors returns the neighbors' peer info
 func (p *Agent) Neighbors(ctx context.Context) ([]peerstore.PeerInfo, error) {
 	var res []peerstore.PeerInfo
@@ -391,6 +472,9 @@ func (p *Agent) Neighbors(ctx context.Context) ([]peerstore.PeerInfo, error) {
 	}
 
 	for i, nb := range nbs {
+		if p.reputation.Banned(nb.ID.Pretty()) || !p.allowlist.Allowed(nb.ID.Pretty()) {
+			continue
+		}
 		if v, ok := p.unicastBlacklist.Get(nb.ID.Pretty()); ok {
 			if t, isT := v.(time.Time); isT {
 				if time.Now().After(t) {
@@ -405,7 +489,7 @@ func (p *Agent) Neighbors(ctx context.Context) ([]peerstore.PeerInfo, error) {
 }
 
 func convertAppMsg(msg proto.Message) (iotexrpc.MessageType, []byte, error) {
-	msgType, err := goproto.GetTypeFromRPCMsg(msg)
+	msgType, err := MessageType(msg)
 	if err != nil {
 		return 0, nil, errors.Wrap(err, "error when converting application message to proto")
 	}
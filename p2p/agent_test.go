@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	libp2pnet "github.com/libp2p/go-libp2p-net"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	"github.com/stretchr/testify/require"
 
@@ -162,3 +163,39 @@ func TestUnicast(t *testing.T) {
 		}))
 	}
 }
+
+type mockConn struct {
+	libp2pnet.Conn
+	closed int
+}
+
+func (c *mockConn) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestClosePeer(t *testing.T) {
+	agent := NewAgent(config.Default, nil, nil)
+	conn := &mockConn{}
+	agent.peerConns.Add("peer1", conn)
+
+	agent.closePeer("peer2")
+	require.Equal(t, 0, conn.closed)
+	agent.closePeer("peer1")
+	require.Equal(t, 1, conn.closed)
+	_, ok := agent.peerConns.Get("peer1")
+	require.False(t, ok)
+	// the connection is closed once
+	agent.closePeer("peer1")
+	require.Equal(t, 1, conn.closed)
+}
+
+func TestCloseBannedPeer(t *testing.T) {
+	agent := NewAgent(config.Default, nil, nil)
+	agent.reputation.OnBan(agent.closePeer)
+	conn := &mockConn{}
+	agent.peerConns.Add("peer1", conn)
+
+	agent.reputation.Ban("peer1", time.Minute)
+	require.Equal(t, 1, conn.closed)
+}
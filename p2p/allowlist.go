@@ -0,0 +1,273 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	ic "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+var (
+	allowedPeersGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "iotex_p2p_allowed_peers",
+			Help: "Number of the peers in the allowlist",
+		},
+	)
+	rejectedPeerCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_p2p_rejected_peer",
+			Help: "Messages and connections of the peers not in the allowlist",
+		},
+		[]string{"direction"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(allowedPeersGauge)
+	prometheus.MustRegister(rejectedPeerCounter)
+}
+
+type (
+	// ConsortiumMembers returns the operator addresses of the current members of the consortium committee
+	ConsortiumMembers func(context.Context) ([]string, error)
+
+	// Allowlist restricts the peers in a permissioned network to the peers listed in the config and the allowlist
+	// file. The file also lists the peers of each consortium member by its operator address, and the members could
+	// be restricted to the delegates of the consortium committee contract, so that an organisation is revoked once
+	// it is removed from the contract. The file and the consortium committee are reloaded periodically. A peer not
+	// allowed is cut off by closing its connections, besides dropping its messages and leaving it out of the
+	// neighbors like a banned peer.
+	Allowlist struct {
+		mutex   sync.RWMutex
+		cfg     config.Allowlist
+		members ConsortiumMembers
+		// admitted are the members admitted by the consortium committee at the last successful read
+		admitted map[string]struct{}
+		peers    map[string]struct{}
+		revoke   func(string)
+		task     *routine.RecurringTask
+	}
+
+	allowlistFile struct {
+		Peers   []string            `yaml:"peers"`
+		Members map[string][]string `yaml:"members"`
+	}
+)
+
+// NewAllowlist creates a peer allowlist
+func NewAllowlist(cfg config.Allowlist) *Allowlist {
+	a := &Allowlist{
+		cfg:      cfg,
+		admitted: map[string]struct{}{},
+		peers:    map[string]struct{}{},
+	}
+	if cfg.File != "" {
+		a.task = routine.NewRecurringTask(func() {
+			if err := a.Reload(context.Background()); err != nil {
+				log.L().Error("Failed to reload the peer allowlist.", zap.Error(err))
+			}
+		}, cfg.ReloadInterval)
+	}
+	return a
+}
+
+// SetConsortiumMembers sets the source of the consortium members, which is read on every reload
+func (a *Allowlist) SetConsortiumMembers(members ConsortiumMembers) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.members = members
+}
+
+// OnRevoke sets the handler called with the ID of each peer revoked from the allowlist on reload
+func (a *Allowlist) OnRevoke(handler func(string)) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.revoke = handler
+}
+
+// Start loads the allowlist, and starts reloading it periodically
+func (a *Allowlist) Start(ctx context.Context) error {
+	if err := a.Reload(ctx); err != nil {
+		return err
+	}
+	if a.task == nil {
+		return nil
+	}
+	return a.task.Start(ctx)
+}
+
+// Stop stops reloading the allowlist
+func (a *Allowlist) Stop(ctx context.Context) error {
+	if a.task == nil {
+		return nil
+	}
+	return a.task.Stop(ctx)
+}
+
+// Enabled returns true if the peers are restricted to the allowlist
+func (a *Allowlist) Enabled() bool {
+	return len(a.cfg.Peers) > 0 || a.cfg.File != ""
+}
+
+// Allowed returns true if the peer is allowed
+func (a *Allowlist) Allowed(peerID string) bool {
+	if !a.Enabled() {
+		return true
+	}
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	_, ok := a.peers[peerID]
+	return ok
+}
+
+// Peers returns the IDs of the peers allowed
+func (a *Allowlist) Peers() []string {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	peers := make([]string, 0, len(a.peers))
+	for id := range a.peers {
+		peers = append(peers, id)
+	}
+	sort.Strings(peers)
+	return peers
+}
+
+// Reload reloads the allowlist from the config, the file and the consortium committee. The allowlist is kept
+// unchanged if the config or the file is invalid. If the consortium committee cannot be read, e.g., the chain is not
+// started yet, the members admitted at the last successful read are kept.
+func (a *Allowlist) Reload(ctx context.Context) error {
+	peers := map[string]struct{}{}
+	if err := addPeers(peers, a.cfg.Peers); err != nil {
+		return errors.Wrap(err, "invalid peer in config")
+	}
+	var file allowlistFile
+	if a.cfg.File != "" {
+		data, err := ioutil.ReadFile(a.cfg.File)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read allowlist file %s", a.cfg.File)
+		}
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return errors.Wrapf(err, "failed to parse allowlist file %s", a.cfg.File)
+		}
+		if err := addPeers(peers, file.Peers); err != nil {
+			return errors.Wrapf(err, "invalid peer in allowlist file %s", a.cfg.File)
+		}
+	}
+
+	revoked, err := a.update(ctx, peers, file.Members)
+	if err != nil {
+		return err
+	}
+	a.mutex.RLock()
+	revoke := a.revoke
+	a.mutex.RUnlock()
+	if revoke != nil {
+		for _, id := range revoked {
+			revoke(id)
+		}
+	}
+	return nil
+}
+
+// update adds the peers of the members admitted into the set, and replaces the allowlist with it. It returns the
+// IDs of the peers revoked.
+func (a *Allowlist) update(ctx context.Context, peers map[string]struct{}, members map[string][]string) ([]string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.cfg.Consortium {
+		if a.members == nil {
+			log.L().Warn("Consortium members are not available to the peer allowlist.")
+		} else if members, err := a.members(ctx); err != nil {
+			log.L().Warn("Failed to read the consortium members.", zap.Error(err))
+		} else {
+			a.admitted = make(map[string]struct{}, len(members))
+			for _, member := range members {
+				a.admitted[member] = struct{}{}
+			}
+		}
+	}
+	for member, entries := range members {
+		if _, ok := a.admitted[member]; a.cfg.Consortium && !ok {
+			continue
+		}
+		if err := addPeers(peers, entries); err != nil {
+			return nil, errors.Wrapf(err, "invalid peer of member %s in allowlist file %s", member, a.cfg.File)
+		}
+	}
+	var revoked []string
+	for id := range a.peers {
+		if _, ok := peers[id]; !ok {
+			log.L().Info("Revoke peer from the allowlist.", zap.String("peer", id))
+			revoked = append(revoked, id)
+		}
+	}
+	sort.Strings(revoked)
+	a.peers = peers
+	allowedPeersGauge.Set(float64(len(peers)))
+	return revoked, nil
+}
+
+// Handle handles admin request. It returns the IDs of the peers allowed, and query "reload" reloads the allowlist.
+func (a *Allowlist) Handle(w http.ResponseWriter, req *http.Request) {
+	if _, ok := req.URL.Query()["reload"]; ok {
+		log.L().Info("Reload peer allowlist by admin.")
+		if err := a.Reload(req.Context()); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(a.Peers()); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// addPeers adds the peers into the set. A peer is given either by its ID, or by its hex encoded public key.
+func addPeers(peers map[string]struct{}, entries []string) error {
+	for _, entry := range entries {
+		id, err := decodePeer(entry)
+		if err != nil {
+			return err
+		}
+		peers[id.Pretty()] = struct{}{}
+	}
+	return nil
+}
+
+func decodePeer(entry string) (peer.ID, error) {
+	entry = strings.TrimSpace(entry)
+	if id, err := peer.IDB58Decode(entry); err == nil {
+		return id, nil
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(entry, "0x"))
+	if err != nil {
+		return "", errors.Errorf("%s is neither a peer ID nor a hex encoded public key", entry)
+	}
+	pk, err := ic.UnmarshalPublicKey(data)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal public key %s", entry)
+	}
+	return peer.IDFromPublicKey(pk)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	ic "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+)
+
+func TestAllowlist(t *testing.T) {
+	require := require.New(t)
+
+	ids := make([]string, 4)
+	keys := make([]string, 4)
+	for i := range ids {
+		_, pk, err := ic.GenerateEd25519Key(rand.Reader)
+		require.NoError(err)
+		id, err := peer.IDFromPublicKey(pk)
+		require.NoError(err)
+		data, err := ic.MarshalPublicKey(pk)
+		require.NoError(err)
+		ids[i], keys[i] = id.Pretty(), hex.EncodeToString(data)
+	}
+
+	// all peers are allowed if the allowlist is not configured
+	a := NewAllowlist(config.Default.Network.Allowlist)
+	require.NoError(a.Reload(context.Background()))
+	require.False(a.Enabled())
+	require.True(a.Allowed(ids[0]))
+
+	// the peers are given by their IDs or public keys
+	cfg := config.Default.Network.Allowlist
+	cfg.Peers = []string{ids[0], keys[1]}
+	a = NewAllowlist(cfg)
+	require.NoError(a.Reload(context.Background()))
+	require.True(a.Allowed(ids[0]))
+	require.True(a.Allowed(ids[1]))
+	require.False(a.Allowed(ids[2]))
+
+	// the file is reloaded, and the members not in the consortium committee are revoked
+	file, err := ioutil.TempFile("", "allowlist")
+	require.NoError(err)
+	defer os.Remove(file.Name())
+	require.NoError(file.Close())
+	writeFile := func(content string) {
+		require.NoError(ioutil.WriteFile(file.Name(), []byte(content), 0644))
+	}
+	writeFile(fmt.Sprintf("peers: [%s]\nmembers:\n  org1: [%s]\n  org2: [%s]\n", ids[1], ids[2], keys[3]))
+	cfg = config.Default.Network.Allowlist
+	cfg.File = file.Name()
+	cfg.Consortium = true
+	a = NewAllowlist(cfg)
+	require.True(a.Enabled())
+	members := []string{"org1", "org2"}
+	var membersErr error
+	a.SetConsortiumMembers(func(context.Context) ([]string, error) { return members, membersErr })
+	var revoked []string
+	a.OnRevoke(func(id string) { revoked = append(revoked, id) })
+	require.NoError(a.Reload(context.Background()))
+	require.Equal(3, len(a.Peers()))
+	require.False(a.Allowed(ids[0]))
+	require.True(a.Allowed(ids[3]))
+	require.Empty(revoked)
+	members = []string{"org1"}
+	require.NoError(a.Reload(context.Background()))
+	require.True(a.Allowed(ids[2]))
+	require.False(a.Allowed(ids[3]))
+	require.Equal([]string{ids[3]}, revoked)
+
+	// the members admitted last time are kept if the consortium committee cannot be read
+	membersErr = errors.New("chain not started")
+	writeFile(fmt.Sprintf("members:\n  org1: [%s]\n  org2: [%s]\n", ids[2], keys[3]))
+	require.NoError(a.Reload(context.Background()))
+	require.False(a.Allowed(ids[1]))
+	require.True(a.Allowed(ids[2]))
+	require.False(a.Allowed(ids[3]))
+	require.Equal([]string{ids[3], ids[1]}, revoked)
+
+	// the allowlist is unchanged if the file is invalid
+	writeFile("peers: [invalid]\n")
+	require.Error(a.Reload(context.Background()))
+	require.Equal([]string{ids[2]}, a.Peers())
+	require.Equal(2, len(revoked))
+
+	// the admin reloads the allowlist
+	membersErr = nil
+	writeFile(fmt.Sprintf("peers: [%s]\n", ids[0]))
+	w := httptest.NewRecorder()
+	a.Handle(w, httptest.NewRequest("GET", "/p2p/allowlist?reload", nil))
+	var peers []string
+	require.NoError(json.NewDecoder(w.Body).Decode(&peers))
+	require.Equal([]string{ids[0]}, peers)
+}
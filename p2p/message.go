@@ -0,0 +1,94 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	goproto "github.com/iotexproject/iotex-proto/golang"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+)
+
+// ErrUnknownMessageType indicates the message type is neither defined in iotex-proto nor registered, e.g., a message
+// of a protocol extension sent by a newer node
+var ErrUnknownMessageType = errors.New("unknown message type")
+
+// protoMessageTypes are the message types of the messages defined in iotex-proto. The messages of the other types in
+// the MessageType enum belong to the protocol extensions, and are registered by RegisterMessage.
+var protoMessageTypes = map[iotexrpc.MessageType]struct{}{
+	iotexrpc.MessageType_ACTION:        {},
+	iotexrpc.MessageType_BLOCK:         {},
+	iotexrpc.MessageType_CONSENSUS:     {},
+	iotexrpc.MessageType_BLOCK_REQUEST: {},
+	iotexrpc.MessageType_TEST:          {},
+}
+
+var (
+	messageMutex sync.RWMutex
+	messageTypes = map[string]iotexrpc.MessageType{}
+	messages     = map[iotexrpc.MessageType]reflect.Type{}
+)
+
+// RegisterMessage registers the message of a protocol extension with its message type, so that the agent could send
+// and receive it. It panics if the message type or the message has been registered, and is supposed to be called in
+// init() of the package owning the message.
+func RegisterMessage(t iotexrpc.MessageType, msg proto.Message) {
+	messageMutex.Lock()
+	defer messageMutex.Unlock()
+	name := proto.MessageName(msg)
+	if _, ok := messages[t]; ok {
+		panic(errors.Errorf("message type %d has been registered", t))
+	}
+	if _, ok := messageTypes[name]; ok {
+		panic(errors.Errorf("message %s has been registered", name))
+	}
+	messageTypes[name] = t
+	messages[t] = reflect.TypeOf(msg).Elem()
+}
+
+// MessageType returns the message type of the message defined in iotex-proto or registered
+func MessageType(msg proto.Message) (iotexrpc.MessageType, error) {
+	if t, err := goproto.GetTypeFromRPCMsg(msg); err == nil {
+		return t, nil
+	}
+	messageMutex.RLock()
+	defer messageMutex.RUnlock()
+	if t, ok := messageTypes[proto.MessageName(msg)]; ok {
+		return t, nil
+	}
+	return iotexrpc.MessageType_UNKNOWN, errors.Wrapf(ErrUnknownMessageType, "message %T", msg)
+}
+
+// IsExtension returns true if the message type is registered by a protocol extension
+func IsExtension(t iotexrpc.MessageType) bool {
+	messageMutex.RLock()
+	defer messageMutex.RUnlock()
+	_, ok := messages[t]
+	return ok
+}
+
+// typifyMessage decodes the message body of the message type
+func typifyMessage(t iotexrpc.MessageType, data []byte) (proto.Message, error) {
+	messageMutex.RLock()
+	mt, ok := messages[t]
+	messageMutex.RUnlock()
+	if !ok {
+		if _, ok := protoMessageTypes[t]; !ok {
+			return nil, errors.Wrapf(ErrUnknownMessageType, "message type %d", t)
+		}
+		return goproto.TypifyRPCMsg(t, data)
+	}
+	msg := reflect.New(mt).Interface().(proto.Message)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal message of type %d", t)
+	}
+	return msg, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func TestRegisterMessage(t *testing.T) {
+	require := require.New(t)
+
+	const msgTest iotexrpc.MessageType = 60001
+	_, err := MessageType(&iotextypes.Receipt{})
+	require.Equal(ErrUnknownMessageType, errors.Cause(err))
+	require.False(IsExtension(msgTest))
+	RegisterMessage(msgTest, &iotextypes.Receipt{})
+	require.True(IsExtension(msgTest))
+	require.False(IsExtension(iotexrpc.MessageType_ACTION))
+	require.Panics(func() { RegisterMessage(msgTest, &iotextypes.Log{}) })
+	require.Panics(func() { RegisterMessage(msgTest+1, &iotextypes.Receipt{}) })
+
+	// the messages of iotex-proto and the registered messages are both supported
+	msgType, body, err := convertAppMsg(&iotextypes.Action{})
+	require.NoError(err)
+	require.Equal(iotexrpc.MessageType_ACTION, msgType)
+	msg, err := typifyMessage(msgType, body)
+	require.NoError(err)
+	require.IsType(&iotextypes.Action{}, msg)
+
+	receipt := &iotextypes.Receipt{BlkHeight: 3, GasConsumed: 10000}
+	msgType, body, err = convertAppMsg(receipt)
+	require.NoError(err)
+	require.Equal(msgTest, msgType)
+	msg, err = typifyMessage(msgType, body)
+	require.NoError(err)
+	require.True(proto.Equal(receipt, msg))
+
+	_, err = typifyMessage(msgTest, []byte{0xff})
+	require.Error(err)
+	require.NotEqual(ErrUnknownMessageType, errors.Cause(err))
+	_, err = typifyMessage(msgTest+1, body)
+	require.Equal(ErrUnknownMessageType, errors.Cause(err))
+	// the message types of protocol extensions are unknown until the extensions register their messages
+	_, err = typifyMessage(iotexrpc.MessageType_PEER_STATUS, body)
+	require.Equal(ErrUnknownMessageType, errors.Cause(err))
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// Offense is the kind of misbehavior of a peer
+type Offense int
+
+const (
+	// MalformedMessage is a message which cannot be decoded
+	MalformedMessage Offense = iota
+	// InvalidAction is an action with invalid signature or content
+	InvalidAction
+	// InvalidBlock is a block with invalid signature or tx root
+	InvalidBlock
+	// InvalidSyncRequest is a block sync request with invalid range
+	InvalidSyncRequest
+)
+
+// maxTrackedPeers is the number of peers above which the peers with recovered scores are not tracked any more
+const maxTrackedPeers = 10000
+
+var (
+	offenseNames = map[Offense]string{
+		MalformedMessage:   "malformedMessage",
+		InvalidAction:      "invalidAction",
+		InvalidBlock:       "invalidBlock",
+		InvalidSyncRequest: "invalidSyncRequest",
+	}
+	offensePenalties = map[Offense]float64{
+		MalformedMessage:   20,
+		InvalidAction:      10,
+		InvalidBlock:       50,
+		InvalidSyncRequest: 20,
+	}
+
+	peerScoreGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_p2p_peer_score",
+			Help: "Reputation score of the misbehaving peers",
+		},
+		[]string{"peer"},
+	)
+	peerOffenseCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_p2p_peer_offense",
+			Help: "Misbehavior of the peers",
+		},
+		[]string{"offense"},
+	)
+	bannedPeersGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "iotex_p2p_banned_peers",
+			Help: "Number of the banned peers",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(peerScoreGauge)
+	prometheus.MustRegister(peerOffenseCounter)
+	prometheus.MustRegister(bannedPeersGauge)
+}
+
+func (o Offense) String() string {
+	if name, ok := offenseNames[o]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+type (
+	// Reputation scores the peers by their misbehavior reported by the components handling the inbound messages,
+	// and bans the peers whose scores drop below the threshold for a while. The agent cuts a banned peer off by
+	// closing its connection, dropping its messages and leaving it out of the neighbors.
+	Reputation struct {
+		mutex sync.Mutex
+		cfg   config.Reputation
+		clock clock.Clock
+		peers map[string]*peerReputation
+		onBan func(string)
+	}
+
+	// PeerReputation is the reputation of a peer
+	PeerReputation struct {
+		ID          string     `json:"id"`
+		Score       float64    `json:"score"`
+		BannedUntil *time.Time `json:"bannedUntil,omitempty"`
+	}
+
+	peerReputation struct {
+		score       float64
+		updated     time.Time
+		bannedUntil time.Time
+	}
+
+	peerCtxKey struct{}
+
+	peerCtx struct {
+		id         string
+		reputation *Reputation
+	}
+)
+
+// NewReputation creates a peer reputation
+func NewReputation(cfg config.Reputation) *Reputation {
+	return newReputation(cfg, clock.New())
+}
+
+func newReputation(cfg config.Reputation, c clock.Clock) *Reputation {
+	return &Reputation{
+		cfg:   cfg,
+		clock: c,
+		peers: map[string]*peerReputation{},
+	}
+}
+
+// WithPeerReputation adds the peer sending the inbound message into context, so that the components handling the
+// message could report the misbehavior of the peer
+func WithPeerReputation(ctx context.Context, peerID string, r *Reputation) context.Context {
+	return context.WithValue(ctx, peerCtxKey{}, peerCtx{id: peerID, reputation: r})
+}
+
+// ReportPeer reports the misbehavior of the peer sending the inbound message in context. It does nothing if the
+// message is not from a peer, e.g., an action sent via API.
+func ReportPeer(ctx context.Context, offense Offense) {
+	pc, ok := ctx.Value(peerCtxKey{}).(peerCtx)
+	if !ok || pc.reputation == nil {
+		return
+	}
+	pc.reputation.Report(pc.id, offense)
+}
+
+// GetPeerID returns the ID of the peer sending the inbound message in context
+func GetPeerID(ctx context.Context) (string, bool) {
+	pc, ok := ctx.Value(peerCtxKey{}).(peerCtx)
+	return pc.id, ok
+}
+
+// OnBan sets the handler called with the ID of each peer banned
+func (r *Reputation) OnBan(handler func(string)) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.onBan = handler
+}
+
+// Report lowers the score of the peer for the offense, and returns true if the peer gets banned
+func (r *Reputation) Report(peerID string, offense Offense) bool {
+	if !r.report(peerID, offense) {
+		return false
+	}
+	r.notifyBan(peerID)
+	return true
+}
+
+func (r *Reputation) report(peerID string, offense Offense) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	now := r.clock.Now()
+	peerOffenseCounter.WithLabelValues(offense.String()).Inc()
+	if len(r.peers) > maxTrackedPeers {
+		r.prune(now)
+	}
+	pr, ok := r.peers[peerID]
+	if !ok {
+		pr = &peerReputation{updated: now}
+		r.peers[peerID] = pr
+	}
+	score := r.score(pr, now) - offensePenalties[offense]
+	pr.score, pr.updated = score, now
+	peerScoreGauge.WithLabelValues(peerID).Set(score)
+	log.L().Debug("Peer misbehaves.",
+		zap.String("peer", peerID),
+		zap.Stringer("offense", offense),
+		zap.Float64("score", score))
+	if r.cfg.BanThreshold == 0 || score >= r.cfg.BanThreshold || now.Before(pr.bannedUntil) {
+		return false
+	}
+	log.L().Warn("Ban peer.",
+		zap.String("peer", peerID),
+		zap.Float64("score", score),
+		zap.Duration("duration", r.cfg.BanDuration))
+	r.ban(peerID, pr, now.Add(r.cfg.BanDuration))
+	return true
+}
+
+// Banned returns true if the peer is banned
+func (r *Reputation) Banned(peerID string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	pr, ok := r.peers[peerID]
+	return ok && r.clock.Now().Before(pr.bannedUntil)
+}
+
+// Ban bans the peer for the duration
+func (r *Reputation) Ban(peerID string, d time.Duration) {
+	r.mutex.Lock()
+	now := r.clock.Now()
+	pr, ok := r.peers[peerID]
+	if !ok {
+		pr = &peerReputation{updated: now}
+		r.peers[peerID] = pr
+	}
+	r.ban(peerID, pr, now.Add(d))
+	r.mutex.Unlock()
+	r.notifyBan(peerID)
+}
+
+// Unban lifts the ban of the peer, and resets its score
+func (r *Reputation) Unban(peerID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.peers, peerID)
+	peerScoreGauge.DeleteLabelValues(peerID)
+	r.updateBannedGauge(r.clock.Now())
+}
+
+// Peers returns the reputation of the misbehaving and banned peers, the lowest score first
+func (r *Reputation) Peers() []PeerReputation {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	now := r.clock.Now()
+	r.prune(now)
+	peers := make([]PeerReputation, 0, len(r.peers))
+	for id, pr := range r.peers {
+		p := PeerReputation{ID: id, Score: r.score(pr, now)}
+		if now.Before(pr.bannedUntil) {
+			bannedUntil := pr.bannedUntil
+			p.BannedUntil = &bannedUntil
+		}
+		peers = append(peers, p)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].Score != peers[j].Score {
+			return peers[i].Score < peers[j].Score
+		}
+		return peers[i].ID < peers[j].ID
+	})
+	return peers
+}
+
+// Handle handles admin request. Path /peers returns the reputation of the peers. Query "ban" bans the given peer for
+// the ban duration, and query "unban" lifts the ban of the given peer.
+func (r *Reputation) Handle(w http.ResponseWriter, req *http.Request) {
+	if !strings.HasSuffix(req.URL.Path, "/peers") {
+		http.NotFound(w, req)
+		return
+	}
+	query := req.URL.Query()
+	if id := query.Get("ban"); id != "" {
+		log.L().Info("Ban peer by admin.", zap.String("peer", id))
+		r.Ban(id, r.cfg.BanDuration)
+	}
+	if id := query.Get("unban"); id != "" {
+		log.L().Info("Unban peer by admin.", zap.String("peer", id))
+		r.Unban(id)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(r.Peers()); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// score returns the score of the peer at the time, which recovers by half every half life
+func (r *Reputation) score(pr *peerReputation, now time.Time) float64 {
+	if r.cfg.ScoreHalfLife <= 0 || !now.After(pr.updated) {
+		return pr.score
+	}
+	return pr.score * math.Pow(0.5, float64(now.Sub(pr.updated))/float64(r.cfg.ScoreHalfLife))
+}
+
+func (r *Reputation) ban(peerID string, pr *peerReputation, until time.Time) {
+	// the peer starts over when the ban is lifted
+	pr.score, pr.updated, pr.bannedUntil = 0, until, until
+	peerScoreGauge.WithLabelValues(peerID).Set(0)
+	r.updateBannedGauge(r.clock.Now())
+}
+
+// notifyBan calls the ban handler out of the lock, so that it may query the reputation
+func (r *Reputation) notifyBan(peerID string) {
+	r.mutex.Lock()
+	onBan := r.onBan
+	r.mutex.Unlock()
+	if onBan != nil {
+		onBan(peerID)
+	}
+}
+
+// prune stops tracking the peers neither banned nor having a low score any more
+func (r *Reputation) prune(now time.Time) {
+	for id, pr := range r.peers {
+		if now.Before(pr.bannedUntil) || r.score(pr, now) <= -1 {
+			continue
+		}
+		delete(r.peers, id)
+		peerScoreGauge.DeleteLabelValues(id)
+	}
+	r.updateBannedGauge(now)
+}
+
+func (r *Reputation) updateBannedGauge(now time.Time) {
+	banned := 0
+	for _, pr := range r.peers {
+		if now.Before(pr.bannedUntil) {
+			banned++
+		}
+	}
+	bannedPeersGauge.Set(float64(banned))
+}
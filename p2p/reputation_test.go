@@ -0,0 +1,119 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+)
+
+func TestReputation(t *testing.T) {
+	require := require.New(t)
+
+	c := clock.NewMock()
+	r := newReputation(config.Default.Network.Reputation, c)
+	halfLife := config.Default.Network.Reputation.ScoreHalfLife
+
+	// the score recovers by half every half life
+	require.False(r.Report("peer1", InvalidBlock))
+	c.Add(halfLife)
+	require.False(r.Report("peer1", InvalidAction))
+	peers := r.Peers()
+	require.Len(peers, 1)
+	require.InDelta(-35, peers[0].Score, 0.001)
+	require.Nil(peers[0].BannedUntil)
+
+	// the peer is banned below the threshold
+	ctx := WithPeerReputation(context.Background(), "peer2", r)
+	ReportPeer(ctx, InvalidBlock)
+	ReportPeer(ctx, InvalidBlock)
+	require.False(r.Banned("peer2"))
+	ReportPeer(ctx, MalformedMessage)
+	require.True(r.Banned("peer2"))
+	require.False(r.Banned("peer1"))
+	ReportPeer(context.Background(), InvalidBlock)
+	peers = r.Peers()
+	require.Len(peers, 2)
+	require.Equal("peer1", peers[0].ID)
+	require.Equal("peer2", peers[1].ID)
+	require.Equal(float64(0), peers[1].Score)
+	require.NotNil(peers[1].BannedUntil)
+
+	// the ban is lifted after the ban duration, and the recovered peers are not tracked any more
+	c.Add(config.Default.Network.Reputation.BanDuration)
+	require.False(r.Banned("peer2"))
+	peers = r.Peers()
+	require.Len(peers, 1)
+	require.Equal("peer1", peers[0].ID)
+	c.Add(config.Default.Network.Reputation.BanDuration)
+	require.Empty(r.Peers())
+
+	// no ban with 0 threshold
+	cfg := config.Default.Network.Reputation
+	cfg.BanThreshold = 0
+	r = newReputation(cfg, c)
+	for i := 0; i < 10; i++ {
+		require.False(r.Report("peer1", InvalidBlock))
+	}
+	require.False(r.Banned("peer1"))
+}
+
+func TestReputationHandle(t *testing.T) {
+	require := require.New(t)
+
+	r := newReputation(config.Default.Network.Reputation, clock.NewMock())
+	r.Report("peer1", MalformedMessage)
+	get := func(url string) []PeerReputation {
+		w := httptest.NewRecorder()
+		r.Handle(w, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(http.StatusOK, w.Code)
+		var peers []PeerReputation
+		require.NoError(json.Unmarshal(w.Body.Bytes(), &peers))
+		return peers
+	}
+	peers := get("/p2p/peers")
+	require.Len(peers, 1)
+	require.Equal(float64(-20), peers[0].Score)
+
+	peers = get("/p2p/peers?ban=peer2")
+	require.Len(peers, 2)
+	require.True(r.Banned("peer2"))
+	require.NotNil(peers[1].BannedUntil)
+	peers = get("/p2p/peers?unban=peer2")
+	require.Len(peers, 1)
+	require.False(r.Banned("peer2"))
+
+	w := httptest.NewRecorder()
+	r.Handle(w, httptest.NewRequest(http.MethodGet, "/p2p/unknown", nil))
+	require.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestReputationOnBan(t *testing.T) {
+	require := require.New(t)
+
+	r := newReputation(config.Default.Network.Reputation, clock.NewMock())
+	var banned []string
+	r.OnBan(func(peerID string) {
+		// the handler may query the reputation
+		require.True(r.Banned(peerID))
+		banned = append(banned, peerID)
+	})
+	require.False(r.Report("peer1", InvalidBlock))
+	require.False(r.Report("peer1", InvalidBlock))
+	require.Empty(banned)
+	require.True(r.Report("peer1", MalformedMessage))
+	r.Ban("peer2", config.Default.Network.Reputation.BanDuration)
+	require.Equal([]string{"peer1", "peer2"}, banned)
+}
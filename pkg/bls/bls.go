@@ -0,0 +1,234 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// Package bls implements BLS signatures on the BLS12-381 curve in the minimal-signature-size variant of
+// draft-irtf-cfrg-bls-signature with proofs of possession. Signatures are points of G1 and public keys are points of
+// G2, so that the signatures of a message by many signers aggregate into one 48-byte signature. Messages are hashed to
+// G1 by the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite of draft-irtf-cfrg-hash-to-curve.
+package bls
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+const (
+	// PrivateKeyLength is the length of a serialized private key
+	PrivateKeyLength = 32
+	// PublicKeyLength is the length of a serialized (compressed) public key
+	PublicKeyLength = 96
+	// SignatureLength is the length of a serialized (compressed) signature
+	SignatureLength = 48
+)
+
+var (
+	// ErrInvalidKey indicates an invalid BLS key
+	ErrInvalidKey = errors.New("invalid BLS key")
+	// ErrInvalidSignature indicates an invalid BLS signature
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+
+	// domain separation tags of the ciphersuites BLS_SIG_ and BLS_POP_ of the proof of possession scheme
+	signatureDomain  = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+	possessionDomain = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+	derivationDomain = []byte("BLS_KEY_DERIVATION")
+
+	// order is the order r of G1 and G2
+	order = bls12381.NewG1().Q()
+)
+
+type (
+	// PrivateKey is a BLS private key
+	PrivateKey struct {
+		k *big.Int
+	}
+
+	// PublicKey is a BLS public key
+	PublicKey struct {
+		p *bls12381.PointG2
+	}
+
+	// Signature is a BLS signature, or an aggregate of signatures on the same message
+	Signature struct {
+		p *bls12381.PointG1
+	}
+)
+
+// GenerateKey generates a random private key
+func GenerateKey() (*PrivateKey, error) {
+	k, err := rand.Int(rand.Reader, order)
+	if err != nil {
+		return nil, err
+	}
+	if k.Sign() == 0 {
+		return GenerateKey()
+	}
+	return &PrivateKey{k: k}, nil
+}
+
+// DerivePrivateKey derives a private key from a secret seed, e.g., the bytes of the block producer key
+func DerivePrivateKey(seed []byte) (*PrivateKey, error) {
+	if len(seed) == 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "empty seed")
+	}
+	for ctr := uint64(0); ; ctr++ {
+		h := hash.Hash256b(append(append(append([]byte{}, derivationDomain...), seed...), byteutil.Uint64ToBytes(ctr)...))
+		k := new(big.Int).SetBytes(h[:])
+		if k.Sign() != 0 && k.Cmp(order) < 0 {
+			return &PrivateKey{k: k}, nil
+		}
+	}
+}
+
+// PrivateKeyFromBytes loads a private key
+func PrivateKeyFromBytes(b []byte) (*PrivateKey, error) {
+	if len(b) != PrivateKeyLength {
+		return nil, errors.Wrapf(ErrInvalidKey, "private key length %d", len(b))
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(order) >= 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "private key out of range")
+	}
+	return &PrivateKey{k: k}, nil
+}
+
+// Bytes returns the serialized private key
+func (sk *PrivateKey) Bytes() []byte {
+	b := make([]byte, PrivateKeyLength)
+	kb := sk.k.Bytes()
+	copy(b[PrivateKeyLength-len(kb):], kb)
+	return b
+}
+
+// PublicKey returns the public key of the private key
+func (sk *PrivateKey) PublicKey() *PublicKey {
+	g2 := bls12381.NewG2()
+	return &PublicKey{p: g2.MulScalarBig(g2.New(), g2.One(), sk.k)}
+}
+
+// Sign signs a message
+func (sk *PrivateKey) Sign(msg []byte) *Signature {
+	return sk.sign(signatureDomain, msg)
+}
+
+// ProvePossession signs the public key of the private key, which prevents rogue key attacks on aggregate signatures
+func (sk *PrivateKey) ProvePossession() *Signature {
+	return sk.sign(possessionDomain, sk.PublicKey().Bytes())
+}
+
+func (sk *PrivateKey) sign(domain []byte, msg []byte) *Signature {
+	g1 := bls12381.NewG1()
+	h, err := g1.HashToCurve(msg, domain)
+	if err != nil {
+		// only fails on a domain separation tag longer than 255 bytes
+		panic(err)
+	}
+	return &Signature{p: g1.MulScalarBig(g1.New(), h, sk.k)}
+}
+
+// PublicKeyFromBytes loads a public key
+func PublicKeyFromBytes(b []byte) (*PublicKey, error) {
+	if len(b) != PublicKeyLength {
+		return nil, errors.Wrapf(ErrInvalidKey, "public key length %d", len(b))
+	}
+	g2 := bls12381.NewG2()
+	// decompression rejects the points outside of the subgroup of G2
+	p, err := g2.FromCompressed(b)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	if g2.IsZero(p) {
+		return nil, errors.Wrap(ErrInvalidKey, "public key is the point at infinity")
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// Bytes returns the serialized public key
+func (pk *PublicKey) Bytes() []byte {
+	return bls12381.NewG2().ToCompressed(pk.p)
+}
+
+// Verify checks the signature of a message
+func (pk *PublicKey) Verify(msg []byte, sig *Signature) bool {
+	return verify(pk, signatureDomain, msg, sig)
+}
+
+// VerifyPossession checks the proof of possession of the public key
+func (pk *PublicKey) VerifyPossession(proof *Signature) bool {
+	return verify(pk, possessionDomain, pk.Bytes(), proof)
+}
+
+// AggregatePublicKeys aggregates public keys, against which the aggregate signature of the same message is verified
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "no public key to aggregate")
+	}
+	g2 := bls12381.NewG2()
+	p := g2.New().Set(pks[0].p)
+	for _, pk := range pks[1:] {
+		g2.Add(p, p, pk.p)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// SignatureFromBytes loads a signature
+func SignatureFromBytes(b []byte) (*Signature, error) {
+	if len(b) != SignatureLength {
+		return nil, errors.Wrapf(ErrInvalidSignature, "signature length %d", len(b))
+	}
+	g1 := bls12381.NewG1()
+	// decompression rejects the points outside of the subgroup of G1
+	p, err := g1.FromCompressed(b)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	if g1.IsZero(p) {
+		return nil, errors.Wrap(ErrInvalidSignature, "signature is the point at infinity")
+	}
+	return &Signature{p: p}, nil
+}
+
+// Bytes returns the serialized signature
+func (sig *Signature) Bytes() []byte {
+	return bls12381.NewG1().ToCompressed(sig.p)
+}
+
+// AggregateSignatures aggregates the signatures of a message by different signers
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.Wrap(ErrInvalidSignature, "no signature to aggregate")
+	}
+	g1 := bls12381.NewG1()
+	p := g1.New().Set(sigs[0].p)
+	for _, sig := range sigs[1:] {
+		g1.Add(p, p, sig.p)
+	}
+	return &Signature{p: p}, nil
+}
+
+// verify checks e(sig, g2) == e(H(msg), pk)
+func verify(pk *PublicKey, domain []byte, msg []byte, sig *Signature) bool {
+	if pk == nil || pk.p == nil || sig == nil || sig.p == nil {
+		return false
+	}
+	engine := bls12381.NewEngine()
+	if engine.G1.IsZero(sig.p) || engine.G2.IsZero(pk.p) {
+		return false
+	}
+	h, err := engine.G1.HashToCurve(msg, domain)
+	if err != nil {
+		return false
+	}
+	// the engine normalizes the points in place, so it is given copies
+	return engine.AddPair(engine.G1.New().Set(sig.p), engine.G2.One()).
+		AddPairInv(h, engine.G2.New().Set(pk.p)).
+		Check()
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKey()
+	require.NoError(err)
+	sk2, err := PrivateKeyFromBytes(sk.Bytes())
+	require.NoError(err)
+	require.Equal(sk.Bytes(), sk2.Bytes())
+
+	msg := []byte("commit block")
+	sig := sk.Sign(msg)
+	pk, err := PublicKeyFromBytes(sk.PublicKey().Bytes())
+	require.NoError(err)
+	require.True(pk.Verify(msg, sig))
+	require.False(pk.Verify([]byte("another block"), sig))
+
+	sig2, err := SignatureFromBytes(sig.Bytes())
+	require.NoError(err)
+	require.True(pk.Verify(msg, sig2))
+
+	other, err := GenerateKey()
+	require.NoError(err)
+	require.False(other.PublicKey().Verify(msg, sig))
+
+	// proof of possession does not verify as a signature of the public key
+	proof := sk.ProvePossession()
+	require.True(pk.VerifyPossession(proof))
+	require.False(pk.Verify(pk.Bytes(), proof))
+	require.False(other.PublicKey().VerifyPossession(proof))
+}
+
+func TestDerivePrivateKey(t *testing.T) {
+	require := require.New(t)
+
+	sk1, err := DerivePrivateKey([]byte("producer key"))
+	require.NoError(err)
+	sk2, err := DerivePrivateKey([]byte("producer key"))
+	require.NoError(err)
+	require.Equal(sk1.Bytes(), sk2.Bytes())
+	sk3, err := DerivePrivateKey([]byte("another producer key"))
+	require.NoError(err)
+	require.NotEqual(sk1.Bytes(), sk3.Bytes())
+
+	_, err = DerivePrivateKey(nil)
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+}
+
+func TestAggregate(t *testing.T) {
+	require := require.New(t)
+
+	msg := []byte("commit block")
+	var (
+		pks  []*PublicKey
+		sigs []*Signature
+	)
+	for i := 0; i < 5; i++ {
+		sk, err := GenerateKey()
+		require.NoError(err)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sk.Sign(msg))
+	}
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(err)
+	aggPK, err := AggregatePublicKeys(pks)
+	require.NoError(err)
+	require.True(aggPK.Verify(msg, aggSig))
+
+	// missing a signer
+	partialPK, err := AggregatePublicKeys(pks[:4])
+	require.NoError(err)
+	require.False(partialPK.Verify(msg, aggSig))
+	partialSig, err := AggregateSignatures(sigs[1:])
+	require.NoError(err)
+	require.False(aggPK.Verify(msg, partialSig))
+
+	_, err = AggregateSignatures(nil)
+	require.Equal(ErrInvalidSignature, errors.Cause(err))
+	_, err = AggregatePublicKeys(nil)
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+}
+
+func TestInvalidBytes(t *testing.T) {
+	require := require.New(t)
+
+	_, err := PrivateKeyFromBytes(make([]byte, PrivateKeyLength))
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+	_, err = PrivateKeyFromBytes([]byte{1, 2, 3})
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+	_, err = PublicKeyFromBytes(make([]byte, PublicKeyLength))
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+	_, err = PublicKeyFromBytes([]byte{1, 2, 3})
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+	_, err = SignatureFromBytes(make([]byte, SignatureLength))
+	require.Equal(ErrInvalidSignature, errors.Cause(err))
+	b := make([]byte, SignatureLength)
+	b[0] = 1
+	_, err = SignatureFromBytes(b)
+	require.Equal(ErrInvalidSignature, errors.Cause(err))
+
+	// compressed points at infinity
+	b[0] = 0xc0
+	_, err = SignatureFromBytes(b)
+	require.Equal(ErrInvalidSignature, errors.Cause(err))
+	b = make([]byte, PublicKeyLength)
+	b[0] = 0xc0
+	_, err = PublicKeyFromBytes(b)
+	require.Equal(ErrInvalidKey, errors.Cause(err))
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package compress
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"hash/fnv"
+	"io/ioutil"
+	"sort"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// CodecID identifies the codec of the data encoded. It is stored along with the data, so the IDs must never change.
+type CodecID byte
+
+// Codecs supported
+const (
+	NoCompression CodecID = iota + 1
+	Gzip
+	Snappy
+	DeflateDict
+)
+
+// Names of the codecs in config
+const (
+	NoCompressionName = "none"
+	GzipName          = "gzip"
+	SnappyName        = "snappy"
+	DeflateDictName   = "deflateDict"
+)
+
+const (
+	// tagMark starts the data encoded with a codec tag. Neither a serialized protobuf message nor a gzip stream
+	// starts with 0x00, so the data written before the codecs are tagged is still recognized.
+	tagMark = 0x00
+	// dictSegmentLen is the length of the segments picked into a trained dictionary
+	dictSegmentLen = 16
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+type (
+	// Codec compresses and decompresses data
+	Codec interface {
+		ID() CodecID
+		Compress([]byte) ([]byte, error)
+		Decompress([]byte) ([]byte, error)
+	}
+
+	// DictionaryCodec is a codec with a preset dictionary, which has to be kept to decompress the data
+	DictionaryCodec interface {
+		Codec
+		Dictionary() []byte
+	}
+
+	// Dictionaries returns the dictionary of the ID
+	Dictionaries func(id uint32) ([]byte, error)
+
+	noCompressionCodec struct{}
+
+	gzipCodec struct{}
+
+	snappyCodec struct{}
+
+	// deflateDictCodec is deflate with a preset dictionary, and the compressed data start with the dictionary ID
+	deflateDictCodec struct {
+		dict []byte
+		id   uint32
+	}
+)
+
+// NewCodec creates the codec of the name. The dictionary is required by the deflateDict codec only.
+func NewCodec(name string, dict []byte) (Codec, error) {
+	switch name {
+	case NoCompressionName:
+		return noCompressionCodec{}, nil
+	case GzipName:
+		return gzipCodec{}, nil
+	case SnappyName:
+		return snappyCodec{}, nil
+	case DeflateDictName:
+		if len(dict) == 0 {
+			return nil, errors.New("deflateDict codec requires a dictionary")
+		}
+		return newDeflateDictCodec(dict), nil
+	default:
+		return nil, errors.Errorf("unknown codec %s", name)
+	}
+}
+
+// LoadCodec creates the codec of the name with the dictionary in the file. It returns nil if the name is empty.
+func LoadCodec(name, dictFile string) (Codec, error) {
+	if name == "" {
+		return nil, nil
+	}
+	var dict []byte
+	if dictFile != "" {
+		var err error
+		if dict, err = ioutil.ReadFile(dictFile); err != nil {
+			return nil, errors.Wrapf(err, "failed to read dictionary file %s", dictFile)
+		}
+	}
+	return NewCodec(name, dict)
+}
+
+// Encode compresses the data with the codec, and tags it with the codec
+func Encode(c Codec, data []byte) ([]byte, error) {
+	compressed, err := c.Compress(data)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{tagMark, byte(c.ID())}, compressed...), nil
+}
+
+// Decode decompresses the data with the codec it is tagged with. The data without tag is either gzip compressed or
+// not compressed at all, which is written before the codecs are tagged.
+func Decode(data []byte, dicts Dictionaries) ([]byte, error) {
+	if len(data) < 2 || data[0] != tagMark {
+		if bytes.HasPrefix(data, gzipMagic) {
+			return Decompress(data)
+		}
+		return data, nil
+	}
+	payload := data[2:]
+	switch id := CodecID(data[1]); id {
+	case NoCompression:
+		return payload, nil
+	case Gzip:
+		return gzipCodec{}.Decompress(payload)
+	case Snappy:
+		return snappyCodec{}.Decompress(payload)
+	case DeflateDict:
+		if len(payload) < 4 {
+			return nil, errors.New("deflateDict data is too short")
+		}
+		if dicts == nil {
+			return nil, errors.New("no dictionary to decode deflateDict data")
+		}
+		dict, err := dicts(binary.BigEndian.Uint32(payload))
+		if err != nil {
+			return nil, err
+		}
+		return newDeflateDictCodec(dict).Decompress(payload)
+	default:
+		return nil, errors.Errorf("unknown codec %d", id)
+	}
+}
+
+// DictionaryID returns the ID of the dictionary
+func DictionaryID(dict []byte) uint32 {
+	return crc32.ChecksumIEEE(dict)
+}
+
+// TrainDictionary trains a dictionary of the size from the samples. It picks the segments recurring in most samples,
+// and puts the most frequent ones at the end of the dictionary, which are the cheapest to refer to. The segments are
+// sampled by their content rather than their offsets, so that a segment is counted wherever it appears.
+func TrainDictionary(samples [][]byte, size int) []byte {
+	counts := map[string]int{}
+	for _, sample := range samples {
+		seen := map[string]struct{}{}
+		for i := 0; i+dictSegmentLen <= len(sample); i++ {
+			segment := sample[i : i+dictSegmentLen]
+			h := fnv.New32a()
+			h.Write(segment)
+			if h.Sum32()%4 != 0 {
+				continue
+			}
+			seen[string(segment)] = struct{}{}
+		}
+		for segment := range seen {
+			counts[segment]++
+		}
+	}
+	segments := make([]string, 0, len(counts))
+	for segment, count := range counts {
+		if count > 1 {
+			segments = append(segments, segment)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if counts[segments[i]] != counts[segments[j]] {
+			return counts[segments[i]] > counts[segments[j]]
+		}
+		return segments[i] < segments[j]
+	})
+	if n := size / dictSegmentLen; len(segments) > n {
+		segments = segments[:n]
+	}
+	dict := make([]byte, 0, len(segments)*dictSegmentLen)
+	for i := len(segments) - 1; i >= 0; i-- {
+		dict = append(dict, segments[i]...)
+	}
+	return dict
+}
+
+func (noCompressionCodec) ID() CodecID { return NoCompression }
+
+func (noCompressionCodec) Compress(data []byte) ([]byte, error) { return data, nil }
+
+func (noCompressionCodec) Decompress(data []byte) ([]byte, error) { return data, nil }
+
+func (gzipCodec) ID() CodecID { return Gzip }
+
+func (gzipCodec) Compress(data []byte) ([]byte, error) { return Compress(data) }
+
+func (gzipCodec) Decompress(data []byte) ([]byte, error) { return Decompress(data) }
+
+func (snappyCodec) ID() CodecID { return Snappy }
+
+func (snappyCodec) Compress(data []byte) ([]byte, error) { return snappy.Encode(nil, data), nil }
+
+func (snappyCodec) Decompress(data []byte) ([]byte, error) { return snappy.Decode(nil, data) }
+
+func newDeflateDictCodec(dict []byte) *deflateDictCodec {
+	return &deflateDictCodec{dict: dict, id: DictionaryID(dict)}
+}
+
+func (c *deflateDictCodec) ID() CodecID { return DeflateDict }
+
+func (c *deflateDictCodec) Dictionary() []byte { return c.dict }
+
+func (c *deflateDictCodec) Compress(data []byte) ([]byte, error) {
+	var bb bytes.Buffer
+	if err := binary.Write(&bb, binary.BigEndian, c.id); err != nil {
+		return nil, err
+	}
+	w, err := flate.NewWriterDict(&bb, flate.BestCompression, c.dict)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
+
+func (c *deflateDictCodec) Decompress(data []byte) ([]byte, error) {
+	if len(data) < 4 || binary.BigEndian.Uint32(data) != c.id {
+		return nil, errors.Errorf("data is not compressed with dictionary %d", c.id)
+	}
+	r := flate.NewReaderDict(bytes.NewReader(data[4:]), c.dict)
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package compress
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	require := require.New(t)
+
+	samples := make([][]byte, 20)
+	for i := range samples {
+		samples[i] = []byte(fmt.Sprintf(
+			"{\"sender\":\"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms\",\"nonce\":%d,\"amount\":\"%d\",\"gasLimit\":10000}",
+			i, i*1000))
+	}
+	dict := TrainDictionary(samples, 1024)
+	require.NotEmpty(dict)
+	require.True(len(dict) <= 1024)
+	// the segments shared by the samples are picked
+	require.Equal(0, len(dict)%dictSegmentLen)
+	require.True(bytes.Contains(samples[0], dict[len(dict)-dictSegmentLen:]))
+	dicts := func(id uint32) ([]byte, error) {
+		if id != DictionaryID(dict) {
+			return nil, errors.Errorf("dictionary %d not found", id)
+		}
+		return dict, nil
+	}
+
+	data := samples[3]
+	for _, name := range []string{NoCompressionName, GzipName, SnappyName, DeflateDictName} {
+		c, err := NewCodec(name, dict)
+		require.NoError(err)
+		encoded, err := Encode(c, data)
+		require.NoError(err)
+		require.Equal(byte(tagMark), encoded[0])
+		require.Equal(byte(c.ID()), encoded[1])
+		decoded, err := Decode(encoded, dicts)
+		require.NoError(err)
+		require.Equal(data, decoded, name)
+	}
+
+	// the dictionary makes the small data smaller
+	c, err := NewCodec(DeflateDictName, dict)
+	require.NoError(err)
+	withDict, err := c.Compress(data)
+	require.NoError(err)
+	withoutDict, err := Compress(data)
+	require.NoError(err)
+	require.True(len(withDict) < len(withoutDict))
+
+	// the data without tag is either gzip compressed or not compressed
+	decoded, err := Decode(withoutDict, nil)
+	require.NoError(err)
+	require.Equal(data, decoded)
+	decoded, err = Decode(data, nil)
+	require.NoError(err)
+	require.Equal(data, decoded)
+
+	// the dictionary is required to decode the data with dictionary
+	encoded, err := Encode(c, data)
+	require.NoError(err)
+	_, err = Decode(encoded, nil)
+	require.Error(err)
+	_, err = NewCodec(DeflateDictName, nil)
+	require.Error(err)
+	_, err = NewCodec("zip", nil)
+	require.Error(err)
+	_, err = Decode([]byte{tagMark, 0xff, 1}, nil)
+	require.Error(err)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+// Failover switches the node between active and stand-by mode with a lease shared by the nodes of the same producer
+// key, so that exactly one of them is active at a time. The node holding the lease is active and renews the lease
+// every quarter of the lease ttl. It goes stand-by as soon as it loses the lease, or a quarter of the ttl before the
+// lease expires if it cannot renew the lease, i.e., always before another node could take over the lease.
+type Failover struct {
+	mutex   sync.Mutex
+	c       consensus.Consensus
+	backend LockBackend
+	holder  string
+	ttl     time.Duration
+	clock   clock.Clock
+	task    *routine.RecurringTask
+	lease   Lease
+	active  bool
+}
+
+// FailoverOption sets failover construction parameter
+type FailoverOption func(*Failover)
+
+// WithFailoverClock sets the clock of the lease
+func WithFailoverClock(c clock.Clock) FailoverOption {
+	return func(f *Failover) {
+		f.clock = c
+	}
+}
+
+// NewFailover creates a failover of the consensus with the lease in the backend. The consensus should start in
+// stand-by mode.
+func NewFailover(
+	c consensus.Consensus,
+	backend LockBackend,
+	holder string,
+	ttl time.Duration,
+	opts ...FailoverOption,
+) (*Failover, error) {
+	if backend == nil {
+		return nil, errors.New("lock backend cannot be nil")
+	}
+	if holder == "" {
+		return nil, errors.New("lease holder cannot be empty")
+	}
+	if ttl < time.Second {
+		return nil, errors.Errorf("lease ttl %s is too short", ttl)
+	}
+	f := &Failover{
+		c:       c,
+		backend: backend,
+		holder:  holder,
+		ttl:     ttl,
+		clock:   clock.New(),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	f.task = routine.NewRecurringTask(f.Check, ttl/4)
+	return f, nil
+}
+
+// Start starts the failover
+func (f *Failover) Start(ctx context.Context) error {
+	f.Check()
+	return f.task.Start(ctx)
+}
+
+// Stop stops the failover, and hands the lease over to the other nodes if the node is active
+func (f *Failover) Stop(ctx context.Context) error {
+	if err := f.task.Stop(ctx); err != nil {
+		return err
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.active {
+		f.setActive(false, "node is stopping")
+	}
+	return f.backend.Release(f.holder)
+}
+
+// Check acquires or renews the lease, and switches the node mode accordingly
+func (f *Failover) Check() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	now := f.clock.Now()
+	lease, acquired, err := f.backend.TryAcquire(f.holder, now, now.Add(f.ttl))
+	if err != nil {
+		// the lease is not acquired this time if another node is updating it, which is retried on the next check
+		if errors.Cause(err) == ErrLeaseBusy {
+			log.L().Debug("Lease is busy.", zap.String("holder", f.holder))
+		} else {
+			log.L().Error("Failed to acquire the lease.", zap.String("holder", f.holder), zap.Error(err))
+		}
+		if f.active && !now.Before(f.lease.Expiry.Add(-f.ttl/4)) {
+			f.setActive(false, "lease cannot be renewed before expiry")
+		}
+		return
+	}
+	f.lease = lease
+	if acquired {
+		// the consensus signs nothing after the lease expires, even if the node is not switched to stand-by in time
+		f.c.SetLease(lease.Term, lease.Expiry)
+	}
+	switch {
+	case acquired && !f.active:
+		f.setActive(true, "lease acquired")
+	case !acquired && f.active:
+		f.setActive(false, "lease lost")
+	}
+}
+
+// Lease returns the last lease seen by the node, and whether the node is active
+func (f *Failover) Lease() (Lease, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.lease, f.active
+}
+
+func (f *Failover) setActive(active bool, reason string) {
+	log.L().Info(
+		"Switch the node mode by failover.",
+		zap.Bool("active", active),
+		zap.String("reason", reason),
+		zap.String("holder", f.lease.Holder),
+		zap.Uint64("term", f.lease.Term),
+		zap.Time("expiry", f.lease.Expiry),
+	)
+	f.active = active
+	f.c.Activate(active)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+)
+
+type flakyBackend struct {
+	LockBackend
+	fail bool
+}
+
+func (b *flakyBackend) TryAcquire(holder string, now, expiry time.Time) (Lease, bool, error) {
+	if b.fail {
+		return Lease{}, false, errors.New("backend is unavailable")
+	}
+	return b.LockBackend.TryAcquire(holder, now, expiry)
+}
+
+func TestFailover(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "failover")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "lease.json")
+	clk := clock.NewMock()
+	ttl := 8 * time.Second
+
+	newNode := func(holder string) (*Failover, *flakyBackend, *bool) {
+		active := new(bool)
+		c := mock_consensus.NewMockConsensus(ctrl)
+		c.EXPECT().Activate(gomock.Any()).Do(func(a bool) { *active = a }).AnyTimes()
+		c.EXPECT().SetLease(gomock.Any(), gomock.Any()).AnyTimes()
+		backend := &flakyBackend{LockBackend: NewFileLock(path)}
+		f, err := NewFailover(c, backend, holder, ttl, WithFailoverClock(clk))
+		require.NoError(err)
+		return f, backend, active
+	}
+	_, err = NewFailover(nil, NewFileLock(path), "", ttl)
+	require.Error(err)
+	_, err = NewFailover(nil, NewFileLock(path), "node", time.Millisecond)
+	require.Error(err)
+
+	f1, b1, active1 := newNode("node1")
+	f2, _, active2 := newNode("node2")
+	f1.Check()
+	f2.Check()
+	require.True(*active1)
+	require.False(*active2)
+	lease, active := f2.Lease()
+	require.False(active)
+	require.Equal("node1", lease.Holder)
+
+	// node1 keeps the lease by renewing it
+	for i := 0; i < 4; i++ {
+		clk.Add(ttl / 4)
+		f1.Check()
+		f2.Check()
+		require.True(*active1)
+		require.False(*active2)
+	}
+
+	// node1 goes stand-by before the lease expires if it cannot renew the lease, and node2 takes over after that
+	b1.fail = true
+	clk.Add(ttl / 4)
+	f1.Check()
+	f2.Check()
+	require.True(*active1)
+	clk.Add(ttl / 2)
+	f1.Check()
+	f2.Check()
+	require.False(*active1)
+	require.False(*active2)
+	clk.Add(ttl / 4)
+	f2.Check()
+	require.True(*active2)
+	b1.fail = false
+	f1.Check()
+	require.False(*active1)
+	lease, _ = f1.Lease()
+	require.Equal("node2", lease.Holder)
+	require.True(clk.Now().Add(ttl).Equal(lease.Expiry))
+	require.Equal(uint64(2), lease.Term)
+
+	// node2 hands the lease over when stopping
+	require.NoError(f2.Stop(context.Background()))
+	require.False(*active2)
+	f1.Check()
+	require.True(*active1)
+
+	// node1 keeps active if the lease is busy, until the lease is about to expire
+	file, err := os.OpenFile(path, os.O_RDWR, 0600)
+	require.NoError(err)
+	require.NoError(lockFile(file))
+	f1.Check()
+	require.True(*active1)
+	clk.Add(ttl * 3 / 4)
+	f1.Check()
+	require.False(*active1)
+	require.NoError(unlockFile(file))
+	require.NoError(file.Close())
+	f1.Check()
+	require.True(*active1)
+
+	// node1 goes stand-by as soon as it loses the lease
+	_, _, err = NewFileLock(path).TryAcquire("node3", clk.Now().Add(ttl), clk.Now().Add(2*ttl))
+	require.NoError(err)
+	f1.Check()
+	require.False(*active1)
+}
@@ -17,19 +17,38 @@ import (
 
 // Controller controls the node high availability status
 type Controller struct {
-	c consensus.Consensus
+	c        consensus.Consensus
+	failover *Failover
+}
+
+// Option sets HA controller construction parameter
+type Option func(*Controller)
+
+// WithFailover makes the node mode controlled by the failover, instead of the admin requests
+func WithFailover(f *Failover) Option {
+	return func(ha *Controller) {
+		ha.failover = f
+	}
 }
 
 // New constructs a HA controller instance
-func New(c consensus.Consensus) *Controller {
-	return &Controller{
+func New(c consensus.Consensus, opts ...Option) *Controller {
+	ha := &Controller{
 		c: c,
 	}
+	for _, opt := range opts {
+		opt(ha)
+	}
+	return ha
 }
 
 // Handle handles admin request
 func (ha *Controller) Handle(w http.ResponseWriter, r *http.Request) {
 	val := strings.ToLower(r.URL.Query().Get("activate"))
+	if val != "" && ha.failover != nil {
+		http.Error(w, "node mode is controlled by the failover", http.StatusConflict)
+		return
+	}
 	switch val {
 	case "true":
 		log.S().Info("Set the node to active mode")
@@ -39,10 +58,16 @@ func (ha *Controller) Handle(w http.ResponseWriter, r *http.Request) {
 		ha.c.Activate(false)
 	case "":
 		type payload struct {
-			Active bool `json:"active"`
+			Active bool   `json:"active"`
+			Lease  *Lease `json:"lease,omitempty"`
+		}
+		p := payload{Active: ha.c.Active()}
+		if ha.failover != nil {
+			lease, _ := ha.failover.Lease()
+			p.Lease = &lease
 		}
 		enc := json.NewEncoder(w)
-		if err := enc.Encode(&payload{Active: ha.c.Active()}); err != nil {
+		if err := enc.Encode(&p); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
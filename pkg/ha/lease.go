@@ -0,0 +1,127 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrLeaseBusy indicates the lease is being updated by another node, so it is not acquired this time
+var ErrLeaseBusy = errors.New("lease is busy")
+
+type (
+	// Lease is the right of a node to produce blocks with the producer key shared by the nodes, until the expiry
+	Lease struct {
+		Holder string    `json:"holder"`
+		Expiry time.Time `json:"expiry"`
+		// Term increases every time the lease changes hands
+		Term uint64 `json:"term"`
+	}
+
+	// LockBackend stores the lease shared by the nodes
+	LockBackend interface {
+		// TryAcquire acquires or renews the lease for the holder until the expiry, if the lease is free, expired at
+		// now, or held by the holder already. It returns the current lease, and whether the holder holds it. It
+		// returns ErrLeaseBusy instead of waiting if another node is updating the lease.
+		TryAcquire(holder string, now, expiry time.Time) (Lease, bool, error)
+		// Release releases the lease if it is held by the holder
+		Release(holder string) error
+	}
+
+	// FileLock is the lock backend keeping the lease in a file guarded by a file lock, which works for the nodes
+	// sharing a host or a file system, and stands in for a distributed lock service in tests
+	FileLock struct {
+		path string
+	}
+)
+
+// Free returns true if the lease is not held by anyone at the time
+func (l Lease) Free(now time.Time) bool {
+	return l.Holder == "" || !now.Before(l.Expiry)
+}
+
+// NewFileLock creates a file lock backend with the lease file path
+func NewFileLock(path string) *FileLock {
+	return &FileLock{path: path}
+}
+
+// TryAcquire acquires or renews the lease in the file
+func (fl *FileLock) TryAcquire(holder string, now, expiry time.Time) (Lease, bool, error) {
+	var (
+		lease    Lease
+		acquired bool
+	)
+	err := fl.update(func(cur Lease) (Lease, bool) {
+		if cur.Holder != holder && !cur.Free(now) {
+			lease = cur
+			return cur, false
+		}
+		lease = Lease{Holder: holder, Expiry: expiry, Term: cur.Term}
+		if cur.Holder != holder {
+			lease.Term++
+		}
+		acquired = true
+		return lease, true
+	})
+	if err != nil {
+		return Lease{}, false, err
+	}
+	return lease, acquired, nil
+}
+
+// Release releases the lease in the file
+func (fl *FileLock) Release(holder string) error {
+	return fl.update(func(cur Lease) (Lease, bool) {
+		if cur.Holder != holder {
+			return cur, false
+		}
+		return Lease{Term: cur.Term}, true
+	})
+}
+
+// update reads the lease, and writes back the new lease if changed, while holding the file lock
+func (fl *FileLock) update(f func(Lease) (Lease, bool)) error {
+	file, err := os.OpenFile(fl.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open lease file %s", fl.path)
+	}
+	defer file.Close()
+	if err := lockFile(file); err != nil {
+		return errors.Wrapf(err, "failed to lock lease file %s", fl.path)
+	}
+	defer unlockFile(file)
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read lease file %s", fl.path)
+	}
+	var cur Lease
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &cur); err != nil {
+			return errors.Wrapf(err, "failed to parse lease file %s", fl.path)
+		}
+	}
+	lease, changed := f(cur)
+	if !changed {
+		return nil
+	}
+	if data, err = json.Marshal(lease); err != nil {
+		return err
+	}
+	if err := file.Truncate(0); err != nil {
+		return errors.Wrapf(err, "failed to write lease file %s", fl.path)
+	}
+	if _, err := file.WriteAt(data, 0); err != nil {
+		return errors.Wrapf(err, "failed to write lease file %s", fl.path)
+	}
+	return errors.Wrapf(file.Sync(), "failed to write lease file %s", fl.path)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLock(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "lease")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "lease.json")
+	fl1, fl2 := NewFileLock(path), NewFileLock(path)
+	now := time.Unix(1600000000, 0)
+
+	lease, ok, err := fl1.TryAcquire("node1", now, now.Add(10*time.Second))
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(Lease{Holder: "node1", Expiry: now.Add(10 * time.Second), Term: 1}, lease)
+
+	// the lease is held by node1 until it expires
+	lease, ok, err = fl2.TryAcquire("node2", now.Add(5*time.Second), now.Add(15*time.Second))
+	require.NoError(err)
+	require.False(ok)
+	require.Equal("node1", lease.Holder)
+	lease, ok, err = fl1.TryAcquire("node1", now.Add(5*time.Second), now.Add(15*time.Second))
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(1), lease.Term)
+	require.Equal(now.Add(15*time.Second), lease.Expiry)
+	require.NoError(fl2.Release("node2"))
+	_, ok, err = fl2.TryAcquire("node2", now.Add(14*time.Second), now.Add(24*time.Second))
+	require.NoError(err)
+	require.False(ok)
+
+	// node2 takes over the expired lease
+	lease, ok, err = fl2.TryAcquire("node2", now.Add(15*time.Second), now.Add(25*time.Second))
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(Lease{Holder: "node2", Expiry: now.Add(25 * time.Second), Term: 2}, lease)
+
+	// node1 takes over the released lease
+	require.NoError(fl2.Release("node2"))
+	lease, ok, err = fl1.TryAcquire("node1", now.Add(16*time.Second), now.Add(26*time.Second))
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(3), lease.Term)
+
+	// the lease is not acquired while another node is updating it
+	file, err := os.OpenFile(path, os.O_RDWR, 0600)
+	require.NoError(err)
+	require.NoError(lockFile(file))
+	_, ok, err = fl2.TryAcquire("node2", now.Add(30*time.Second), now.Add(40*time.Second))
+	require.Equal(ErrLeaseBusy, errors.Cause(err))
+	require.False(ok)
+	require.NoError(unlockFile(file))
+	require.NoError(file.Close())
+
+	require.NoError(ioutil.WriteFile(path, []byte("corrupted"), 0600))
+	_, _, err = fl1.TryAcquire("node1", now, now.Add(10*time.Second))
+	require.Error(err)
+}
@@ -0,0 +1,361 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rewardhistory
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/rewardhistory/rewardhistorypb"
+)
+
+const (
+	indexerStatus   = "is"
+	rewardHistoryNS = "rh"
+)
+
+var tipBlockHeightKey = []byte("tipHeight")
+
+type (
+	// RewardHistory is the rewards granted to a recipient in an epoch
+	RewardHistory struct {
+		Epoch           uint64
+		BlockReward     *big.Int
+		NumBlocks       uint64
+		EpochReward     *big.Int
+		FoundationBonus *big.Int
+	}
+
+	// Indexer is the indexer of the rewards granted by the rewarding protocol, per recipient and epoch
+	Indexer struct {
+		mutex         sync.RWMutex
+		kvStore       db.KVStore
+		rp            *rolldpos.Protocol
+		rewardingAddr string
+	}
+)
+
+// NewRewardHistory creates an empty reward history of an epoch
+func NewRewardHistory(epoch uint64) *RewardHistory {
+	return &RewardHistory{
+		Epoch:           epoch,
+		BlockReward:     big.NewInt(0),
+		EpochReward:     big.NewInt(0),
+		FoundationBonus: big.NewInt(0),
+	}
+}
+
+// TotalReward returns the sum of all kinds of rewards
+func (rh *RewardHistory) TotalReward() *big.Int {
+	total := new(big.Int).Add(rh.BlockReward, rh.EpochReward)
+	return total.Add(total, rh.FoundationBonus)
+}
+
+func (rh *RewardHistory) isEmpty() bool {
+	return rh.NumBlocks == 0 && rh.TotalReward().Sign() == 0
+}
+
+// Proto converts the reward history to protobuf
+func (rh *RewardHistory) Proto() *rewardhistorypb.RewardHistory {
+	return &rewardhistorypb.RewardHistory{
+		Epoch:           rh.Epoch,
+		BlockReward:     rh.BlockReward.String(),
+		NumBlocks:       rh.NumBlocks,
+		EpochReward:     rh.EpochReward.String(),
+		FoundationBonus: rh.FoundationBonus.String(),
+	}
+}
+
+// Serialize serializes the reward history into bytes
+func (rh *RewardHistory) Serialize() ([]byte, error) {
+	return proto.Marshal(rh.Proto())
+}
+
+// Deserialize deserializes bytes into the reward history
+func (rh *RewardHistory) Deserialize(buf []byte) error {
+	pb := &rewardhistorypb.RewardHistory{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal reward history")
+	}
+	rh.Epoch = pb.GetEpoch()
+	rh.NumBlocks = pb.GetNumBlocks()
+	var ok bool
+	if rh.BlockReward, ok = new(big.Int).SetString(pb.GetBlockReward(), 10); !ok {
+		return errors.Errorf("invalid block reward %s", pb.GetBlockReward())
+	}
+	if rh.EpochReward, ok = new(big.Int).SetString(pb.GetEpochReward(), 10); !ok {
+		return errors.Errorf("invalid epoch reward %s", pb.GetEpochReward())
+	}
+	if rh.FoundationBonus, ok = new(big.Int).SetString(pb.GetFoundationBonus(), 10); !ok {
+		return errors.Errorf("invalid foundation bonus %s", pb.GetFoundationBonus())
+	}
+	return nil
+}
+
+// apply adds (sign > 0) or subtracts (sign < 0) a reward log to the reward history
+func (rh *RewardHistory) apply(rl *rewardingpb.RewardLog, sign int) error {
+	amount, ok := new(big.Int).SetString(rl.GetAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid reward amount %s", rl.GetAmount())
+	}
+	if sign < 0 {
+		amount.Neg(amount)
+	}
+	switch rl.GetType() {
+	case rewardingpb.RewardLog_BLOCK_REWARD:
+		rh.BlockReward.Add(rh.BlockReward, amount)
+		if sign < 0 {
+			if rh.NumBlocks == 0 {
+				return errors.New("number of rewarded blocks cannot be negative")
+			}
+			rh.NumBlocks--
+		} else {
+			rh.NumBlocks++
+		}
+	case rewardingpb.RewardLog_EPOCH_REWARD:
+		rh.EpochReward.Add(rh.EpochReward, amount)
+	case rewardingpb.RewardLog_FOUNDATION_BONUS:
+		rh.FoundationBonus.Add(rh.FoundationBonus, amount)
+	default:
+		return errors.Errorf("unknown reward type %d", rl.GetType())
+	}
+	if rh.BlockReward.Sign() < 0 || rh.EpochReward.Sign() < 0 || rh.FoundationBonus.Sign() < 0 {
+		return errors.New("reward cannot be negative")
+	}
+	return nil
+}
+
+// NewIndexer creates a new indexer, the rolldpos protocol is used to map block heights to epochs
+func NewIndexer(kv db.KVStore, rp *rolldpos.Protocol) (*Indexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kvStore")
+	}
+	if rp == nil {
+		return nil, errors.New("empty rolldpos protocol")
+	}
+	h := hash.Hash160b([]byte("rewarding"))
+	addr, err := address.FromBytes(h[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct the address of rewarding protocol")
+	}
+	return &Indexer{
+		kvStore:       kv,
+		rp:            rp,
+		rewardingAddr: addr.String(),
+	}, nil
+}
+
+func (x *Indexer) tipHeight() (uint64, error) {
+	value, err := x.kvStore.Get(indexerStatus, tipBlockHeightKey)
+	if err != nil {
+		return uint64(0), err
+	}
+	return byteutil.BytesToUint64(value), nil
+}
+
+// Start starts the indexer
+func (x *Indexer) Start(ctx context.Context) error {
+	if err := x.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	_, err := x.tipHeight()
+	switch errors.Cause(err) {
+	case db.ErrNotExist:
+		if err := x.kvStore.Put(indexerStatus, tipBlockHeightKey, byteutil.Uint64ToBytes(0)); err != nil {
+			return errors.Wrap(err, "failed to initialize tip block height")
+		}
+	case nil:
+		break
+	default:
+		return err
+	}
+
+	return nil
+}
+
+// Stop stops the indexer
+func (x *Indexer) Stop(ctx context.Context) error {
+	return x.kvStore.Stop(ctx)
+}
+
+// Height returns the tip height of the indexer
+func (x *Indexer) Height() (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.tipHeight()
+}
+
+// PutBlock indexes the rewards granted in the block
+func (x *Indexer) PutBlock(_ context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	// the block to be indexed must be exactly current top + 1
+	tipHeight, err := x.tipHeight()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight+1)
+	}
+	b, err := x.applyBlock(blk, 1)
+	if err != nil {
+		return err
+	}
+	b.Put(
+		indexerStatus,
+		tipBlockHeightKey,
+		byteutil.Uint64ToBytes(height),
+		"failed to update tip block height",
+	)
+	return x.kvStore.WriteBatch(b)
+}
+
+// DeleteTipBlock reverts the rewards granted in the tip block
+func (x *Indexer) DeleteTipBlock(blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	// the block to be deleted must be exactly current top
+	tipHeight, err := x.tipHeight()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight)
+	}
+	b, err := x.applyBlock(blk, -1)
+	if err != nil {
+		return err
+	}
+	b.Put(
+		indexerStatus,
+		tipBlockHeightKey,
+		byteutil.Uint64ToBytes(height-1),
+		"failed to update tip block height",
+	)
+	return x.kvStore.WriteBatch(b)
+}
+
+// RewardHistory returns the rewards granted to the address in the epoch
+func (x *Indexer) RewardHistory(addr address.Address, epoch uint64) (*RewardHistory, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.rewardHistory(addr, epoch)
+}
+
+// RewardHistories returns the rewards granted to the address in epochs [start, start+count), the epochs in which
+// the address was not rewarded are skipped
+func (x *Indexer) RewardHistories(addr address.Address, start, count uint64) ([]*RewardHistory, error) {
+	if start == 0 {
+		return nil, errors.New("epoch number starts from 1")
+	}
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	histories := make([]*RewardHistory, 0)
+	for epoch := start; epoch < start+count; epoch++ {
+		rh, err := x.rewardHistory(addr, epoch)
+		if errors.Cause(err) == db.ErrNotExist {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, rh)
+	}
+	return histories, nil
+}
+
+func (x *Indexer) rewardHistory(addr address.Address, epoch uint64) (*RewardHistory, error) {
+	value, err := x.kvStore.Get(rewardHistoryNS, rewardHistoryKey(addr, epoch))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get reward history of %s in epoch %d", addr.String(), epoch)
+	}
+	rh := &RewardHistory{}
+	if err := rh.Deserialize(value); err != nil {
+		return nil, err
+	}
+	return rh, nil
+}
+
+// applyBlock returns the batch that adds (sign > 0) or subtracts (sign < 0) the reward logs in the block
+func (x *Indexer) applyBlock(blk *block.Block, sign int) (batch.KVStoreBatch, error) {
+	epoch := x.rp.GetEpochNum(blk.Height())
+	histories := make(map[string]*RewardHistory)
+	addrs := make([]address.Address, 0)
+	for _, receipt := range blk.Receipts {
+		if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if l.Address != x.rewardingAddr {
+				continue
+			}
+			rl := &rewardingpb.RewardLog{}
+			if err := proto.Unmarshal(l.Data, rl); err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal reward log")
+			}
+			rh, ok := histories[rl.GetAddr()]
+			if !ok {
+				addr, err := address.FromString(rl.GetAddr())
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid reward address %s", rl.GetAddr())
+				}
+				rh, err = x.rewardHistory(addr, epoch)
+				switch errors.Cause(err) {
+				case nil:
+				case db.ErrNotExist:
+					rh = NewRewardHistory(epoch)
+				default:
+					return nil, err
+				}
+				histories[rl.GetAddr()] = rh
+				addrs = append(addrs, addr)
+			}
+			if err := rh.apply(rl, sign); err != nil {
+				return nil, errors.Wrapf(err, "failed to apply reward log of block %d", blk.Height())
+			}
+		}
+	}
+
+	b := batch.NewBatch()
+	for _, addr := range addrs {
+		rh := histories[addr.String()]
+		key := rewardHistoryKey(addr, epoch)
+		if rh.isEmpty() {
+			b.Delete(rewardHistoryNS, key, "failed to delete reward history")
+			continue
+		}
+		data, err := rh.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		b.Put(rewardHistoryNS, key, data, "failed to put reward history")
+	}
+	return b, nil
+}
+
+func rewardHistoryKey(addr address.Address, epoch uint64) []byte {
+	return append(addr.Bytes(), byteutil.Uint64ToBytesBigEndian(epoch)...)
+}
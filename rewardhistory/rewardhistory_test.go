@@ -0,0 +1,172 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rewardhistory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func rewardLog(t *testing.T, addr string, typ rewardingpb.RewardLog_RewardType, amount int64, height uint64) *action.Log {
+	data, err := proto.Marshal(&rewardingpb.RewardLog{
+		Type:   typ,
+		Addr:   addr,
+		Amount: big.NewInt(amount).String(),
+	})
+	require.NoError(t, err)
+	return &action.Log{
+		Address:     rewardingAddr(t),
+		Data:        data,
+		BlockHeight: height,
+	}
+}
+
+func rewardingAddr(t *testing.T) string {
+	h := hash.Hash160b([]byte("rewarding"))
+	addr, err := address.FromBytes(h[:])
+	require.NoError(t, err)
+	return addr.String()
+}
+
+func TestIndexer(t *testing.T) {
+	require := require.New(t)
+
+	addr1 := identityset.Address(1)
+	addr2 := identityset.Address(2)
+	blks := make([]*block.Block, 0)
+	prevHash := hash.ZeroHash256
+	for i := uint64(1); i <= 3; i++ {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(i).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(testutil.TimestampNow()).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		prevHash = blk.HashBlock()
+		blks = append(blks, &blk)
+	}
+	// epoch 1 is block 1 and 2, epoch 2 is block 3 and 4
+	blks[0].Receipts = []*action.Receipt{
+		{
+			Status: 1,
+			Logs:   []*action.Log{rewardLog(t, addr1.String(), rewardingpb.RewardLog_BLOCK_REWARD, 16, 1)},
+		},
+	}
+	blks[1].Receipts = []*action.Receipt{
+		{
+			Status: 1,
+			Logs:   []*action.Log{rewardLog(t, addr2.String(), rewardingpb.RewardLog_BLOCK_REWARD, 16, 2)},
+		},
+		{
+			Status: 1,
+			Logs: []*action.Log{
+				rewardLog(t, addr1.String(), rewardingpb.RewardLog_EPOCH_REWARD, 100, 2),
+				rewardLog(t, addr2.String(), rewardingpb.RewardLog_EPOCH_REWARD, 50, 2),
+				rewardLog(t, addr1.String(), rewardingpb.RewardLog_FOUNDATION_BONUS, 80, 2),
+				// not a reward log
+				{Address: addr1.String(), Data: []byte("data"), BlockHeight: 2},
+			},
+		},
+		// failed receipt
+		{
+			Status: 0,
+			Logs:   []*action.Log{rewardLog(t, addr1.String(), rewardingpb.RewardLog_BLOCK_REWARD, 16, 2)},
+		},
+	}
+	blks[2].Receipts = []*action.Receipt{
+		{
+			Status: 1,
+			Logs:   []*action.Log{rewardLog(t, addr1.String(), rewardingpb.RewardLog_BLOCK_REWARD, 16, 3)},
+		},
+	}
+
+	ctx := context.Background()
+	x, err := NewIndexer(db.NewMemKVStore(), rolldpos.NewProtocol(2, 2, 1))
+	require.NoError(err)
+	require.NoError(x.Start(ctx))
+	defer func() {
+		require.NoError(x.Stop(ctx))
+	}()
+	require.Error(x.PutBlock(ctx, blks[1]))
+	for _, blk := range blks {
+		require.NoError(x.PutBlock(ctx, blk))
+	}
+	height, err := x.Height()
+	require.NoError(err)
+	require.Equal(uint64(3), height)
+
+	rh, err := x.RewardHistory(addr1, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), rh.Epoch)
+	require.Equal(big.NewInt(16), rh.BlockReward)
+	require.Equal(uint64(1), rh.NumBlocks)
+	require.Equal(big.NewInt(100), rh.EpochReward)
+	require.Equal(big.NewInt(80), rh.FoundationBonus)
+	require.Equal(big.NewInt(196), rh.TotalReward())
+	rh, err = x.RewardHistory(addr2, 1)
+	require.NoError(err)
+	require.Equal(big.NewInt(16), rh.BlockReward)
+	require.Equal(big.NewInt(50), rh.EpochReward)
+	require.Equal(big.NewInt(0), rh.FoundationBonus)
+
+	histories, err := x.RewardHistories(addr1, 1, 5)
+	require.NoError(err)
+	require.Equal(2, len(histories))
+	require.Equal(uint64(2), histories[1].Epoch)
+	require.Equal(big.NewInt(16), histories[1].BlockReward)
+	histories, err = x.RewardHistories(addr2, 1, 5)
+	require.NoError(err)
+	require.Equal(1, len(histories))
+	_, err = x.RewardHistories(addr2, 0, 5)
+	require.Error(err)
+
+	// revert the tip blocks
+	require.Error(x.DeleteTipBlock(blks[1]))
+	require.NoError(x.DeleteTipBlock(blks[2]))
+	_, err = x.RewardHistory(addr1, 2)
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+	require.NoError(x.DeleteTipBlock(blks[1]))
+	rh, err = x.RewardHistory(addr1, 1)
+	require.NoError(err)
+	require.Equal(big.NewInt(16), rh.BlockReward)
+	require.Equal(big.NewInt(0), rh.EpochReward)
+	_, err = x.RewardHistory(addr2, 1)
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+	height, err = x.Height()
+	require.NoError(err)
+	require.Equal(uint64(1), height)
+}
+
+func TestRewardHistorySerialize(t *testing.T) {
+	require := require.New(t)
+
+	rh := NewRewardHistory(3)
+	rh.BlockReward = big.NewInt(32)
+	rh.NumBlocks = 2
+	rh.EpochReward = big.NewInt(100)
+	data, err := rh.Serialize()
+	require.NoError(err)
+	rh1 := &RewardHistory{}
+	require.NoError(rh1.Deserialize(data))
+	require.Equal(rh, rh1)
+}
@@ -0,0 +1,155 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rewardhistory.proto
+
+package rewardhistorypb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type RewardHistory struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockReward          string   `protobuf:"bytes,2,opt,name=blockReward,proto3" json:"blockReward,omitempty"`
+	NumBlocks            uint64   `protobuf:"varint,3,opt,name=numBlocks,proto3" json:"numBlocks,omitempty"`
+	EpochReward          string   `protobuf:"bytes,4,opt,name=epochReward,proto3" json:"epochReward,omitempty"`
+	FoundationBonus      string   `protobuf:"bytes,5,opt,name=foundationBonus,proto3" json:"foundationBonus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RewardHistory) Reset()         { *m = RewardHistory{} }
+func (m *RewardHistory) String() string { return proto.CompactTextString(m) }
+func (*RewardHistory) ProtoMessage()    {}
+func (*RewardHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92df5cf6ef6299c0, []int{0}
+}
+
+func (m *RewardHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RewardHistory.Unmarshal(m, b)
+}
+func (m *RewardHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RewardHistory.Marshal(b, m, deterministic)
+}
+func (m *RewardHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardHistory.Merge(m, src)
+}
+func (m *RewardHistory) XXX_Size() int {
+	return xxx_messageInfo_RewardHistory.Size(m)
+}
+func (m *RewardHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardHistory proto.InternalMessageInfo
+
+func (m *RewardHistory) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RewardHistory) GetBlockReward() string {
+	if m != nil {
+		return m.BlockReward
+	}
+	return ""
+}
+
+func (m *RewardHistory) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *RewardHistory) GetEpochReward() string {
+	if m != nil {
+		return m.EpochReward
+	}
+	return ""
+}
+
+func (m *RewardHistory) GetFoundationBonus() string {
+	if m != nil {
+		return m.FoundationBonus
+	}
+	return ""
+}
+
+type RewardHistoryList struct {
+	RewardHistories      []*RewardHistory `protobuf:"bytes,1,rep,name=rewardHistories,proto3" json:"rewardHistories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RewardHistoryList) Reset()         { *m = RewardHistoryList{} }
+func (m *RewardHistoryList) String() string { return proto.CompactTextString(m) }
+func (*RewardHistoryList) ProtoMessage()    {}
+func (*RewardHistoryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92df5cf6ef6299c0, []int{1}
+}
+
+func (m *RewardHistoryList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RewardHistoryList.Unmarshal(m, b)
+}
+func (m *RewardHistoryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RewardHistoryList.Marshal(b, m, deterministic)
+}
+func (m *RewardHistoryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardHistoryList.Merge(m, src)
+}
+func (m *RewardHistoryList) XXX_Size() int {
+	return xxx_messageInfo_RewardHistoryList.Size(m)
+}
+func (m *RewardHistoryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardHistoryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardHistoryList proto.InternalMessageInfo
+
+func (m *RewardHistoryList) GetRewardHistories() []*RewardHistory {
+	if m != nil {
+		return m.RewardHistories
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardHistory)(nil), "rewardhistorypb.RewardHistory")
+	proto.RegisterType((*RewardHistoryList)(nil), "rewardhistorypb.RewardHistoryList")
+}
+
+func init() { proto.RegisterFile("rewardhistory.proto", fileDescriptor_92df5cf6ef6299c0) }
+
+var fileDescriptor_92df5cf6ef6299c0 = []byte{
+	// 193 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0x4a, 0x2d, 0x4f,
+	0x2c, 0x4a, 0xc9, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0xe2, 0x47, 0x11, 0x2c, 0x48, 0x52, 0xda, 0xc8, 0xc8, 0xc5, 0x1b, 0x04, 0x16, 0xf3, 0x80, 0x88,
+	0x09, 0x89, 0x70, 0xb1, 0xa6, 0x16, 0xe4, 0x27, 0x67, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04,
+	0x41, 0x38, 0x42, 0x0a, 0x5c, 0xdc, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0x10, 0xb5, 0x12, 0x4c, 0x0a,
+	0x8c, 0x1a, 0x9c, 0x41, 0xc8, 0x42, 0x42, 0x32, 0x5c, 0x9c, 0x79, 0xa5, 0xb9, 0x4e, 0x20, 0x91,
+	0x62, 0x09, 0x66, 0xb0, 0x5e, 0x84, 0x00, 0x48, 0x3f, 0xd8, 0x20, 0xa8, 0x7e, 0x16, 0x88, 0x7e,
+	0x24, 0x21, 0x21, 0x0d, 0x2e, 0xfe, 0xb4, 0xfc, 0xd2, 0xbc, 0x94, 0xc4, 0x92, 0xcc, 0xfc, 0x3c,
+	0xa7, 0xfc, 0xbc, 0xd2, 0x62, 0x09, 0x56, 0xb0, 0x2a, 0x74, 0x61, 0xa5, 0x58, 0x2e, 0x41, 0x14,
+	0x27, 0xfb, 0x64, 0x16, 0x97, 0x08, 0x79, 0x70, 0xf1, 0x17, 0x21, 0x09, 0x66, 0xa6, 0x16, 0x4b,
+	0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xe9, 0xa1, 0xf9, 0x59, 0x0f, 0x45, 0x73, 0x10, 0xba,
+	0xb6, 0x24, 0x36, 0x70, 0x50, 0x19, 0x03, 0x06, 0x00, 0xdc, 0x07, 0xee, 0xf3, 0x41, 0x01, 0x00,
+	0x00,
+}
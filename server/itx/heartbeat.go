@@ -70,12 +70,21 @@ func (h *HeartbeatHandler) Log() {
 		log.L().Error("dispatcher is not the instance of IotxDispatcher")
 		return
 	}
-	numDPEvts := len(*dp.EventChan())
+	numDPEvts := 0
+	queueAudit := dp.QueueAudit()
+	for _, stats := range queueAudit {
+		numDPEvts += stats.Depth
+	}
 	dpEvtsAudit, err := json.Marshal(dp.EventAudit())
 	if err != nil {
 		log.L().Error("error when serializing the dispatcher event audit map.", zap.Error(err))
 		return
 	}
+	dpQueueAudit, err := json.Marshal(queueAudit)
+	if err != nil {
+		log.L().Error("error when serializing the dispatcher queue audit map.", zap.Error(err))
+		return
+	}
 
 	ctx := context.Background()
 	peers, err := p2pAgent.Neighbors(ctx)
@@ -87,7 +96,8 @@ func (h *HeartbeatHandler) Log() {
 	log.L().Info("Node status.",
 		zap.Int("numPeers", numPeers),
 		zap.Int("pendingDispatcherEvents", numDPEvts),
-		zap.String("pendingDispatcherEventsAudit", string(dpEvtsAudit)))
+		zap.String("pendingDispatcherEventsAudit", string(dpEvtsAudit)),
+		zap.String("dispatcherQueueAudit", string(dpQueueAudit)))
 
 	heartbeatMtc.WithLabelValues("numPeers", "node").Set(float64(numPeers))
 	heartbeatMtc.WithLabelValues("pendingDispatcherEvents", "node").Set(float64(numDPEvts))
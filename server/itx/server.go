@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"os"
 	"runtime"
 	"sync"
 
@@ -19,7 +20,9 @@ import (
 
 	"github.com/iotexproject/iotex-core/chainservice"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/consensus"
 	"github.com/iotexproject/iotex-core/dispatcher"
+	"github.com/iotexproject/iotex-core/follower"
 	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/ha"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -36,6 +39,7 @@ type Server struct {
 	p2pAgent             *p2p.Agent
 	dispatcher           dispatcher.Dispatcher
 	initializedSubChains map[uint32]bool
+	failover             *ha.Failover
 	mutex                sync.RWMutex
 	subModuleCancel      context.CancelFunc
 }
@@ -61,6 +65,10 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 	chains := make(map[uint32]*chainservice.ChainService)
 	var cs *chainservice.ChainService
 	var opts []chainservice.Option
+	if cfg.System.HA.LeasePath != "" {
+		// the node starts stand-by, and becomes active once it acquires the lease
+		cfg.System.Active = false
+	}
 	if testing {
 		opts = []chainservice.Option{
 			chainservice.WithTesting(),
@@ -81,6 +89,25 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 		chainservices:        chains,
 		initializedSubChains: map[uint32]bool{},
 	}
+	if cfg.System.HA.LeasePath != "" {
+		holder := cfg.System.HA.NodeID
+		if holder == "" {
+			host, err := os.Hostname()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get host name as the lease holder")
+			}
+			holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+		}
+		svr.failover, err = ha.NewFailover(
+			cs.Consensus(),
+			ha.NewFileLock(cfg.System.HA.LeasePath),
+			holder,
+			cfg.System.HA.LeaseTTL,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "fail to create failover")
+		}
+	}
 	// Setup sub-chain starter
 	// TODO: sub-chain infra should use main-chain API instead of protocol directly
 	return &svr, nil
@@ -90,16 +117,27 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 func (s *Server) Start(ctx context.Context) error {
 	cctx, cancel := context.WithCancel(context.Background())
 	s.subModuleCancel = cancel
-	if err := s.p2pAgent.Start(cctx); err != nil {
-		return errors.Wrap(err, "error when starting P2P agent")
+	// the read-only follower does not take part in p2p
+	follower := s.cfg.System.Follower.LeaderURL != ""
+	if !follower {
+		if err := s.p2pAgent.Start(cctx); err != nil {
+			return errors.Wrap(err, "error when starting P2P agent")
+		}
 	}
 	for _, cs := range s.chainservices {
 		if err := cs.Start(cctx); err != nil {
 			return errors.Wrap(err, "error when starting blockchain")
 		}
 	}
-	if err := s.dispatcher.Start(cctx); err != nil {
-		return errors.Wrap(err, "error when starting dispatcher")
+	if !follower {
+		if err := s.dispatcher.Start(cctx); err != nil {
+			return errors.Wrap(err, "error when starting dispatcher")
+		}
+	}
+	if s.failover != nil {
+		if err := s.failover.Start(cctx); err != nil {
+			return errors.Wrap(err, "error when starting failover")
+		}
 	}
 
 	return nil
@@ -108,11 +146,19 @@ func (s *Server) Start(ctx context.Context) error {
 // Stop stops the server
 func (s *Server) Stop(ctx context.Context) error {
 	defer s.subModuleCancel()
-	if err := s.p2pAgent.Stop(ctx); err != nil {
-		return errors.Wrap(err, "error when stopping P2P agent")
+	if s.failover != nil {
+		// stop signing and hand the lease over before anything else
+		if err := s.failover.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping failover")
+		}
 	}
-	if err := s.dispatcher.Stop(ctx); err != nil {
-		return errors.Wrap(err, "error when stopping dispatcher")
+	if s.cfg.System.Follower.LeaderURL == "" {
+		if err := s.p2pAgent.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping P2P agent")
+		}
+		if err := s.dispatcher.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping dispatcher")
+		}
 	}
 	for _, cs := range s.chainservices {
 		if err := cs.Stop(ctx); err != nil {
@@ -192,8 +238,20 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 	if cfg.System.HTTPAdminPort > 0 {
 		mux := http.NewServeMux()
 		log.RegisterLevelConfigMux(mux)
-		haCtl := ha.New(svr.rootChainService.Consensus())
+		var haOpts []ha.Option
+		if svr.failover != nil {
+			haOpts = append(haOpts, ha.WithFailover(svr.failover))
+		}
+		haCtl := ha.New(svr.rootChainService.Consensus(), haOpts...)
 		mux.Handle("/ha", http.HandlerFunc(haCtl.Handle))
+		roundInspector := consensus.NewRoundInspector(svr.rootChainService.Consensus())
+		mux.Handle("/consensus/", http.HandlerFunc(roundInspector.Handle))
+		mux.Handle("/p2p/", http.HandlerFunc(svr.p2pAgent.Reputation().Handle))
+		mux.Handle("/p2p/allowlist", http.HandlerFunc(svr.p2pAgent.Allowlist().Handle))
+		if cfg.Consensus.Scheme == config.StandaloneScheme && cfg.Consensus.Standalone.DevMode {
+			devCtl := consensus.NewDevController(svr.rootChainService.Consensus())
+			mux.Handle("/dev/", http.HandlerFunc(devCtl.Handle))
+		}
 		mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
 		mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
 		mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
@@ -216,11 +274,25 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 		}()
 	}
 
+	var exportserv *follower.ExportServer
+	if cfg.System.Follower.ExportPort > 0 {
+		exportserv = follower.NewExportServer(cfg.System.Follower, svr.rootChainService.Exporter())
+		if err := exportserv.Start(ctx); err != nil {
+			log.L().Error("Error when listen to follower export port.", zap.Error(err))
+			exportserv = nil
+		}
+	}
+
 	<-ctx.Done()
 	probeSvr.NotReady()
 	if err := adminserv.Shutdown(ctx); err != nil {
 		log.L().Error("Error when serving metrics data.", zap.Error(err))
 	}
+	if exportserv != nil {
+		if err := exportserv.Stop(ctx); err != nil {
+			log.L().Error("Error when exporting to followers.", zap.Error(err))
+		}
+	}
 	if err := svr.Stop(ctx); err != nil {
 		log.L().Panic("Failed to stop server.", zap.Error(err))
 	}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
+	blocksyncpb "github.com/iotexproject/iotex-core/blocksync/blocksyncpb"
 	iotexrpc "github.com/iotexproject/iotex-proto/golang/iotexrpc"
 	go_libp2p_peerstore "github.com/libp2p/go-libp2p-peerstore"
 	reflect "reflect"
@@ -157,3 +158,115 @@ func (mr *MockBlockSyncMockRecorder) ProcessBlockSync(ctx, blk interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockSync", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockSync), ctx, blk)
 }
+
+// ProcessPeerStatus mocks base method
+func (m *MockBlockSync) ProcessPeerStatus(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, status *blocksyncpb.PeerStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessPeerStatus", ctx, peer, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessPeerStatus indicates an expected call of ProcessPeerStatus
+func (mr *MockBlockSyncMockRecorder) ProcessPeerStatus(ctx, peer, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPeerStatus", reflect.TypeOf((*MockBlockSync)(nil).ProcessPeerStatus), ctx, peer, status)
+}
+
+// ProcessHeadersRequest mocks base method
+func (m *MockBlockSync) ProcessHeadersRequest(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, req *blocksyncpb.HeadersRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessHeadersRequest", ctx, peer, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessHeadersRequest indicates an expected call of ProcessHeadersRequest
+func (mr *MockBlockSyncMockRecorder) ProcessHeadersRequest(ctx, peer, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessHeadersRequest", reflect.TypeOf((*MockBlockSync)(nil).ProcessHeadersRequest), ctx, peer, req)
+}
+
+// ProcessHeaders mocks base method
+func (m *MockBlockSync) ProcessHeaders(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, headers *blocksyncpb.Headers) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessHeaders", ctx, peer, headers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessHeaders indicates an expected call of ProcessHeaders
+func (mr *MockBlockSyncMockRecorder) ProcessHeaders(ctx, peer, headers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessHeaders", reflect.TypeOf((*MockBlockSync)(nil).ProcessHeaders), ctx, peer, headers)
+}
+
+// ProcessBodiesRequest mocks base method
+func (m *MockBlockSync) ProcessBodiesRequest(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, req *blocksyncpb.BodiesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBodiesRequest", ctx, peer, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBodiesRequest indicates an expected call of ProcessBodiesRequest
+func (mr *MockBlockSyncMockRecorder) ProcessBodiesRequest(ctx, peer, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBodiesRequest", reflect.TypeOf((*MockBlockSync)(nil).ProcessBodiesRequest), ctx, peer, req)
+}
+
+// ProcessBodies mocks base method
+func (m *MockBlockSync) ProcessBodies(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, bodies *blocksyncpb.Bodies) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBodies", ctx, peer, bodies)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBodies indicates an expected call of ProcessBodies
+func (mr *MockBlockSyncMockRecorder) ProcessBodies(ctx, peer, bodies interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBodies", reflect.TypeOf((*MockBlockSync)(nil).ProcessBodies), ctx, peer, bodies)
+}
+
+// ProcessCompactBlock mocks base method
+func (m *MockBlockSync) ProcessCompactBlock(ctx context.Context, cb *blocksyncpb.CompactBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessCompactBlock", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessCompactBlock indicates an expected call of ProcessCompactBlock
+func (mr *MockBlockSyncMockRecorder) ProcessCompactBlock(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessCompactBlock", reflect.TypeOf((*MockBlockSync)(nil).ProcessCompactBlock), ctx, cb)
+}
+
+// ProcessActionsRequest mocks base method
+func (m *MockBlockSync) ProcessActionsRequest(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, req *blocksyncpb.ActionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessActionsRequest", ctx, peer, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessActionsRequest indicates an expected call of ProcessActionsRequest
+func (mr *MockBlockSyncMockRecorder) ProcessActionsRequest(ctx, peer, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessActionsRequest", reflect.TypeOf((*MockBlockSync)(nil).ProcessActionsRequest), ctx, peer, req)
+}
+
+// ProcessBlockActions mocks base method
+func (m *MockBlockSync) ProcessBlockActions(ctx context.Context, peer go_libp2p_peerstore.PeerInfo, actions *blocksyncpb.BlockActions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBlockActions", ctx, peer, actions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBlockActions indicates an expected call of ProcessBlockActions
+func (mr *MockBlockSyncMockRecorder) ProcessBlockActions(ctx, peer, actions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockActions", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockActions), ctx, peer, actions)
+}
@@ -11,6 +11,7 @@ import (
 	scheme "github.com/iotexproject/iotex-core/consensus/scheme"
 	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
 	reflect "reflect"
+	time "time"
 )
 
 // MockConsensus is a mock of Consensus interface
@@ -144,3 +145,15 @@ func (mr *MockConsensusMockRecorder) Active() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockConsensus)(nil).Active))
 }
+
+// SetLease mocks base method
+func (m *MockConsensus) SetLease(arg0 uint64, arg1 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLease", arg0, arg1)
+}
+
+// SetLease indicates an expected call of SetLease
+func (mr *MockConsensusMockRecorder) SetLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLease", reflect.TypeOf((*MockConsensus)(nil).SetLease), arg0, arg1)
+}
@@ -108,6 +108,20 @@ func (mr *MockSubscriberMockRecorder) HandleConsensusMsg(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleConsensusMsg", reflect.TypeOf((*MockSubscriber)(nil).HandleConsensusMsg), arg0)
 }
 
+// HandleMessage mocks base method
+func (m *MockSubscriber) HandleMessage(arg0 context.Context, arg1 go_libp2p_peerstore.PeerInfo, arg2 proto.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleMessage indicates an expected call of HandleMessage
+func (mr *MockSubscriberMockRecorder) HandleMessage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleMessage", reflect.TypeOf((*MockSubscriber)(nil).HandleMessage), arg0, arg1, arg2)
+}
+
 // MockDispatcher is a mock of Dispatcher interface
 type MockDispatcher struct {
 	ctrl     *gomock.Controller
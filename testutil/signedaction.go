@@ -270,3 +270,29 @@ func SignedRestake(
 	}
 	return selp, nil
 }
+
+// SignedCompoundStake returns a signed compound stake
+func SignedCompoundStake(
+	nonce uint64,
+	index uint64,
+	enable bool,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	ownerPriKey crypto.PrivateKey,
+) (action.SealedEnvelope, error) {
+	cs, err := action.NewCompoundStake(nonce, index, enable, payload, gasLimit, gasPrice)
+	if err != nil {
+		return action.SealedEnvelope{}, err
+	}
+	bd := &action.EnvelopeBuilder{}
+	elp := bd.SetNonce(nonce).
+		SetGasPrice(gasPrice).
+		SetGasLimit(gasLimit).
+		SetAction(cs).Build()
+	selp, err := action.Sign(elp, ownerPriKey)
+	if err != nil {
+		return action.SealedEnvelope{}, errors.Wrapf(err, "failed to sign compound stake %v", elp)
+	}
+	return selp, nil
+}
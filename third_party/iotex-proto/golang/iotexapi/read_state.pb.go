@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/api/read_state.proto
+
+package iotexapi
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ReadStakingDataMethod_Name int32
+
+const (
+	ReadStakingDataMethod_INVALID                          ReadStakingDataMethod_Name = 0
+	ReadStakingDataMethod_BUCKETS                          ReadStakingDataMethod_Name = 1
+	ReadStakingDataMethod_BUCKETS_BY_VOTER                 ReadStakingDataMethod_Name = 2
+	ReadStakingDataMethod_BUCKETS_BY_CANDIDATE             ReadStakingDataMethod_Name = 3
+	ReadStakingDataMethod_CANDIDATES                       ReadStakingDataMethod_Name = 4
+	ReadStakingDataMethod_CANDIDATE_BY_NAME                ReadStakingDataMethod_Name = 5
+	ReadStakingDataMethod_TOTAL_STAKED                     ReadStakingDataMethod_Name = 6
+	ReadStakingDataMethod_TOTAL_VOTES                      ReadStakingDataMethod_Name = 7
+	ReadStakingDataMethod_STAKE_DISTRIBUTION_BY_DURATION   ReadStakingDataMethod_Name = 8
+	ReadStakingDataMethod_STAKE_DISTRIBUTION_BY_AUTO_STAKE ReadStakingDataMethod_Name = 9
+	ReadStakingDataMethod_VOTER_COUNT_BY_CANDIDATE         ReadStakingDataMethod_Name = 10
+)
+
+var ReadStakingDataMethod_Name_name = map[int32]string{
+	0:  "INVALID",
+	1:  "BUCKETS",
+	2:  "BUCKETS_BY_VOTER",
+	3:  "BUCKETS_BY_CANDIDATE",
+	4:  "CANDIDATES",
+	5:  "CANDIDATE_BY_NAME",
+	6:  "TOTAL_STAKED",
+	7:  "TOTAL_VOTES",
+	8:  "STAKE_DISTRIBUTION_BY_DURATION",
+	9:  "STAKE_DISTRIBUTION_BY_AUTO_STAKE",
+	10: "VOTER_COUNT_BY_CANDIDATE",
+}
+
+var ReadStakingDataMethod_Name_value = map[string]int32{
+	"INVALID":                          0,
+	"BUCKETS":                          1,
+	"BUCKETS_BY_VOTER":                 2,
+	"BUCKETS_BY_CANDIDATE":             3,
+	"CANDIDATES":                       4,
+	"CANDIDATE_BY_NAME":                5,
+	"TOTAL_STAKED":                     6,
+	"TOTAL_VOTES":                      7,
+	"STAKE_DISTRIBUTION_BY_DURATION":   8,
+	"STAKE_DISTRIBUTION_BY_AUTO_STAKE": 9,
+	"VOTER_COUNT_BY_CANDIDATE":         10,
+}
+
+func (x ReadStakingDataMethod_Name) String() string {
+	return proto.EnumName(ReadStakingDataMethod_Name_name, int32(x))
+}
+
+func (ReadStakingDataMethod_Name) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{1, 0}
+}
+
+type PaginationParam struct {
+	Offset               uint32   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaginationParam) Reset()         { *m = PaginationParam{} }
+func (m *PaginationParam) String() string { return proto.CompactTextString(m) }
+func (*PaginationParam) ProtoMessage()    {}
+func (*PaginationParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{0}
+}
+
+func (m *PaginationParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaginationParam.Unmarshal(m, b)
+}
+func (m *PaginationParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaginationParam.Marshal(b, m, deterministic)
+}
+func (m *PaginationParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaginationParam.Merge(m, src)
+}
+func (m *PaginationParam) XXX_Size() int {
+	return xxx_messageInfo_PaginationParam.Size(m)
+}
+func (m *PaginationParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaginationParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaginationParam proto.InternalMessageInfo
+
+func (m *PaginationParam) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PaginationParam) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReadStakingDataMethod struct {
+	Method               ReadStakingDataMethod_Name `protobuf:"varint,1,opt,name=method,proto3,enum=iotexapi.ReadStakingDataMethod_Name" json:"method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ReadStakingDataMethod) Reset()         { *m = ReadStakingDataMethod{} }
+func (m *ReadStakingDataMethod) String() string { return proto.CompactTextString(m) }
+func (*ReadStakingDataMethod) ProtoMessage()    {}
+func (*ReadStakingDataMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{1}
+}
+
+func (m *ReadStakingDataMethod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataMethod.Unmarshal(m, b)
+}
+func (m *ReadStakingDataMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataMethod.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataMethod.Merge(m, src)
+}
+func (m *ReadStakingDataMethod) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataMethod.Size(m)
+}
+func (m *ReadStakingDataMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataMethod proto.InternalMessageInfo
+
+func (m *ReadStakingDataMethod) GetMethod() ReadStakingDataMethod_Name {
+	if m != nil {
+		return m.Method
+	}
+	return ReadStakingDataMethod_INVALID
+}
+
+type ReadStakingDataRequest struct {
+	// Types that are valid to be assigned to Request:
+	//	*ReadStakingDataRequest_Buckets
+	//	*ReadStakingDataRequest_BucketsByVoter
+	//	*ReadStakingDataRequest_BucketsByCandidate
+	//	*ReadStakingDataRequest_Candidates_
+	//	*ReadStakingDataRequest_CandidateByName_
+	//	*ReadStakingDataRequest_VoterCountByCandidate_
+	Request              isReadStakingDataRequest_Request `protobuf_oneof:"request"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ReadStakingDataRequest) Reset()         { *m = ReadStakingDataRequest{} }
+func (m *ReadStakingDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadStakingDataRequest) ProtoMessage()    {}
+func (*ReadStakingDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2}
+}
+
+func (m *ReadStakingDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest.Merge(m, src)
+}
+func (m *ReadStakingDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest.Size(m)
+}
+func (m *ReadStakingDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest proto.InternalMessageInfo
+
+type isReadStakingDataRequest_Request interface {
+	isReadStakingDataRequest_Request()
+}
+
+type ReadStakingDataRequest_Buckets struct {
+	Buckets *ReadStakingDataRequest_VoteBuckets `protobuf:"bytes,1,opt,name=buckets,proto3,oneof"`
+}
+
+type ReadStakingDataRequest_BucketsByVoter struct {
+	BucketsByVoter *ReadStakingDataRequest_VoteBucketsByVoter `protobuf:"bytes,2,opt,name=bucketsByVoter,proto3,oneof"`
+}
+
+type ReadStakingDataRequest_BucketsByCandidate struct {
+	BucketsByCandidate *ReadStakingDataRequest_VoteBucketsByCandidate `protobuf:"bytes,3,opt,name=bucketsByCandidate,proto3,oneof"`
+}
+
+type ReadStakingDataRequest_Candidates_ struct {
+	Candidates *ReadStakingDataRequest_Candidates `protobuf:"bytes,4,opt,name=candidates,proto3,oneof"`
+}
+
+type ReadStakingDataRequest_CandidateByName_ struct {
+	CandidateByName *ReadStakingDataRequest_CandidateByName `protobuf:"bytes,5,opt,name=candidateByName,proto3,oneof"`
+}
+
+type ReadStakingDataRequest_VoterCountByCandidate_ struct {
+	VoterCountByCandidate *ReadStakingDataRequest_VoterCountByCandidate `protobuf:"bytes,6,opt,name=voterCountByCandidate,proto3,oneof"`
+}
+
+func (*ReadStakingDataRequest_Buckets) isReadStakingDataRequest_Request() {}
+
+func (*ReadStakingDataRequest_BucketsByVoter) isReadStakingDataRequest_Request() {}
+
+func (*ReadStakingDataRequest_BucketsByCandidate) isReadStakingDataRequest_Request() {}
+
+func (*ReadStakingDataRequest_Candidates_) isReadStakingDataRequest_Request() {}
+
+func (*ReadStakingDataRequest_CandidateByName_) isReadStakingDataRequest_Request() {}
+
+func (*ReadStakingDataRequest_VoterCountByCandidate_) isReadStakingDataRequest_Request() {}
+
+func (m *ReadStakingDataRequest) GetRequest() isReadStakingDataRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ReadStakingDataRequest) GetBuckets() *ReadStakingDataRequest_VoteBuckets {
+	if x, ok := m.GetRequest().(*ReadStakingDataRequest_Buckets); ok {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (m *ReadStakingDataRequest) GetBucketsByVoter() *ReadStakingDataRequest_VoteBucketsByVoter {
+	if x, ok := m.GetRequest().(*ReadStakingDataRequest_BucketsByVoter); ok {
+		return x.BucketsByVoter
+	}
+	return nil
+}
+
+func (m *ReadStakingDataRequest) GetBucketsByCandidate() *ReadStakingDataRequest_VoteBucketsByCandidate {
+	if x, ok := m.GetRequest().(*ReadStakingDataRequest_BucketsByCandidate); ok {
+		return x.BucketsByCandidate
+	}
+	return nil
+}
+
+func (m *ReadStakingDataRequest) GetCandidates() *ReadStakingDataRequest_Candidates {
+	if x, ok := m.GetRequest().(*ReadStakingDataRequest_Candidates_); ok {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (m *ReadStakingDataRequest) GetCandidateByName() *ReadStakingDataRequest_CandidateByName {
+	if x, ok := m.GetRequest().(*ReadStakingDataRequest_CandidateByName_); ok {
+		return x.CandidateByName
+	}
+	return nil
+}
+
+func (m *ReadStakingDataRequest) GetVoterCountByCandidate() *ReadStakingDataRequest_VoterCountByCandidate {
+	if x, ok := m.GetRequest().(*ReadStakingDataRequest_VoterCountByCandidate_); ok {
+		return x.VoterCountByCandidate
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ReadStakingDataRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ReadStakingDataRequest_Buckets)(nil),
+		(*ReadStakingDataRequest_BucketsByVoter)(nil),
+		(*ReadStakingDataRequest_BucketsByCandidate)(nil),
+		(*ReadStakingDataRequest_Candidates_)(nil),
+		(*ReadStakingDataRequest_CandidateByName_)(nil),
+		(*ReadStakingDataRequest_VoterCountByCandidate_)(nil),
+	}
+}
+
+type ReadStakingDataRequest_VoteBuckets struct {
+	Pagination           *PaginationParam `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReadStakingDataRequest_VoteBuckets) Reset()         { *m = ReadStakingDataRequest_VoteBuckets{} }
+func (m *ReadStakingDataRequest_VoteBuckets) String() string { return proto.CompactTextString(m) }
+func (*ReadStakingDataRequest_VoteBuckets) ProtoMessage()    {}
+func (*ReadStakingDataRequest_VoteBuckets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2, 0}
+}
+
+func (m *ReadStakingDataRequest_VoteBuckets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBuckets.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest_VoteBuckets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBuckets.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest_VoteBuckets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest_VoteBuckets.Merge(m, src)
+}
+func (m *ReadStakingDataRequest_VoteBuckets) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBuckets.Size(m)
+}
+func (m *ReadStakingDataRequest_VoteBuckets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest_VoteBuckets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest_VoteBuckets proto.InternalMessageInfo
+
+func (m *ReadStakingDataRequest_VoteBuckets) GetPagination() *PaginationParam {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ReadStakingDataRequest_VoteBucketsByVoter struct {
+	VoterAddress         string           `protobuf:"bytes,1,opt,name=voterAddress,proto3" json:"voterAddress,omitempty"`
+	Pagination           *PaginationParam `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) Reset() {
+	*m = ReadStakingDataRequest_VoteBucketsByVoter{}
+}
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) String() string { return proto.CompactTextString(m) }
+func (*ReadStakingDataRequest_VoteBucketsByVoter) ProtoMessage()    {}
+func (*ReadStakingDataRequest_VoteBucketsByVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2, 1}
+}
+
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByVoter.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByVoter.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByVoter.Merge(m, src)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByVoter.Size(m)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByVoter proto.InternalMessageInfo
+
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) GetVoterAddress() string {
+	if m != nil {
+		return m.VoterAddress
+	}
+	return ""
+}
+
+func (m *ReadStakingDataRequest_VoteBucketsByVoter) GetPagination() *PaginationParam {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ReadStakingDataRequest_VoteBucketsByCandidate struct {
+	CandName             string           `protobuf:"bytes,1,opt,name=candName,proto3" json:"candName,omitempty"`
+	Pagination           *PaginationParam `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) Reset() {
+	*m = ReadStakingDataRequest_VoteBucketsByCandidate{}
+}
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ReadStakingDataRequest_VoteBucketsByCandidate) ProtoMessage() {}
+func (*ReadStakingDataRequest_VoteBucketsByCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2, 2}
+}
+
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByCandidate.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByCandidate.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByCandidate.Merge(m, src)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByCandidate.Size(m)
+}
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest_VoteBucketsByCandidate proto.InternalMessageInfo
+
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) GetCandName() string {
+	if m != nil {
+		return m.CandName
+	}
+	return ""
+}
+
+func (m *ReadStakingDataRequest_VoteBucketsByCandidate) GetPagination() *PaginationParam {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ReadStakingDataRequest_Candidates struct {
+	Pagination           *PaginationParam `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReadStakingDataRequest_Candidates) Reset()         { *m = ReadStakingDataRequest_Candidates{} }
+func (m *ReadStakingDataRequest_Candidates) String() string { return proto.CompactTextString(m) }
+func (*ReadStakingDataRequest_Candidates) ProtoMessage()    {}
+func (*ReadStakingDataRequest_Candidates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2, 3}
+}
+
+func (m *ReadStakingDataRequest_Candidates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest_Candidates.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest_Candidates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest_Candidates.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest_Candidates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest_Candidates.Merge(m, src)
+}
+func (m *ReadStakingDataRequest_Candidates) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest_Candidates.Size(m)
+}
+func (m *ReadStakingDataRequest_Candidates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest_Candidates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest_Candidates proto.InternalMessageInfo
+
+func (m *ReadStakingDataRequest_Candidates) GetPagination() *PaginationParam {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ReadStakingDataRequest_CandidateByName struct {
+	CandName             string   `protobuf:"bytes,1,opt,name=candName,proto3" json:"candName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadStakingDataRequest_CandidateByName) Reset() {
+	*m = ReadStakingDataRequest_CandidateByName{}
+}
+func (m *ReadStakingDataRequest_CandidateByName) String() string { return proto.CompactTextString(m) }
+func (*ReadStakingDataRequest_CandidateByName) ProtoMessage()    {}
+func (*ReadStakingDataRequest_CandidateByName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2, 4}
+}
+
+func (m *ReadStakingDataRequest_CandidateByName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest_CandidateByName.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest_CandidateByName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest_CandidateByName.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest_CandidateByName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest_CandidateByName.Merge(m, src)
+}
+func (m *ReadStakingDataRequest_CandidateByName) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest_CandidateByName.Size(m)
+}
+func (m *ReadStakingDataRequest_CandidateByName) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest_CandidateByName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest_CandidateByName proto.InternalMessageInfo
+
+func (m *ReadStakingDataRequest_CandidateByName) GetCandName() string {
+	if m != nil {
+		return m.CandName
+	}
+	return ""
+}
+
+type ReadStakingDataRequest_VoterCountByCandidate struct {
+	CandName             string   `protobuf:"bytes,1,opt,name=candName,proto3" json:"candName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadStakingDataRequest_VoterCountByCandidate) Reset() {
+	*m = ReadStakingDataRequest_VoterCountByCandidate{}
+}
+func (m *ReadStakingDataRequest_VoterCountByCandidate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ReadStakingDataRequest_VoterCountByCandidate) ProtoMessage() {}
+func (*ReadStakingDataRequest_VoterCountByCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08103f271b0c8be, []int{2, 5}
+}
+
+func (m *ReadStakingDataRequest_VoterCountByCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStakingDataRequest_VoterCountByCandidate.Unmarshal(m, b)
+}
+func (m *ReadStakingDataRequest_VoterCountByCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStakingDataRequest_VoterCountByCandidate.Marshal(b, m, deterministic)
+}
+func (m *ReadStakingDataRequest_VoterCountByCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStakingDataRequest_VoterCountByCandidate.Merge(m, src)
+}
+func (m *ReadStakingDataRequest_VoterCountByCandidate) XXX_Size() int {
+	return xxx_messageInfo_ReadStakingDataRequest_VoterCountByCandidate.Size(m)
+}
+func (m *ReadStakingDataRequest_VoterCountByCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStakingDataRequest_VoterCountByCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStakingDataRequest_VoterCountByCandidate proto.InternalMessageInfo
+
+func (m *ReadStakingDataRequest_VoterCountByCandidate) GetCandName() string {
+	if m != nil {
+		return m.CandName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("iotexapi.ReadStakingDataMethod_Name", ReadStakingDataMethod_Name_name, ReadStakingDataMethod_Name_value)
+	proto.RegisterType((*PaginationParam)(nil), "iotexapi.PaginationParam")
+	proto.RegisterType((*ReadStakingDataMethod)(nil), "iotexapi.ReadStakingDataMethod")
+	proto.RegisterType((*ReadStakingDataRequest)(nil), "iotexapi.ReadStakingDataRequest")
+	proto.RegisterType((*ReadStakingDataRequest_VoteBuckets)(nil), "iotexapi.ReadStakingDataRequest.VoteBuckets")
+	proto.RegisterType((*ReadStakingDataRequest_VoteBucketsByVoter)(nil), "iotexapi.ReadStakingDataRequest.VoteBucketsByVoter")
+	proto.RegisterType((*ReadStakingDataRequest_VoteBucketsByCandidate)(nil), "iotexapi.ReadStakingDataRequest.VoteBucketsByCandidate")
+	proto.RegisterType((*ReadStakingDataRequest_Candidates)(nil), "iotexapi.ReadStakingDataRequest.Candidates")
+	proto.RegisterType((*ReadStakingDataRequest_CandidateByName)(nil), "iotexapi.ReadStakingDataRequest.CandidateByName")
+	proto.RegisterType((*ReadStakingDataRequest_VoterCountByCandidate)(nil), "iotexapi.ReadStakingDataRequest.VoterCountByCandidate")
+}
+
+func init() { proto.RegisterFile("proto/api/read_state.proto", fileDescriptor_a08103f271b0c8be) }
+
+var fileDescriptor_a08103f271b0c8be = []byte{
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x71, 0x4f, 0xda, 0x40,
+	0x1c, 0x45, 0x54, 0xc0, 0x1f, 0x4e, 0x6e, 0xbf, 0x88, 0xe9, 0xc8, 0xb2, 0x18, 0xe2, 0x1f, 0x4b,
+	0x36, 0x61, 0x91, 0x6c, 0xcb, 0x92, 0x25, 0x4b, 0x4b, 0xc9, 0x4a, 0x54, 0x30, 0x47, 0x31, 0xd9,
+	0xb2, 0x85, 0x9c, 0xf4, 0xc4, 0x4e, 0xe9, 0xb1, 0xf6, 0x58, 0xe6, 0xbf, 0xfb, 0x46, 0xfb, 0x3a,
+	0xfb, 0x34, 0x4b, 0xaf, 0xa5, 0x22, 0x76, 0x4e, 0xe3, 0x7f, 0xf7, 0x1e, 0xf7, 0xde, 0x3b, 0xde,
+	0xfd, 0xda, 0x42, 0x65, 0xe2, 0x0b, 0x29, 0xea, 0x6c, 0xe2, 0xd6, 0x7d, 0xce, 0x9c, 0x41, 0x20,
+	0x99, 0xe4, 0x35, 0x45, 0x62, 0xc1, 0x15, 0x92, 0xff, 0x64, 0x13, 0xb7, 0xfa, 0x01, 0x4a, 0x47,
+	0x6c, 0xe4, 0x7a, 0x4c, 0xba, 0xc2, 0x3b, 0x62, 0x3e, 0x1b, 0xe3, 0x16, 0xe4, 0xc4, 0xe9, 0x69,
+	0xc0, 0xa5, 0xb6, 0xb4, 0xbd, 0xf4, 0xfc, 0x11, 0x8d, 0x11, 0x6e, 0xc2, 0xea, 0x85, 0x3b, 0x76,
+	0xa5, 0x96, 0x55, 0x74, 0x04, 0xaa, 0x7f, 0xb2, 0x50, 0xa6, 0x9c, 0x39, 0x3d, 0xc9, 0xce, 0x5d,
+	0x6f, 0x64, 0x32, 0xc9, 0x0e, 0xb9, 0x3c, 0x13, 0x0e, 0xbe, 0x87, 0xdc, 0x58, 0xad, 0x94, 0xcf,
+	0xc6, 0xde, 0x4e, 0x6d, 0x96, 0x5a, 0x4b, 0x15, 0xd4, 0x3a, 0x6c, 0xcc, 0x69, 0xac, 0xa9, 0xfe,
+	0xca, 0xc2, 0x4a, 0x48, 0x60, 0x11, 0xf2, 0xed, 0xce, 0xb1, 0x7e, 0xd0, 0x36, 0x49, 0x26, 0x04,
+	0x46, 0xbf, 0xb9, 0xdf, 0xb2, 0x7b, 0x64, 0x09, 0x37, 0x81, 0xc4, 0x60, 0x60, 0x7c, 0x1a, 0x1c,
+	0x77, 0xed, 0x16, 0x25, 0x59, 0xd4, 0x60, 0x73, 0x8e, 0x6d, 0xea, 0x1d, 0xb3, 0x6d, 0xea, 0x76,
+	0x8b, 0x2c, 0xe3, 0x06, 0x40, 0x02, 0x7b, 0x64, 0x05, 0xcb, 0xf0, 0x38, 0xc1, 0xe1, 0xde, 0x8e,
+	0x7e, 0xd8, 0x22, 0xab, 0x48, 0x60, 0xdd, 0xee, 0xda, 0xfa, 0xc1, 0xa0, 0x67, 0xeb, 0xfb, 0x2d,
+	0x93, 0xe4, 0xb0, 0x04, 0xc5, 0x88, 0x09, 0x33, 0x7a, 0x24, 0x8f, 0x55, 0x78, 0xa6, 0x7e, 0x1c,
+	0x98, 0xed, 0x9e, 0x4d, 0xdb, 0x46, 0xdf, 0x6e, 0x77, 0x3b, 0xa1, 0x85, 0xd9, 0xa7, 0x7a, 0xb8,
+	0x26, 0x05, 0xdc, 0x81, 0xed, 0xf4, 0x3d, 0x7a, 0xdf, 0xee, 0x46, 0xde, 0x64, 0x0d, 0x9f, 0x82,
+	0xa6, 0x0e, 0x3e, 0x68, 0x76, 0xfb, 0x1d, 0xfb, 0xfa, 0x89, 0xa1, 0xfa, 0xbb, 0x00, 0x5b, 0x0b,
+	0x5d, 0x51, 0xfe, 0x7d, 0xca, 0x03, 0x89, 0x16, 0xe4, 0x4f, 0xa6, 0xc3, 0x73, 0x2e, 0x03, 0x55,
+	0x6f, 0x71, 0xef, 0xe5, 0x3f, 0xeb, 0x8d, 0x25, 0xb5, 0x63, 0x21, 0xb9, 0x11, 0x69, 0xac, 0x0c,
+	0x9d, 0xc9, 0xf1, 0x2b, 0x6c, 0xc4, 0x4b, 0xe3, 0x32, 0xdc, 0xe2, 0xab, 0x0b, 0x2e, 0xee, 0x35,
+	0xee, 0x63, 0x18, 0x4b, 0xad, 0x0c, 0x5d, 0x30, 0x43, 0x17, 0x30, 0x61, 0x9a, 0xcc, 0x73, 0x5c,
+	0x87, 0x49, 0xae, 0x2d, 0xab, 0x88, 0xb7, 0xf7, 0x8b, 0x48, 0xe4, 0x56, 0x86, 0xa6, 0x98, 0xe2,
+	0x21, 0xc0, 0x70, 0x06, 0x02, 0x6d, 0x45, 0x45, 0xbc, 0xf8, 0x6f, 0x44, 0xa2, 0x0f, 0x5b, 0x99,
+	0x33, 0xc0, 0x2f, 0x50, 0x4a, 0x90, 0x71, 0x19, 0x0e, 0xa3, 0xb6, 0xaa, 0x3c, 0x5f, 0xdd, 0xdd,
+	0x33, 0xd2, 0x59, 0x19, 0xba, 0x68, 0x85, 0x1e, 0x94, 0x7f, 0x84, 0x05, 0x35, 0xc5, 0xd4, 0x93,
+	0xf3, 0xd5, 0xe4, 0x54, 0xc6, 0x9b, 0x3b, 0x55, 0x73, 0x43, 0x6d, 0x65, 0x68, 0xba, 0x6d, 0xc5,
+	0x82, 0xe2, 0x5c, 0x99, 0xf8, 0x0e, 0x60, 0x92, 0x3c, 0xf8, 0xf1, 0x08, 0x3d, 0xb9, 0xca, 0x5c,
+	0x78, 0x29, 0xd0, 0xb9, 0xcd, 0x95, 0x00, 0xf0, 0xe6, 0xcd, 0x63, 0x15, 0xd6, 0x55, 0xb0, 0xee,
+	0x38, 0x3e, 0x0f, 0xa2, 0xa9, 0x5c, 0xa3, 0xd7, 0xb8, 0x85, 0xd0, 0xec, 0x7d, 0x42, 0x05, 0x6c,
+	0xa5, 0xcf, 0x02, 0x56, 0xa0, 0x10, 0x76, 0xab, 0xee, 0x27, 0x0a, 0x4d, 0xf0, 0x43, 0x02, 0x3f,
+	0x02, 0x5c, 0x4d, 0xc6, 0x43, 0xea, 0xda, 0x85, 0xd2, 0xc2, 0x38, 0xdc, 0x76, 0xe4, 0x4a, 0x03,
+	0xca, 0xa9, 0x37, 0x7b, 0x9b, 0xc8, 0x58, 0x83, 0xbc, 0x1f, 0x8d, 0x85, 0xf1, 0xfa, 0x73, 0x63,
+	0xe4, 0xca, 0xb3, 0xe9, 0x49, 0x6d, 0x28, 0xc6, 0x75, 0x75, 0xc2, 0x89, 0x2f, 0xbe, 0xf1, 0xa1,
+	0x8c, 0xc0, 0x6e, 0xf4, 0x5d, 0x18, 0x89, 0x0b, 0xe6, 0x8d, 0xea, 0xb3, 0x7f, 0x70, 0x92, 0x53,
+	0x74, 0xe3, 0xef, 0x00, 0xae, 0x73, 0xf3, 0x55, 0x37, 0x06, 0x00, 0x00,
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/rpc/rpc.proto
+
+package iotexrpc
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type MessageType int32
+
+const (
+	MessageType_UNKNOWN          MessageType = 0
+	MessageType_ACTION           MessageType = 1
+	MessageType_BLOCK            MessageType = 2
+	MessageType_CONSENSUS        MessageType = 3
+	MessageType_BLOCK_REQUEST    MessageType = 4
+	MessageType_PEER_STATUS      MessageType = 101
+	MessageType_HEADERS_REQUEST  MessageType = 102
+	MessageType_HEADERS          MessageType = 103
+	MessageType_BODIES_REQUEST   MessageType = 104
+	MessageType_BODIES           MessageType = 105
+	MessageType_COMPACT_BLOCK    MessageType = 106
+	MessageType_ACTIONS_REQUEST  MessageType = 107
+	MessageType_BLOCK_ACTIONS    MessageType = 108
+	MessageType_ACTION_INVENTORY MessageType = 109
+	MessageType_ACTION_REQUEST   MessageType = 110
+	MessageType_ACTIONS          MessageType = 111
+	MessageType_TEST             MessageType = 10001
+)
+
+var MessageType_name = map[int32]string{
+	0:     "UNKNOWN",
+	1:     "ACTION",
+	2:     "BLOCK",
+	3:     "CONSENSUS",
+	4:     "BLOCK_REQUEST",
+	101:   "PEER_STATUS",
+	102:   "HEADERS_REQUEST",
+	103:   "HEADERS",
+	104:   "BODIES_REQUEST",
+	105:   "BODIES",
+	106:   "COMPACT_BLOCK",
+	107:   "ACTIONS_REQUEST",
+	108:   "BLOCK_ACTIONS",
+	109:   "ACTION_INVENTORY",
+	110:   "ACTION_REQUEST",
+	111:   "ACTIONS",
+	10001: "TEST",
+}
+
+var MessageType_value = map[string]int32{
+	"UNKNOWN":          0,
+	"ACTION":           1,
+	"BLOCK":            2,
+	"CONSENSUS":        3,
+	"BLOCK_REQUEST":    4,
+	"PEER_STATUS":      101,
+	"HEADERS_REQUEST":  102,
+	"HEADERS":          103,
+	"BODIES_REQUEST":   104,
+	"BODIES":           105,
+	"COMPACT_BLOCK":    106,
+	"ACTIONS_REQUEST":  107,
+	"BLOCK_ACTIONS":    108,
+	"ACTION_INVENTORY": 109,
+	"ACTION_REQUEST":   110,
+	"ACTIONS":          111,
+	"TEST":             10001,
+}
+
+func (x MessageType) String() string {
+	return proto.EnumName(MessageType_name, int32(x))
+}
+
+func (MessageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{0}
+}
+
+type BlockSync struct {
+	Start                uint64   `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  uint64   `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockSync) Reset()         { *m = BlockSync{} }
+func (m *BlockSync) String() string { return proto.CompactTextString(m) }
+func (*BlockSync) ProtoMessage()    {}
+func (*BlockSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{0}
+}
+
+func (m *BlockSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSync.Unmarshal(m, b)
+}
+func (m *BlockSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSync.Marshal(b, m, deterministic)
+}
+func (m *BlockSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSync.Merge(m, src)
+}
+func (m *BlockSync) XXX_Size() int {
+	return xxx_messageInfo_BlockSync.Size(m)
+}
+func (m *BlockSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSync proto.InternalMessageInfo
+
+func (m *BlockSync) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *BlockSync) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type BroadcastMsg struct {
+	ChainId              uint32               `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	MsgType              MessageType          `protobuf:"varint,2,opt,name=msg_type,json=msgType,proto3,enum=iotexrpc.MessageType" json:"msg_type,omitempty"`
+	MsgBody              []byte               `protobuf:"bytes,3,opt,name=msg_body,json=msgBody,proto3" json:"msg_body,omitempty"`
+	PeerId               string               `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BroadcastMsg) Reset()         { *m = BroadcastMsg{} }
+func (m *BroadcastMsg) String() string { return proto.CompactTextString(m) }
+func (*BroadcastMsg) ProtoMessage()    {}
+func (*BroadcastMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{1}
+}
+
+func (m *BroadcastMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastMsg.Unmarshal(m, b)
+}
+func (m *BroadcastMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastMsg.Marshal(b, m, deterministic)
+}
+func (m *BroadcastMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastMsg.Merge(m, src)
+}
+func (m *BroadcastMsg) XXX_Size() int {
+	return xxx_messageInfo_BroadcastMsg.Size(m)
+}
+func (m *BroadcastMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastMsg proto.InternalMessageInfo
+
+func (m *BroadcastMsg) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BroadcastMsg) GetMsgType() MessageType {
+	if m != nil {
+		return m.MsgType
+	}
+	return MessageType_UNKNOWN
+}
+
+func (m *BroadcastMsg) GetMsgBody() []byte {
+	if m != nil {
+		return m.MsgBody
+	}
+	return nil
+}
+
+func (m *BroadcastMsg) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *BroadcastMsg) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type UnicastMsg struct {
+	ChainId              uint32               `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Addr                 string               `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	MsgType              MessageType          `protobuf:"varint,3,opt,name=msg_type,json=msgType,proto3,enum=iotexrpc.MessageType" json:"msg_type,omitempty"`
+	MsgBody              []byte               `protobuf:"bytes,4,opt,name=msg_body,json=msgBody,proto3" json:"msg_body,omitempty"`
+	PeerId               string               `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UnicastMsg) Reset()         { *m = UnicastMsg{} }
+func (m *UnicastMsg) String() string { return proto.CompactTextString(m) }
+func (*UnicastMsg) ProtoMessage()    {}
+func (*UnicastMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{2}
+}
+
+func (m *UnicastMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnicastMsg.Unmarshal(m, b)
+}
+func (m *UnicastMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnicastMsg.Marshal(b, m, deterministic)
+}
+func (m *UnicastMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnicastMsg.Merge(m, src)
+}
+func (m *UnicastMsg) XXX_Size() int {
+	return xxx_messageInfo_UnicastMsg.Size(m)
+}
+func (m *UnicastMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnicastMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnicastMsg proto.InternalMessageInfo
+
+func (m *UnicastMsg) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *UnicastMsg) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *UnicastMsg) GetMsgType() MessageType {
+	if m != nil {
+		return m.MsgType
+	}
+	return MessageType_UNKNOWN
+}
+
+func (m *UnicastMsg) GetMsgBody() []byte {
+	if m != nil {
+		return m.MsgBody
+	}
+	return nil
+}
+
+func (m *UnicastMsg) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *UnicastMsg) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("iotexrpc.MessageType", MessageType_name, MessageType_value)
+	proto.RegisterType((*BlockSync)(nil), "iotexrpc.BlockSync")
+	proto.RegisterType((*BroadcastMsg)(nil), "iotexrpc.BroadcastMsg")
+	proto.RegisterType((*UnicastMsg)(nil), "iotexrpc.UnicastMsg")
+}
+
+func init() { proto.RegisterFile("proto/rpc/rpc.proto", fileDescriptor_59d40974ffbedc26) }
+
+var fileDescriptor_59d40974ffbedc26 = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xc4, 0x8d, 0xf3, 0xe3, 0x2f, 0x4d, 0xbb, 0x7c, 0x2d, 0x22, 0xed, 0x85, 0x28, 0xa7, 0x08,
+	0x09, 0x07, 0x35, 0x42, 0xe2, 0x1a, 0xa7, 0x96, 0x88, 0x4a, 0xec, 0x62, 0x3b, 0x20, 0xb8, 0x58,
+	0x8e, 0xbd, 0x75, 0xdc, 0xc6, 0x5e, 0xcb, 0x76, 0x25, 0xf2, 0x18, 0x3c, 0x03, 0x4f, 0xc3, 0x33,
+	0xf0, 0x32, 0x68, 0x77, 0xe3, 0x06, 0x0e, 0x95, 0xe8, 0xc1, 0xd2, 0xce, 0x7c, 0xf3, 0xed, 0xcc,
+	0x68, 0x65, 0x38, 0xc9, 0x0b, 0x56, 0xb1, 0x71, 0x91, 0x87, 0xfc, 0xd3, 0x05, 0xc2, 0x4e, 0xc2,
+	0x2a, 0xfa, 0xbd, 0xc8, 0xc3, 0xf3, 0x57, 0x31, 0x63, 0xf1, 0x86, 0x8e, 0x05, 0xbf, 0xba, 0xbf,
+	0x19, 0x57, 0x49, 0x4a, 0xcb, 0x2a, 0x48, 0x73, 0x29, 0x1d, 0x4e, 0x40, 0x33, 0x36, 0x2c, 0xbc,
+	0x73, 0xb7, 0x59, 0x88, 0xa7, 0xd0, 0x2c, 0xab, 0xa0, 0xa8, 0xfa, 0x07, 0x03, 0x65, 0xa4, 0x3a,
+	0x12, 0x20, 0x81, 0x06, 0xcd, 0xa2, 0x7e, 0x43, 0x70, 0xfc, 0x38, 0xfc, 0xa5, 0xc0, 0xa1, 0x51,
+	0xb0, 0x20, 0x0a, 0x83, 0xb2, 0x5a, 0x94, 0x31, 0x9e, 0x41, 0x27, 0x5c, 0x07, 0x49, 0xe6, 0x27,
+	0x51, 0x5f, 0x19, 0x28, 0xa3, 0x9e, 0xd3, 0x16, 0x78, 0x1e, 0xe1, 0x5b, 0xe8, 0xa4, 0x65, 0xec,
+	0x57, 0xdb, 0x9c, 0x8a, 0x6b, 0x8f, 0x2e, 0x5e, 0xe8, 0x75, 0x3c, 0x7d, 0x41, 0xcb, 0x32, 0x88,
+	0xa9, 0xb7, 0xcd, 0xa9, 0xd3, 0x4e, 0xcb, 0x98, 0x1f, 0xf0, 0x4c, 0x6e, 0xac, 0x58, 0xb4, 0x15,
+	0xa6, 0x87, 0x62, 0x64, 0xb0, 0x68, 0x8b, 0x2f, 0xa1, 0x9d, 0x53, 0x5a, 0x70, 0x1b, 0x75, 0xa0,
+	0x8c, 0x34, 0xa7, 0xc5, 0xe1, 0x3c, 0xc2, 0xf7, 0xa0, 0x3d, 0x34, 0xeb, 0x37, 0x07, 0xca, 0xa8,
+	0x7b, 0x71, 0xae, 0xcb, 0xee, 0x7a, 0xdd, 0x5d, 0xf7, 0x6a, 0x85, 0xb3, 0x17, 0x0f, 0x7f, 0x2b,
+	0x00, 0xcb, 0x2c, 0xf9, 0x8f, 0x26, 0x08, 0x6a, 0x10, 0x45, 0x85, 0x68, 0xa1, 0x39, 0xe2, 0xfc,
+	0x4f, 0xbb, 0xc6, 0x93, 0xdb, 0xa9, 0x8f, 0xb6, 0x6b, 0x3e, 0xde, 0xae, 0xf5, 0x84, 0x76, 0xaf,
+	0x7f, 0x1e, 0x40, 0xf7, 0xaf, 0x18, 0xd8, 0x85, 0xf6, 0xd2, 0xba, 0xb2, 0xec, 0x2f, 0x16, 0x79,
+	0x86, 0x00, 0xad, 0xe9, 0xcc, 0x9b, 0xdb, 0x16, 0x51, 0x50, 0x83, 0xa6, 0xf1, 0xd1, 0x9e, 0x5d,
+	0x91, 0x03, 0xec, 0x81, 0x36, 0xb3, 0x2d, 0xd7, 0xb4, 0xdc, 0xa5, 0x4b, 0x1a, 0xf8, 0x1c, 0x7a,
+	0x62, 0xe2, 0x3b, 0xe6, 0xa7, 0xa5, 0xe9, 0x7a, 0x44, 0xc5, 0x63, 0xe8, 0x5e, 0x9b, 0xa6, 0xe3,
+	0xbb, 0xde, 0xd4, 0x5b, 0xba, 0x84, 0xe2, 0x09, 0x1c, 0x7f, 0x30, 0xa7, 0x97, 0xa6, 0xe3, 0x3e,
+	0xa8, 0x6e, 0xb8, 0xd7, 0x8e, 0x24, 0x31, 0x22, 0x1c, 0x19, 0xf6, 0xe5, 0xdc, 0xdc, 0x0b, 0xd6,
+	0xdc, 0x5f, 0x72, 0x24, 0xe1, 0x2e, 0x33, 0x7b, 0x71, 0x3d, 0x9d, 0x79, 0xbe, 0xcc, 0x71, 0xcb,
+	0x2f, 0x95, 0xf1, 0xf6, 0x3b, 0x77, 0xfb, 0x34, 0xbb, 0x11, 0xd9, 0xe0, 0x29, 0x10, 0x09, 0xfc,
+	0xb9, 0xf5, 0xd9, 0xb4, 0x3c, 0xdb, 0xf9, 0x4a, 0x52, 0x6e, 0xb8, 0x63, 0xeb, 0xe5, 0x8c, 0x27,
+	0xaa, 0xd7, 0x18, 0x6a, 0xa0, 0x7a, 0x9c, 0xfe, 0x61, 0x19, 0xef, 0xbe, 0x4d, 0xe2, 0xa4, 0x5a,
+	0xdf, 0xaf, 0xf4, 0x90, 0xa5, 0x63, 0xf1, 0x7e, 0x79, 0xc1, 0x6e, 0x69, 0x58, 0x49, 0xf0, 0x46,
+	0xfe, 0x64, 0x31, 0xdb, 0x04, 0x59, 0x3c, 0xae, 0xdf, 0x77, 0xd5, 0x12, 0xf4, 0xe4, 0xcf, 0x00,
+	0xa1, 0x7c, 0x6f, 0x15, 0x84, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,3268 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/action.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type RewardType int32
+
+const (
+	RewardType_BlockReward RewardType = 0
+	RewardType_EpochReward RewardType = 1
+)
+
+var RewardType_name = map[int32]string{
+	0: "BlockReward",
+	1: "EpochReward",
+}
+
+var RewardType_value = map[string]int32{
+	"BlockReward": 0,
+	"EpochReward": 1,
+}
+
+func (x RewardType) String() string {
+	return proto.EnumName(RewardType_name, int32(x))
+}
+
+func (RewardType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{0}
+}
+
+type Transfer struct {
+	// used by state-based model
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{0}
+}
+
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transfer.Unmarshal(m, b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return xxx_messageInfo_Transfer.Size(m)
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
+func (m *Transfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Transfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Transfer) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// Candidates and list of candidates
+type Candidate struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Votes                []byte   `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,4,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Candidate) Reset()         { *m = Candidate{} }
+func (m *Candidate) String() string { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()    {}
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{1}
+}
+
+func (m *Candidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candidate.Unmarshal(m, b)
+}
+func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
+}
+func (m *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(m, src)
+}
+func (m *Candidate) XXX_Size() int {
+	return xxx_messageInfo_Candidate.Size(m)
+}
+func (m *Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidate proto.InternalMessageInfo
+
+func (m *Candidate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Candidate) GetVotes() []byte {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *Candidate) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *Candidate) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+type CandidateList struct {
+	Candidates           []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CandidateList) Reset()         { *m = CandidateList{} }
+func (m *CandidateList) String() string { return proto.CompactTextString(m) }
+func (*CandidateList) ProtoMessage()    {}
+func (*CandidateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{2}
+}
+
+func (m *CandidateList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateList.Unmarshal(m, b)
+}
+func (m *CandidateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateList.Marshal(b, m, deterministic)
+}
+func (m *CandidateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateList.Merge(m, src)
+}
+func (m *CandidateList) XXX_Size() int {
+	return xxx_messageInfo_CandidateList.Size(m)
+}
+func (m *CandidateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateList proto.InternalMessageInfo
+
+func (m *CandidateList) GetCandidates() []*Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type PutPollResult struct {
+	Height               uint64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Candidates           *CandidateList `protobuf:"bytes,2,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PutPollResult) Reset()         { *m = PutPollResult{} }
+func (m *PutPollResult) String() string { return proto.CompactTextString(m) }
+func (*PutPollResult) ProtoMessage()    {}
+func (*PutPollResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{3}
+}
+
+func (m *PutPollResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutPollResult.Unmarshal(m, b)
+}
+func (m *PutPollResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutPollResult.Marshal(b, m, deterministic)
+}
+func (m *PutPollResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutPollResult.Merge(m, src)
+}
+func (m *PutPollResult) XXX_Size() int {
+	return xxx_messageInfo_PutPollResult.Size(m)
+}
+func (m *PutPollResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutPollResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutPollResult proto.InternalMessageInfo
+
+func (m *PutPollResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PutPollResult) GetCandidates() *CandidateList {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type Execution struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Contract             string   `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Execution) Reset()         { *m = Execution{} }
+func (m *Execution) String() string { return proto.CompactTextString(m) }
+func (*Execution) ProtoMessage()    {}
+func (*Execution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{4}
+}
+
+func (m *Execution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Execution.Unmarshal(m, b)
+}
+func (m *Execution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Execution.Marshal(b, m, deterministic)
+}
+func (m *Execution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Execution.Merge(m, src)
+}
+func (m *Execution) XXX_Size() int {
+	return xxx_messageInfo_Execution.Size(m)
+}
+func (m *Execution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Execution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Execution proto.InternalMessageInfo
+
+func (m *Execution) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Execution) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Execution) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// create stake
+type StakeCreate struct {
+	CandidateName        string   `protobuf:"bytes,1,opt,name=candidateName,proto3" json:"candidateName,omitempty"`
+	StakedAmount         string   `protobuf:"bytes,2,opt,name=stakedAmount,proto3" json:"stakedAmount,omitempty"`
+	StakedDuration       uint32   `protobuf:"varint,3,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	AutoStake            bool     `protobuf:"varint,4,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeCreate) Reset()         { *m = StakeCreate{} }
+func (m *StakeCreate) String() string { return proto.CompactTextString(m) }
+func (*StakeCreate) ProtoMessage()    {}
+func (*StakeCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{5}
+}
+
+func (m *StakeCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeCreate.Unmarshal(m, b)
+}
+func (m *StakeCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeCreate.Marshal(b, m, deterministic)
+}
+func (m *StakeCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeCreate.Merge(m, src)
+}
+func (m *StakeCreate) XXX_Size() int {
+	return xxx_messageInfo_StakeCreate.Size(m)
+}
+func (m *StakeCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeCreate proto.InternalMessageInfo
+
+func (m *StakeCreate) GetCandidateName() string {
+	if m != nil {
+		return m.CandidateName
+	}
+	return ""
+}
+
+func (m *StakeCreate) GetStakedAmount() string {
+	if m != nil {
+		return m.StakedAmount
+	}
+	return ""
+}
+
+func (m *StakeCreate) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *StakeCreate) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *StakeCreate) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// unstake or withdraw
+type StakeReclaim struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeReclaim) Reset()         { *m = StakeReclaim{} }
+func (m *StakeReclaim) String() string { return proto.CompactTextString(m) }
+func (*StakeReclaim) ProtoMessage()    {}
+func (*StakeReclaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{6}
+}
+
+func (m *StakeReclaim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeReclaim.Unmarshal(m, b)
+}
+func (m *StakeReclaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeReclaim.Marshal(b, m, deterministic)
+}
+func (m *StakeReclaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeReclaim.Merge(m, src)
+}
+func (m *StakeReclaim) XXX_Size() int {
+	return xxx_messageInfo_StakeReclaim.Size(m)
+}
+func (m *StakeReclaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeReclaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeReclaim proto.InternalMessageInfo
+
+func (m *StakeReclaim) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeReclaim) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// add the amount of bucket
+type StakeAddDeposit struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeAddDeposit) Reset()         { *m = StakeAddDeposit{} }
+func (m *StakeAddDeposit) String() string { return proto.CompactTextString(m) }
+func (*StakeAddDeposit) ProtoMessage()    {}
+func (*StakeAddDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{7}
+}
+
+func (m *StakeAddDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeAddDeposit.Unmarshal(m, b)
+}
+func (m *StakeAddDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeAddDeposit.Marshal(b, m, deterministic)
+}
+func (m *StakeAddDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeAddDeposit.Merge(m, src)
+}
+func (m *StakeAddDeposit) XXX_Size() int {
+	return xxx_messageInfo_StakeAddDeposit.Size(m)
+}
+func (m *StakeAddDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeAddDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeAddDeposit proto.InternalMessageInfo
+
+func (m *StakeAddDeposit) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeAddDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *StakeAddDeposit) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// restake the duration and autoStake flag of bucket
+type StakeRestake struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	StakedDuration       uint32   `protobuf:"varint,2,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	AutoStake            bool     `protobuf:"varint,3,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeRestake) Reset()         { *m = StakeRestake{} }
+func (m *StakeRestake) String() string { return proto.CompactTextString(m) }
+func (*StakeRestake) ProtoMessage()    {}
+func (*StakeRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{8}
+}
+
+func (m *StakeRestake) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeRestake.Unmarshal(m, b)
+}
+func (m *StakeRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeRestake.Marshal(b, m, deterministic)
+}
+func (m *StakeRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeRestake.Merge(m, src)
+}
+func (m *StakeRestake) XXX_Size() int {
+	return xxx_messageInfo_StakeRestake.Size(m)
+}
+func (m *StakeRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeRestake proto.InternalMessageInfo
+
+func (m *StakeRestake) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeRestake) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *StakeRestake) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *StakeRestake) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// move the bucket to vote for another candidate or transfer the ownership of bucket to another voters
+type StakeChangeCandidate struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	CandidateName        string   `protobuf:"bytes,2,opt,name=candidateName,proto3" json:"candidateName,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeChangeCandidate) Reset()         { *m = StakeChangeCandidate{} }
+func (m *StakeChangeCandidate) String() string { return proto.CompactTextString(m) }
+func (*StakeChangeCandidate) ProtoMessage()    {}
+func (*StakeChangeCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{9}
+}
+
+func (m *StakeChangeCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeChangeCandidate.Unmarshal(m, b)
+}
+func (m *StakeChangeCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeChangeCandidate.Marshal(b, m, deterministic)
+}
+func (m *StakeChangeCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeChangeCandidate.Merge(m, src)
+}
+func (m *StakeChangeCandidate) XXX_Size() int {
+	return xxx_messageInfo_StakeChangeCandidate.Size(m)
+}
+func (m *StakeChangeCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeChangeCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeChangeCandidate proto.InternalMessageInfo
+
+func (m *StakeChangeCandidate) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeChangeCandidate) GetCandidateName() string {
+	if m != nil {
+		return m.CandidateName
+	}
+	return ""
+}
+
+func (m *StakeChangeCandidate) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type StakeTransferOwnership struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	VoterAddress         string   `protobuf:"bytes,2,opt,name=voterAddress,proto3" json:"voterAddress,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeTransferOwnership) Reset()         { *m = StakeTransferOwnership{} }
+func (m *StakeTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*StakeTransferOwnership) ProtoMessage()    {}
+func (*StakeTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{10}
+}
+
+func (m *StakeTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeTransferOwnership.Unmarshal(m, b)
+}
+func (m *StakeTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeTransferOwnership.Marshal(b, m, deterministic)
+}
+func (m *StakeTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeTransferOwnership.Merge(m, src)
+}
+func (m *StakeTransferOwnership) XXX_Size() int {
+	return xxx_messageInfo_StakeTransferOwnership.Size(m)
+}
+func (m *StakeTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeTransferOwnership proto.InternalMessageInfo
+
+func (m *StakeTransferOwnership) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeTransferOwnership) GetVoterAddress() string {
+	if m != nil {
+		return m.VoterAddress
+	}
+	return ""
+}
+
+func (m *StakeTransferOwnership) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type CandidateBasicInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OperatorAddress      string   `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateBasicInfo) Reset()         { *m = CandidateBasicInfo{} }
+func (m *CandidateBasicInfo) String() string { return proto.CompactTextString(m) }
+func (*CandidateBasicInfo) ProtoMessage()    {}
+func (*CandidateBasicInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{11}
+}
+
+func (m *CandidateBasicInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateBasicInfo.Unmarshal(m, b)
+}
+func (m *CandidateBasicInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateBasicInfo.Marshal(b, m, deterministic)
+}
+func (m *CandidateBasicInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateBasicInfo.Merge(m, src)
+}
+func (m *CandidateBasicInfo) XXX_Size() int {
+	return xxx_messageInfo_CandidateBasicInfo.Size(m)
+}
+func (m *CandidateBasicInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateBasicInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateBasicInfo proto.InternalMessageInfo
+
+func (m *CandidateBasicInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CandidateBasicInfo) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *CandidateBasicInfo) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+type CandidateRegister struct {
+	Candidate            *CandidateBasicInfo `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	StakedAmount         string              `protobuf:"bytes,2,opt,name=stakedAmount,proto3" json:"stakedAmount,omitempty"`
+	StakedDuration       uint32              `protobuf:"varint,3,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	AutoStake            bool                `protobuf:"varint,4,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	OwnerAddress         string              `protobuf:"bytes,5,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
+	Payload              []byte              `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CandidateRegister) Reset()         { *m = CandidateRegister{} }
+func (m *CandidateRegister) String() string { return proto.CompactTextString(m) }
+func (*CandidateRegister) ProtoMessage()    {}
+func (*CandidateRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{12}
+}
+
+func (m *CandidateRegister) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateRegister.Unmarshal(m, b)
+}
+func (m *CandidateRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateRegister.Marshal(b, m, deterministic)
+}
+func (m *CandidateRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateRegister.Merge(m, src)
+}
+func (m *CandidateRegister) XXX_Size() int {
+	return xxx_messageInfo_CandidateRegister.Size(m)
+}
+func (m *CandidateRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateRegister proto.InternalMessageInfo
+
+func (m *CandidateRegister) GetCandidate() *CandidateBasicInfo {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *CandidateRegister) GetStakedAmount() string {
+	if m != nil {
+		return m.StakedAmount
+	}
+	return ""
+}
+
+func (m *CandidateRegister) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *CandidateRegister) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *CandidateRegister) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *CandidateRegister) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type StartSubChain struct {
+	// TODO: chainID chould be assigned by system and returned via a receipt
+	ChainID              uint32   `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	SecurityDeposit      string   `protobuf:"bytes,2,opt,name=securityDeposit,proto3" json:"securityDeposit,omitempty"`
+	OperationDeposit     string   `protobuf:"bytes,3,opt,name=operationDeposit,proto3" json:"operationDeposit,omitempty"`
+	StartHeight          uint64   `protobuf:"varint,4,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	ParentHeightOffset   uint64   `protobuf:"varint,5,opt,name=parentHeightOffset,proto3" json:"parentHeightOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartSubChain) Reset()         { *m = StartSubChain{} }
+func (m *StartSubChain) String() string { return proto.CompactTextString(m) }
+func (*StartSubChain) ProtoMessage()    {}
+func (*StartSubChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{13}
+}
+
+func (m *StartSubChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartSubChain.Unmarshal(m, b)
+}
+func (m *StartSubChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartSubChain.Marshal(b, m, deterministic)
+}
+func (m *StartSubChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSubChain.Merge(m, src)
+}
+func (m *StartSubChain) XXX_Size() int {
+	return xxx_messageInfo_StartSubChain.Size(m)
+}
+func (m *StartSubChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSubChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartSubChain proto.InternalMessageInfo
+
+func (m *StartSubChain) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *StartSubChain) GetSecurityDeposit() string {
+	if m != nil {
+		return m.SecurityDeposit
+	}
+	return ""
+}
+
+func (m *StartSubChain) GetOperationDeposit() string {
+	if m != nil {
+		return m.OperationDeposit
+	}
+	return ""
+}
+
+func (m *StartSubChain) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *StartSubChain) GetParentHeightOffset() uint64 {
+	if m != nil {
+		return m.ParentHeightOffset
+	}
+	return 0
+}
+
+type StopSubChain struct {
+	ChainID              uint32   `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	StopHeight           uint64   `protobuf:"varint,2,opt,name=stopHeight,proto3" json:"stopHeight,omitempty"`
+	SubChainAddress      string   `protobuf:"bytes,3,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopSubChain) Reset()         { *m = StopSubChain{} }
+func (m *StopSubChain) String() string { return proto.CompactTextString(m) }
+func (*StopSubChain) ProtoMessage()    {}
+func (*StopSubChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{14}
+}
+
+func (m *StopSubChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopSubChain.Unmarshal(m, b)
+}
+func (m *StopSubChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopSubChain.Marshal(b, m, deterministic)
+}
+func (m *StopSubChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopSubChain.Merge(m, src)
+}
+func (m *StopSubChain) XXX_Size() int {
+	return xxx_messageInfo_StopSubChain.Size(m)
+}
+func (m *StopSubChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopSubChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopSubChain proto.InternalMessageInfo
+
+func (m *StopSubChain) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *StopSubChain) GetStopHeight() uint64 {
+	if m != nil {
+		return m.StopHeight
+	}
+	return 0
+}
+
+func (m *StopSubChain) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+type MerkleRoot struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleRoot) Reset()         { *m = MerkleRoot{} }
+func (m *MerkleRoot) String() string { return proto.CompactTextString(m) }
+func (*MerkleRoot) ProtoMessage()    {}
+func (*MerkleRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{15}
+}
+
+func (m *MerkleRoot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleRoot.Unmarshal(m, b)
+}
+func (m *MerkleRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleRoot.Marshal(b, m, deterministic)
+}
+func (m *MerkleRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleRoot.Merge(m, src)
+}
+func (m *MerkleRoot) XXX_Size() int {
+	return xxx_messageInfo_MerkleRoot.Size(m)
+}
+func (m *MerkleRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleRoot proto.InternalMessageInfo
+
+func (m *MerkleRoot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MerkleRoot) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type PutBlock struct {
+	SubChainAddress      string        `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	Height               uint64        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Roots                []*MerkleRoot `protobuf:"bytes,3,rep,name=roots,proto3" json:"roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PutBlock) Reset()         { *m = PutBlock{} }
+func (m *PutBlock) String() string { return proto.CompactTextString(m) }
+func (*PutBlock) ProtoMessage()    {}
+func (*PutBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{16}
+}
+
+func (m *PutBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutBlock.Unmarshal(m, b)
+}
+func (m *PutBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutBlock.Marshal(b, m, deterministic)
+}
+func (m *PutBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutBlock.Merge(m, src)
+}
+func (m *PutBlock) XXX_Size() int {
+	return xxx_messageInfo_PutBlock.Size(m)
+}
+func (m *PutBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutBlock proto.InternalMessageInfo
+
+func (m *PutBlock) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PutBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PutBlock) GetRoots() []*MerkleRoot {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type CreateDeposit struct {
+	ChainID              uint32   `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeposit) Reset()         { *m = CreateDeposit{} }
+func (m *CreateDeposit) String() string { return proto.CompactTextString(m) }
+func (*CreateDeposit) ProtoMessage()    {}
+func (*CreateDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{17}
+}
+
+func (m *CreateDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeposit.Unmarshal(m, b)
+}
+func (m *CreateDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeposit.Marshal(b, m, deterministic)
+}
+func (m *CreateDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeposit.Merge(m, src)
+}
+func (m *CreateDeposit) XXX_Size() int {
+	return xxx_messageInfo_CreateDeposit.Size(m)
+}
+func (m *CreateDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeposit proto.InternalMessageInfo
+
+func (m *CreateDeposit) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *CreateDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *CreateDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type SettleDeposit struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Index                uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleDeposit) Reset()         { *m = SettleDeposit{} }
+func (m *SettleDeposit) String() string { return proto.CompactTextString(m) }
+func (*SettleDeposit) ProtoMessage()    {}
+func (*SettleDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{18}
+}
+
+func (m *SettleDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleDeposit.Unmarshal(m, b)
+}
+func (m *SettleDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleDeposit.Marshal(b, m, deterministic)
+}
+func (m *SettleDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleDeposit.Merge(m, src)
+}
+func (m *SettleDeposit) XXX_Size() int {
+	return xxx_messageInfo_SettleDeposit.Size(m)
+}
+func (m *SettleDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleDeposit proto.InternalMessageInfo
+
+func (m *SettleDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SettleDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *SettleDeposit) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// plum main chain APIs
+type CreatePlumChain struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePlumChain) Reset()         { *m = CreatePlumChain{} }
+func (m *CreatePlumChain) String() string { return proto.CompactTextString(m) }
+func (*CreatePlumChain) ProtoMessage()    {}
+func (*CreatePlumChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{19}
+}
+
+func (m *CreatePlumChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePlumChain.Unmarshal(m, b)
+}
+func (m *CreatePlumChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePlumChain.Marshal(b, m, deterministic)
+}
+func (m *CreatePlumChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePlumChain.Merge(m, src)
+}
+func (m *CreatePlumChain) XXX_Size() int {
+	return xxx_messageInfo_CreatePlumChain.Size(m)
+}
+func (m *CreatePlumChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePlumChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePlumChain proto.InternalMessageInfo
+
+type TerminatePlumChain struct {
+	SubChainAddress      string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminatePlumChain) Reset()         { *m = TerminatePlumChain{} }
+func (m *TerminatePlumChain) String() string { return proto.CompactTextString(m) }
+func (*TerminatePlumChain) ProtoMessage()    {}
+func (*TerminatePlumChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{20}
+}
+
+func (m *TerminatePlumChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminatePlumChain.Unmarshal(m, b)
+}
+func (m *TerminatePlumChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminatePlumChain.Marshal(b, m, deterministic)
+}
+func (m *TerminatePlumChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminatePlumChain.Merge(m, src)
+}
+func (m *TerminatePlumChain) XXX_Size() int {
+	return xxx_messageInfo_TerminatePlumChain.Size(m)
+}
+func (m *TerminatePlumChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminatePlumChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminatePlumChain proto.InternalMessageInfo
+
+func (m *TerminatePlumChain) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+type PlumPutBlock struct {
+	SubChainAddress      string            `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	Height               uint64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Roots                map[string][]byte `protobuf:"bytes,3,rep,name=roots,proto3" json:"roots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PlumPutBlock) Reset()         { *m = PlumPutBlock{} }
+func (m *PlumPutBlock) String() string { return proto.CompactTextString(m) }
+func (*PlumPutBlock) ProtoMessage()    {}
+func (*PlumPutBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{21}
+}
+
+func (m *PlumPutBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumPutBlock.Unmarshal(m, b)
+}
+func (m *PlumPutBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumPutBlock.Marshal(b, m, deterministic)
+}
+func (m *PlumPutBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumPutBlock.Merge(m, src)
+}
+func (m *PlumPutBlock) XXX_Size() int {
+	return xxx_messageInfo_PlumPutBlock.Size(m)
+}
+func (m *PlumPutBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumPutBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumPutBlock proto.InternalMessageInfo
+
+func (m *PlumPutBlock) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumPutBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PlumPutBlock) GetRoots() map[string][]byte {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type PlumCreateDeposit struct {
+	SubChainAddress      string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumCreateDeposit) Reset()         { *m = PlumCreateDeposit{} }
+func (m *PlumCreateDeposit) String() string { return proto.CompactTextString(m) }
+func (*PlumCreateDeposit) ProtoMessage()    {}
+func (*PlumCreateDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{22}
+}
+
+func (m *PlumCreateDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumCreateDeposit.Unmarshal(m, b)
+}
+func (m *PlumCreateDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumCreateDeposit.Marshal(b, m, deterministic)
+}
+func (m *PlumCreateDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumCreateDeposit.Merge(m, src)
+}
+func (m *PlumCreateDeposit) XXX_Size() int {
+	return xxx_messageInfo_PlumCreateDeposit.Size(m)
+}
+func (m *PlumCreateDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumCreateDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumCreateDeposit proto.InternalMessageInfo
+
+func (m *PlumCreateDeposit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumCreateDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *PlumCreateDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type PlumStartExit struct {
+	SubChainAddress             string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	PreviousTransfer            []byte   `protobuf:"bytes,2,opt,name=previousTransfer,proto3" json:"previousTransfer,omitempty"`
+	PreviousTransferBlockProof  []byte   `protobuf:"bytes,3,opt,name=previousTransferBlockProof,proto3" json:"previousTransferBlockProof,omitempty"`
+	PreviousTransferBlockHeight uint64   `protobuf:"varint,4,opt,name=previousTransferBlockHeight,proto3" json:"previousTransferBlockHeight,omitempty"`
+	ExitTransfer                []byte   `protobuf:"bytes,5,opt,name=exitTransfer,proto3" json:"exitTransfer,omitempty"`
+	ExitTransferBlockProof      []byte   `protobuf:"bytes,6,opt,name=exitTransferBlockProof,proto3" json:"exitTransferBlockProof,omitempty"`
+	ExitTransferBlockHeight     uint64   `protobuf:"varint,7,opt,name=exitTransferBlockHeight,proto3" json:"exitTransferBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *PlumStartExit) Reset()         { *m = PlumStartExit{} }
+func (m *PlumStartExit) String() string { return proto.CompactTextString(m) }
+func (*PlumStartExit) ProtoMessage()    {}
+func (*PlumStartExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{23}
+}
+
+func (m *PlumStartExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumStartExit.Unmarshal(m, b)
+}
+func (m *PlumStartExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumStartExit.Marshal(b, m, deterministic)
+}
+func (m *PlumStartExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumStartExit.Merge(m, src)
+}
+func (m *PlumStartExit) XXX_Size() int {
+	return xxx_messageInfo_PlumStartExit.Size(m)
+}
+func (m *PlumStartExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumStartExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumStartExit proto.InternalMessageInfo
+
+func (m *PlumStartExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumStartExit) GetPreviousTransfer() []byte {
+	if m != nil {
+		return m.PreviousTransfer
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetPreviousTransferBlockProof() []byte {
+	if m != nil {
+		return m.PreviousTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetPreviousTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.PreviousTransferBlockHeight
+	}
+	return 0
+}
+
+func (m *PlumStartExit) GetExitTransfer() []byte {
+	if m != nil {
+		return m.ExitTransfer
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetExitTransferBlockProof() []byte {
+	if m != nil {
+		return m.ExitTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetExitTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.ExitTransferBlockHeight
+	}
+	return 0
+}
+
+type PlumChallengeExit struct {
+	SubChainAddress              string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	CoinID                       uint64   `protobuf:"varint,2,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	ChallengeTransfer            []byte   `protobuf:"bytes,3,opt,name=challengeTransfer,proto3" json:"challengeTransfer,omitempty"`
+	ChallengeTransferBlockProof  []byte   `protobuf:"bytes,4,opt,name=challengeTransferBlockProof,proto3" json:"challengeTransferBlockProof,omitempty"`
+	ChallengeTransferBlockHeight uint64   `protobuf:"varint,5,opt,name=challengeTransferBlockHeight,proto3" json:"challengeTransferBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *PlumChallengeExit) Reset()         { *m = PlumChallengeExit{} }
+func (m *PlumChallengeExit) String() string { return proto.CompactTextString(m) }
+func (*PlumChallengeExit) ProtoMessage()    {}
+func (*PlumChallengeExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{24}
+}
+
+func (m *PlumChallengeExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumChallengeExit.Unmarshal(m, b)
+}
+func (m *PlumChallengeExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumChallengeExit.Marshal(b, m, deterministic)
+}
+func (m *PlumChallengeExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumChallengeExit.Merge(m, src)
+}
+func (m *PlumChallengeExit) XXX_Size() int {
+	return xxx_messageInfo_PlumChallengeExit.Size(m)
+}
+func (m *PlumChallengeExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumChallengeExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumChallengeExit proto.InternalMessageInfo
+
+func (m *PlumChallengeExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumChallengeExit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+func (m *PlumChallengeExit) GetChallengeTransfer() []byte {
+	if m != nil {
+		return m.ChallengeTransfer
+	}
+	return nil
+}
+
+func (m *PlumChallengeExit) GetChallengeTransferBlockProof() []byte {
+	if m != nil {
+		return m.ChallengeTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumChallengeExit) GetChallengeTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.ChallengeTransferBlockHeight
+	}
+	return 0
+}
+
+type PlumResponseChallengeExit struct {
+	SubChainAddress             string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	CoinID                      uint64   `protobuf:"varint,2,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	ChallengeTransfer           []byte   `protobuf:"bytes,3,opt,name=challengeTransfer,proto3" json:"challengeTransfer,omitempty"`
+	ResponseTransfer            []byte   `protobuf:"bytes,4,opt,name=responseTransfer,proto3" json:"responseTransfer,omitempty"`
+	ResponseTransferBlockProof  []byte   `protobuf:"bytes,5,opt,name=responseTransferBlockProof,proto3" json:"responseTransferBlockProof,omitempty"`
+	PreviousTransferBlockHeight uint64   `protobuf:"varint,6,opt,name=previousTransferBlockHeight,proto3" json:"previousTransferBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *PlumResponseChallengeExit) Reset()         { *m = PlumResponseChallengeExit{} }
+func (m *PlumResponseChallengeExit) String() string { return proto.CompactTextString(m) }
+func (*PlumResponseChallengeExit) ProtoMessage()    {}
+func (*PlumResponseChallengeExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{25}
+}
+
+func (m *PlumResponseChallengeExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumResponseChallengeExit.Unmarshal(m, b)
+}
+func (m *PlumResponseChallengeExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumResponseChallengeExit.Marshal(b, m, deterministic)
+}
+func (m *PlumResponseChallengeExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumResponseChallengeExit.Merge(m, src)
+}
+func (m *PlumResponseChallengeExit) XXX_Size() int {
+	return xxx_messageInfo_PlumResponseChallengeExit.Size(m)
+}
+func (m *PlumResponseChallengeExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumResponseChallengeExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumResponseChallengeExit proto.InternalMessageInfo
+
+func (m *PlumResponseChallengeExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumResponseChallengeExit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+func (m *PlumResponseChallengeExit) GetChallengeTransfer() []byte {
+	if m != nil {
+		return m.ChallengeTransfer
+	}
+	return nil
+}
+
+func (m *PlumResponseChallengeExit) GetResponseTransfer() []byte {
+	if m != nil {
+		return m.ResponseTransfer
+	}
+	return nil
+}
+
+func (m *PlumResponseChallengeExit) GetResponseTransferBlockProof() []byte {
+	if m != nil {
+		return m.ResponseTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumResponseChallengeExit) GetPreviousTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.PreviousTransferBlockHeight
+	}
+	return 0
+}
+
+type PlumFinalizeExit struct {
+	SubChainAddress      string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	CoinID               uint64   `protobuf:"varint,2,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumFinalizeExit) Reset()         { *m = PlumFinalizeExit{} }
+func (m *PlumFinalizeExit) String() string { return proto.CompactTextString(m) }
+func (*PlumFinalizeExit) ProtoMessage()    {}
+func (*PlumFinalizeExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{26}
+}
+
+func (m *PlumFinalizeExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumFinalizeExit.Unmarshal(m, b)
+}
+func (m *PlumFinalizeExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumFinalizeExit.Marshal(b, m, deterministic)
+}
+func (m *PlumFinalizeExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumFinalizeExit.Merge(m, src)
+}
+func (m *PlumFinalizeExit) XXX_Size() int {
+	return xxx_messageInfo_PlumFinalizeExit.Size(m)
+}
+func (m *PlumFinalizeExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumFinalizeExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumFinalizeExit proto.InternalMessageInfo
+
+func (m *PlumFinalizeExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumFinalizeExit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+// plum sub chain APIs
+type PlumSettleDeposit struct {
+	CoinID               uint64   `protobuf:"varint,1,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumSettleDeposit) Reset()         { *m = PlumSettleDeposit{} }
+func (m *PlumSettleDeposit) String() string { return proto.CompactTextString(m) }
+func (*PlumSettleDeposit) ProtoMessage()    {}
+func (*PlumSettleDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{27}
+}
+
+func (m *PlumSettleDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumSettleDeposit.Unmarshal(m, b)
+}
+func (m *PlumSettleDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumSettleDeposit.Marshal(b, m, deterministic)
+}
+func (m *PlumSettleDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumSettleDeposit.Merge(m, src)
+}
+func (m *PlumSettleDeposit) XXX_Size() int {
+	return xxx_messageInfo_PlumSettleDeposit.Size(m)
+}
+func (m *PlumSettleDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumSettleDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumSettleDeposit proto.InternalMessageInfo
+
+func (m *PlumSettleDeposit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+type PlumTransfer struct {
+	CoinID               uint64   `protobuf:"varint,1,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	Denomination         []byte   `protobuf:"bytes,2,opt,name=denomination,proto3" json:"denomination,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient            string   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumTransfer) Reset()         { *m = PlumTransfer{} }
+func (m *PlumTransfer) String() string { return proto.CompactTextString(m) }
+func (*PlumTransfer) ProtoMessage()    {}
+func (*PlumTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{28}
+}
+
+func (m *PlumTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumTransfer.Unmarshal(m, b)
+}
+func (m *PlumTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumTransfer.Marshal(b, m, deterministic)
+}
+func (m *PlumTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumTransfer.Merge(m, src)
+}
+func (m *PlumTransfer) XXX_Size() int {
+	return xxx_messageInfo_PlumTransfer.Size(m)
+}
+func (m *PlumTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumTransfer proto.InternalMessageInfo
+
+func (m *PlumTransfer) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+func (m *PlumTransfer) GetDenomination() []byte {
+	if m != nil {
+		return m.Denomination
+	}
+	return nil
+}
+
+func (m *PlumTransfer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PlumTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type ActionCore struct {
+	Version  uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Nonce    uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasPrice string `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//	*ActionCore_Transfer
+	//	*ActionCore_Execution
+	//	*ActionCore_StartSubChain
+	//	*ActionCore_StopSubChain
+	//	*ActionCore_PutBlock
+	//	*ActionCore_CreateDeposit
+	//	*ActionCore_SettleDeposit
+	//	*ActionCore_CreatePlumChain
+	//	*ActionCore_TerminatePlumChain
+	//	*ActionCore_PlumPutBlock
+	//	*ActionCore_PlumCreateDeposit
+	//	*ActionCore_PlumStartExit
+	//	*ActionCore_PlumChallengeExit
+	//	*ActionCore_PlumResponseChallengeExit
+	//	*ActionCore_PlumFinalizeExit
+	//	*ActionCore_PlumSettleDeposit
+	//	*ActionCore_PlumTransfer
+	//	*ActionCore_DepositToRewardingFund
+	//	*ActionCore_ClaimFromRewardingFund
+	//	*ActionCore_GrantReward
+	//	*ActionCore_StakeCreate
+	//	*ActionCore_StakeUnstake
+	//	*ActionCore_StakeWithdraw
+	//	*ActionCore_StakeAddDeposit
+	//	*ActionCore_StakeRestake
+	//	*ActionCore_StakeChangeCandidate
+	//	*ActionCore_StakeTransferOwnership
+	//	*ActionCore_CandidateRegister
+	//	*ActionCore_CandidateUpdate
+	//	*ActionCore_CandidateBLSKey
+	//	*ActionCore_PutPollResult
+	//	*ActionCore_DoubleSignEvidence
+	//	*ActionCore_StakeSplit
+	//	*ActionCore_StakeMerge
+	//	*ActionCore_StakeCompound
+	//	*ActionCore_ProposeParameter
+	//	*ActionCore_VoteProposal
+	Action               isActionCore_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ActionCore) Reset()         { *m = ActionCore{} }
+func (m *ActionCore) String() string { return proto.CompactTextString(m) }
+func (*ActionCore) ProtoMessage()    {}
+func (*ActionCore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{29}
+}
+
+func (m *ActionCore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionCore.Unmarshal(m, b)
+}
+func (m *ActionCore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionCore.Marshal(b, m, deterministic)
+}
+func (m *ActionCore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionCore.Merge(m, src)
+}
+func (m *ActionCore) XXX_Size() int {
+	return xxx_messageInfo_ActionCore.Size(m)
+}
+func (m *ActionCore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionCore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionCore proto.InternalMessageInfo
+
+func (m *ActionCore) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ActionCore) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ActionCore) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ActionCore) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+type isActionCore_Action interface {
+	isActionCore_Action()
+}
+
+type ActionCore_Transfer struct {
+	Transfer *Transfer `protobuf:"bytes,10,opt,name=transfer,proto3,oneof"`
+}
+
+type ActionCore_Execution struct {
+	Execution *Execution `protobuf:"bytes,12,opt,name=execution,proto3,oneof"`
+}
+
+type ActionCore_StartSubChain struct {
+	StartSubChain *StartSubChain `protobuf:"bytes,13,opt,name=startSubChain,proto3,oneof"`
+}
+
+type ActionCore_StopSubChain struct {
+	StopSubChain *StopSubChain `protobuf:"bytes,14,opt,name=stopSubChain,proto3,oneof"`
+}
+
+type ActionCore_PutBlock struct {
+	PutBlock *PutBlock `protobuf:"bytes,15,opt,name=putBlock,proto3,oneof"`
+}
+
+type ActionCore_CreateDeposit struct {
+	CreateDeposit *CreateDeposit `protobuf:"bytes,16,opt,name=createDeposit,proto3,oneof"`
+}
+
+type ActionCore_SettleDeposit struct {
+	SettleDeposit *SettleDeposit `protobuf:"bytes,17,opt,name=settleDeposit,proto3,oneof"`
+}
+
+type ActionCore_CreatePlumChain struct {
+	CreatePlumChain *CreatePlumChain `protobuf:"bytes,18,opt,name=createPlumChain,proto3,oneof"`
+}
+
+type ActionCore_TerminatePlumChain struct {
+	TerminatePlumChain *TerminatePlumChain `protobuf:"bytes,19,opt,name=terminatePlumChain,proto3,oneof"`
+}
+
+type ActionCore_PlumPutBlock struct {
+	PlumPutBlock *PlumPutBlock `protobuf:"bytes,20,opt,name=plumPutBlock,proto3,oneof"`
+}
+
+type ActionCore_PlumCreateDeposit struct {
+	PlumCreateDeposit *PlumCreateDeposit `protobuf:"bytes,21,opt,name=plumCreateDeposit,proto3,oneof"`
+}
+
+type ActionCore_PlumStartExit struct {
+	PlumStartExit *PlumStartExit `protobuf:"bytes,22,opt,name=plumStartExit,proto3,oneof"`
+}
+
+type ActionCore_PlumChallengeExit struct {
+	PlumChallengeExit *PlumChallengeExit `protobuf:"bytes,23,opt,name=plumChallengeExit,proto3,oneof"`
+}
+
+type ActionCore_PlumResponseChallengeExit struct {
+	PlumResponseChallengeExit *PlumResponseChallengeExit `protobuf:"bytes,24,opt,name=plumResponseChallengeExit,proto3,oneof"`
+}
+
+type ActionCore_PlumFinalizeExit struct {
+	PlumFinalizeExit *PlumFinalizeExit `protobuf:"bytes,25,opt,name=plumFinalizeExit,proto3,oneof"`
+}
+
+type ActionCore_PlumSettleDeposit struct {
+	PlumSettleDeposit *PlumSettleDeposit `protobuf:"bytes,26,opt,name=plumSettleDeposit,proto3,oneof"`
+}
+
+type ActionCore_PlumTransfer struct {
+	PlumTransfer *PlumTransfer `protobuf:"bytes,27,opt,name=plumTransfer,proto3,oneof"`
+}
+
+type ActionCore_DepositToRewardingFund struct {
+	DepositToRewardingFund *DepositToRewardingFund `protobuf:"bytes,30,opt,name=depositToRewardingFund,proto3,oneof"`
+}
+
+type ActionCore_ClaimFromRewardingFund struct {
+	ClaimFromRewardingFund *ClaimFromRewardingFund `protobuf:"bytes,31,opt,name=claimFromRewardingFund,proto3,oneof"`
+}
+
+type ActionCore_GrantReward struct {
+	GrantReward *GrantReward `protobuf:"bytes,32,opt,name=grantReward,proto3,oneof"`
+}
+
+type ActionCore_StakeCreate struct {
+	StakeCreate *StakeCreate `protobuf:"bytes,40,opt,name=stakeCreate,proto3,oneof"`
+}
+
+type ActionCore_StakeUnstake struct {
+	StakeUnstake *StakeReclaim `protobuf:"bytes,41,opt,name=stakeUnstake,proto3,oneof"`
+}
+
+type ActionCore_StakeWithdraw struct {
+	StakeWithdraw *StakeReclaim `protobuf:"bytes,42,opt,name=stakeWithdraw,proto3,oneof"`
+}
+
+type ActionCore_StakeAddDeposit struct {
+	StakeAddDeposit *StakeAddDeposit `protobuf:"bytes,43,opt,name=stakeAddDeposit,proto3,oneof"`
+}
+
+type ActionCore_StakeRestake struct {
+	StakeRestake *StakeRestake `protobuf:"bytes,44,opt,name=stakeRestake,proto3,oneof"`
+}
+
+type ActionCore_StakeChangeCandidate struct {
+	StakeChangeCandidate *StakeChangeCandidate `protobuf:"bytes,45,opt,name=stakeChangeCandidate,proto3,oneof"`
+}
+
+type ActionCore_StakeTransferOwnership struct {
+	StakeTransferOwnership *StakeTransferOwnership `protobuf:"bytes,46,opt,name=stakeTransferOwnership,proto3,oneof"`
+}
+
+type ActionCore_CandidateRegister struct {
+	CandidateRegister *CandidateRegister `protobuf:"bytes,47,opt,name=candidateRegister,proto3,oneof"`
+}
+
+type ActionCore_CandidateUpdate struct {
+	CandidateUpdate *CandidateBasicInfo `protobuf:"bytes,48,opt,name=candidateUpdate,proto3,oneof"`
+}
+
+type ActionCore_CandidateBLSKey struct {
+	CandidateBLSKey *CandidateBLSKey `protobuf:"bytes,49,opt,name=candidateBLSKey,proto3,oneof"`
+}
+
+type ActionCore_PutPollResult struct {
+	PutPollResult *PutPollResult `protobuf:"bytes,50,opt,name=putPollResult,proto3,oneof"`
+}
+
+type ActionCore_DoubleSignEvidence struct {
+	DoubleSignEvidence *DoubleSignEvidence `protobuf:"bytes,51,opt,name=doubleSignEvidence,proto3,oneof"`
+}
+
+type ActionCore_StakeSplit struct {
+	StakeSplit *StakeSplit `protobuf:"bytes,60,opt,name=stakeSplit,proto3,oneof"`
+}
+
+type ActionCore_StakeMerge struct {
+	StakeMerge *StakeMerge `protobuf:"bytes,61,opt,name=stakeMerge,proto3,oneof"`
+}
+
+type ActionCore_StakeCompound struct {
+	StakeCompound *StakeCompound `protobuf:"bytes,62,opt,name=stakeCompound,proto3,oneof"`
+}
+
+type ActionCore_ProposeParameter struct {
+	ProposeParameter *ProposeParameter `protobuf:"bytes,70,opt,name=proposeParameter,proto3,oneof"`
+}
+
+type ActionCore_VoteProposal struct {
+	VoteProposal *VoteProposal `protobuf:"bytes,71,opt,name=voteProposal,proto3,oneof"`
+}
+
+func (*ActionCore_Transfer) isActionCore_Action() {}
+
+func (*ActionCore_Execution) isActionCore_Action() {}
+
+func (*ActionCore_StartSubChain) isActionCore_Action() {}
+
+func (*ActionCore_StopSubChain) isActionCore_Action() {}
+
+func (*ActionCore_PutBlock) isActionCore_Action() {}
+
+func (*ActionCore_CreateDeposit) isActionCore_Action() {}
+
+func (*ActionCore_SettleDeposit) isActionCore_Action() {}
+
+func (*ActionCore_CreatePlumChain) isActionCore_Action() {}
+
+func (*ActionCore_TerminatePlumChain) isActionCore_Action() {}
+
+func (*ActionCore_PlumPutBlock) isActionCore_Action() {}
+
+func (*ActionCore_PlumCreateDeposit) isActionCore_Action() {}
+
+func (*ActionCore_PlumStartExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumChallengeExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumResponseChallengeExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumFinalizeExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumSettleDeposit) isActionCore_Action() {}
+
+func (*ActionCore_PlumTransfer) isActionCore_Action() {}
+
+func (*ActionCore_DepositToRewardingFund) isActionCore_Action() {}
+
+func (*ActionCore_ClaimFromRewardingFund) isActionCore_Action() {}
+
+func (*ActionCore_GrantReward) isActionCore_Action() {}
+
+func (*ActionCore_StakeCreate) isActionCore_Action() {}
+
+func (*ActionCore_StakeUnstake) isActionCore_Action() {}
+
+func (*ActionCore_StakeWithdraw) isActionCore_Action() {}
+
+func (*ActionCore_StakeAddDeposit) isActionCore_Action() {}
+
+func (*ActionCore_StakeRestake) isActionCore_Action() {}
+
+func (*ActionCore_StakeChangeCandidate) isActionCore_Action() {}
+
+func (*ActionCore_StakeTransferOwnership) isActionCore_Action() {}
+
+func (*ActionCore_CandidateRegister) isActionCore_Action() {}
+
+func (*ActionCore_CandidateUpdate) isActionCore_Action() {}
+
+func (*ActionCore_CandidateBLSKey) isActionCore_Action() {}
+
+func (*ActionCore_PutPollResult) isActionCore_Action() {}
+
+func (*ActionCore_DoubleSignEvidence) isActionCore_Action() {}
+
+func (*ActionCore_StakeSplit) isActionCore_Action() {}
+
+func (*ActionCore_StakeMerge) isActionCore_Action() {}
+
+func (*ActionCore_StakeCompound) isActionCore_Action() {}
+
+func (*ActionCore_ProposeParameter) isActionCore_Action() {}
+
+func (*ActionCore_VoteProposal) isActionCore_Action() {}
+
+func (m *ActionCore) GetAction() isActionCore_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *ActionCore) GetTransfer() *Transfer {
+	if x, ok := m.GetAction().(*ActionCore_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *ActionCore) GetExecution() *Execution {
+	if x, ok := m.GetAction().(*ActionCore_Execution); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStartSubChain() *StartSubChain {
+	if x, ok := m.GetAction().(*ActionCore_StartSubChain); ok {
+		return x.StartSubChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStopSubChain() *StopSubChain {
+	if x, ok := m.GetAction().(*ActionCore_StopSubChain); ok {
+		return x.StopSubChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPutBlock() *PutBlock {
+	if x, ok := m.GetAction().(*ActionCore_PutBlock); ok {
+		return x.PutBlock
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCreateDeposit() *CreateDeposit {
+	if x, ok := m.GetAction().(*ActionCore_CreateDeposit); ok {
+		return x.CreateDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetSettleDeposit() *SettleDeposit {
+	if x, ok := m.GetAction().(*ActionCore_SettleDeposit); ok {
+		return x.SettleDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCreatePlumChain() *CreatePlumChain {
+	if x, ok := m.GetAction().(*ActionCore_CreatePlumChain); ok {
+		return x.CreatePlumChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetTerminatePlumChain() *TerminatePlumChain {
+	if x, ok := m.GetAction().(*ActionCore_TerminatePlumChain); ok {
+		return x.TerminatePlumChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumPutBlock() *PlumPutBlock {
+	if x, ok := m.GetAction().(*ActionCore_PlumPutBlock); ok {
+		return x.PlumPutBlock
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumCreateDeposit() *PlumCreateDeposit {
+	if x, ok := m.GetAction().(*ActionCore_PlumCreateDeposit); ok {
+		return x.PlumCreateDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumStartExit() *PlumStartExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumStartExit); ok {
+		return x.PlumStartExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumChallengeExit() *PlumChallengeExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumChallengeExit); ok {
+		return x.PlumChallengeExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumResponseChallengeExit() *PlumResponseChallengeExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumResponseChallengeExit); ok {
+		return x.PlumResponseChallengeExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumFinalizeExit() *PlumFinalizeExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumFinalizeExit); ok {
+		return x.PlumFinalizeExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumSettleDeposit() *PlumSettleDeposit {
+	if x, ok := m.GetAction().(*ActionCore_PlumSettleDeposit); ok {
+		return x.PlumSettleDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumTransfer() *PlumTransfer {
+	if x, ok := m.GetAction().(*ActionCore_PlumTransfer); ok {
+		return x.PlumTransfer
+	}
+	return nil
+}
+
+func (m *ActionCore) GetDepositToRewardingFund() *DepositToRewardingFund {
+	if x, ok := m.GetAction().(*ActionCore_DepositToRewardingFund); ok {
+		return x.DepositToRewardingFund
+	}
+	return nil
+}
+
+func (m *ActionCore) GetClaimFromRewardingFund() *ClaimFromRewardingFund {
+	if x, ok := m.GetAction().(*ActionCore_ClaimFromRewardingFund); ok {
+		return x.ClaimFromRewardingFund
+	}
+	return nil
+}
+
+func (m *ActionCore) GetGrantReward() *GrantReward {
+	if x, ok := m.GetAction().(*ActionCore_GrantReward); ok {
+		return x.GrantReward
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeCreate() *StakeCreate {
+	if x, ok := m.GetAction().(*ActionCore_StakeCreate); ok {
+		return x.StakeCreate
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeUnstake() *StakeReclaim {
+	if x, ok := m.GetAction().(*ActionCore_StakeUnstake); ok {
+		return x.StakeUnstake
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeWithdraw() *StakeReclaim {
+	if x, ok := m.GetAction().(*ActionCore_StakeWithdraw); ok {
+		return x.StakeWithdraw
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeAddDeposit() *StakeAddDeposit {
+	if x, ok := m.GetAction().(*ActionCore_StakeAddDeposit); ok {
+		return x.StakeAddDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeRestake() *StakeRestake {
+	if x, ok := m.GetAction().(*ActionCore_StakeRestake); ok {
+		return x.StakeRestake
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeChangeCandidate() *StakeChangeCandidate {
+	if x, ok := m.GetAction().(*ActionCore_StakeChangeCandidate); ok {
+		return x.StakeChangeCandidate
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeTransferOwnership() *StakeTransferOwnership {
+	if x, ok := m.GetAction().(*ActionCore_StakeTransferOwnership); ok {
+		return x.StakeTransferOwnership
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCandidateRegister() *CandidateRegister {
+	if x, ok := m.GetAction().(*ActionCore_CandidateRegister); ok {
+		return x.CandidateRegister
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCandidateUpdate() *CandidateBasicInfo {
+	if x, ok := m.GetAction().(*ActionCore_CandidateUpdate); ok {
+		return x.CandidateUpdate
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCandidateBLSKey() *CandidateBLSKey {
+	if x, ok := m.GetAction().(*ActionCore_CandidateBLSKey); ok {
+		return x.CandidateBLSKey
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPutPollResult() *PutPollResult {
+	if x, ok := m.GetAction().(*ActionCore_PutPollResult); ok {
+		return x.PutPollResult
+	}
+	return nil
+}
+
+func (m *ActionCore) GetDoubleSignEvidence() *DoubleSignEvidence {
+	if x, ok := m.GetAction().(*ActionCore_DoubleSignEvidence); ok {
+		return x.DoubleSignEvidence
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeSplit() *StakeSplit {
+	if x, ok := m.GetAction().(*ActionCore_StakeSplit); ok {
+		return x.StakeSplit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeMerge() *StakeMerge {
+	if x, ok := m.GetAction().(*ActionCore_StakeMerge); ok {
+		return x.StakeMerge
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeCompound() *StakeCompound {
+	if x, ok := m.GetAction().(*ActionCore_StakeCompound); ok {
+		return x.StakeCompound
+	}
+	return nil
+}
+
+func (m *ActionCore) GetProposeParameter() *ProposeParameter {
+	if x, ok := m.GetAction().(*ActionCore_ProposeParameter); ok {
+		return x.ProposeParameter
+	}
+	return nil
+}
+
+func (m *ActionCore) GetVoteProposal() *VoteProposal {
+	if x, ok := m.GetAction().(*ActionCore_VoteProposal); ok {
+		return x.VoteProposal
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ActionCore) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ActionCore_Transfer)(nil),
+		(*ActionCore_Execution)(nil),
+		(*ActionCore_StartSubChain)(nil),
+		(*ActionCore_StopSubChain)(nil),
+		(*ActionCore_PutBlock)(nil),
+		(*ActionCore_CreateDeposit)(nil),
+		(*ActionCore_SettleDeposit)(nil),
+		(*ActionCore_CreatePlumChain)(nil),
+		(*ActionCore_TerminatePlumChain)(nil),
+		(*ActionCore_PlumPutBlock)(nil),
+		(*ActionCore_PlumCreateDeposit)(nil),
+		(*ActionCore_PlumStartExit)(nil),
+		(*ActionCore_PlumChallengeExit)(nil),
+		(*ActionCore_PlumResponseChallengeExit)(nil),
+		(*ActionCore_PlumFinalizeExit)(nil),
+		(*ActionCore_PlumSettleDeposit)(nil),
+		(*ActionCore_PlumTransfer)(nil),
+		(*ActionCore_DepositToRewardingFund)(nil),
+		(*ActionCore_ClaimFromRewardingFund)(nil),
+		(*ActionCore_GrantReward)(nil),
+		(*ActionCore_StakeCreate)(nil),
+		(*ActionCore_StakeUnstake)(nil),
+		(*ActionCore_StakeWithdraw)(nil),
+		(*ActionCore_StakeAddDeposit)(nil),
+		(*ActionCore_StakeRestake)(nil),
+		(*ActionCore_StakeChangeCandidate)(nil),
+		(*ActionCore_StakeTransferOwnership)(nil),
+		(*ActionCore_CandidateRegister)(nil),
+		(*ActionCore_CandidateUpdate)(nil),
+		(*ActionCore_CandidateBLSKey)(nil),
+		(*ActionCore_PutPollResult)(nil),
+		(*ActionCore_DoubleSignEvidence)(nil),
+		(*ActionCore_StakeSplit)(nil),
+		(*ActionCore_StakeMerge)(nil),
+		(*ActionCore_StakeCompound)(nil),
+		(*ActionCore_ProposeParameter)(nil),
+		(*ActionCore_VoteProposal)(nil),
+	}
+}
+
+type Action struct {
+	Core                 *ActionCore `protobuf:"bytes,1,opt,name=core,proto3" json:"core,omitempty"`
+	SenderPubKey         []byte      `protobuf:"bytes,2,opt,name=senderPubKey,proto3" json:"senderPubKey,omitempty"`
+	Signature            []byte      `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Action) Reset()         { *m = Action{} }
+func (m *Action) String() string { return proto.CompactTextString(m) }
+func (*Action) ProtoMessage()    {}
+func (*Action) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{30}
+}
+
+func (m *Action) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Action.Unmarshal(m, b)
+}
+func (m *Action) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Action.Marshal(b, m, deterministic)
+}
+func (m *Action) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Action.Merge(m, src)
+}
+func (m *Action) XXX_Size() int {
+	return xxx_messageInfo_Action.Size(m)
+}
+func (m *Action) XXX_DiscardUnknown() {
+	xxx_messageInfo_Action.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Action proto.InternalMessageInfo
+
+func (m *Action) GetCore() *ActionCore {
+	if m != nil {
+		return m.Core
+	}
+	return nil
+}
+
+func (m *Action) GetSenderPubKey() []byte {
+	if m != nil {
+		return m.SenderPubKey
+	}
+	return nil
+}
+
+func (m *Action) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type Receipt struct {
+	Status               uint64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	BlkHeight            uint64   `protobuf:"varint,2,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	ActHash              []byte   `protobuf:"bytes,3,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	GasConsumed          uint64   `protobuf:"varint,4,opt,name=gasConsumed,proto3" json:"gasConsumed,omitempty"`
+	ContractAddress      string   `protobuf:"bytes,5,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Logs                 []*Log   `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{31}
+}
+
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Receipt.Unmarshal(m, b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return xxx_messageInfo_Receipt.Size(m)
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetBlkHeight() uint64 {
+	if m != nil {
+		return m.BlkHeight
+	}
+	return 0
+}
+
+func (m *Receipt) GetActHash() []byte {
+	if m != nil {
+		return m.ActHash
+	}
+	return nil
+}
+
+func (m *Receipt) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
+func (m *Receipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Receipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type Log struct {
+	ContractAddress      string   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Topics               [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BlkHeight            uint64   `protobuf:"varint,4,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	ActHash              []byte   `protobuf:"bytes,5,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	Index                uint32   `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{32}
+}
+
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Log.Unmarshal(m, b)
+}
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+}
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
+}
+func (m *Log) XXX_Size() int {
+	return xxx_messageInfo_Log.Size(m)
+}
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Log proto.InternalMessageInfo
+
+func (m *Log) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Log) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *Log) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Log) GetBlkHeight() uint64 {
+	if m != nil {
+		return m.BlkHeight
+	}
+	return 0
+}
+
+func (m *Log) GetActHash() []byte {
+	if m != nil {
+		return m.ActHash
+	}
+	return nil
+}
+
+func (m *Log) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type DepositToRewardingFund struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositToRewardingFund) Reset()         { *m = DepositToRewardingFund{} }
+func (m *DepositToRewardingFund) String() string { return proto.CompactTextString(m) }
+func (*DepositToRewardingFund) ProtoMessage()    {}
+func (*DepositToRewardingFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{33}
+}
+
+func (m *DepositToRewardingFund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositToRewardingFund.Unmarshal(m, b)
+}
+func (m *DepositToRewardingFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositToRewardingFund.Marshal(b, m, deterministic)
+}
+func (m *DepositToRewardingFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositToRewardingFund.Merge(m, src)
+}
+func (m *DepositToRewardingFund) XXX_Size() int {
+	return xxx_messageInfo_DepositToRewardingFund.Size(m)
+}
+func (m *DepositToRewardingFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositToRewardingFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositToRewardingFund proto.InternalMessageInfo
+
+func (m *DepositToRewardingFund) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *DepositToRewardingFund) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ClaimFromRewardingFund struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimFromRewardingFund) Reset()         { *m = ClaimFromRewardingFund{} }
+func (m *ClaimFromRewardingFund) String() string { return proto.CompactTextString(m) }
+func (*ClaimFromRewardingFund) ProtoMessage()    {}
+func (*ClaimFromRewardingFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{34}
+}
+
+func (m *ClaimFromRewardingFund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimFromRewardingFund.Unmarshal(m, b)
+}
+func (m *ClaimFromRewardingFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimFromRewardingFund.Marshal(b, m, deterministic)
+}
+func (m *ClaimFromRewardingFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimFromRewardingFund.Merge(m, src)
+}
+func (m *ClaimFromRewardingFund) XXX_Size() int {
+	return xxx_messageInfo_ClaimFromRewardingFund.Size(m)
+}
+func (m *ClaimFromRewardingFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimFromRewardingFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimFromRewardingFund proto.InternalMessageInfo
+
+func (m *ClaimFromRewardingFund) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *ClaimFromRewardingFund) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GrantReward struct {
+	Type                 RewardType `protobuf:"varint,1,opt,name=type,proto3,enum=iotextypes.RewardType" json:"type,omitempty"`
+	Height               uint64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GrantReward) Reset()         { *m = GrantReward{} }
+func (m *GrantReward) String() string { return proto.CompactTextString(m) }
+func (*GrantReward) ProtoMessage()    {}
+func (*GrantReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{35}
+}
+
+func (m *GrantReward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantReward.Unmarshal(m, b)
+}
+func (m *GrantReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantReward.Marshal(b, m, deterministic)
+}
+func (m *GrantReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantReward.Merge(m, src)
+}
+func (m *GrantReward) XXX_Size() int {
+	return xxx_messageInfo_GrantReward.Size(m)
+}
+func (m *GrantReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantReward proto.InternalMessageInfo
+
+func (m *GrantReward) GetType() RewardType {
+	if m != nil {
+		return m.Type
+	}
+	return RewardType_BlockReward
+}
+
+func (m *GrantReward) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// split part of the bucket into a new bucket
+type StakeSplit struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeSplit) Reset()         { *m = StakeSplit{} }
+func (m *StakeSplit) String() string { return proto.CompactTextString(m) }
+func (*StakeSplit) ProtoMessage()    {}
+func (*StakeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{36}
+}
+
+func (m *StakeSplit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeSplit.Unmarshal(m, b)
+}
+func (m *StakeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeSplit.Marshal(b, m, deterministic)
+}
+func (m *StakeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeSplit.Merge(m, src)
+}
+func (m *StakeSplit) XXX_Size() int {
+	return xxx_messageInfo_StakeSplit.Size(m)
+}
+func (m *StakeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeSplit proto.InternalMessageInfo
+
+func (m *StakeSplit) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeSplit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *StakeSplit) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// merge the buckets into the bucket
+type StakeMerge struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	MergeIndices         []uint64 `protobuf:"varint,2,rep,packed,name=mergeIndices,proto3" json:"mergeIndices,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeMerge) Reset()         { *m = StakeMerge{} }
+func (m *StakeMerge) String() string { return proto.CompactTextString(m) }
+func (*StakeMerge) ProtoMessage()    {}
+func (*StakeMerge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{37}
+}
+
+func (m *StakeMerge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeMerge.Unmarshal(m, b)
+}
+func (m *StakeMerge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeMerge.Marshal(b, m, deterministic)
+}
+func (m *StakeMerge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeMerge.Merge(m, src)
+}
+func (m *StakeMerge) XXX_Size() int {
+	return xxx_messageInfo_StakeMerge.Size(m)
+}
+func (m *StakeMerge) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeMerge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeMerge proto.InternalMessageInfo
+
+func (m *StakeMerge) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeMerge) GetMergeIndices() []uint64 {
+	if m != nil {
+		return m.MergeIndices
+	}
+	return nil
+}
+
+func (m *StakeMerge) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// toggle auto-compounding of the bucket
+type StakeCompound struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Enable               bool     `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeCompound) Reset()         { *m = StakeCompound{} }
+func (m *StakeCompound) String() string { return proto.CompactTextString(m) }
+func (*StakeCompound) ProtoMessage()    {}
+func (*StakeCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{38}
+}
+
+func (m *StakeCompound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeCompound.Unmarshal(m, b)
+}
+func (m *StakeCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeCompound.Marshal(b, m, deterministic)
+}
+func (m *StakeCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeCompound.Merge(m, src)
+}
+func (m *StakeCompound) XXX_Size() int {
+	return xxx_messageInfo_StakeCompound.Size(m)
+}
+func (m *StakeCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeCompound proto.InternalMessageInfo
+
+func (m *StakeCompound) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeCompound) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *StakeCompound) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// two conflicting consensus votes signed by the same delegate in the same round, the messages are serialized
+// ConsensusMessage, which cannot be referenced here without an import cycle
+type DoubleSignEvidence struct {
+	Message1             []byte   `protobuf:"bytes,1,opt,name=message1,proto3" json:"message1,omitempty"`
+	Message2             []byte   `protobuf:"bytes,2,opt,name=message2,proto3" json:"message2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoubleSignEvidence) Reset()         { *m = DoubleSignEvidence{} }
+func (m *DoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidence) ProtoMessage()    {}
+func (*DoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{39}
+}
+
+func (m *DoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSignEvidence.Unmarshal(m, b)
+}
+func (m *DoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSignEvidence.Marshal(b, m, deterministic)
+}
+func (m *DoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidence.Merge(m, src)
+}
+func (m *DoubleSignEvidence) XXX_Size() int {
+	return xxx_messageInfo_DoubleSignEvidence.Size(m)
+}
+func (m *DoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidence proto.InternalMessageInfo
+
+func (m *DoubleSignEvidence) GetMessage1() []byte {
+	if m != nil {
+		return m.Message1
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetMessage2() []byte {
+	if m != nil {
+		return m.Message2
+	}
+	return nil
+}
+
+// propose a new value of a governed protocol parameter
+type ProposeParameter struct {
+	Parameter            string   `protobuf:"bytes,1,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposeParameter) Reset()         { *m = ProposeParameter{} }
+func (m *ProposeParameter) String() string { return proto.CompactTextString(m) }
+func (*ProposeParameter) ProtoMessage()    {}
+func (*ProposeParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{40}
+}
+
+func (m *ProposeParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposeParameter.Unmarshal(m, b)
+}
+func (m *ProposeParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposeParameter.Marshal(b, m, deterministic)
+}
+func (m *ProposeParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeParameter.Merge(m, src)
+}
+func (m *ProposeParameter) XXX_Size() int {
+	return xxx_messageInfo_ProposeParameter.Size(m)
+}
+func (m *ProposeParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeParameter proto.InternalMessageInfo
+
+func (m *ProposeParameter) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
+}
+
+func (m *ProposeParameter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ProposeParameter) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// vote on a pending parameter proposal
+type VoteProposal struct {
+	ProposalID           uint64   `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Approve              bool     `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteProposal) Reset()         { *m = VoteProposal{} }
+func (m *VoteProposal) String() string { return proto.CompactTextString(m) }
+func (*VoteProposal) ProtoMessage()    {}
+func (*VoteProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{41}
+}
+
+func (m *VoteProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteProposal.Unmarshal(m, b)
+}
+func (m *VoteProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteProposal.Marshal(b, m, deterministic)
+}
+func (m *VoteProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteProposal.Merge(m, src)
+}
+func (m *VoteProposal) XXX_Size() int {
+	return xxx_messageInfo_VoteProposal.Size(m)
+}
+func (m *VoteProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteProposal proto.InternalMessageInfo
+
+func (m *VoteProposal) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+func (m *VoteProposal) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+func (m *VoteProposal) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// register the BLS public key the candidate endorses blocks with, along with the proof of possession of its private key
+type CandidateBLSKey struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	ProofOfPossession    []byte   `protobuf:"bytes,2,opt,name=proofOfPossession,proto3" json:"proofOfPossession,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateBLSKey) Reset()         { *m = CandidateBLSKey{} }
+func (m *CandidateBLSKey) String() string { return proto.CompactTextString(m) }
+func (*CandidateBLSKey) ProtoMessage()    {}
+func (*CandidateBLSKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{42}
+}
+
+func (m *CandidateBLSKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateBLSKey.Unmarshal(m, b)
+}
+func (m *CandidateBLSKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateBLSKey.Marshal(b, m, deterministic)
+}
+func (m *CandidateBLSKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateBLSKey.Merge(m, src)
+}
+func (m *CandidateBLSKey) XXX_Size() int {
+	return xxx_messageInfo_CandidateBLSKey.Size(m)
+}
+func (m *CandidateBLSKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateBLSKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateBLSKey proto.InternalMessageInfo
+
+func (m *CandidateBLSKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *CandidateBLSKey) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("iotextypes.RewardType", RewardType_name, RewardType_value)
+	proto.RegisterType((*Transfer)(nil), "iotextypes.Transfer")
+	proto.RegisterType((*Candidate)(nil), "iotextypes.Candidate")
+	proto.RegisterType((*CandidateList)(nil), "iotextypes.CandidateList")
+	proto.RegisterType((*PutPollResult)(nil), "iotextypes.PutPollResult")
+	proto.RegisterType((*Execution)(nil), "iotextypes.Execution")
+	proto.RegisterType((*StakeCreate)(nil), "iotextypes.StakeCreate")
+	proto.RegisterType((*StakeReclaim)(nil), "iotextypes.StakeReclaim")
+	proto.RegisterType((*StakeAddDeposit)(nil), "iotextypes.StakeAddDeposit")
+	proto.RegisterType((*StakeRestake)(nil), "iotextypes.StakeRestake")
+	proto.RegisterType((*StakeChangeCandidate)(nil), "iotextypes.StakeChangeCandidate")
+	proto.RegisterType((*StakeTransferOwnership)(nil), "iotextypes.StakeTransferOwnership")
+	proto.RegisterType((*CandidateBasicInfo)(nil), "iotextypes.CandidateBasicInfo")
+	proto.RegisterType((*CandidateRegister)(nil), "iotextypes.CandidateRegister")
+	proto.RegisterType((*StartSubChain)(nil), "iotextypes.StartSubChain")
+	proto.RegisterType((*StopSubChain)(nil), "iotextypes.StopSubChain")
+	proto.RegisterType((*MerkleRoot)(nil), "iotextypes.MerkleRoot")
+	proto.RegisterType((*PutBlock)(nil), "iotextypes.PutBlock")
+	proto.RegisterType((*CreateDeposit)(nil), "iotextypes.CreateDeposit")
+	proto.RegisterType((*SettleDeposit)(nil), "iotextypes.SettleDeposit")
+	proto.RegisterType((*CreatePlumChain)(nil), "iotextypes.CreatePlumChain")
+	proto.RegisterType((*TerminatePlumChain)(nil), "iotextypes.TerminatePlumChain")
+	proto.RegisterType((*PlumPutBlock)(nil), "iotextypes.PlumPutBlock")
+	proto.RegisterMapType((map[string][]byte)(nil), "iotextypes.PlumPutBlock.RootsEntry")
+	proto.RegisterType((*PlumCreateDeposit)(nil), "iotextypes.PlumCreateDeposit")
+	proto.RegisterType((*PlumStartExit)(nil), "iotextypes.PlumStartExit")
+	proto.RegisterType((*PlumChallengeExit)(nil), "iotextypes.PlumChallengeExit")
+	proto.RegisterType((*PlumResponseChallengeExit)(nil), "iotextypes.PlumResponseChallengeExit")
+	proto.RegisterType((*PlumFinalizeExit)(nil), "iotextypes.PlumFinalizeExit")
+	proto.RegisterType((*PlumSettleDeposit)(nil), "iotextypes.PlumSettleDeposit")
+	proto.RegisterType((*PlumTransfer)(nil), "iotextypes.PlumTransfer")
+	proto.RegisterType((*ActionCore)(nil), "iotextypes.ActionCore")
+	proto.RegisterType((*Action)(nil), "iotextypes.Action")
+	proto.RegisterType((*Receipt)(nil), "iotextypes.Receipt")
+	proto.RegisterType((*Log)(nil), "iotextypes.Log")
+	proto.RegisterType((*DepositToRewardingFund)(nil), "iotextypes.DepositToRewardingFund")
+	proto.RegisterType((*ClaimFromRewardingFund)(nil), "iotextypes.ClaimFromRewardingFund")
+	proto.RegisterType((*GrantReward)(nil), "iotextypes.GrantReward")
+	proto.RegisterType((*StakeSplit)(nil), "iotextypes.StakeSplit")
+	proto.RegisterType((*StakeMerge)(nil), "iotextypes.StakeMerge")
+	proto.RegisterType((*StakeCompound)(nil), "iotextypes.StakeCompound")
+	proto.RegisterType((*DoubleSignEvidence)(nil), "iotextypes.DoubleSignEvidence")
+	proto.RegisterType((*ProposeParameter)(nil), "iotextypes.ProposeParameter")
+	proto.RegisterType((*VoteProposal)(nil), "iotextypes.VoteProposal")
+	proto.RegisterType((*CandidateBLSKey)(nil), "iotextypes.CandidateBLSKey")
+}
+
+func init() { proto.RegisterFile("proto/types/action.proto", fileDescriptor_d4dd5ed50f883f28) }
+
+var fileDescriptor_d4dd5ed50f883f28 = []byte{
+	// 2340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x5d, 0x73, 0x1c, 0x47,
+	0xf1, 0xee, 0x74, 0x92, 0xa5, 0xd6, 0x9d, 0x25, 0x0d, 0xb2, 0xbc, 0xfe, 0xc0, 0xa8, 0x36, 0x81,
+	0x32, 0x8a, 0x23, 0x13, 0xa5, 0x1c, 0x1c, 0x08, 0xae, 0xd8, 0x92, 0xed, 0xb3, 0x23, 0x63, 0xb1,
+	0x72, 0x42, 0x55, 0x80, 0x22, 0x7b, 0x7b, 0xa3, 0xd3, 0xa2, 0xbd, 0x9d, 0xad, 0x9d, 0x59, 0x59,
+	0xca, 0x03, 0xef, 0xbc, 0xf1, 0x37, 0xf8, 0x05, 0x14, 0x3f, 0x80, 0x17, 0xde, 0x78, 0xe2, 0xb7,
+	0xf0, 0x40, 0x15, 0xd5, 0x33, 0xb3, 0xbb, 0x33, 0xbb, 0x7b, 0x67, 0x39, 0x15, 0xc8, 0x8b, 0x7d,
+	0xdd, 0xd3, 0xd3, 0x5f, 0xd3, 0xd3, 0xdd, 0xd3, 0x2b, 0x70, 0x92, 0x94, 0x09, 0x76, 0x57, 0x9c,
+	0x27, 0x94, 0xdf, 0xf5, 0x03, 0x11, 0xb2, 0x78, 0x5b, 0xa2, 0x08, 0x84, 0x4c, 0xd0, 0x33, 0xb9,
+	0xe0, 0x7e, 0x09, 0x8b, 0xaf, 0x52, 0x3f, 0xe6, 0x47, 0x34, 0x25, 0x1b, 0xb0, 0xe0, 0x4f, 0x58,
+	0x16, 0x0b, 0xa7, 0xbd, 0xd9, 0xbe, 0xbd, 0xe4, 0x69, 0x88, 0xdc, 0x84, 0xa5, 0x94, 0x06, 0x61,
+	0x12, 0xd2, 0x58, 0x38, 0x1d, 0xb9, 0x54, 0x22, 0x88, 0x03, 0x97, 0x12, 0xff, 0x3c, 0x62, 0xfe,
+	0xc8, 0x99, 0xdb, 0x6c, 0xdf, 0xee, 0x79, 0x39, 0xe8, 0x9e, 0xc3, 0xd2, 0xae, 0x1f, 0x8f, 0xc2,
+	0x91, 0x2f, 0x28, 0x92, 0xf9, 0xa3, 0x51, 0x4a, 0x39, 0xd7, 0xdc, 0x73, 0x90, 0xac, 0xc3, 0xfc,
+	0x29, 0x13, 0x94, 0x4b, 0xd6, 0x3d, 0x4f, 0x01, 0xa8, 0x4c, 0x92, 0x0d, 0x3f, 0xa3, 0xe7, 0x9a,
+	0xab, 0x86, 0xc8, 0xbb, 0xd0, 0x4f, 0xe9, 0x6b, 0x3f, 0x1d, 0x3d, 0xd4, 0xdc, 0xba, 0x92, 0x9b,
+	0x8d, 0x74, 0x9f, 0x40, 0xbf, 0x10, 0xbd, 0x1f, 0x72, 0x41, 0xee, 0x01, 0x04, 0x39, 0x02, 0x35,
+	0x98, 0xbb, 0xbd, 0xbc, 0x73, 0x65, 0xbb, 0x74, 0xc4, 0x76, 0x41, 0xee, 0x19, 0x84, 0xee, 0x10,
+	0xfa, 0x07, 0x99, 0x38, 0x60, 0x51, 0xe4, 0x51, 0x9e, 0x45, 0x02, 0xd5, 0x3a, 0xa6, 0xe1, 0xf8,
+	0x58, 0xf9, 0xa8, 0xeb, 0x69, 0x88, 0x7c, 0x6c, 0xf1, 0x47, 0x4b, 0x96, 0x77, 0xae, 0x35, 0xf2,
+	0x47, 0x75, 0x2c, 0x19, 0x87, 0xb0, 0xf4, 0xf8, 0x8c, 0x06, 0x19, 0x9e, 0xd0, 0xd4, 0x33, 0xb8,
+	0x0e, 0x8b, 0x01, 0x8b, 0x45, 0xea, 0x07, 0xf9, 0x11, 0x14, 0x30, 0x21, 0xd0, 0x1d, 0xf9, 0xc2,
+	0xd7, 0x8e, 0x92, 0xbf, 0xdd, 0xbf, 0xb6, 0x61, 0xf9, 0x50, 0xf8, 0x27, 0x74, 0x37, 0xa5, 0xe8,
+	0xfe, 0x77, 0xa1, 0x5f, 0x88, 0xfc, 0xa5, 0x3f, 0xa1, 0x9a, 0xbd, 0x8d, 0x24, 0x2e, 0xf4, 0x38,
+	0x6e, 0x1a, 0x3d, 0x54, 0x3a, 0x28, 0x49, 0x16, 0x8e, 0xfc, 0x08, 0x2e, 0x2b, 0x78, 0x2f, 0x4b,
+	0x7d, 0xd4, 0x59, 0xca, 0xed, 0x7b, 0x15, 0x2c, 0x46, 0x8d, 0x9f, 0x09, 0x26, 0x95, 0x90, 0x87,
+	0xb4, 0xe8, 0x95, 0x08, 0x33, 0x6a, 0xe6, 0xed, 0xa8, 0x79, 0x0e, 0x3d, 0x49, 0xe2, 0xd1, 0x20,
+	0xf2, 0xc3, 0x09, 0xd9, 0x84, 0xe5, 0x61, 0x16, 0x9c, 0x50, 0xf1, 0x2c, 0x1e, 0xd1, 0x33, 0xed,
+	0x76, 0x13, 0x65, 0xf2, 0xea, 0xd8, 0xbc, 0x28, 0xac, 0x48, 0x5e, 0x0f, 0x47, 0xa3, 0x3d, 0x9a,
+	0x30, 0x1e, 0x8a, 0x0b, 0xb0, 0x2b, 0x8f, 0xa0, 0x63, 0x1d, 0xc1, 0xf4, 0x40, 0xff, 0x73, 0xbb,
+	0xd0, 0x59, 0x3a, 0xe1, 0x02, 0x42, 0xea, 0x5e, 0xec, 0xbc, 0xd9, 0x8b, 0x73, 0x33, 0xbc, 0xd8,
+	0xb5, 0x55, 0x3a, 0x83, 0x75, 0x75, 0xfc, 0xc7, 0x7e, 0x3c, 0xa6, 0xe5, 0x35, 0x7c, 0xb3, 0x66,
+	0xb5, 0x48, 0xe9, 0x34, 0x45, 0xca, 0x74, 0x67, 0x9c, 0xc1, 0x86, 0x94, 0x9c, 0xa7, 0x95, 0x97,
+	0xaf, 0x63, 0x9a, 0xf2, 0xe3, 0x30, 0xb9, 0x80, 0x6c, 0x17, 0x7a, 0x78, 0xfb, 0xd3, 0xfc, 0x6e,
+	0xeb, 0xf8, 0x33, 0x71, 0x33, 0x25, 0x93, 0xc2, 0xd0, 0x47, 0x3e, 0x0f, 0x83, 0x67, 0xf1, 0x11,
+	0xc3, 0xdb, 0x11, 0x97, 0x01, 0x2f, 0x7f, 0x93, 0xdb, 0xb0, 0xc2, 0x12, 0x9a, 0xfa, 0x82, 0x55,
+	0x44, 0x55, 0xd1, 0xf5, 0x74, 0x33, 0xd7, 0x94, 0x6e, 0xfe, 0xdd, 0x86, 0xb5, 0x32, 0x81, 0xd0,
+	0x71, 0xc8, 0x05, 0x4d, 0xc9, 0x27, 0xb0, 0x54, 0x38, 0x4d, 0x8a, 0x5f, 0xde, 0xb9, 0xd5, 0x98,
+	0x12, 0x0a, 0x65, 0xbd, 0x72, 0xc3, 0xff, 0xf1, 0x2e, 0xba, 0xd0, 0x63, 0x78, 0x48, 0xb9, 0x89,
+	0xf3, 0x4a, 0x92, 0x89, 0x33, 0xbd, 0xbe, 0x60, 0x7b, 0xfd, 0x9f, 0x6d, 0xe8, 0x1f, 0x0a, 0x3f,
+	0x15, 0x87, 0xd9, 0x70, 0xf7, 0xd8, 0x0f, 0x63, 0xa4, 0x0d, 0xf0, 0xc7, 0xb3, 0x3d, 0x69, 0x75,
+	0xdf, 0xcb, 0x41, 0xf4, 0x3b, 0xa7, 0x41, 0x96, 0x86, 0xe2, 0x5c, 0xdf, 0xc7, 0xdc, 0xef, 0x15,
+	0x34, 0xd9, 0x82, 0x55, 0x75, 0x14, 0x21, 0x8b, 0x73, 0x52, 0xe5, 0xfa, 0x1a, 0x1e, 0xe3, 0x8a,
+	0xa3, 0x02, 0x03, 0x95, 0x98, 0xbb, 0x2a, 0xae, 0x0c, 0x14, 0xd9, 0x06, 0x92, 0xf8, 0x29, 0x8d,
+	0x35, 0xfc, 0xf2, 0xe8, 0x88, 0x53, 0x21, 0xed, 0xec, 0x7a, 0x0d, 0x2b, 0x6e, 0x8a, 0xf7, 0x99,
+	0x25, 0x17, 0xb0, 0xe8, 0x16, 0x00, 0x17, 0x2c, 0xd1, 0xa2, 0x3b, 0x92, 0xa3, 0x81, 0x91, 0x16,
+	0x6b, 0x2e, 0x76, 0x04, 0x55, 0xd1, 0xee, 0x47, 0x00, 0x2f, 0x68, 0x7a, 0x12, 0x51, 0x8f, 0x31,
+	0xd1, 0x18, 0xb5, 0x58, 0x28, 0xfd, 0x28, 0xa3, 0x45, 0xa1, 0x44, 0xc0, 0xfd, 0x1a, 0x16, 0x0f,
+	0x32, 0xf1, 0x28, 0x62, 0xc1, 0x49, 0x93, 0xb4, 0x76, 0xa3, 0x34, 0xa3, 0x8e, 0x75, 0xac, 0x3a,
+	0x76, 0x07, 0xe6, 0x53, 0xc6, 0x04, 0x6a, 0x89, 0x25, 0x72, 0xc3, 0x8c, 0xd7, 0x52, 0x3d, 0x4f,
+	0x11, 0xb9, 0xbf, 0x87, 0xbe, 0xaa, 0x2f, 0xf9, 0x51, 0x4c, 0x77, 0xd4, 0xb4, 0xac, 0x6a, 0x35,
+	0x17, 0x73, 0x95, 0xe6, 0xc2, 0xfd, 0x0d, 0xf4, 0x0f, 0xa9, 0x10, 0x51, 0x21, 0xe0, 0x9b, 0xf5,
+	0x28, 0xeb, 0x30, 0x1f, 0xca, 0x9c, 0x33, 0x27, 0x8d, 0x55, 0x80, 0xbb, 0x06, 0x2b, 0x4a, 0xfb,
+	0x83, 0x28, 0x9b, 0x48, 0xef, 0xb8, 0x0f, 0x80, 0xbc, 0xa2, 0xe9, 0x24, 0x8c, 0x4d, 0xec, 0xc5,
+	0xdd, 0xea, 0xfe, 0xbd, 0x0d, 0x3d, 0xdc, 0xf7, 0x2d, 0x9e, 0xc8, 0xc7, 0xf6, 0x89, 0xbc, 0x63,
+	0x9e, 0x88, 0x29, 0x6a, 0x1b, 0x0f, 0x86, 0x3f, 0x8e, 0x45, 0x7a, 0xae, 0x8f, 0xe7, 0xfa, 0x7d,
+	0x80, 0x12, 0x49, 0x56, 0x61, 0xee, 0x84, 0x9e, 0x6b, 0xf1, 0xf8, 0xb3, 0x39, 0xa0, 0x7e, 0xd6,
+	0xb9, 0xdf, 0x76, 0x39, 0xac, 0x49, 0xf3, 0xad, 0xc3, 0x7d, 0x2b, 0x5b, 0xbe, 0xc1, 0x61, 0xff,
+	0xa7, 0x03, 0x7d, 0x94, 0x2a, 0xb3, 0xc9, 0xe3, 0xb3, 0xb7, 0x92, 0xb8, 0x05, 0xab, 0x49, 0x4a,
+	0x4f, 0x43, 0x96, 0xf1, 0xbc, 0xf0, 0x68, 0xab, 0x6a, 0x78, 0xf2, 0x00, 0xae, 0x57, 0x71, 0xd2,
+	0x83, 0x07, 0x29, 0x63, 0x47, 0xba, 0xa8, 0xcc, 0xa0, 0x20, 0x9f, 0xc2, 0x8d, 0xc6, 0x55, 0x2b,
+	0xff, 0xcc, 0x22, 0xc1, 0x8c, 0x4b, 0xcf, 0x42, 0x51, 0x68, 0xaa, 0x5a, 0x20, 0x0b, 0x47, 0x3e,
+	0x82, 0x0d, 0x13, 0x36, 0x34, 0x54, 0x09, 0x78, 0xca, 0x2a, 0xb9, 0x0f, 0x57, 0x6b, 0x2b, 0x5a,
+	0xb3, 0x4b, 0x52, 0xb3, 0x69, 0xcb, 0xee, 0x9f, 0x3a, 0xfa, 0xd4, 0x8f, 0xfd, 0x28, 0xa2, 0xf1,
+	0x98, 0xbe, 0xe5, 0x19, 0x6c, 0xc0, 0x42, 0xc0, 0xe4, 0xdd, 0xd7, 0x11, 0xac, 0x20, 0x72, 0x07,
+	0xd6, 0x82, 0x9c, 0x65, 0x61, 0xb2, 0x72, 0x73, 0x7d, 0x01, 0xbd, 0x5b, 0x43, 0x1a, 0xc6, 0xab,
+	0x3e, 0x67, 0x16, 0x09, 0x79, 0x04, 0x37, 0x9b, 0x97, 0xb5, 0x1b, 0x54, 0xde, 0x9f, 0x49, 0xe3,
+	0xfe, 0xad, 0x03, 0xd7, 0xd0, 0x17, 0x1e, 0xe5, 0x09, 0x8b, 0x39, 0xfd, 0x6e, 0x7d, 0xb2, 0x05,
+	0xab, 0xa9, 0x56, 0xa4, 0x20, 0x56, 0x8e, 0xa8, 0xe1, 0x31, 0xba, 0xab, 0x38, 0xc3, 0x7d, 0x2a,
+	0xd2, 0x66, 0x50, 0xbc, 0x29, 0xba, 0x17, 0xde, 0x18, 0xdd, 0xee, 0x2b, 0x58, 0x45, 0xd7, 0x3d,
+	0x09, 0x63, 0x3f, 0x0a, 0xbf, 0xfe, 0x96, 0x3c, 0xe6, 0xbe, 0xa7, 0x82, 0xb3, 0x56, 0x0e, 0x34,
+	0x71, 0xdb, 0x22, 0xfe, 0xa3, 0x4a, 0xc3, 0xe6, 0xd3, 0xb6, 0x89, 0x0e, 0x2f, 0xe2, 0x88, 0xc6,
+	0x4c, 0x26, 0xfc, 0xbc, 0x09, 0xef, 0x79, 0x16, 0x0e, 0xb3, 0xa4, 0x6c, 0x85, 0x74, 0xc2, 0x52,
+	0x80, 0x9d, 0xca, 0xba, 0xd5, 0x54, 0xf6, 0xaf, 0x2b, 0x00, 0x0f, 0xe5, 0x9b, 0x7b, 0x97, 0xa5,
+	0xb2, 0x5b, 0x3e, 0xa5, 0x29, 0x47, 0x09, 0xba, 0x2c, 0x6a, 0x10, 0x99, 0xc7, 0x2c, 0x0e, 0xa8,
+	0x36, 0x56, 0x01, 0xf8, 0xda, 0x1b, 0xfb, 0x7c, 0x3f, 0x9c, 0xe8, 0xae, 0xa7, 0xeb, 0x15, 0xb0,
+	0x5e, 0x3b, 0x48, 0xc3, 0x80, 0x6a, 0xb9, 0x05, 0x4c, 0x76, 0x60, 0x51, 0xe4, 0xf1, 0x01, 0xb2,
+	0xe1, 0x5c, 0x37, 0xcb, 0x45, 0xee, 0x8e, 0x41, 0xcb, 0x2b, 0xe8, 0xc8, 0x3d, 0x58, 0xa2, 0xf9,
+	0xf3, 0xd3, 0xe9, 0x6d, 0xb6, 0xab, 0x0f, 0xe3, 0xe2, 0x6d, 0x3a, 0x68, 0x79, 0x25, 0x25, 0x79,
+	0x08, 0x7d, 0x6e, 0x76, 0x7d, 0x4e, 0xbf, 0xfe, 0xe6, 0xb5, 0xda, 0xc2, 0x41, 0xcb, 0xb3, 0x77,
+	0x90, 0x07, 0xd8, 0xe1, 0x96, 0x5d, 0x96, 0x73, 0x59, 0x72, 0x70, 0x6c, 0x0e, 0xe5, 0xfa, 0xa0,
+	0xe5, 0x59, 0xf4, 0x68, 0x6d, 0xa2, 0x8b, 0x9f, 0xb3, 0x52, 0xb7, 0x36, 0x2f, 0x8c, 0x68, 0x6d,
+	0x4e, 0x87, 0x6a, 0x07, 0x66, 0x51, 0x73, 0x56, 0x1b, 0x9e, 0xea, 0x26, 0x01, 0xaa, 0x6d, 0xed,
+	0x90, 0x96, 0x9b, 0x41, 0xe8, 0xac, 0x35, 0x58, 0x6e, 0x12, 0x48, 0xcb, 0x4d, 0x04, 0x79, 0x0a,
+	0x2b, 0x81, 0xdd, 0x79, 0x38, 0x44, 0x32, 0xb9, 0x51, 0xd7, 0xa3, 0x20, 0x19, 0xb4, 0xbc, 0xea,
+	0x2e, 0x72, 0x00, 0x44, 0xd4, 0xfa, 0x15, 0xe7, 0x7b, 0xf5, 0xb7, 0x46, 0xbd, 0xab, 0x19, 0xb4,
+	0xbc, 0x86, 0xbd, 0x78, 0x28, 0x89, 0xd1, 0x55, 0x38, 0xeb, 0xf5, 0x43, 0x31, 0xbb, 0x0e, 0x3c,
+	0x14, 0x93, 0x9e, 0xbc, 0x80, 0xb5, 0xa4, 0xda, 0x39, 0x38, 0x57, 0x24, 0x93, 0xef, 0x57, 0x99,
+	0x54, 0x1d, 0x5d, 0xdf, 0x89, 0xce, 0x4e, 0xcc, 0x96, 0xc0, 0xd9, 0xa8, 0x3b, 0xdb, 0xea, 0x19,
+	0xd0, 0xd9, 0xd6, 0x8e, 0x42, 0x23, 0x33, 0x83, 0x3b, 0x57, 0xa7, 0x68, 0x64, 0x12, 0x15, 0x1a,
+	0x99, 0x48, 0x42, 0xe1, 0x5a, 0x32, 0xad, 0x30, 0x38, 0x8e, 0x64, 0xfb, 0xc3, 0x2a, 0xdb, 0x46,
+	0xe2, 0x41, 0xcb, 0x9b, 0xce, 0x89, 0x3c, 0x87, 0xd5, 0xa4, 0x92, 0x44, 0x9d, 0x6b, 0x92, 0xfb,
+	0xcd, 0x2a, 0x77, 0x93, 0x66, 0xd0, 0xf2, 0x6a, 0xfb, 0x72, 0x0f, 0x58, 0x41, 0xe9, 0x5c, 0x6f,
+	0xf6, 0x40, 0x35, 0x72, 0xeb, 0x3b, 0xf3, 0x10, 0x29, 0x2a, 0xd1, 0x8d, 0xe6, 0x10, 0x31, 0xb2,
+	0x8d, 0x45, 0x4f, 0x7e, 0x0b, 0x1b, 0x23, 0xc5, 0xea, 0x15, 0xf3, 0xe4, 0x3b, 0x3a, 0x8c, 0xc7,
+	0x4f, 0xb2, 0x78, 0xe4, 0xdc, 0x92, 0x9c, 0x5c, 0x93, 0xd3, 0x5e, 0x23, 0xe5, 0xa0, 0xe5, 0x4d,
+	0xe1, 0x81, 0xdc, 0xe5, 0xe0, 0xe8, 0x49, 0xca, 0x26, 0x36, 0xf7, 0x1f, 0xd4, 0xb9, 0xef, 0x36,
+	0x52, 0x22, 0xf7, 0x66, 0x1e, 0xe4, 0xe7, 0xb0, 0x3c, 0x4e, 0xfd, 0x58, 0x28, 0xac, 0xb3, 0x29,
+	0x59, 0x5e, 0x35, 0x59, 0x3e, 0x2d, 0x97, 0x07, 0x2d, 0xcf, 0xa4, 0xc6, 0xcd, 0xbc, 0x9c, 0xc9,
+	0x39, 0xb7, 0xeb, 0x9b, 0x8d, 0x91, 0x1d, 0x6e, 0x36, 0xa8, 0x55, 0xb6, 0xf4, 0x4f, 0xe8, 0xe7,
+	0xb1, 0xfc, 0xcf, 0xf9, 0x71, 0x53, 0xb6, 0x2c, 0xe7, 0x66, 0x2a, 0x5b, 0x96, 0xf4, 0xe4, 0x53,
+	0x99, 0xb0, 0x4f, 0xe8, 0xaf, 0x43, 0x71, 0x3c, 0x4a, 0xfd, 0xd7, 0xce, 0xd6, 0x1b, 0x19, 0xd8,
+	0x1b, 0x30, 0x6b, 0x71, 0x7b, 0x9a, 0xe6, 0xbc, 0x57, 0xcf, 0x5a, 0x95, 0x81, 0x1b, 0x66, 0xad,
+	0xca, 0xae, 0xc2, 0x14, 0x3d, 0x2e, 0x73, 0xee, 0x4c, 0xd5, 0x44, 0xae, 0x17, 0xa6, 0x68, 0x98,
+	0x7c, 0x01, 0xeb, 0xbc, 0x61, 0xb8, 0xe5, 0xbc, 0x2f, 0xf9, 0x6c, 0xd6, 0x1d, 0x6a, 0xd3, 0x0d,
+	0x5a, 0x5e, 0xe3, 0x7e, 0x0c, 0x1d, 0xde, 0x38, 0xba, 0x72, 0xb6, 0xeb, 0xa1, 0xd3, 0x3c, 0xe4,
+	0xc2, 0xd0, 0x69, 0xe6, 0x81, 0xb7, 0x30, 0xa8, 0xce, 0x88, 0x9c, 0xbb, 0xf5, 0x5b, 0x58, 0x1b,
+	0x24, 0xe1, 0x2d, 0xac, 0xed, 0x24, 0xcf, 0x61, 0xa5, 0x40, 0x7e, 0x9e, 0xe0, 0xbf, 0xce, 0x4f,
+	0x2e, 0x32, 0x63, 0x92, 0x65, 0xc4, 0xde, 0x28, 0xeb, 0x51, 0x41, 0xb8, 0x7f, 0x88, 0x53, 0xf7,
+	0x0f, 0x1a, 0xea, 0x91, 0x4d, 0x62, 0x31, 0x52, 0x28, 0x99, 0xae, 0xcd, 0x79, 0xb9, 0xb3, 0xd3,
+	0x90, 0xae, 0x4d, 0x02, 0x99, 0xae, 0x4d, 0x04, 0x96, 0xb4, 0x11, 0xcb, 0x86, 0x11, 0x3d, 0x0c,
+	0xc7, 0xf1, 0xe3, 0xd3, 0x70, 0x44, 0xb1, 0x3d, 0xfa, 0xb0, 0x6e, 0xda, 0x5e, 0x8d, 0x0a, 0x4b,
+	0x5a, 0x7d, 0x2f, 0xb9, 0x8f, 0x33, 0x1a, 0xff, 0x84, 0x1e, 0x26, 0x51, 0x28, 0x9c, 0x4f, 0x36,
+	0xdb, 0xd5, 0xc1, 0xc6, 0x61, 0xb1, 0x3a, 0x68, 0x79, 0x06, 0x6d, 0xb1, 0xf3, 0x05, 0x4d, 0xc7,
+	0xd4, 0xf9, 0xc5, 0x94, 0x9d, 0x72, 0xb5, 0xd8, 0x29, 0x21, 0xdd, 0x1e, 0x9d, 0xd0, 0x5d, 0x36,
+	0x49, 0x18, 0x26, 0x9f, 0x07, 0x8d, 0xed, 0x51, 0x49, 0x50, 0x5c, 0xb7, 0x1c, 0x21, 0x2b, 0x40,
+	0xca, 0x12, 0xc6, 0xe9, 0x81, 0x9f, 0xfa, 0x13, 0x8a, 0xe1, 0xf2, 0xa4, 0xa1, 0x02, 0x54, 0x68,
+	0x64, 0x05, 0xa8, 0xe0, 0xf0, 0xc6, 0xe1, 0x10, 0x55, 0xd1, 0xfa, 0x91, 0xf3, 0xb4, 0x7e, 0xe3,
+	0xbe, 0x30, 0xd6, 0xf1, 0xc6, 0x99, 0xf4, 0x8f, 0x16, 0x61, 0x41, 0x7d, 0x42, 0x72, 0x4f, 0x61,
+	0x41, 0x35, 0xb6, 0x64, 0x0b, 0xba, 0x01, 0x4b, 0xf3, 0xc9, 0xa6, 0xe5, 0x96, 0xb2, 0xf5, 0xf5,
+	0x24, 0x8d, 0x1c, 0x66, 0xd2, 0x78, 0x44, 0xd3, 0x03, 0xf5, 0x4d, 0x47, 0xf7, 0xd9, 0x26, 0x0e,
+	0x3b, 0x6a, 0x1e, 0x8e, 0x63, 0x5f, 0x64, 0x29, 0xd5, 0x4f, 0xa1, 0x12, 0xe1, 0xfe, 0xa3, 0x0d,
+	0x97, 0x3c, 0x1a, 0xd0, 0x30, 0x91, 0x5d, 0x3f, 0x17, 0xbe, 0xc8, 0x78, 0xde, 0xcd, 0x2b, 0x08,
+	0x39, 0x0c, 0xa3, 0x13, 0x6b, 0x16, 0x57, 0x22, 0xe4, 0x17, 0xa8, 0x40, 0x0c, 0x7c, 0x7e, 0x9c,
+	0x0f, 0x8e, 0x35, 0x88, 0x03, 0xc4, 0xb1, 0xcf, 0x77, 0x59, 0xcc, 0xb3, 0x09, 0x1d, 0xe5, 0x03,
+	0x44, 0x03, 0x85, 0xcf, 0x97, 0xfc, 0x73, 0x8b, 0x3d, 0x25, 0xad, 0xa2, 0xc9, 0x3b, 0xd0, 0x8d,
+	0xd8, 0x98, 0x3b, 0x0b, 0x72, 0x5a, 0xb3, 0x62, 0x7a, 0x65, 0x9f, 0x8d, 0x3d, 0xb9, 0xe8, 0xfe,
+	0xa5, 0x0d, 0x73, 0xfb, 0x6c, 0xdc, 0xc4, 0xb6, 0xdd, 0xcc, 0x76, 0x03, 0x16, 0x04, 0x4b, 0xc2,
+	0x00, 0x07, 0xd5, 0x73, 0xf8, 0x39, 0x4c, 0x41, 0x4d, 0xdf, 0x7e, 0x6c, 0x37, 0x74, 0x67, 0xb8,
+	0x61, 0xde, 0x76, 0x43, 0x31, 0x25, 0x5b, 0x90, 0x6f, 0x14, 0x05, 0xb8, 0x7b, 0xb0, 0xd1, 0x5c,
+	0x83, 0xa7, 0xce, 0xe2, 0x72, 0x9d, 0x3a, 0xc6, 0xf7, 0xa8, 0x3d, 0xd8, 0x68, 0xae, 0xb5, 0x6f,
+	0xc5, 0xe5, 0x57, 0xb0, 0x6c, 0x94, 0x57, 0x8c, 0x40, 0xf4, 0xac, 0xdc, 0x78, 0xd9, 0x8e, 0x40,
+	0x45, 0xf1, 0xea, 0x3c, 0xa1, 0x9e, 0xa4, 0x99, 0x36, 0x5e, 0x73, 0xbf, 0x02, 0x28, 0xaf, 0xff,
+	0xff, 0xe4, 0xeb, 0x50, 0xa4, 0x25, 0xa8, 0xc4, 0x70, 0xa1, 0x8f, 0x20, 0x13, 0x24, 0x7d, 0x16,
+	0x8f, 0xc2, 0x80, 0xaa, 0x03, 0xef, 0x7a, 0x16, 0x6e, 0x86, 0xb4, 0x40, 0x4e, 0xe3, 0x8d, 0x34,
+	0x72, 0x21, 0x93, 0x68, 0xec, 0x0f, 0x23, 0xf5, 0x08, 0x5d, 0xf4, 0x34, 0x34, 0x43, 0xc8, 0x3e,
+	0x90, 0x7a, 0xf6, 0xc5, 0x97, 0xe9, 0x84, 0x72, 0xee, 0x8f, 0xe9, 0x07, 0x52, 0x4c, 0xcf, 0x2b,
+	0x60, 0x63, 0x6d, 0x47, 0x9f, 0x68, 0x01, 0xbb, 0x5f, 0xc1, 0x6a, 0x35, 0x89, 0x61, 0x0c, 0x27,
+	0x39, 0xa0, 0x03, 0xa3, 0x44, 0xd8, 0x83, 0xcb, 0x25, 0x3d, 0xb8, 0x9c, 0xa1, 0xef, 0x10, 0x7a,
+	0x66, 0x7a, 0xc3, 0xa9, 0x7d, 0xa2, 0x7f, 0x17, 0x23, 0x01, 0x03, 0x23, 0xef, 0x48, 0x92, 0xa4,
+	0xec, 0x34, 0x77, 0x49, 0x0e, 0xce, 0x90, 0xf1, 0x3b, 0x58, 0xa9, 0x14, 0x48, 0x69, 0x44, 0x36,
+	0x8c, 0xc2, 0xe0, 0x33, 0x3d, 0x77, 0xed, 0x79, 0x25, 0x02, 0x47, 0x40, 0x09, 0x4e, 0x5c, 0x5e,
+	0x1e, 0x1d, 0x30, 0xce, 0x29, 0xe7, 0xe5, 0x00, 0xa2, 0xbe, 0xb0, 0xb5, 0x0d, 0x50, 0xc6, 0x34,
+	0x59, 0x81, 0x65, 0xf9, 0xdc, 0x52, 0xa8, 0xd5, 0x16, 0x22, 0x1e, 0x27, 0x2c, 0x38, 0xd6, 0x88,
+	0xf6, 0xa3, 0x9f, 0x7e, 0x79, 0x6f, 0x1c, 0x8a, 0xe3, 0x6c, 0xb8, 0x1d, 0xb0, 0xc9, 0x5d, 0x79,
+	0x33, 0x92, 0x94, 0xfd, 0x81, 0x06, 0x42, 0x01, 0xef, 0xab, 0x3f, 0x0f, 0x18, 0xb3, 0xc8, 0x8f,
+	0xc7, 0x77, 0xcb, 0x9b, 0x33, 0x5c, 0x90, 0x0b, 0x1f, 0xfe, 0x77, 0x00, 0x42, 0xa7, 0xf8, 0x1d,
+	0x40, 0x20, 0x00, 0x00,
+}
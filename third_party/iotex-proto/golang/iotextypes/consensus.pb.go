@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/consensus.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConsensusVote_Topic int32
+
+const (
+	ConsensusVote_PROPOSAL ConsensusVote_Topic = 0
+	ConsensusVote_LOCK     ConsensusVote_Topic = 1
+	ConsensusVote_COMMIT   ConsensusVote_Topic = 2
+)
+
+var ConsensusVote_Topic_name = map[int32]string{
+	0: "PROPOSAL",
+	1: "LOCK",
+	2: "COMMIT",
+}
+
+var ConsensusVote_Topic_value = map[string]int32{
+	"PROPOSAL": 0,
+	"LOCK":     1,
+	"COMMIT":   2,
+}
+
+func (x ConsensusVote_Topic) String() string {
+	return proto.EnumName(ConsensusVote_Topic_name, int32(x))
+}
+
+func (ConsensusVote_Topic) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2637092b19291c2e, []int{1, 0}
+}
+
+type BlockProposal struct {
+	Block                *Block         `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Endorsements         []*Endorsement `protobuf:"bytes,2,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BlockProposal) Reset()         { *m = BlockProposal{} }
+func (m *BlockProposal) String() string { return proto.CompactTextString(m) }
+func (*BlockProposal) ProtoMessage()    {}
+func (*BlockProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2637092b19291c2e, []int{0}
+}
+
+func (m *BlockProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockProposal.Unmarshal(m, b)
+}
+func (m *BlockProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockProposal.Marshal(b, m, deterministic)
+}
+func (m *BlockProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockProposal.Merge(m, src)
+}
+func (m *BlockProposal) XXX_Size() int {
+	return xxx_messageInfo_BlockProposal.Size(m)
+}
+func (m *BlockProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockProposal proto.InternalMessageInfo
+
+func (m *BlockProposal) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockProposal) GetEndorsements() []*Endorsement {
+	if m != nil {
+		return m.Endorsements
+	}
+	return nil
+}
+
+type ConsensusVote struct {
+	BlockHash            []byte              `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Topic                ConsensusVote_Topic `protobuf:"varint,2,opt,name=topic,proto3,enum=iotextypes.ConsensusVote_Topic" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConsensusVote) Reset()         { *m = ConsensusVote{} }
+func (m *ConsensusVote) String() string { return proto.CompactTextString(m) }
+func (*ConsensusVote) ProtoMessage()    {}
+func (*ConsensusVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2637092b19291c2e, []int{1}
+}
+
+func (m *ConsensusVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusVote.Unmarshal(m, b)
+}
+func (m *ConsensusVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusVote.Marshal(b, m, deterministic)
+}
+func (m *ConsensusVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusVote.Merge(m, src)
+}
+func (m *ConsensusVote) XXX_Size() int {
+	return xxx_messageInfo_ConsensusVote.Size(m)
+}
+func (m *ConsensusVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusVote proto.InternalMessageInfo
+
+func (m *ConsensusVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ConsensusVote) GetTopic() ConsensusVote_Topic {
+	if m != nil {
+		return m.Topic
+	}
+	return ConsensusVote_PROPOSAL
+}
+
+type ConsensusMessage struct {
+	Height       uint64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Endorsement  *Endorsement `protobuf:"bytes,2,opt,name=endorsement,proto3" json:"endorsement,omitempty"`
+	BlsSignature []byte       `protobuf:"bytes,3,opt,name=blsSignature,proto3" json:"blsSignature,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//	*ConsensusMessage_BlockProposal
+	//	*ConsensusMessage_Vote
+	Msg                  isConsensusMessage_Msg `protobuf_oneof:"msg"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ConsensusMessage) Reset()         { *m = ConsensusMessage{} }
+func (m *ConsensusMessage) String() string { return proto.CompactTextString(m) }
+func (*ConsensusMessage) ProtoMessage()    {}
+func (*ConsensusMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2637092b19291c2e, []int{2}
+}
+
+func (m *ConsensusMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusMessage.Unmarshal(m, b)
+}
+func (m *ConsensusMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusMessage.Marshal(b, m, deterministic)
+}
+func (m *ConsensusMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusMessage.Merge(m, src)
+}
+func (m *ConsensusMessage) XXX_Size() int {
+	return xxx_messageInfo_ConsensusMessage.Size(m)
+}
+func (m *ConsensusMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusMessage proto.InternalMessageInfo
+
+func (m *ConsensusMessage) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsensusMessage) GetEndorsement() *Endorsement {
+	if m != nil {
+		return m.Endorsement
+	}
+	return nil
+}
+
+func (m *ConsensusMessage) GetBlsSignature() []byte {
+	if m != nil {
+		return m.BlsSignature
+	}
+	return nil
+}
+
+type isConsensusMessage_Msg interface {
+	isConsensusMessage_Msg()
+}
+
+type ConsensusMessage_BlockProposal struct {
+	BlockProposal *BlockProposal `protobuf:"bytes,100,opt,name=blockProposal,proto3,oneof"`
+}
+
+type ConsensusMessage_Vote struct {
+	Vote *ConsensusVote `protobuf:"bytes,101,opt,name=vote,proto3,oneof"`
+}
+
+func (*ConsensusMessage_BlockProposal) isConsensusMessage_Msg() {}
+
+func (*ConsensusMessage_Vote) isConsensusMessage_Msg() {}
+
+func (m *ConsensusMessage) GetMsg() isConsensusMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *ConsensusMessage) GetBlockProposal() *BlockProposal {
+	if x, ok := m.GetMsg().(*ConsensusMessage_BlockProposal); ok {
+		return x.BlockProposal
+	}
+	return nil
+}
+
+func (m *ConsensusMessage) GetVote() *ConsensusVote {
+	if x, ok := m.GetMsg().(*ConsensusMessage_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ConsensusMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ConsensusMessage_BlockProposal)(nil),
+		(*ConsensusMessage_Vote)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("iotextypes.ConsensusVote_Topic", ConsensusVote_Topic_name, ConsensusVote_Topic_value)
+	proto.RegisterType((*BlockProposal)(nil), "iotextypes.BlockProposal")
+	proto.RegisterType((*ConsensusVote)(nil), "iotextypes.ConsensusVote")
+	proto.RegisterType((*ConsensusMessage)(nil), "iotextypes.ConsensusMessage")
+}
+
+func init() { proto.RegisterFile("proto/types/consensus.proto", fileDescriptor_2637092b19291c2e) }
+
+var fileDescriptor_2637092b19291c2e = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6b, 0xe2, 0x40,
+	0x14, 0xc6, 0x4d, 0x34, 0xe2, 0x3e, 0xe3, 0x92, 0x9d, 0xc3, 0x6e, 0xd6, 0x75, 0x59, 0xc9, 0x65,
+	0x85, 0x65, 0x13, 0xb0, 0x48, 0x29, 0x3d, 0xa9, 0x14, 0x2c, 0x55, 0x22, 0xa3, 0xf4, 0xd0, 0x5b,
+	0x12, 0x87, 0x24, 0x6d, 0xcc, 0x84, 0xcc, 0xa4, 0xb4, 0xf7, 0xfe, 0x03, 0xfd, 0x8f, 0x8b, 0x93,
+	0xda, 0x4c, 0x10, 0x7a, 0x7c, 0xef, 0xfb, 0xbd, 0xef, 0xcd, 0x97, 0x17, 0xf8, 0x95, 0xe5, 0x94,
+	0x53, 0x87, 0x3f, 0x67, 0x84, 0x39, 0x01, 0x4d, 0x19, 0x49, 0x59, 0xc1, 0x6c, 0xd1, 0x45, 0x10,
+	0x53, 0x4e, 0x9e, 0x84, 0xd6, 0x1f, 0xc8, 0xa0, 0x9f, 0xd0, 0xe0, 0x21, 0x88, 0xbc, 0x38, 0x2d,
+	0xc9, 0xfe, 0x6f, 0x59, 0x25, 0xe9, 0x8e, 0xe6, 0x8c, 0xec, 0x49, 0xca, 0x4b, 0xd9, 0x2a, 0xa0,
+	0x37, 0x3b, 0x8c, 0xac, 0x73, 0x9a, 0x51, 0xe6, 0x25, 0xe8, 0x2f, 0x68, 0xc2, 0xc3, 0x54, 0x86,
+	0xca, 0xa8, 0x3b, 0xfe, 0x66, 0x57, 0x9b, 0x6c, 0x41, 0xe2, 0x52, 0x47, 0x97, 0xa0, 0x4b, 0x76,
+	0xcc, 0x54, 0x87, 0xcd, 0x51, 0x77, 0xfc, 0x43, 0xe6, 0xaf, 0x2a, 0x1d, 0xd7, 0x60, 0xeb, 0x55,
+	0x81, 0xde, 0xfc, 0x98, 0xe9, 0x96, 0x72, 0x82, 0x06, 0xf0, 0x45, 0xf8, 0x2e, 0x3c, 0x16, 0x89,
+	0xdd, 0x3a, 0xae, 0x1a, 0x68, 0x02, 0x1a, 0xa7, 0x59, 0x1c, 0x98, 0xea, 0x50, 0x19, 0x7d, 0x1d,
+	0xff, 0x91, 0xb7, 0xd4, 0x7c, 0xec, 0xed, 0x01, 0xc3, 0x25, 0x6d, 0xfd, 0x03, 0x4d, 0xd4, 0x48,
+	0x87, 0xce, 0x1a, 0xbb, 0x6b, 0x77, 0x33, 0x5d, 0x1a, 0x0d, 0xd4, 0x81, 0xd6, 0xd2, 0x9d, 0xdf,
+	0x18, 0x0a, 0x02, 0x68, 0xcf, 0xdd, 0xd5, 0xea, 0x7a, 0x6b, 0xa8, 0xd6, 0x8b, 0x0a, 0xc6, 0x87,
+	0xd7, 0x8a, 0x30, 0xe6, 0x85, 0x04, 0x7d, 0x87, 0x76, 0x44, 0xe2, 0x30, 0xe2, 0xe2, 0x4d, 0x2d,
+	0xfc, 0x5e, 0xa1, 0x0b, 0xe8, 0x4a, 0x81, 0xc4, 0xb3, 0x3e, 0x09, 0x2f, 0xb3, 0xc8, 0x02, 0xdd,
+	0x4f, 0xd8, 0x26, 0x0e, 0x53, 0x8f, 0x17, 0x39, 0x31, 0x9b, 0x22, 0x6c, 0xad, 0x87, 0xa6, 0xd0,
+	0xf3, 0xe5, 0xb3, 0x98, 0x3b, 0xb1, 0xe0, 0xe7, 0xc9, 0x35, 0x8e, 0xc0, 0xa2, 0x81, 0xeb, 0x13,
+	0xc8, 0x81, 0xd6, 0x23, 0xe5, 0xc4, 0x24, 0xa7, 0x93, 0xb5, 0x2f, 0xb6, 0x68, 0x60, 0x01, 0xce,
+	0x34, 0x68, 0xee, 0x59, 0x38, 0x3b, 0xbf, 0x9b, 0x84, 0x31, 0x8f, 0x0a, 0xdf, 0x0e, 0xe8, 0xde,
+	0x11, 0x53, 0x59, 0x4e, 0xef, 0x49, 0xc0, 0xcb, 0xe2, 0x7f, 0xf9, 0x43, 0x85, 0x34, 0xf1, 0xd2,
+	0xd0, 0xa9, 0x5c, 0xfd, 0xb6, 0x10, 0xce, 0xde, 0x06, 0x00, 0x2e, 0x25, 0xde, 0x6f, 0xb9, 0x02,
+	0x00, 0x00,
+}
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/endorsement.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Endorsement struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Endorser             []byte               `protobuf:"bytes,2,opt,name=endorser,proto3" json:"endorser,omitempty"`
+	Signature            []byte               `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Endorsement) Reset()         { *m = Endorsement{} }
+func (m *Endorsement) String() string { return proto.CompactTextString(m) }
+func (*Endorsement) ProtoMessage()    {}
+func (*Endorsement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f7e62a7e1c60498, []int{0}
+}
+
+func (m *Endorsement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Endorsement.Unmarshal(m, b)
+}
+func (m *Endorsement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Endorsement.Marshal(b, m, deterministic)
+}
+func (m *Endorsement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Endorsement.Merge(m, src)
+}
+func (m *Endorsement) XXX_Size() int {
+	return xxx_messageInfo_Endorsement.Size(m)
+}
+func (m *Endorsement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Endorsement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Endorsement proto.InternalMessageInfo
+
+func (m *Endorsement) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Endorsement) GetEndorser() []byte {
+	if m != nil {
+		return m.Endorser
+	}
+	return nil
+}
+
+func (m *Endorsement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// BLS signature aggregated from the signatures of a set of endorsers, signers is the bitmap of the endorsers in the
+// ordered list of endorsers, e.g., the delegates of an epoch
+type AggregateEndorsement struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signers              []byte               `protobuf:"bytes,2,opt,name=signers,proto3" json:"signers,omitempty"`
+	Signature            []byte               `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AggregateEndorsement) Reset()         { *m = AggregateEndorsement{} }
+func (m *AggregateEndorsement) String() string { return proto.CompactTextString(m) }
+func (*AggregateEndorsement) ProtoMessage()    {}
+func (*AggregateEndorsement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f7e62a7e1c60498, []int{1}
+}
+
+func (m *AggregateEndorsement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateEndorsement.Unmarshal(m, b)
+}
+func (m *AggregateEndorsement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateEndorsement.Marshal(b, m, deterministic)
+}
+func (m *AggregateEndorsement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateEndorsement.Merge(m, src)
+}
+func (m *AggregateEndorsement) XXX_Size() int {
+	return xxx_messageInfo_AggregateEndorsement.Size(m)
+}
+func (m *AggregateEndorsement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateEndorsement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateEndorsement proto.InternalMessageInfo
+
+func (m *AggregateEndorsement) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AggregateEndorsement) GetSigners() []byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *AggregateEndorsement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Endorsement)(nil), "iotextypes.Endorsement")
+	proto.RegisterType((*AggregateEndorsement)(nil), "iotextypes.AggregateEndorsement")
+}
+
+func init() { proto.RegisterFile("proto/types/endorsement.proto", fileDescriptor_8f7e62a7e1c60498) }
+
+var fileDescriptor_8f7e62a7e1c60498 = []byte{
+	// 225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x90, 0x3f, 0x4b, 0x04, 0x31,
+	0x10, 0xc5, 0x89, 0x82, 0x7a, 0x73, 0x56, 0x8b, 0xc5, 0xb2, 0x28, 0x1e, 0x57, 0x5d, 0x63, 0x02,
+	0x8a, 0x68, 0xab, 0xe0, 0x17, 0x38, 0xac, 0xec, 0x76, 0xcf, 0x71, 0x5c, 0xb9, 0x64, 0x42, 0x32,
+	0x0b, 0x5a, 0xda, 0xf9, 0xb1, 0xc5, 0xe4, 0x76, 0xd3, 0x59, 0x5d, 0xf9, 0xfe, 0xf0, 0xf8, 0xf1,
+	0xe0, 0xc2, 0x07, 0x16, 0x36, 0xf2, 0xe5, 0x31, 0x1a, 0x74, 0xaf, 0x1c, 0x22, 0x5a, 0x74, 0xa2,
+	0x93, 0x5f, 0x41, 0xcf, 0x82, 0x9f, 0x29, 0x6d, 0x2e, 0x89, 0x99, 0xb6, 0x68, 0x52, 0xd2, 0x0d,
+	0x6f, 0x46, 0x7a, 0x8b, 0x51, 0x5a, 0xeb, 0x73, 0x79, 0xf9, 0xad, 0x60, 0xfe, 0x54, 0x26, 0xaa,
+	0x7b, 0x98, 0x4d, 0x95, 0x5a, 0x2d, 0xd4, 0x6a, 0x7e, 0xdd, 0xe8, 0x3c, 0xa2, 0xc7, 0x11, 0xfd,
+	0x3c, 0x36, 0xd6, 0xa5, 0x5c, 0x35, 0x70, 0xb2, 0x63, 0x09, 0xf5, 0xc1, 0x42, 0xad, 0x4e, 0xd7,
+	0x93, 0xae, 0xce, 0x61, 0x16, 0x7b, 0x72, 0xad, 0x0c, 0x01, 0xeb, 0xc3, 0x14, 0x16, 0x63, 0xf9,
+	0xa3, 0xe0, 0xec, 0x81, 0x28, 0x20, 0xb5, 0x82, 0xfb, 0x81, 0xa9, 0xe1, 0xf8, 0x6f, 0x1f, 0x43,
+	0xdc, 0xb1, 0x8c, 0xf2, 0x7f, 0x94, 0xc7, 0xbb, 0x97, 0x5b, 0xea, 0xe5, 0x7d, 0xe8, 0xf4, 0x86,
+	0xad, 0x49, 0x47, 0xfa, 0xc0, 0x1f, 0xb8, 0x91, 0x2c, 0xae, 0xf2, 0xf5, 0xc4, 0xdb, 0xd6, 0x91,
+	0x29, 0x47, 0x77, 0x47, 0x29, 0xb8, 0xf9, 0x1d, 0x00, 0xe7, 0x0c, 0x52, 0x85, 0x9c, 0x01, 0x00,
+	0x00,
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/receiptstatus.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// BELOW ARE DEFINITIONS FOR EVM ERROR CLASSIFICATION IN RECEIPT STATUS
+type ReceiptStatus int32
+
+const (
+	ReceiptStatus_Failure ReceiptStatus = 0
+	ReceiptStatus_Success ReceiptStatus = 1
+	//1xx for EVM ErrorCode
+	ReceiptStatus_ErrUnknown                  ReceiptStatus = 100
+	ReceiptStatus_ErrOutOfGas                 ReceiptStatus = 101
+	ReceiptStatus_ErrCodeStoreOutOfGas        ReceiptStatus = 102
+	ReceiptStatus_ErrDepth                    ReceiptStatus = 103
+	ReceiptStatus_ErrContractAddressCollision ReceiptStatus = 104
+	ReceiptStatus_ErrNoCompatibleInterpreter  ReceiptStatus = 105
+	ReceiptStatus_ErrExecutionReverted        ReceiptStatus = 106
+	ReceiptStatus_ErrMaxCodeSizeExceeded      ReceiptStatus = 107
+	ReceiptStatus_ErrWriteProtection          ReceiptStatus = 108
+	//2xx for Staking ErrorCode
+	ReceiptStatus_ErrLoadAccount            ReceiptStatus = 200
+	ReceiptStatus_ErrNotEnoughBalance       ReceiptStatus = 201
+	ReceiptStatus_ErrInvalidBucketIndex     ReceiptStatus = 202
+	ReceiptStatus_ErrUnauthorizedOperator   ReceiptStatus = 203
+	ReceiptStatus_ErrInvalidBucketType      ReceiptStatus = 204
+	ReceiptStatus_ErrCandidateNotExist      ReceiptStatus = 205
+	ReceiptStatus_ErrWithdrawBeforeUnstake  ReceiptStatus = 206
+	ReceiptStatus_ErrWithdrawBeforeMaturity ReceiptStatus = 207
+)
+
+var ReceiptStatus_name = map[int32]string{
+	0:   "Failure",
+	1:   "Success",
+	100: "ErrUnknown",
+	101: "ErrOutOfGas",
+	102: "ErrCodeStoreOutOfGas",
+	103: "ErrDepth",
+	104: "ErrContractAddressCollision",
+	105: "ErrNoCompatibleInterpreter",
+	106: "ErrExecutionReverted",
+	107: "ErrMaxCodeSizeExceeded",
+	108: "ErrWriteProtection",
+	200: "ErrLoadAccount",
+	201: "ErrNotEnoughBalance",
+	202: "ErrInvalidBucketIndex",
+	203: "ErrUnauthorizedOperator",
+	204: "ErrInvalidBucketType",
+	205: "ErrCandidateNotExist",
+	206: "ErrWithdrawBeforeUnstake",
+	207: "ErrWithdrawBeforeMaturity",
+}
+
+var ReceiptStatus_value = map[string]int32{
+	"Failure":                     0,
+	"Success":                     1,
+	"ErrUnknown":                  100,
+	"ErrOutOfGas":                 101,
+	"ErrCodeStoreOutOfGas":        102,
+	"ErrDepth":                    103,
+	"ErrContractAddressCollision": 104,
+	"ErrNoCompatibleInterpreter":  105,
+	"ErrExecutionReverted":        106,
+	"ErrMaxCodeSizeExceeded":      107,
+	"ErrWriteProtection":          108,
+	"ErrLoadAccount":              200,
+	"ErrNotEnoughBalance":         201,
+	"ErrInvalidBucketIndex":       202,
+	"ErrUnauthorizedOperator":     203,
+	"ErrInvalidBucketType":        204,
+	"ErrCandidateNotExist":        205,
+	"ErrWithdrawBeforeUnstake":    206,
+	"ErrWithdrawBeforeMaturity":   207,
+}
+
+func (x ReceiptStatus) String() string {
+	return proto.EnumName(ReceiptStatus_name, int32(x))
+}
+
+func (ReceiptStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b91d1deedbe7f532, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("iotextypes.ReceiptStatus", ReceiptStatus_name, ReceiptStatus_value)
+}
+
+func init() { proto.RegisterFile("proto/types/receiptstatus.proto", fileDescriptor_b91d1deedbe7f532) }
+
+var fileDescriptor_b91d1deedbe7f532 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x5d, 0x6b, 0x53, 0x41,
+	0x10, 0x86, 0x3d, 0x08, 0x2a, 0x53, 0xad, 0xcb, 0x56, 0x6b, 0x1b, 0xb5, 0xbd, 0x17, 0x6c, 0x2e,
+	0x44, 0xbc, 0x6e, 0xe2, 0x2a, 0x01, 0x6b, 0xa4, 0xb1, 0x08, 0xde, 0x6d, 0x76, 0x27, 0x39, 0xdb,
+	0x9c, 0xee, 0x1c, 0x66, 0x67, 0xdb, 0x93, 0xfe, 0x42, 0xbf, 0xfd, 0x09, 0xfe, 0x15, 0xc9, 0xc6,
+	0x0f, 0xc4, 0xcb, 0xe1, 0x79, 0x87, 0x79, 0xd8, 0x7d, 0x61, 0xbf, 0x65, 0x12, 0xea, 0xcb, 0xb2,
+	0xc5, 0xd4, 0x67, 0x74, 0x18, 0x5a, 0x49, 0x62, 0x25, 0xa7, 0x83, 0x42, 0x34, 0x04, 0x12, 0xec,
+	0x0a, 0x7f, 0xf4, 0xe3, 0x2a, 0xdc, 0x3a, 0x5e, 0x67, 0x26, 0x25, 0xa3, 0x37, 0xe0, 0xfa, 0x0b,
+	0x1b, 0x9a, 0xcc, 0xa8, 0xae, 0xac, 0x86, 0x49, 0x76, 0x0e, 0x53, 0x52, 0x95, 0xde, 0x04, 0x30,
+	0xcc, 0x27, 0x71, 0x11, 0xe9, 0x22, 0x2a, 0xaf, 0x6f, 0xc3, 0x86, 0x61, 0x1e, 0x67, 0x19, 0xcf,
+	0x5e, 0xda, 0xa4, 0x50, 0xef, 0xc0, 0x1d, 0xc3, 0x3c, 0x24, 0x8f, 0x13, 0x21, 0xc6, 0x3f, 0x64,
+	0xa6, 0x6f, 0xc2, 0x0d, 0xc3, 0xfc, 0x1c, 0x5b, 0xa9, 0xd5, 0x5c, 0xef, 0xc3, 0xfd, 0x92, 0x8b,
+	0xc2, 0xd6, 0xc9, 0xa1, 0xf7, 0x8c, 0x29, 0x0d, 0xa9, 0x69, 0x42, 0x0a, 0x14, 0x55, 0xad, 0xf7,
+	0xa0, 0x67, 0x98, 0x5f, 0xd3, 0x90, 0xce, 0x5a, 0x2b, 0x61, 0xda, 0xe0, 0x28, 0x0a, 0x72, 0xcb,
+	0x28, 0xc8, 0x2a, 0xfc, 0x3a, 0x64, 0x3a, 0x74, 0x59, 0x02, 0xc5, 0x63, 0x3c, 0x47, 0x16, 0xf4,
+	0xea, 0x54, 0xf7, 0x60, 0xdb, 0x30, 0x1f, 0xd9, 0xae, 0x58, 0x84, 0x4b, 0x34, 0x9d, 0x43, 0xf4,
+	0xe8, 0xd5, 0x42, 0x6f, 0x83, 0x36, 0xcc, 0xef, 0x38, 0x08, 0xbe, 0x61, 0x12, 0x74, 0xab, 0x5d,
+	0xd5, 0xe8, 0x2d, 0xd8, 0x34, 0xcc, 0xaf, 0xc8, 0xfa, 0x43, 0xe7, 0x28, 0x47, 0x51, 0x1f, 0x2a,
+	0xbd, 0x03, 0x5b, 0x45, 0x41, 0x4c, 0xa4, 0x3c, 0xaf, 0x07, 0xb6, 0xb1, 0xd1, 0xa1, 0xfa, 0x58,
+	0xe9, 0x1e, 0xdc, 0x35, 0xcc, 0xa3, 0x78, 0x6e, 0x9b, 0xe0, 0x07, 0xd9, 0x2d, 0x50, 0x46, 0xd1,
+	0x63, 0xa7, 0x3e, 0x55, 0xfa, 0x01, 0xdc, 0x2b, 0x4f, 0x64, 0xb3, 0xd4, 0xc4, 0xe1, 0x12, 0xfd,
+	0xb8, 0x45, 0xb6, 0x42, 0xac, 0x3e, 0x57, 0x7a, 0xb7, 0x68, 0xff, 0xb3, 0xf9, 0x76, 0xd9, 0xa2,
+	0xfa, 0xf2, 0x1b, 0x0d, 0x6d, 0xf4, 0xc1, 0x5b, 0xc1, 0xd5, 0xdd, 0x2e, 0x24, 0x51, 0x5f, 0x2b,
+	0xfd, 0x10, 0x76, 0x56, 0xda, 0x41, 0x6a, 0xcf, 0xf6, 0x62, 0x80, 0x33, 0x62, 0x3c, 0x89, 0x49,
+	0xec, 0x02, 0xd5, 0xb7, 0x4a, 0xef, 0xc1, 0xee, 0x7f, 0xf8, 0xc8, 0x4a, 0xe6, 0x20, 0x4b, 0xf5,
+	0xbd, 0x1a, 0x3c, 0x7b, 0xff, 0x74, 0x1e, 0xa4, 0xce, 0xd3, 0x03, 0x47, 0x67, 0xfd, 0xf2, 0xf5,
+	0x2d, 0xd3, 0x29, 0x3a, 0x59, 0x0f, 0x8f, 0xd7, 0x75, 0x99, 0x53, 0x63, 0xe3, 0xbc, 0xff, 0xb7,
+	0x1a, 0xd3, 0x6b, 0x05, 0x3c, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0x82, 0x2d, 0x80, 0xb8, 0x50,
+	0x02, 0x00, 0x00,
+}
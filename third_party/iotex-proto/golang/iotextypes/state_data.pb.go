@@ -0,0 +1,435 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/state_data.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProbationCandidateList (slashing #1)
+type ProbationCandidateList struct {
+	ProbationList        []*ProbationCandidateList_Info `protobuf:"bytes,1,rep,name=probationList,proto3" json:"probationList,omitempty"`
+	IntensityRate        uint32                         `protobuf:"varint,2,opt,name=intensityRate,proto3" json:"intensityRate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ProbationCandidateList) Reset()         { *m = ProbationCandidateList{} }
+func (m *ProbationCandidateList) String() string { return proto.CompactTextString(m) }
+func (*ProbationCandidateList) ProtoMessage()    {}
+func (*ProbationCandidateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d0bfa2d89671, []int{0}
+}
+
+func (m *ProbationCandidateList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbationCandidateList.Unmarshal(m, b)
+}
+func (m *ProbationCandidateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbationCandidateList.Marshal(b, m, deterministic)
+}
+func (m *ProbationCandidateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbationCandidateList.Merge(m, src)
+}
+func (m *ProbationCandidateList) XXX_Size() int {
+	return xxx_messageInfo_ProbationCandidateList.Size(m)
+}
+func (m *ProbationCandidateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbationCandidateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbationCandidateList proto.InternalMessageInfo
+
+func (m *ProbationCandidateList) GetProbationList() []*ProbationCandidateList_Info {
+	if m != nil {
+		return m.ProbationList
+	}
+	return nil
+}
+
+func (m *ProbationCandidateList) GetIntensityRate() uint32 {
+	if m != nil {
+		return m.IntensityRate
+	}
+	return 0
+}
+
+type ProbationCandidateList_Info struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbationCandidateList_Info) Reset()         { *m = ProbationCandidateList_Info{} }
+func (m *ProbationCandidateList_Info) String() string { return proto.CompactTextString(m) }
+func (*ProbationCandidateList_Info) ProtoMessage()    {}
+func (*ProbationCandidateList_Info) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d0bfa2d89671, []int{0, 0}
+}
+
+func (m *ProbationCandidateList_Info) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbationCandidateList_Info.Unmarshal(m, b)
+}
+func (m *ProbationCandidateList_Info) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbationCandidateList_Info.Marshal(b, m, deterministic)
+}
+func (m *ProbationCandidateList_Info) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbationCandidateList_Info.Merge(m, src)
+}
+func (m *ProbationCandidateList_Info) XXX_Size() int {
+	return xxx_messageInfo_ProbationCandidateList_Info.Size(m)
+}
+func (m *ProbationCandidateList_Info) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbationCandidateList_Info.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbationCandidateList_Info proto.InternalMessageInfo
+
+func (m *ProbationCandidateList_Info) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProbationCandidateList_Info) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type VoteBucket struct {
+	Index                uint64               `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	CandidateAddress     string               `protobuf:"bytes,2,opt,name=candidateAddress,proto3" json:"candidateAddress,omitempty"`
+	StakedAmount         string               `protobuf:"bytes,3,opt,name=stakedAmount,proto3" json:"stakedAmount,omitempty"`
+	StakedDuration       uint32               `protobuf:"varint,4,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	StakeStartTime       *timestamp.Timestamp `protobuf:"bytes,6,opt,name=stakeStartTime,proto3" json:"stakeStartTime,omitempty"`
+	UnstakeStartTime     *timestamp.Timestamp `protobuf:"bytes,7,opt,name=unstakeStartTime,proto3" json:"unstakeStartTime,omitempty"`
+	AutoStake            bool                 `protobuf:"varint,8,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	Owner                string               `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VoteBucket) Reset()         { *m = VoteBucket{} }
+func (m *VoteBucket) String() string { return proto.CompactTextString(m) }
+func (*VoteBucket) ProtoMessage()    {}
+func (*VoteBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d0bfa2d89671, []int{1}
+}
+
+func (m *VoteBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteBucket.Unmarshal(m, b)
+}
+func (m *VoteBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteBucket.Marshal(b, m, deterministic)
+}
+func (m *VoteBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteBucket.Merge(m, src)
+}
+func (m *VoteBucket) XXX_Size() int {
+	return xxx_messageInfo_VoteBucket.Size(m)
+}
+func (m *VoteBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteBucket proto.InternalMessageInfo
+
+func (m *VoteBucket) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *VoteBucket) GetCandidateAddress() string {
+	if m != nil {
+		return m.CandidateAddress
+	}
+	return ""
+}
+
+func (m *VoteBucket) GetStakedAmount() string {
+	if m != nil {
+		return m.StakedAmount
+	}
+	return ""
+}
+
+func (m *VoteBucket) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *VoteBucket) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *VoteBucket) GetStakeStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StakeStartTime
+	}
+	return nil
+}
+
+func (m *VoteBucket) GetUnstakeStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UnstakeStartTime
+	}
+	return nil
+}
+
+func (m *VoteBucket) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *VoteBucket) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type VoteBucketList struct {
+	Buckets              []*VoteBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VoteBucketList) Reset()         { *m = VoteBucketList{} }
+func (m *VoteBucketList) String() string { return proto.CompactTextString(m) }
+func (*VoteBucketList) ProtoMessage()    {}
+func (*VoteBucketList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d0bfa2d89671, []int{2}
+}
+
+func (m *VoteBucketList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteBucketList.Unmarshal(m, b)
+}
+func (m *VoteBucketList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteBucketList.Marshal(b, m, deterministic)
+}
+func (m *VoteBucketList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteBucketList.Merge(m, src)
+}
+func (m *VoteBucketList) XXX_Size() int {
+	return xxx_messageInfo_VoteBucketList.Size(m)
+}
+func (m *VoteBucketList) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteBucketList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteBucketList proto.InternalMessageInfo
+
+func (m *VoteBucketList) GetBuckets() []*VoteBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type CandidateV2 struct {
+	OwnerAddress         string   `protobuf:"bytes,1,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
+	OperatorAddress      string   `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	TotalWeightedVotes   string   `protobuf:"bytes,5,opt,name=totalWeightedVotes,proto3" json:"totalWeightedVotes,omitempty"`
+	SelfStakeBucketIdx   uint64   `protobuf:"varint,6,opt,name=selfStakeBucketIdx,proto3" json:"selfStakeBucketIdx,omitempty"`
+	SelfStakingTokens    string   `protobuf:"bytes,7,opt,name=selfStakingTokens,proto3" json:"selfStakingTokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateV2) Reset()         { *m = CandidateV2{} }
+func (m *CandidateV2) String() string { return proto.CompactTextString(m) }
+func (*CandidateV2) ProtoMessage()    {}
+func (*CandidateV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d0bfa2d89671, []int{3}
+}
+
+func (m *CandidateV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateV2.Unmarshal(m, b)
+}
+func (m *CandidateV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateV2.Marshal(b, m, deterministic)
+}
+func (m *CandidateV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateV2.Merge(m, src)
+}
+func (m *CandidateV2) XXX_Size() int {
+	return xxx_messageInfo_CandidateV2.Size(m)
+}
+func (m *CandidateV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateV2 proto.InternalMessageInfo
+
+func (m *CandidateV2) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *CandidateV2) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *CandidateV2) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *CandidateV2) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CandidateV2) GetTotalWeightedVotes() string {
+	if m != nil {
+		return m.TotalWeightedVotes
+	}
+	return ""
+}
+
+func (m *CandidateV2) GetSelfStakeBucketIdx() uint64 {
+	if m != nil {
+		return m.SelfStakeBucketIdx
+	}
+	return 0
+}
+
+func (m *CandidateV2) GetSelfStakingTokens() string {
+	if m != nil {
+		return m.SelfStakingTokens
+	}
+	return ""
+}
+
+type CandidateListV2 struct {
+	Candidates           []*CandidateV2 `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CandidateListV2) Reset()         { *m = CandidateListV2{} }
+func (m *CandidateListV2) String() string { return proto.CompactTextString(m) }
+func (*CandidateListV2) ProtoMessage()    {}
+func (*CandidateListV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d0bfa2d89671, []int{4}
+}
+
+func (m *CandidateListV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateListV2.Unmarshal(m, b)
+}
+func (m *CandidateListV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateListV2.Marshal(b, m, deterministic)
+}
+func (m *CandidateListV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateListV2.Merge(m, src)
+}
+func (m *CandidateListV2) XXX_Size() int {
+	return xxx_messageInfo_CandidateListV2.Size(m)
+}
+func (m *CandidateListV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateListV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateListV2 proto.InternalMessageInfo
+
+func (m *CandidateListV2) GetCandidates() []*CandidateV2 {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProbationCandidateList)(nil), "iotextypes.ProbationCandidateList")
+	proto.RegisterType((*ProbationCandidateList_Info)(nil), "iotextypes.ProbationCandidateList.Info")
+	proto.RegisterType((*VoteBucket)(nil), "iotextypes.VoteBucket")
+	proto.RegisterType((*VoteBucketList)(nil), "iotextypes.VoteBucketList")
+	proto.RegisterType((*CandidateV2)(nil), "iotextypes.CandidateV2")
+	proto.RegisterType((*CandidateListV2)(nil), "iotextypes.CandidateListV2")
+}
+
+func init() { proto.RegisterFile("proto/types/state_data.proto", fileDescriptor_96e4d0bfa2d89671) }
+
+var fileDescriptor_96e4d0bfa2d89671 = []byte{
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xef, 0x6a, 0xd4, 0x4e,
+	0x14, 0x25, 0xed, 0xf6, 0x4f, 0x6e, 0x7f, 0xfd, 0xf3, 0x1b, 0xa4, 0x86, 0x52, 0x70, 0x09, 0xa2,
+	0x41, 0x34, 0x91, 0x15, 0x2d, 0xf8, 0xad, 0xab, 0x08, 0x15, 0x05, 0x99, 0x96, 0x0a, 0x7e, 0x91,
+	0xd9, 0xe4, 0x36, 0x1d, 0xbb, 0x99, 0x09, 0x33, 0x37, 0x74, 0xfb, 0x22, 0xbe, 0x80, 0xcf, 0xe2,
+	0x7b, 0x49, 0x26, 0x9b, 0xee, 0x66, 0x77, 0xa1, 0xdf, 0xf6, 0x9e, 0x7b, 0xce, 0x9d, 0x99, 0x7b,
+	0xce, 0x06, 0x8e, 0x4b, 0xa3, 0x49, 0x27, 0x74, 0x57, 0xa2, 0x4d, 0x2c, 0x09, 0xc2, 0x9f, 0x99,
+	0x20, 0x11, 0x3b, 0x98, 0x81, 0xd4, 0x84, 0x13, 0xd7, 0x3c, 0x7a, 0x92, 0x6b, 0x9d, 0x8f, 0x31,
+	0x71, 0x9d, 0x51, 0x75, 0x95, 0x90, 0x2c, 0xd0, 0x92, 0x28, 0xca, 0x86, 0x1c, 0xfe, 0xf5, 0xe0,
+	0xf0, 0x9b, 0xd1, 0x23, 0x41, 0x52, 0xab, 0x0f, 0x42, 0x65, 0x32, 0x13, 0x84, 0x5f, 0xa4, 0x25,
+	0xf6, 0x15, 0x76, 0xcb, 0xb6, 0x53, 0x03, 0x81, 0xd7, 0x5f, 0x8f, 0x76, 0x06, 0xcf, 0xe3, 0xd9,
+	0xfc, 0x78, 0xb5, 0x34, 0x3e, 0x53, 0x57, 0x9a, 0x77, 0xd5, 0xec, 0x29, 0xec, 0x4a, 0x45, 0xa8,
+	0xac, 0xa4, 0x3b, 0x2e, 0x08, 0x83, 0xb5, 0xbe, 0x17, 0xed, 0xf2, 0x2e, 0x78, 0xf4, 0x0e, 0x7a,
+	0xb5, 0x98, 0x05, 0xb0, 0x25, 0xb2, 0xcc, 0xa0, 0xb5, 0x81, 0xd7, 0xf7, 0x22, 0x9f, 0xb7, 0x25,
+	0x7b, 0x04, 0x1b, 0xa9, 0xae, 0x14, 0x4d, 0xf5, 0x4d, 0x11, 0xfe, 0x5e, 0x07, 0xb8, 0xd4, 0x84,
+	0xc3, 0x2a, 0xbd, 0x41, 0xaa, 0x49, 0x52, 0x65, 0x38, 0x71, 0xe2, 0x1e, 0x6f, 0x0a, 0xf6, 0x02,
+	0x0e, 0xd2, 0xf6, 0x9e, 0xa7, 0xd3, 0xe9, 0x6b, 0x6e, 0xfa, 0x12, 0xce, 0x42, 0xf8, 0xcf, 0x92,
+	0xb8, 0xc1, 0xec, 0xb4, 0x70, 0xa7, 0xad, 0x3b, 0x5e, 0x07, 0x63, 0xcf, 0x60, 0xaf, 0xa9, 0x3f,
+	0x56, 0xc6, 0x3d, 0x34, 0xe8, 0xb9, 0x3b, 0x2d, 0xa0, 0xec, 0x3d, 0x40, 0x6a, 0x50, 0x10, 0x5e,
+	0xc8, 0x02, 0x83, 0x8d, 0xbe, 0x17, 0xed, 0x0c, 0x8e, 0xe2, 0xc6, 0x9a, 0xb8, 0xb5, 0x26, 0xbe,
+	0x68, 0xad, 0xe1, 0x73, 0x6c, 0x36, 0x9c, 0x9e, 0x71, 0x4e, 0xc2, 0x90, 0xd3, 0x6f, 0x3e, 0xa8,
+	0x5f, 0x50, 0xb0, 0x4f, 0x70, 0x50, 0xa9, 0x85, 0x29, 0x5b, 0x0f, 0x4e, 0x59, 0xd2, 0xb0, 0x63,
+	0xf0, 0x45, 0x45, 0xfa, 0xbc, 0x46, 0x83, 0xed, 0xbe, 0x17, 0x6d, 0xf3, 0x19, 0x50, 0xef, 0x5c,
+	0xdf, 0x2a, 0x34, 0x81, 0xef, 0x56, 0xd5, 0x14, 0xe1, 0x10, 0xf6, 0x66, 0xbe, 0xb8, 0x20, 0xbc,
+	0x86, 0xad, 0x91, 0xab, 0xec, 0x34, 0x51, 0x87, 0xf3, 0x89, 0x9a, 0x91, 0x79, 0x4b, 0x0b, 0xff,
+	0xac, 0xc1, 0xce, 0x7d, 0xc0, 0x2e, 0x07, 0xb5, 0x37, 0x6e, 0xf8, 0x69, 0x27, 0x21, 0x1d, 0x8c,
+	0x45, 0xb0, 0xaf, 0x4b, 0x34, 0x82, 0xb4, 0xe9, 0x5a, 0xbd, 0x08, 0xd7, 0xc1, 0x34, 0x78, 0x2b,
+	0x4c, 0xd6, 0xf2, 0x1a, 0xab, 0xbb, 0x20, 0x63, 0xd0, 0x53, 0xa2, 0x40, 0xe7, 0xb0, 0xcf, 0xdd,
+	0x6f, 0x16, 0x03, 0x23, 0x4d, 0x62, 0xfc, 0x1d, 0x65, 0x7e, 0x4d, 0x98, 0xd5, 0x77, 0xb7, 0xce,
+	0x5f, 0x9f, 0xaf, 0xe8, 0xd4, 0x7c, 0x8b, 0xe3, 0x2b, 0xb7, 0xae, 0xe6, 0x8d, 0x67, 0xd9, 0xc4,
+	0xf9, 0xd9, 0xe3, 0x2b, 0x3a, 0xec, 0x25, 0xfc, 0xdf, 0xa2, 0x52, 0xe5, 0x17, 0xfa, 0x06, 0x95,
+	0x75, 0xc6, 0xf9, 0x7c, 0xb9, 0x11, 0x7e, 0x86, 0xfd, 0xce, 0xbf, 0xf0, 0x72, 0xc0, 0x4e, 0x00,
+	0xee, 0x83, 0xdd, 0x6e, 0xfb, 0xf1, 0xfc, 0xb6, 0xe7, 0xb6, 0xca, 0xe7, 0xa8, 0xc3, 0x93, 0x1f,
+	0x6f, 0x73, 0x49, 0xd7, 0xd5, 0x28, 0x4e, 0x75, 0x91, 0x38, 0x41, 0x69, 0xf4, 0x2f, 0x4c, 0xa9,
+	0x29, 0x5e, 0x35, 0x5f, 0xa0, 0x5c, 0x8f, 0x85, 0xca, 0x93, 0xd9, 0xc0, 0xd1, 0xa6, 0x6b, 0xbc,
+	0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0x46, 0x5e, 0x64, 0x92, 0xa3, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/progressbar/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/compress"
+	"github.com/iotexproject/iotex-core/tools/iomigrater/common"
+)
+
+// dictSize is the size of the dictionary trained, which is the window size of deflate
+const dictSize = 32 << 10
+
+// Multi-language support
+var (
+	recompressCmdShorts = map[string]string{
+		"english": "Sub-Command for recompressing the blocks in IoTeX blockchain db files.",
+		"chinese": "重新压缩IoTeX区块链 db 文件中区块的子命令",
+	}
+	recompressCmdLongs = map[string]string{
+		"english": "Sub-Command for recompressing the blocks in IoTeX blockchain db file and its split files with the codec, " +
+			"and optionally training the dictionary of the deflateDict codec from the latest blocks.",
+		"chinese": "使用指定编码重新压缩IoTeX区块链 db 文件及其分片文件中区块的子命令，并可以用最新的区块训练 deflateDict 编码的字典",
+	}
+	recompressCmdUse = map[string]string{
+		"english": "recompress",
+		"chinese": "recompress",
+	}
+	recompressFlagDbFileUse = map[string]string{
+		"english": "The db file you want to recompress.",
+		"chinese": "您要重新压缩的 db 文件。",
+	}
+	recompressFlagCodecUse = map[string]string{
+		"english": "The codec to compress the blocks with, could be none, gzip, snappy or deflateDict.",
+		"chinese": "压缩区块的编码，可以是 none、gzip、snappy 或 deflateDict。",
+	}
+	recompressFlagDictFileUse = map[string]string{
+		"english": "The dictionary file of the deflateDict codec.",
+		"chinese": "deflateDict 编码的字典文件。",
+	}
+	recompressFlagTrainSamplesUse = map[string]string{
+		"english": "The number of the latest blocks to train the dictionary into the dictionary file, 0 means no training.",
+		"chinese": "用于训练字典并写入字典文件的最新区块数量，0 表示不训练。",
+	}
+	recompressFlagSplitDBSizeMBUse = map[string]string{
+		"english": "The split db size in MB the db file is split with, 0 means the db file is not split.",
+		"chinese": "db 文件分片的大小（MB），0 表示 db 文件没有分片。",
+	}
+	recompressFlagSplitDBHeightUse = map[string]string{
+		"english": "The height the db file is split from.",
+		"chinese": "db 文件开始分片的高度。",
+	}
+	recompressFlagArchiveDirUse = map[string]string{
+		"english": "The cold storage directory of the archived split files, which are skipped.",
+		"chinese": "已归档的分片文件所在的冷存储目录，这些文件将被跳过。",
+	}
+)
+
+var (
+	// Recompress Used to Sub command.
+	Recompress = &cobra.Command{
+		Use:   common.TranslateInLang(recompressCmdUse),
+		Short: common.TranslateInLang(recompressCmdShorts),
+		Long:  common.TranslateInLang(recompressCmdLongs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return recompressDbFile()
+		},
+	}
+)
+
+var (
+	recompressFile = ""
+	codecName      = ""
+	dictFile       = ""
+	trainSamples   = uint64(0)
+	splitDBSizeMB  = uint64(0)
+	splitDBHeight  = uint64(0)
+	archiveDir     = ""
+)
+
+func init() {
+	Recompress.PersistentFlags().StringVarP(&recompressFile, "db-file", "d", "", common.TranslateInLang(recompressFlagDbFileUse))
+	Recompress.PersistentFlags().StringVarP(&codecName, "codec", "c", "", common.TranslateInLang(recompressFlagCodecUse))
+	Recompress.PersistentFlags().StringVarP(&dictFile, "dict-file", "f", "", common.TranslateInLang(recompressFlagDictFileUse))
+	Recompress.PersistentFlags().Uint64VarP(&trainSamples, "train-samples", "t", uint64(0), common.TranslateInLang(recompressFlagTrainSamplesUse))
+	Recompress.PersistentFlags().Uint64Var(&splitDBSizeMB, "split-db-size-mb", uint64(0), common.TranslateInLang(recompressFlagSplitDBSizeMBUse))
+	Recompress.PersistentFlags().Uint64Var(&splitDBHeight, "split-db-height", config.Default.DB.SplitDBHeight, common.TranslateInLang(recompressFlagSplitDBHeightUse))
+	Recompress.PersistentFlags().StringVar(&archiveDir, "archive-dir", "", common.TranslateInLang(recompressFlagArchiveDirUse))
+}
+
+func recompressDbFile() error {
+	// Check flags
+	if recompressFile == "" {
+		return fmt.Errorf("--db-file is empty")
+	}
+	if codecName == "" {
+		return fmt.Errorf("--codec is empty")
+	}
+	if trainSamples > 0 && (codecName != compress.DeflateDictName || dictFile == "") {
+		return fmt.Errorf("--train-samples requires the deflateDict codec and --dict-file")
+	}
+
+	cfg, err := config.New()
+	if err != nil {
+		return fmt.Errorf("Failed to new config: %v", err)
+	}
+	cfg.DB.DbPath = recompressFile
+	cfg.DB.SplitDBSizeMB = splitDBSizeMB
+	cfg.DB.SplitDBHeight = splitDBHeight
+	cfg.DB.ColdStorage.ArchiveDir = archiveDir
+	ctx := context.Background()
+
+	if trainSamples > 0 {
+		if err := trainDictionary(ctx, cfg.DB); err != nil {
+			return fmt.Errorf("Failed to train the dictionary: %v", err)
+		}
+	}
+	codec, err := compress.LoadCodec(codecName, dictFile)
+	if err != nil {
+		return fmt.Errorf("Failed to create the codec: %v", err)
+	}
+	dao := blockdao.NewBlockDAO(db.NewBoltDB(cfg.DB), nil, false, cfg.DB, blockdao.WithCodec(codec))
+	if err := dao.Start(ctx); err != nil {
+		return fmt.Errorf("Failed to start the db file: %v", err)
+	}
+	defer dao.Stop(ctx)
+	height, err := dao.Height()
+	if err != nil {
+		return err
+	}
+	if height == 0 {
+		return nil
+	}
+
+	// Show the progressbar
+	intHeight, step := getProgressMod(height)
+	bar := progressbar.New(intHeight)
+	archived := 0
+	for i := uint64(1); i <= height; i++ {
+		recompressed, err := blockdao.RecompressBlock(dao, i)
+		if err != nil {
+			return fmt.Errorf("Failed to recompress block on height %d: %v", i, err)
+		}
+		// the blocks in cold storage are read-only
+		if !recompressed {
+			archived++
+		}
+
+		if i%uint64(step) == 0 {
+			bar.Add(1)
+			intHeight--
+		}
+	}
+	if intHeight > 0 {
+		bar.Add(intHeight)
+	}
+	if archived > 0 {
+		fmt.Printf("\n%d blocks in cold storage are not recompressed.\n", archived)
+	}
+
+	return nil
+}
+
+// trainDictionary trains the dictionary from the latest blocks, and writes it into the dictionary file
+func trainDictionary(ctx context.Context, cfg config.DB) error {
+	dao := blockdao.NewBlockDAO(db.NewBoltDB(cfg), nil, false, cfg)
+	if err := dao.Start(ctx); err != nil {
+		return err
+	}
+	defer dao.Stop(ctx)
+	height, err := dao.Height()
+	if err != nil {
+		return err
+	}
+	var samples [][]byte
+	for i := uint64(0); i < trainSamples && i < height; i++ {
+		blk, err := dao.GetBlockByHeight(height - i)
+		if err != nil {
+			return err
+		}
+		header, err := blk.Header.Serialize()
+		if err != nil {
+			return err
+		}
+		body, err := blk.Body.Serialize()
+		if err != nil {
+			return err
+		}
+		samples = append(samples, header, body)
+	}
+	dict := compress.TrainDictionary(samples, dictSize)
+	if len(dict) == 0 {
+		return errors.New("no segment recurs in the samples")
+	}
+	return ioutil.WriteFile(dictFile, dict, 0600)
+}
@@ -48,6 +48,7 @@ func Execute() {
 func init() {
 	RootCmd.AddCommand(cmd.CheckHeight)
 	RootCmd.AddCommand(cmd.MigrateDb)
+	RootCmd.AddCommand(cmd.Recompress)
 
 	RootCmd.HelpFunc()
 }
@@ -0,0 +1,292 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// This is a what-if tool that recomputes the native staking candidate votes from a node's state db under
+// alternative vote weight constants, and shows how the delegate ranking would change. It works on a snapshot of the
+// state db, which is opened read-only, so the node has to be stopped or the tool pointed to a copy of the db.
+// To use, run "make build-votesimulator" and point it to the node's config, e.g.
+// votesimulator -config-path=config.yaml -genesis-path=genesis.yaml -duration-lg=1.5 -num-delegates=36
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	glog "log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// openDBTimeout is how long to wait for the state db to be released by another process
+const openDBTimeout = 5 * time.Second
+
+var (
+	// height is the height to load the buckets at, 0 means the tip of the state db
+	height uint64
+	// durationLg, autoStake and selfStake override genesis.VoteWeightCalConsts, 0 means unchanged
+	durationLg float64
+	autoStake  float64
+	selfStake  float64
+	// scoreThreshold and numDelegates override the genesis values, empty or 0 means unchanged
+	scoreThreshold string
+	numDelegates   uint64
+)
+
+// ranking is the result of ranking the candidates under a set of parameters
+type ranking struct {
+	cands     staking.CandidateList
+	rank      map[string]int
+	delegates map[string]bool
+}
+
+func init() {
+	flag.Uint64Var(&height, "height", 0, "Height to load the buckets at, default to the tip of the state db")
+	flag.Float64Var(&durationLg, "duration-lg", 0, "Alternative DurationLg")
+	flag.Float64Var(&autoStake, "auto-stake", 0, "Alternative AutoStake")
+	flag.Float64Var(&selfStake, "self-stake", 0, "Alternative SelfStake")
+	flag.StringVar(&scoreThreshold, "score-threshold", "", "Alternative ScoreThreshold in Rau")
+	flag.Uint64Var(&numDelegates, "num-delegates", 0, "Alternative NumDelegates")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr,
+			"usage: votesimulator -config-path=[string] -genesis-path=[string]\n -height=[uint64] -duration-lg=[float] -auto-stake=[float] -self-stake=[float] -score-threshold=[string] -num-delegates=[uint64]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func main() {
+	genesisCfg, err := genesis.New()
+	if err != nil {
+		glog.Fatalln("Failed to new genesis config.", zap.Error(err))
+	}
+	cfg, err := config.New()
+	if err != nil {
+		glog.Fatalln("Failed to new config.", zap.Error(err))
+	}
+	cfg.Genesis = genesisCfg
+	if err := simulate(cfg); err != nil {
+		log.L().Fatal("Failed to simulate.", zap.Error(err))
+	}
+}
+
+func simulate(cfg config.Config) error {
+	sr, closeDB, err := openStateReader(cfg, height)
+	if err != nil {
+		return errors.Wrap(err, "failed to open state db")
+	}
+	defer closeDB()
+	minSelfStake, ok := new(big.Int).SetString(cfg.Genesis.Staking.RegistrationConsts.MinSelfStake, 10)
+	if !ok {
+		return errors.Errorf("failed to parse min self stake %s", cfg.Genesis.Staking.RegistrationConsts.MinSelfStake)
+	}
+
+	// rank under the current genesis parameters
+	current, err := rank(sr, cfg.Genesis.Staking.VoteWeightCalConsts, minSelfStake, cfg.Genesis.ScoreThreshold, cfg.Genesis.NumDelegates)
+	if err != nil {
+		return errors.Wrap(err, "failed to rank candidates under the current parameters")
+	}
+
+	// rank under the alternative parameters
+	consts := cfg.Genesis.Staking.VoteWeightCalConsts
+	if durationLg != 0 {
+		consts.DurationLg = durationLg
+	}
+	if autoStake != 0 {
+		consts.AutoStake = autoStake
+	}
+	if selfStake != 0 {
+		consts.SelfStake = selfStake
+	}
+	threshold := cfg.Genesis.ScoreThreshold
+	if scoreThreshold != "" {
+		threshold = scoreThreshold
+	}
+	num := cfg.Genesis.NumDelegates
+	if numDelegates != 0 {
+		num = numDelegates
+	}
+	simulated, err := rank(sr, consts, minSelfStake, threshold, num)
+	if err != nil {
+		return errors.Wrap(err, "failed to rank candidates under the alternative parameters")
+	}
+
+	fmt.Printf("current:   DurationLg=%v AutoStake=%v SelfStake=%v ScoreThreshold=%s NumDelegates=%d\n",
+		cfg.Genesis.Staking.VoteWeightCalConsts.DurationLg,
+		cfg.Genesis.Staking.VoteWeightCalConsts.AutoStake,
+		cfg.Genesis.Staking.VoteWeightCalConsts.SelfStake,
+		cfg.Genesis.ScoreThreshold,
+		cfg.Genesis.NumDelegates,
+	)
+	fmt.Printf("simulated: DurationLg=%v AutoStake=%v SelfStake=%v ScoreThreshold=%s NumDelegates=%d\n\n",
+		consts.DurationLg, consts.AutoStake, consts.SelfStake, threshold, num)
+	printDiff(current, simulated)
+	return nil
+}
+
+// openStateReader opens a snapshot of the state db read from the config, and returns a reader at the given height.
+// The state db itself is only opened read-only to take the snapshot, so that the tool never writes the db of a node.
+func openStateReader(cfg config.Config, height uint64) (protocol.StateReader, func(), error) {
+	if !fileutil.FileExists(cfg.Chain.TrieDBPath) {
+		return nil, nil, errors.Errorf("state db %s does not exist", cfg.Chain.TrieDBPath)
+	}
+	dir, err := ioutil.TempDir("", "votesimulator")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		os.RemoveAll(dir)
+	}
+	snapshot := filepath.Join(dir, filepath.Base(cfg.Chain.TrieDBPath))
+	if err := snapshotDB(cfg.Chain.TrieDBPath, snapshot); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	cfg.Chain.TrieDBPath = snapshot
+	var sf factory.Factory
+	if cfg.Chain.EnableTrielessStateDB {
+		sf, err = factory.NewStateDB(cfg, factory.DefaultStateDBOption())
+	} else {
+		sf, err = factory.NewFactory(cfg, factory.DefaultTrieOption())
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if err := sf.Start(context.Background()); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	closeDB := func() {
+		if err := sf.Stop(context.Background()); err != nil {
+			log.L().Error("Failed to close state db.", zap.Error(err))
+		}
+		cleanup()
+	}
+	tip, err := sf.Height()
+	if err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+	if height == 0 || height == tip {
+		return sf, closeDB, nil
+	}
+	if height > tip {
+		closeDB()
+		return nil, nil, errors.Errorf("height %d is higher than the tip height %d of state db", height, tip)
+	}
+	return factory.NewHistoryStateReader(sf, height), closeDB, nil
+}
+
+// snapshotDB copies a consistent snapshot of the bolt db at the path into the file. The db is opened read-only, and
+// the node owning the db has to release it within the timeout, e.g., be stopped, or point the tool to a copy.
+func snapshotDB(path, file string) error {
+	db, err := bolt.Open(path, 0600, &bolt.Options{ReadOnly: true, Timeout: openDBTimeout})
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s read-only, is it in use by a node?", path)
+	}
+	defer db.Close()
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if err := db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(f)
+		return err
+	}); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to snapshot %s", path)
+	}
+	return f.Close()
+}
+
+// rank tallies the votes under the given constants and selects the delegates the same way the poll protocol does
+func rank(
+	sr protocol.StateReader,
+	consts genesis.VoteWeightCalConsts,
+	minSelfStake *big.Int,
+	scoreThreshold string,
+	numDelegates uint64,
+) (*ranking, error) {
+	threshold, ok := new(big.Int).SetString(scoreThreshold, 10)
+	if !ok {
+		return nil, errors.Errorf("failed to parse score threshold %s", scoreThreshold)
+	}
+	list, err := staking.TallyCandidateVotes(sr, consts)
+	if err != nil {
+		return nil, err
+	}
+	r := &ranking{
+		rank:      make(map[string]int),
+		delegates: make(map[string]bool),
+	}
+	for _, cand := range list {
+		if cand.SelfStake.Cmp(minSelfStake) < 0 || cand.Votes.Cmp(threshold) < 0 {
+			continue
+		}
+		r.cands = append(r.cands, cand)
+		r.rank[cand.Name] = len(r.cands)
+		if uint64(len(r.cands)) <= numDelegates {
+			r.delegates[cand.Name] = true
+		}
+	}
+	return r, nil
+}
+
+func printDiff(current, simulated *ranking) {
+	votes := make(map[string]*big.Int)
+	for _, cand := range current.cands {
+		votes[cand.Name] = cand.Votes
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RANK\tNAME\tVOTES\tCURRENT RANK\tCURRENT VOTES\tCHANGE")
+	for i, cand := range simulated.cands {
+		prevRank, prevVotes := "-", "-"
+		if r, ok := current.rank[cand.Name]; ok {
+			prevRank = fmt.Sprintf("%d", r)
+			prevVotes = votes[cand.Name].String()
+		}
+		change := ""
+		switch {
+		case simulated.delegates[cand.Name] && !current.delegates[cand.Name]:
+			change = "joins delegates"
+		case !simulated.delegates[cand.Name] && current.delegates[cand.Name]:
+			change = "leaves delegates"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", i+1, cand.Name, cand.Votes, prevRank, prevVotes, change)
+	}
+	// candidates that fall below the score threshold or self-stake requirement
+	for _, cand := range current.cands {
+		if _, ok := simulated.rank[cand.Name]; ok {
+			continue
+		}
+		change := "below threshold"
+		if current.delegates[cand.Name] {
+			change = "leaves delegates, below threshold"
+		}
+		fmt.Fprintf(w, "-\t%s\t-\t%d\t%s\t%s\n", cand.Name, current.rank[cand.Name], cand.Votes, change)
+	}
+	if err := w.Flush(); err != nil {
+		log.L().Error("Failed to print the result.", zap.Error(err))
+	}
+}